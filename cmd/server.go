@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor"
 	shared "github.com/cristiano-pacheco/pingo/internal/shared/modules"
 	"github.com/spf13/cobra"
 	"go.uber.org/fx"
@@ -14,6 +15,7 @@ var serverCmd = &cobra.Command{
 		app := fx.New(
 			shared.Module,
 			identity.Module,
+			monitor.Module,
 		)
 		app.Run()
 	},
@@ -0,0 +1,36 @@
+package enum
+
+import (
+	"net/http"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+)
+
+const (
+	APIKeyScopeRead  = "read"
+	APIKeyScopeWrite = "write"
+)
+
+type APIKeyScopeEnum struct {
+	value string
+}
+
+func NewAPIKeyScopeEnum(value string) (APIKeyScopeEnum, error) {
+	if value != APIKeyScopeRead && value != APIKeyScopeWrite {
+		return APIKeyScopeEnum{}, errs.ErrInvalidAPIKeyScope
+	}
+	return APIKeyScopeEnum{value: value}, nil
+}
+
+func (e APIKeyScopeEnum) String() string {
+	return e.value
+}
+
+// AllowsMethod reports whether a request with the given HTTP method can be made with the scope,
+// read keys are limited to the methods that do not change anything.
+func (e APIKeyScopeEnum) AllowsMethod(method string) bool {
+	if e.value == APIKeyScopeWrite {
+		return true
+	}
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
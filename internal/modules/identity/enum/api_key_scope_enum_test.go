@@ -0,0 +1,48 @@
+package enum_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+)
+
+func TestNewAPIKeyScopeEnum_ValidScopes_ReturnsEnum(t *testing.T) {
+	scopes := []string{enum.APIKeyScopeRead, enum.APIKeyScopeWrite}
+
+	for _, scope := range scopes {
+		t.Run(scope, func(t *testing.T) {
+			// Act
+			s, err := enum.NewAPIKeyScopeEnum(scope)
+			// Assert
+			require.NoError(t, err)
+			require.Equal(t, scope, s.String())
+		})
+	}
+}
+
+func TestNewAPIKeyScopeEnum_InvalidScope_ReturnsError(t *testing.T) {
+	// Arrange
+	invalid := "admin"
+	// Act
+	_, err := enum.NewAPIKeyScopeEnum(invalid)
+	// Assert
+	require.ErrorIs(t, err, errs.ErrInvalidAPIKeyScope)
+}
+
+func TestAPIKeyScopeEnum_AllowsMethod(t *testing.T) {
+	// Arrange
+	read, _ := enum.NewAPIKeyScopeEnum(enum.APIKeyScopeRead)
+	write, _ := enum.NewAPIKeyScopeEnum(enum.APIKeyScopeWrite)
+
+	// Act & Assert
+	require.True(t, read.AllowsMethod(http.MethodGet))
+	require.True(t, read.AllowsMethod(http.MethodHead))
+	require.False(t, read.AllowsMethod(http.MethodPost))
+	require.False(t, read.AllowsMethod(http.MethodDelete))
+	require.True(t, write.AllowsMethod(http.MethodGet))
+	require.True(t, write.AllowsMethod(http.MethodPut))
+}
@@ -0,0 +1,40 @@
+package enum
+
+import (
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+)
+
+const (
+	OrganizationRoleOwner  = "owner"
+	OrganizationRoleAdmin  = "admin"
+	OrganizationRoleEditor = "editor"
+	OrganizationRoleViewer = "viewer"
+)
+
+// organizationRoleRanks orders the roles, a role is granted everything a lower one is.
+var organizationRoleRanks = map[string]int{
+	OrganizationRoleViewer: 1,
+	OrganizationRoleEditor: 2,
+	OrganizationRoleAdmin:  3,
+	OrganizationRoleOwner:  4,
+}
+
+type OrganizationRoleEnum struct {
+	value string
+}
+
+func NewOrganizationRoleEnum(value string) (OrganizationRoleEnum, error) {
+	if _, ok := organizationRoleRanks[value]; !ok {
+		return OrganizationRoleEnum{}, errs.ErrInvalidOrganizationRole
+	}
+	return OrganizationRoleEnum{value: value}, nil
+}
+
+func (e OrganizationRoleEnum) String() string {
+	return e.value
+}
+
+// Includes reports whether the role grants at least the permissions of the other role.
+func (e OrganizationRoleEnum) Includes(other OrganizationRoleEnum) bool {
+	return organizationRoleRanks[e.value] >= organizationRoleRanks[other.value]
+}
@@ -0,0 +1,54 @@
+package enum_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+)
+
+func TestNewOrganizationRoleEnum_ValidRoles_ReturnsEnum(t *testing.T) {
+	roles := []string{
+		enum.OrganizationRoleOwner,
+		enum.OrganizationRoleAdmin,
+		enum.OrganizationRoleEditor,
+		enum.OrganizationRoleViewer,
+	}
+
+	for _, role := range roles {
+		t.Run(role, func(t *testing.T) {
+			// Act
+			r, err := enum.NewOrganizationRoleEnum(role)
+			// Assert
+			require.NoError(t, err)
+			require.Equal(t, role, r.String())
+		})
+	}
+}
+
+func TestNewOrganizationRoleEnum_InvalidRole_ReturnsError(t *testing.T) {
+	// Arrange
+	invalid := "guest"
+	// Act
+	_, err := enum.NewOrganizationRoleEnum(invalid)
+	// Assert
+	require.ErrorIs(t, err, errs.ErrInvalidOrganizationRole)
+}
+
+func TestOrganizationRoleEnum_Includes(t *testing.T) {
+	// Arrange
+	owner, _ := enum.NewOrganizationRoleEnum(enum.OrganizationRoleOwner)
+	admin, _ := enum.NewOrganizationRoleEnum(enum.OrganizationRoleAdmin)
+	editor, _ := enum.NewOrganizationRoleEnum(enum.OrganizationRoleEditor)
+	viewer, _ := enum.NewOrganizationRoleEnum(enum.OrganizationRoleViewer)
+
+	// Act & Assert
+	require.True(t, owner.Includes(admin))
+	require.True(t, admin.Includes(editor))
+	require.True(t, editor.Includes(editor))
+	require.True(t, editor.Includes(viewer))
+	require.False(t, viewer.Includes(editor))
+	require.False(t, admin.Includes(owner))
+}
@@ -5,9 +5,12 @@ import (
 )
 
 const (
-	TokenTypeAccountConfirmation = "account_confirmation"
-	TokenTypeLoginVerification   = "login_verification" // #nosec G101 -- false positive: enum token type, not credentials
-	TokenTypeResetPassword       = "reset_password"
+	TokenTypeAccountConfirmation    = "account_confirmation"
+	TokenTypeLoginVerification      = "login_verification" // #nosec G101 -- false positive: enum token type, not credentials
+	TokenTypeResetPassword          = "reset_password"
+	TokenTypeOrganizationInvitation = "organization_invitation"
+	TokenTypeMagicLink              = "magic_link"
+	TokenTypeLoginChallenge         = "login_challenge"
 )
 
 type TokenTypeEnum struct {
@@ -17,7 +20,10 @@ type TokenTypeEnum struct {
 func NewTokenTypeEnum(value string) (TokenTypeEnum, error) {
 	if value != TokenTypeLoginVerification &&
 		value != TokenTypeResetPassword &&
-		value != TokenTypeAccountConfirmation {
+		value != TokenTypeAccountConfirmation &&
+		value != TokenTypeOrganizationInvitation &&
+		value != TokenTypeMagicLink &&
+		value != TokenTypeLoginChallenge {
 		return TokenTypeEnum{}, errs.ErrInvalidTokenType
 	}
 	return TokenTypeEnum{value: value}, nil
@@ -46,6 +46,42 @@ func TestNewTokenTypeEnum(t *testing.T) {
 		require.Equal(t, value, result.String())
 	})
 
+	t.Run("ValidOrganizationInvitationToken_ReturnsValidEnum", func(t *testing.T) {
+		// Arrange
+		value := enum.TokenTypeOrganizationInvitation
+
+		// Act
+		result, err := enum.NewTokenTypeEnum(value)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, value, result.String())
+	})
+
+	t.Run("ValidMagicLinkToken_ReturnsValidEnum", func(t *testing.T) {
+		// Arrange
+		value := enum.TokenTypeMagicLink
+
+		// Act
+		result, err := enum.NewTokenTypeEnum(value)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, value, result.String())
+	})
+
+	t.Run("ValidLoginChallengeToken_ReturnsValidEnum", func(t *testing.T) {
+		// Arrange
+		value := enum.TokenTypeLoginChallenge
+
+		// Act
+		result, err := enum.NewTokenTypeEnum(value)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, value, result.String())
+	})
+
 	t.Run("InvalidTokenType_ReturnsError", func(t *testing.T) {
 		// Arrange
 		value := "invalid_token_type"
@@ -48,7 +48,99 @@ var (
 		http.StatusBadRequest,
 		nil,
 	)
-	ErrUserNotFound           = errs.New("IDENTITY_12", "User not found", http.StatusNotFound, nil)
-	ErrInvalidTokenType       = errs.New("IDENTITY_13", "Invalid token type", http.StatusBadRequest, nil)
-	ErrUserNotInPendingStatus = errs.New("IDENTITY_14", "User is not in pending status", http.StatusBadRequest, nil)
+	ErrUserNotFound             = errs.New("IDENTITY_12", "User not found", http.StatusNotFound, nil)
+	ErrInvalidTokenType         = errs.New("IDENTITY_13", "Invalid token type", http.StatusBadRequest, nil)
+	ErrUserNotInPendingStatus   = errs.New("IDENTITY_14", "User is not in pending status", http.StatusBadRequest, nil)
+	ErrInvalidOrganizationRole  = errs.New("IDENTITY_15", "Invalid organization role", http.StatusBadRequest, nil)
+	ErrOrganizationAccessDenied = errs.New(
+		"IDENTITY_16",
+		"The organization role does not allow this action",
+		http.StatusForbidden,
+		nil,
+	)
+	ErrInvalidOrganizationInvitationToken = errs.New(
+		"IDENTITY_17",
+		"Invalid organization invitation token",
+		http.StatusBadRequest,
+		nil,
+	)
+	ErrAlreadyOrganizationMember = errs.New(
+		"IDENTITY_18",
+		"User is already a member of the organization",
+		http.StatusBadRequest,
+		nil,
+	)
+	ErrLastOrganizationOwner = errs.New(
+		"IDENTITY_19",
+		"The organization must keep at least one owner",
+		http.StatusBadRequest,
+		nil,
+	)
+	ErrInvalidOrganizationID = errs.New("IDENTITY_20", "Invalid organization ID", http.StatusBadRequest, nil)
+	ErrInvalidAPIKeyScope    = errs.New("IDENTITY_21", "Invalid API key scope", http.StatusBadRequest, nil)
+	ErrInvalidAPIKey         = errs.New("IDENTITY_22", "Invalid API key", http.StatusUnauthorized, nil)
+	ErrAPIKeyScopeDenied     = errs.New(
+		"IDENTITY_23",
+		"The API key scope does not allow this action",
+		http.StatusForbidden,
+		nil,
+	)
+	ErrInvalidAPIKeyExpiration = errs.New(
+		"IDENTITY_24",
+		"The API key expiration must be in the future",
+		http.StatusBadRequest,
+		nil,
+	)
+	ErrInvalidRefreshToken       = errs.New("IDENTITY_25", "Invalid refresh token", http.StatusUnauthorized, nil)
+	ErrInvalidPasswordResetToken = errs.New(
+		"IDENTITY_26",
+		"Invalid or expired password reset token",
+		http.StatusBadRequest,
+		nil,
+	)
+	ErrPasswordLoginDisabled = errs.New(
+		"IDENTITY_27",
+		"Password login is disabled, sign in with a magic link",
+		http.StatusForbidden,
+		nil,
+	)
+	ErrMagicLinkLoginDisabled = errs.New("IDENTITY_28", "Magic link login is disabled", http.StatusForbidden, nil)
+	ErrInvalidMagicLinkToken  = errs.New(
+		"IDENTITY_29",
+		"Invalid or expired magic link",
+		http.StatusUnauthorized,
+		nil,
+	)
+	ErrTOTPNotConfigured = errs.New(
+		"IDENTITY_30",
+		"Authenticator apps are not enabled on this server",
+		http.StatusServiceUnavailable,
+		nil,
+	)
+	ErrTOTPAlreadyEnrolled = errs.New(
+		"IDENTITY_31",
+		"An authenticator app is already enrolled",
+		http.StatusBadRequest,
+		nil,
+	)
+	ErrTOTPNotEnrolled  = errs.New("IDENTITY_32", "No authenticator app is enrolled", http.StatusBadRequest, nil)
+	ErrInvalidTOTPCode  = errs.New("IDENTITY_33", "Invalid authentication code", http.StatusBadRequest, nil)
+	ErrAPIKeyNotAllowed = errs.New(
+		"IDENTITY_34",
+		"API keys can't be used for this action, sign in instead",
+		http.StatusForbidden,
+		nil,
+	)
+	ErrInvalidLoginChallenge = errs.New(
+		"IDENTITY_35",
+		"Invalid or expired login challenge, sign in again",
+		http.StatusUnauthorized,
+		nil,
+	)
+	ErrTOTPLocked = errs.New(
+		"IDENTITY_36",
+		"Too many invalid authentication codes, try again later",
+		http.StatusTooManyRequests,
+		nil,
+	)
 )
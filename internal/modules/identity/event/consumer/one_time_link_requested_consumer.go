@@ -0,0 +1,85 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/event"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/repository"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+)
+
+type OneTimeLinkRequestedConsumer struct {
+	oneTimeLinkService service.OneTimeLinkServiceI
+	userRepository     repository.UserRepositoryI
+	logger             logger.Logger
+}
+
+func NewOneTimeLinkRequestedConsumer(
+	oneTimeLinkService service.OneTimeLinkServiceI,
+	userRepository repository.UserRepositoryI,
+	logger logger.Logger,
+) *OneTimeLinkRequestedConsumer {
+	return &OneTimeLinkRequestedConsumer{
+		oneTimeLinkService: oneTimeLinkService,
+		userRepository:     userRepository,
+		logger:             logger,
+	}
+}
+
+func (c *OneTimeLinkRequestedConsumer) Topic() string {
+	return event.IdentityOneTimeLinkRequestedTopic
+}
+
+func (c *OneTimeLinkRequestedConsumer) GroupID() string {
+	return "default"
+}
+
+func (c *OneTimeLinkRequestedConsumer) ProcessMessage(ctx context.Context, message broker.Message) error {
+	ctx, span := trace.Span(ctx, "OneTimeLinkRequestedConsumer.ProcessMessage")
+	defer span.End()
+
+	var oneTimeLinkRequestedMessage event.OneTimeLinkRequestedMessage
+	if err := json.Unmarshal(message.Value, &oneTimeLinkRequestedMessage); err != nil {
+		c.logger.Error().Msgf("error unmarshaling message: %v", err)
+		return broker.NonRetryable(err)
+	}
+
+	if oneTimeLinkRequestedMessage.UserID == 0 {
+		c.logger.Error().Msg("invalid user ID")
+		return broker.NonRetryable(errors.New("invalid user ID"))
+	}
+
+	link, ok := service.OneTimeLinkByTokenType(oneTimeLinkRequestedMessage.TokenType)
+	if !ok {
+		c.logger.Error().Msgf("invalid token type %q", oneTimeLinkRequestedMessage.TokenType)
+		return broker.NonRetryable(fmt.Errorf("invalid token type %q", oneTimeLinkRequestedMessage.TokenType))
+	}
+
+	user, err := c.userRepository.FindByID(ctx, oneTimeLinkRequestedMessage.UserID)
+	if err != nil {
+		c.logger.Error().Msgf("error finding user by ID %d: %v", oneTimeLinkRequestedMessage.UserID, err)
+		return err
+	}
+
+	// the user may have been blocked since the link was requested
+	if user.Status != enum.UserStatusActive {
+		c.logger.Info().Msgf("skipping %s link of the inactive user ID %d", link.TokenType, user.ID)
+		return nil
+	}
+
+	if err = c.oneTimeLinkService.Send(ctx, user, link); err != nil {
+		c.logger.Error().Msgf("error sending %s link for user ID %d: %v", link.TokenType, user.ID, err)
+		return err
+	}
+
+	c.logger.Info().
+		Msgf("Successfully processed %s link requested event for user ID: %d", link.TokenType, user.ID)
+	return nil
+}
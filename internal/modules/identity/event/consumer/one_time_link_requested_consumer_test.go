@@ -0,0 +1,144 @@
+package consumer_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/event"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/event/consumer"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/repository/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+	service_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/service/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+)
+
+type OneTimeLinkRequestedConsumerTestSuite struct {
+	suite.Suite
+	sut                *consumer.OneTimeLinkRequestedConsumer
+	oneTimeLinkService *service_mocks.MockOneTimeLinkServiceI
+	userRepository     *repository_mocks.MockUserRepositoryI
+	logger             logger.Logger
+}
+
+func (s *OneTimeLinkRequestedConsumerTestSuite) SetupTest() {
+	s.oneTimeLinkService = service_mocks.NewMockOneTimeLinkServiceI(s.T())
+	s.userRepository = repository_mocks.NewMockUserRepositoryI(s.T())
+	s.logger = logger.New(config.Config{Log: config.Log{LogLevel: "disabled"}})
+
+	s.sut = consumer.NewOneTimeLinkRequestedConsumer(
+		s.oneTimeLinkService,
+		s.userRepository,
+		s.logger,
+	)
+}
+
+func TestOneTimeLinkRequestedConsumerSuite(t *testing.T) {
+	suite.Run(t, new(OneTimeLinkRequestedConsumerTestSuite))
+}
+
+func (s *OneTimeLinkRequestedConsumerTestSuite) newMessage(userID uint64, tokenType string) broker.Message {
+	messageBytes, err := json.Marshal(event.OneTimeLinkRequestedMessage{UserID: userID, TokenType: tokenType})
+	s.Require().NoError(err)
+	return broker.Message{Value: messageBytes}
+}
+
+func (s *OneTimeLinkRequestedConsumerTestSuite) TestTopic_ReturnsCorrectTopic() {
+	// Arrange
+	expectedTopic := event.IdentityOneTimeLinkRequestedTopic
+
+	// Act
+	result := s.sut.Topic()
+
+	// Assert
+	s.Equal(expectedTopic, result)
+}
+
+func (s *OneTimeLinkRequestedConsumerTestSuite) TestProcessMessage_ActiveUser_SendsTheLinkOfTheTokenType() {
+	// Arrange
+	ctx := context.Background()
+	user := model.UserModel{ID: 7, Email: "jane@example.com", Status: enum.UserStatusActive}
+	cases := []struct {
+		tokenType string
+		link      service.OneTimeLink
+	}{
+		{tokenType: enum.TokenTypeResetPassword, link: service.PasswordResetLink},
+		{tokenType: enum.TokenTypeMagicLink, link: service.MagicLink},
+	}
+
+	s.userRepository.On("FindByID", mock.Anything, user.ID).Return(user, nil)
+
+	for _, tc := range cases {
+		s.oneTimeLinkService.On("Send", mock.Anything, user, tc.link).Return(nil).Once()
+
+		// Act
+		err := s.sut.ProcessMessage(ctx, s.newMessage(user.ID, tc.tokenType))
+
+		// Assert
+		s.Require().NoError(err, tc.tokenType)
+	}
+}
+
+func (s *OneTimeLinkRequestedConsumerTestSuite) TestProcessMessage_InactiveUser_SkipsTheLink() {
+	// Arrange
+	ctx := context.Background()
+	user := model.UserModel{ID: 7, Status: enum.UserStatusSuspended}
+
+	s.userRepository.On("FindByID", mock.Anything, user.ID).Return(user, nil)
+
+	// Act
+	err := s.sut.ProcessMessage(ctx, s.newMessage(user.ID, enum.TokenTypeMagicLink))
+
+	// Assert
+	s.Require().NoError(err)
+	s.oneTimeLinkService.AssertNotCalled(s.T(), "Send", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *OneTimeLinkRequestedConsumerTestSuite) TestProcessMessage_UnknownTokenType_ReturnsNonRetryableError() {
+	// Arrange
+	ctx := context.Background()
+
+	// Act
+	err := s.sut.ProcessMessage(ctx, s.newMessage(7, enum.TokenTypeAccountConfirmation))
+
+	// Assert
+	s.Require().Error(err)
+	s.True(broker.IsNonRetryable(err))
+}
+
+func (s *OneTimeLinkRequestedConsumerTestSuite) TestProcessMessage_InvalidMessageFormat_ReturnsNonRetryableError() {
+	// Arrange
+	ctx := context.Background()
+	message := broker.Message{Value: []byte("invalid-json")}
+
+	// Act
+	err := s.sut.ProcessMessage(ctx, message)
+
+	// Assert
+	s.Require().Error(err)
+	s.True(broker.IsNonRetryable(err))
+}
+
+func (s *OneTimeLinkRequestedConsumerTestSuite) TestProcessMessage_SendError_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	user := model.UserModel{ID: 7, Status: enum.UserStatusActive}
+	sendErr := errors.New("smtp error")
+
+	s.userRepository.On("FindByID", mock.Anything, user.ID).Return(user, nil)
+	s.oneTimeLinkService.On("Send", mock.Anything, user, service.PasswordResetLink).Return(sendErr)
+
+	// Act
+	err := s.sut.ProcessMessage(ctx, s.newMessage(user.ID, enum.TokenTypeResetPassword))
+
+	// Assert
+	s.Require().ErrorIs(err, sendErr)
+}
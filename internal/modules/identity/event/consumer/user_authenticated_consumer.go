@@ -17,7 +17,7 @@ import (
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
 	"github.com/cristiano-pacheco/pingo/internal/shared/errs"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
-	"github.com/cristiano-pacheco/pingo/pkg/kafka"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
 )
 
 const (
@@ -57,14 +57,14 @@ func (c *UserAuthenticatedConsumer) GroupID() string {
 	return "default"
 }
 
-func (c *UserAuthenticatedConsumer) ProcessMessage(ctx context.Context, message kafka.Message) error {
+func (c *UserAuthenticatedConsumer) ProcessMessage(ctx context.Context, message broker.Message) error {
 	ctx, span := trace.Span(ctx, "AuthLoginUseCase.Execute")
 	defer span.End()
 
 	var userAuthenticatedMessage event.UserAuthenticatedMessage
 	if err := json.Unmarshal(message.Value, &userAuthenticatedMessage); err != nil {
 		c.logger.Error().Msgf("error unmarshaling message: %v", err)
-		return err
+		return broker.NonRetryable(err)
 	}
 
 	user, err := c.userRepository.FindByID(ctx, userAuthenticatedMessage.UserID)
@@ -19,7 +19,7 @@ import (
 	"github.com/cristiano-pacheco/pingo/internal/shared/errs"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
-	"github.com/cristiano-pacheco/pingo/pkg/kafka"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
 )
 
 type UserAuthenticatedConsumerTestSuite struct {
@@ -95,7 +95,7 @@ func (s *UserAuthenticatedConsumerTestSuite) TestProcessMessage_ValidMessage_Pro
 	messageBytes, err := json.Marshal(userAuthenticatedMessage)
 	s.Require().NoError(err)
 
-	message := kafka.Message{
+	message := broker.Message{
 		Value: messageBytes,
 	}
 
@@ -128,7 +128,7 @@ func (s *UserAuthenticatedConsumerTestSuite) TestProcessMessage_ValidMessage_Pro
 func (s *UserAuthenticatedConsumerTestSuite) TestProcessMessage_InvalidMessageFormat_ReturnsError() {
 	// Arrange
 	ctx := context.Background()
-	invalidMessage := kafka.Message{
+	invalidMessage := broker.Message{
 		Value: []byte("invalid-json"),
 	}
 
@@ -137,6 +137,7 @@ func (s *UserAuthenticatedConsumerTestSuite) TestProcessMessage_InvalidMessageFo
 
 	// Assert
 	s.Require().Error(err)
+	s.True(broker.IsNonRetryable(err))
 }
 
 func (s *UserAuthenticatedConsumerTestSuite) TestProcessMessage_UserNotFound_ReturnsError() {
@@ -150,7 +151,7 @@ func (s *UserAuthenticatedConsumerTestSuite) TestProcessMessage_UserNotFound_Ret
 	messageBytes, err := json.Marshal(userAuthenticatedMessage)
 	s.Require().NoError(err)
 
-	message := kafka.Message{
+	message := broker.Message{
 		Value: messageBytes,
 	}
 
@@ -178,7 +179,7 @@ func (s *UserAuthenticatedConsumerTestSuite) TestProcessMessage_DeleteTokenRepos
 	messageBytes, err := json.Marshal(userAuthenticatedMessage)
 	s.Require().NoError(err)
 
-	message := kafka.Message{
+	message := broker.Message{
 		Value: messageBytes,
 	}
 
@@ -215,7 +216,7 @@ func (s *UserAuthenticatedConsumerTestSuite) TestProcessMessage_DeleteTokenNotFo
 	messageBytes, err := json.Marshal(userAuthenticatedMessage)
 	s.Require().NoError(err)
 
-	message := kafka.Message{
+	message := broker.Message{
 		Value: messageBytes,
 	}
 
@@ -256,7 +257,7 @@ func (s *UserAuthenticatedConsumerTestSuite) TestProcessMessage_HashServiceError
 	messageBytes, err := json.Marshal(userAuthenticatedMessage)
 	s.Require().NoError(err)
 
-	message := kafka.Message{
+	message := broker.Message{
 		Value: messageBytes,
 	}
 
@@ -294,7 +295,7 @@ func (s *UserAuthenticatedConsumerTestSuite) TestProcessMessage_CreateTokenError
 	messageBytes, err := json.Marshal(userAuthenticatedMessage)
 	s.Require().NoError(err)
 
-	message := kafka.Message{
+	message := broker.Message{
 		Value: messageBytes,
 	}
 
@@ -335,7 +336,7 @@ func (s *UserAuthenticatedConsumerTestSuite) TestProcessMessage_SendEmailError_R
 	messageBytes, err := json.Marshal(userAuthenticatedMessage)
 	s.Require().NoError(err)
 
-	message := kafka.Message{
+	message := broker.Message{
 		Value: messageBytes,
 	}
 
@@ -378,7 +379,7 @@ func (s *UserAuthenticatedConsumerTestSuite) TestProcessMessage_GeneratesValidVe
 	messageBytes, err := json.Marshal(userAuthenticatedMessage)
 	s.Require().NoError(err)
 
-	message := kafka.Message{
+	message := broker.Message{
 		Value: messageBytes,
 	}
 
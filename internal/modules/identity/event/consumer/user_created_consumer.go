@@ -13,7 +13,7 @@ import (
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/repository"
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
-	"github.com/cristiano-pacheco/pingo/pkg/kafka"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
 )
 
 const accountConfirmationTokenExpiration = 24 * time.Hour
@@ -50,19 +50,19 @@ func (c *UserCreatedConsumer) GroupID() string {
 	return "default"
 }
 
-func (c *UserCreatedConsumer) ProcessMessage(ctx context.Context, message kafka.Message) error {
+func (c *UserCreatedConsumer) ProcessMessage(ctx context.Context, message broker.Message) error {
 	ctx, span := trace.Span(ctx, "UserCreatedConsumer.ProcessMessage")
 	defer span.End()
 
 	var userCreatedMessage event.UserCreatedMessage
 	if err := json.Unmarshal(message.Value, &userCreatedMessage); err != nil {
 		c.logger.Error().Msgf("error unmarshaling message: %v", err)
-		return err
+		return broker.NonRetryable(err)
 	}
 
 	if userCreatedMessage.UserID == 0 {
 		c.logger.Error().Msg("invalid user ID")
-		return errors.New("invalid user ID")
+		return broker.NonRetryable(errors.New("invalid user ID"))
 	}
 
 	user, err := c.userRepository.FindByID(ctx, userCreatedMessage.UserID)
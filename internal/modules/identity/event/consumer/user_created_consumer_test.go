@@ -17,7 +17,7 @@ import (
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
 
-	"github.com/cristiano-pacheco/pingo/pkg/kafka"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
@@ -89,7 +89,7 @@ func (s *UserCreatedConsumerTestSuite) TestProcessMessage_ValidMessage_Processes
 	messageBytes, err := json.Marshal(userCreatedMessage)
 	s.Require().NoError(err)
 
-	message := kafka.Message{
+	message := broker.Message{
 		Value: messageBytes,
 	}
 
@@ -147,7 +147,7 @@ func (s *UserCreatedConsumerTestSuite) TestProcessMessage_ValidMessage_Processes
 func (s *UserCreatedConsumerTestSuite) TestProcessMessage_InvalidJSON_ReturnsError() {
 	// Arrange
 	ctx := context.Background()
-	message := kafka.Message{
+	message := broker.Message{
 		Value: []byte("invalid-json"),
 	}
 
@@ -156,6 +156,7 @@ func (s *UserCreatedConsumerTestSuite) TestProcessMessage_InvalidJSON_ReturnsErr
 
 	// Assert
 	s.Require().Error(err)
+	s.True(broker.IsNonRetryable(err))
 }
 
 func (s *UserCreatedConsumerTestSuite) TestProcessMessage_ZeroUserID_ReturnsError() {
@@ -168,7 +169,7 @@ func (s *UserCreatedConsumerTestSuite) TestProcessMessage_ZeroUserID_ReturnsErro
 	messageBytes, err := json.Marshal(userCreatedMessage)
 	s.Require().NoError(err)
 
-	message := kafka.Message{
+	message := broker.Message{
 		Value: messageBytes,
 	}
 
@@ -178,6 +179,7 @@ func (s *UserCreatedConsumerTestSuite) TestProcessMessage_ZeroUserID_ReturnsErro
 	// Assert
 	s.Require().Error(err)
 	s.Contains(err.Error(), "invalid user ID")
+	s.True(broker.IsNonRetryable(err))
 }
 
 func (s *UserCreatedConsumerTestSuite) TestProcessMessage_UserNotFound_ReturnsError() {
@@ -191,7 +193,7 @@ func (s *UserCreatedConsumerTestSuite) TestProcessMessage_UserNotFound_ReturnsEr
 	messageBytes, err := json.Marshal(userCreatedMessage)
 	s.Require().NoError(err)
 
-	message := kafka.Message{
+	message := broker.Message{
 		Value: messageBytes,
 	}
 
@@ -220,7 +222,7 @@ func (s *UserCreatedConsumerTestSuite) TestProcessMessage_HashServiceFails_Retur
 	messageBytes, err := json.Marshal(userCreatedMessage)
 	s.Require().NoError(err)
 
-	message := kafka.Message{
+	message := broker.Message{
 		Value: messageBytes,
 	}
 
@@ -258,7 +260,7 @@ func (s *UserCreatedConsumerTestSuite) TestProcessMessage_OneTimeTokenCreationFa
 	messageBytes, err := json.Marshal(userCreatedMessage)
 	s.Require().NoError(err)
 
-	message := kafka.Message{
+	message := broker.Message{
 		Value: messageBytes,
 	}
 
@@ -300,7 +302,7 @@ func (s *UserCreatedConsumerTestSuite) TestProcessMessage_SendEmailConfirmationF
 	messageBytes, err := json.Marshal(userCreatedMessage)
 	s.Require().NoError(err)
 
-	message := kafka.Message{
+	message := broker.Message{
 		Value: messageBytes,
 	}
 
@@ -0,0 +1,12 @@
+package event
+
+const (
+	IdentityOneTimeLinkRequestedTopic = "identity.one_time_link.requested"
+)
+
+// OneTimeLinkRequestedMessage requests the email of a one-time link, TokenType tells which one:
+// a password reset or a magic link.
+type OneTimeLinkRequestedMessage struct {
+	UserID    uint64 `json:"user_id"`
+	TokenType string `json:"token_type"`
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	event "github.com/cristiano-pacheco/pingo/internal/modules/identity/event"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockOneTimeLinkRequestedProducerI is an autogenerated mock type for the OneTimeLinkRequestedProducerI type
+type MockOneTimeLinkRequestedProducerI struct {
+	mock.Mock
+}
+
+type MockOneTimeLinkRequestedProducerI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOneTimeLinkRequestedProducerI) EXPECT() *MockOneTimeLinkRequestedProducerI_Expecter {
+	return &MockOneTimeLinkRequestedProducerI_Expecter{mock: &_m.Mock}
+}
+
+// Produce provides a mock function with given fields: ctx, message
+func (_m *MockOneTimeLinkRequestedProducerI) Produce(ctx context.Context, message event.OneTimeLinkRequestedMessage) error {
+	ret := _m.Called(ctx, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Produce")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, event.OneTimeLinkRequestedMessage) error); ok {
+		r0 = rf(ctx, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockOneTimeLinkRequestedProducerI_Produce_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Produce'
+type MockOneTimeLinkRequestedProducerI_Produce_Call struct {
+	*mock.Call
+}
+
+// Produce is a helper method to define mock.On call
+//   - ctx context.Context
+//   - message event.OneTimeLinkRequestedMessage
+func (_e *MockOneTimeLinkRequestedProducerI_Expecter) Produce(ctx interface{}, message interface{}) *MockOneTimeLinkRequestedProducerI_Produce_Call {
+	return &MockOneTimeLinkRequestedProducerI_Produce_Call{Call: _e.mock.On("Produce", ctx, message)}
+}
+
+func (_c *MockOneTimeLinkRequestedProducerI_Produce_Call) Run(run func(ctx context.Context, message event.OneTimeLinkRequestedMessage)) *MockOneTimeLinkRequestedProducerI_Produce_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(event.OneTimeLinkRequestedMessage))
+	})
+	return _c
+}
+
+func (_c *MockOneTimeLinkRequestedProducerI_Produce_Call) Return(_a0 error) *MockOneTimeLinkRequestedProducerI_Produce_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockOneTimeLinkRequestedProducerI_Produce_Call) RunAndReturn(run func(context.Context, event.OneTimeLinkRequestedMessage) error) *MockOneTimeLinkRequestedProducerI_Produce_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockOneTimeLinkRequestedProducerI creates a new instance of MockOneTimeLinkRequestedProducerI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOneTimeLinkRequestedProducerI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOneTimeLinkRequestedProducerI {
+	mock := &MockOneTimeLinkRequestedProducerI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,44 @@
+package producer
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/event"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/outbox"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+)
+
+type OneTimeLinkRequestedProducerI interface {
+	Produce(ctx context.Context, message event.OneTimeLinkRequestedMessage) error
+}
+
+type OneTimeLinkRequestedProducer struct {
+	outboxPublisher outbox.PublisherI
+}
+
+var _ OneTimeLinkRequestedProducerI = (*OneTimeLinkRequestedProducer)(nil)
+
+func NewOneTimeLinkRequestedProducer(outboxPublisher outbox.PublisherI) *OneTimeLinkRequestedProducer {
+	return &OneTimeLinkRequestedProducer{
+		outboxPublisher: outboxPublisher,
+	}
+}
+
+// Produce publishes the message through the outbox, it reaches the broker once the transaction
+// carried by ctx, if any, commits.
+func (p *OneTimeLinkRequestedProducer) Produce(
+	ctx context.Context,
+	message event.OneTimeLinkRequestedMessage,
+) error {
+	ctx, span := trace.Span(ctx, "OneTimeLinkRequestedProducer.Produce")
+	defer span.End()
+
+	msg, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	m := broker.Message{Topic: event.IdentityOneTimeLinkRequestedTopic, Value: msg}
+	return p.outboxPublisher.Publish(ctx, m)
+}
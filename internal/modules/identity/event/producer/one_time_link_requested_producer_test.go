@@ -0,0 +1,69 @@
+package producer_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/event"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/event/producer"
+	outbox_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/outbox/mocks"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type OneTimeLinkRequestedProducerTestSuite struct {
+	suite.Suite
+	sut                 *producer.OneTimeLinkRequestedProducer
+	outboxPublisherMock *outbox_mocks.MockPublisherI
+}
+
+func (s *OneTimeLinkRequestedProducerTestSuite) SetupTest() {
+	s.outboxPublisherMock = outbox_mocks.NewMockPublisherI(s.T())
+
+	s.sut = producer.NewOneTimeLinkRequestedProducer(s.outboxPublisherMock)
+}
+
+func TestOneTimeLinkRequestedProducerSuite(t *testing.T) {
+	suite.Run(t, new(OneTimeLinkRequestedProducerTestSuite))
+}
+
+func (s *OneTimeLinkRequestedProducerTestSuite) TestProduce_ValidMessage_ProducesSuccessfully() {
+	// Arrange
+	ctx := context.Background()
+	message := event.OneTimeLinkRequestedMessage{UserID: 123, TokenType: enum.TokenTypeMagicLink}
+
+	expectedMessageBytes, err := json.Marshal(message)
+	s.Require().NoError(err)
+
+	expectedMessage := broker.Message{
+		Topic: event.IdentityOneTimeLinkRequestedTopic,
+		Value: expectedMessageBytes,
+	}
+
+	s.outboxPublisherMock.On("Publish", mock.Anything, expectedMessage).Return(nil)
+
+	// Act
+	err = s.sut.Produce(ctx, message)
+
+	// Assert
+	s.Require().NoError(err)
+}
+
+func (s *OneTimeLinkRequestedProducerTestSuite) TestProduce_PublisherError_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	message := event.OneTimeLinkRequestedMessage{UserID: 123, TokenType: enum.TokenTypeResetPassword}
+	publisherError := errors.New("database error")
+
+	s.outboxPublisherMock.On("Publish", mock.Anything, mock.Anything).Return(publisherError)
+
+	// Act
+	err := s.sut.Produce(ctx, message)
+
+	// Assert
+	s.Require().ErrorIs(err, publisherError)
+}
@@ -6,9 +6,8 @@ import (
 
 	"github.com/cristiano-pacheco/go-otel/trace"
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/event"
-	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
-	"github.com/cristiano-pacheco/pingo/pkg/kafka"
-	"go.uber.org/fx"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/outbox"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
 )
 
 type UserAuthenticatedProducerI interface {
@@ -16,34 +15,19 @@ type UserAuthenticatedProducerI interface {
 }
 
 type UserAuthenticatedProducer struct {
-	producer kafka.Producer
+	outboxPublisher outbox.PublisherI
 }
 
 var _ UserAuthenticatedProducerI = (*UserAuthenticatedProducer)(nil)
 
-func NewUserAuthenticatedProducer(
-	lc fx.Lifecycle,
-	kafkaFacade kafka.Builder,
-	logger logger.Logger,
-) *UserAuthenticatedProducer {
-	p := UserAuthenticatedProducer{
-		producer: kafkaFacade.BuildProducer(event.IdentityUserAuthenticatedTopic),
+func NewUserAuthenticatedProducer(outboxPublisher outbox.PublisherI) *UserAuthenticatedProducer {
+	return &UserAuthenticatedProducer{
+		outboxPublisher: outboxPublisher,
 	}
-
-	lc.Append(fx.Hook{
-		OnStop: func(_ context.Context) error {
-			err := p.producer.Close()
-			if err != nil {
-				logger.Error().Msgf("failed to close producer: %v", err)
-			}
-			logger.Info().Msg("UserAuthenticatedProducer closed successfully...")
-			return err
-		},
-	})
-
-	return &p
 }
 
+// Produce publishes the message through the outbox, it reaches the broker once the transaction
+// carried by ctx, if any, commits.
 func (p *UserAuthenticatedProducer) Produce(ctx context.Context, message event.UserAuthenticatedMessage) error {
 	ctx, span := trace.Span(ctx, "UserAuthenticatedProducer.Produce")
 	defer span.End()
@@ -52,10 +36,6 @@ func (p *UserAuthenticatedProducer) Produce(ctx context.Context, message event.U
 	if err != nil {
 		return err
 	}
-	m := kafka.Message{Value: msg}
-	err = p.producer.Produce(ctx, m)
-	if err != nil {
-		return err
-	}
-	return nil
+	m := broker.Message{Topic: event.IdentityUserAuthenticatedTopic, Value: msg}
+	return p.outboxPublisher.Publish(ctx, m)
 }
@@ -8,52 +8,22 @@ import (
 
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/event"
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/event/producer"
-	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
-	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
-	"github.com/cristiano-pacheco/pingo/pkg/kafka"
-	kafka_mocks "github.com/cristiano-pacheco/pingo/pkg/kafka/mocks"
+	outbox_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/outbox/mocks"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
-	"go.uber.org/fx"
 )
 
-type mockLifecycle struct{}
-
-func (m *mockLifecycle) Append(hook fx.Hook) {
-	// No-op for testing
-}
-
 type UserAuthenticatedProducerTestSuite struct {
 	suite.Suite
-	sut          *producer.UserAuthenticatedProducer
-	kafkaBuilder *kafka_mocks.MockBuilder
-	mockProducer *kafka_mocks.MockProducer
-	logger       logger.Logger
+	sut                 *producer.UserAuthenticatedProducer
+	outboxPublisherMock *outbox_mocks.MockPublisherI
 }
 
 func (s *UserAuthenticatedProducerTestSuite) SetupTest() {
-	s.kafkaBuilder = kafka_mocks.NewMockBuilder(s.T())
-	s.mockProducer = kafka_mocks.NewMockProducer(s.T())
-
-	loggerConfig := config.Config{
-		Log: config.Log{
-			LogLevel: "disabled",
-		},
-		OpenTelemetry: config.OpenTelemetry{
-			Enabled: false,
-		},
-	}
-	s.logger = logger.New(loggerConfig)
-
-	lifecycle := &mockLifecycle{}
-
-	s.kafkaBuilder.On("BuildProducer", event.IdentityUserAuthenticatedTopic).Return(s.mockProducer)
+	s.outboxPublisherMock = outbox_mocks.NewMockPublisherI(s.T())
 
-	s.sut = producer.NewUserAuthenticatedProducer(
-		lifecycle,
-		s.kafkaBuilder,
-		s.logger,
-	)
+	s.sut = producer.NewUserAuthenticatedProducer(s.outboxPublisherMock)
 }
 
 func TestUserAuthenticatedProducerSuite(t *testing.T) {
@@ -72,11 +42,12 @@ func (s *UserAuthenticatedProducerTestSuite) TestProduce_ValidMessage_ProducesSu
 	expectedMessageBytes, err := json.Marshal(userAuthenticatedMessage)
 	s.Require().NoError(err)
 
-	expectedKafkaMessage := kafka.Message{
+	expectedKafkaMessage := broker.Message{
+		Topic: event.IdentityUserAuthenticatedTopic,
 		Value: expectedMessageBytes,
 	}
 
-	s.mockProducer.On("Produce", mock.Anything, expectedKafkaMessage).Return(nil)
+	s.outboxPublisherMock.On("Publish", mock.Anything, expectedKafkaMessage).Return(nil)
 
 	// Act
 	err = s.sut.Produce(ctx, userAuthenticatedMessage)
@@ -97,12 +68,13 @@ func (s *UserAuthenticatedProducerTestSuite) TestProduce_ProducerError_ReturnsEr
 	expectedMessageBytes, err := json.Marshal(userAuthenticatedMessage)
 	s.Require().NoError(err)
 
-	expectedKafkaMessage := kafka.Message{
+	expectedKafkaMessage := broker.Message{
+		Topic: event.IdentityUserAuthenticatedTopic,
 		Value: expectedMessageBytes,
 	}
 
-	producerError := errors.New("kafka producer error")
-	s.mockProducer.On("Produce", mock.Anything, expectedKafkaMessage).Return(producerError)
+	producerError := errors.New("database error")
+	s.outboxPublisherMock.On("Publish", mock.Anything, expectedKafkaMessage).Return(producerError)
 
 	// Act
 	err = s.sut.Produce(ctx, userAuthenticatedMessage)
@@ -128,11 +100,12 @@ func (s *UserAuthenticatedProducerTestSuite) TestProduce_MarshalError_ReturnsErr
 	expectedMessageBytes, err := json.Marshal(userAuthenticatedMessage)
 	s.Require().NoError(err)
 
-	expectedKafkaMessage := kafka.Message{
+	expectedKafkaMessage := broker.Message{
+		Topic: event.IdentityUserAuthenticatedTopic,
 		Value: expectedMessageBytes,
 	}
 
-	s.mockProducer.On("Produce", mock.Anything, expectedKafkaMessage).Return(nil)
+	s.outboxPublisherMock.On("Publish", mock.Anything, expectedKafkaMessage).Return(nil)
 
 	// Act
 	err = s.sut.Produce(ctx, userAuthenticatedMessage)
@@ -152,11 +125,12 @@ func (s *UserAuthenticatedProducerTestSuite) TestProduce_EmptyUserID_ProducesSuc
 	expectedMessageBytes, err := json.Marshal(userAuthenticatedMessage)
 	s.Require().NoError(err)
 
-	expectedKafkaMessage := kafka.Message{
+	expectedKafkaMessage := broker.Message{
+		Topic: event.IdentityUserAuthenticatedTopic,
 		Value: expectedMessageBytes,
 	}
 
-	s.mockProducer.On("Produce", mock.Anything, expectedKafkaMessage).Return(nil)
+	s.outboxPublisherMock.On("Publish", mock.Anything, expectedKafkaMessage).Return(nil)
 
 	// Act
 	err = s.sut.Produce(ctx, userAuthenticatedMessage)
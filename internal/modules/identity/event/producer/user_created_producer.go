@@ -6,9 +6,8 @@ import (
 
 	"github.com/cristiano-pacheco/go-otel/trace"
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/event"
-	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
-	"github.com/cristiano-pacheco/pingo/pkg/kafka"
-	"go.uber.org/fx"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/outbox"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
 )
 
 type UserCreatedProducerI interface {
@@ -16,34 +15,19 @@ type UserCreatedProducerI interface {
 }
 
 type UserCreatedProducer struct {
-	producer kafka.Producer
+	outboxPublisher outbox.PublisherI
 }
 
 var _ UserCreatedProducerI = (*UserCreatedProducer)(nil)
 
-func NewUserCreatedProducer(
-	lc fx.Lifecycle,
-	logger logger.Logger,
-	kafkaBuilder kafka.Builder,
-) *UserCreatedProducer {
-	p := UserCreatedProducer{
-		producer: kafkaBuilder.BuildProducer(event.IdentityUserCreatedTopic),
+func NewUserCreatedProducer(outboxPublisher outbox.PublisherI) *UserCreatedProducer {
+	return &UserCreatedProducer{
+		outboxPublisher: outboxPublisher,
 	}
-
-	lc.Append(fx.Hook{
-		OnStop: func(_ context.Context) error {
-			err := p.producer.Close()
-			if err != nil {
-				logger.Error().Msgf("failed to close producer: %v", err)
-			}
-			logger.Info().Msg("UserCreatedProducer closed successfully...")
-			return err
-		},
-	})
-
-	return &p
 }
 
+// Produce publishes the message through the outbox, it reaches the broker once the transaction
+// carried by ctx, if any, commits.
 func (p *UserCreatedProducer) Produce(ctx context.Context, message event.UserCreatedMessage) error {
 	ctx, span := trace.Span(ctx, "UserCreatedProducer.Produce")
 	defer span.End()
@@ -52,10 +36,6 @@ func (p *UserCreatedProducer) Produce(ctx context.Context, message event.UserCre
 	if err != nil {
 		return err
 	}
-	m := kafka.Message{Value: msg}
-	err = p.producer.Produce(ctx, m)
-	if err != nil {
-		return err
-	}
-	return nil
+	m := broker.Message{Topic: event.IdentityUserCreatedTopic, Value: msg}
+	return p.outboxPublisher.Publish(ctx, m)
 }
@@ -8,44 +8,22 @@ import (
 
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/event"
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/event/producer"
-	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
-	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
-	"github.com/cristiano-pacheco/pingo/pkg/kafka"
-	kafka_mocks "github.com/cristiano-pacheco/pingo/pkg/kafka/mocks"
+	outbox_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/outbox/mocks"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
 
 type UserCreatedProducerTestSuite struct {
 	suite.Suite
-	sut          *producer.UserCreatedProducer
-	producerMock *kafka_mocks.MockProducer
-	builderMock  *kafka_mocks.MockBuilder
-	logger       logger.Logger
+	sut                 *producer.UserCreatedProducer
+	outboxPublisherMock *outbox_mocks.MockPublisherI
 }
 
 func (s *UserCreatedProducerTestSuite) SetupTest() {
-	s.producerMock = kafka_mocks.NewMockProducer(s.T())
-	s.builderMock = kafka_mocks.NewMockBuilder(s.T())
-
-	loggerConfig := config.Config{
-		Log: config.Log{
-			LogLevel: "disabled",
-		},
-		OpenTelemetry: config.OpenTelemetry{
-			Enabled: false,
-		},
-	}
-	s.logger = logger.New(loggerConfig)
-
-	s.builderMock.On("BuildProducer", event.IdentityUserCreatedTopic).Return(s.producerMock)
+	s.outboxPublisherMock = outbox_mocks.NewMockPublisherI(s.T())
 
-	lifecycle := &mockLifecycle{}
-	s.sut = producer.NewUserCreatedProducer(
-		lifecycle,
-		s.logger,
-		s.builderMock,
-	)
+	s.sut = producer.NewUserCreatedProducer(s.outboxPublisherMock)
 }
 
 func TestUserCreatedProducerSuite(t *testing.T) {
@@ -64,11 +42,12 @@ func (s *UserCreatedProducerTestSuite) TestProduce_ValidMessage_ProducesSuccessf
 	expectedMessageBytes, err := json.Marshal(userCreatedMessage)
 	s.Require().NoError(err)
 
-	expectedKafkaMessage := kafka.Message{
+	expectedKafkaMessage := broker.Message{
+		Topic: event.IdentityUserCreatedTopic,
 		Value: expectedMessageBytes,
 	}
 
-	s.producerMock.On("Produce", mock.Anything, expectedKafkaMessage).Return(nil)
+	s.outboxPublisherMock.On("Publish", mock.Anything, expectedKafkaMessage).Return(nil)
 
 	// Act
 	err = s.sut.Produce(ctx, userCreatedMessage)
@@ -89,12 +68,13 @@ func (s *UserCreatedProducerTestSuite) TestProduce_ProducerFails_ReturnsError()
 	expectedMessageBytes, err := json.Marshal(userCreatedMessage)
 	s.Require().NoError(err)
 
-	expectedKafkaMessage := kafka.Message{
+	expectedKafkaMessage := broker.Message{
+		Topic: event.IdentityUserCreatedTopic,
 		Value: expectedMessageBytes,
 	}
 
-	producerError := errors.New("failed to produce message")
-	s.producerMock.On("Produce", mock.Anything, expectedKafkaMessage).Return(producerError)
+	producerError := errors.New("database error")
+	s.outboxPublisherMock.On("Publish", mock.Anything, expectedKafkaMessage).Return(producerError)
 
 	// Act
 	err = s.sut.Produce(ctx, userCreatedMessage)
@@ -116,11 +96,12 @@ func (s *UserCreatedProducerTestSuite) TestProduce_ZeroUserID_ProducesSuccessful
 	expectedMessageBytes, err := json.Marshal(userCreatedMessage)
 	s.Require().NoError(err)
 
-	expectedKafkaMessage := kafka.Message{
+	expectedKafkaMessage := broker.Message{
+		Topic: event.IdentityUserCreatedTopic,
 		Value: expectedMessageBytes,
 	}
 
-	s.producerMock.On("Produce", mock.Anything, expectedKafkaMessage).Return(nil)
+	s.outboxPublisherMock.On("Publish", mock.Anything, expectedKafkaMessage).Return(nil)
 
 	// Act
 	err = s.sut.Produce(ctx, userCreatedMessage)
@@ -141,11 +122,12 @@ func (s *UserCreatedProducerTestSuite) TestProduce_LargeUserID_ProducesSuccessfu
 	expectedMessageBytes, err := json.Marshal(userCreatedMessage)
 	s.Require().NoError(err)
 
-	expectedKafkaMessage := kafka.Message{
+	expectedKafkaMessage := broker.Message{
+		Topic: event.IdentityUserCreatedTopic,
 		Value: expectedMessageBytes,
 	}
 
-	s.producerMock.On("Produce", mock.Anything, expectedKafkaMessage).Return(nil)
+	s.outboxPublisherMock.On("Publish", mock.Anything, expectedKafkaMessage).Return(nil)
 
 	// Act
 	err = s.sut.Produce(ctx, userCreatedMessage)
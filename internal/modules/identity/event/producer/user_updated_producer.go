@@ -6,9 +6,8 @@ import (
 
 	"github.com/cristiano-pacheco/go-otel/trace"
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/event"
-	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
-	"github.com/cristiano-pacheco/pingo/pkg/kafka"
-	"go.uber.org/fx"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/outbox"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
 )
 
 type UserUpdatedProducerI interface {
@@ -16,45 +15,27 @@ type UserUpdatedProducerI interface {
 }
 
 type UserUpdatedProducer struct {
-	producer kafka.Producer
+	outboxPublisher outbox.PublisherI
 }
 
 var _ UserUpdatedProducerI = (*UserUpdatedProducer)(nil)
 
-func NewUserUpdatedProducer(lc fx.Lifecycle,
-	logger logger.Logger,
-	kafkaBuilder kafka.Builder,
-) *UserUpdatedProducer {
-	p := UserUpdatedProducer{
-		producer: kafkaBuilder.BuildProducer(event.IdentityUserUpdatedTopic),
+func NewUserUpdatedProducer(outboxPublisher outbox.PublisherI) *UserUpdatedProducer {
+	return &UserUpdatedProducer{
+		outboxPublisher: outboxPublisher,
 	}
-
-	lc.Append(fx.Hook{
-		OnStop: func(_ context.Context) error {
-			err := p.producer.Close()
-			if err != nil {
-				logger.Error().Msgf("failed to close producer: %v", err)
-			}
-			logger.Info().Msg("UserUpdatedProducer closed successfully...")
-			return err
-		},
-	})
-
-	return &p
 }
 
+// Produce publishes the message through the outbox, it reaches the broker once the transaction
+// carried by ctx, if any, commits.
 func (p *UserUpdatedProducer) Produce(ctx context.Context, message event.UserUpdatedMessage) error {
-	ctx, span := trace.Span(ctx, "UserCreatedProducer.Produce")
+	ctx, span := trace.Span(ctx, "UserUpdatedProducer.Produce")
 	defer span.End()
 
 	msg, err := json.Marshal(message)
 	if err != nil {
 		return err
 	}
-	m := kafka.Message{Value: msg}
-	err = p.producer.Produce(ctx, m)
-	if err != nil {
-		return err
-	}
-	return nil
+	m := broker.Message{Topic: event.IdentityUserUpdatedTopic, Value: msg}
+	return p.outboxPublisher.Publish(ctx, m)
 }
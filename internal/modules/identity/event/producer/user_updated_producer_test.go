@@ -8,44 +8,22 @@ import (
 
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/event"
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/event/producer"
-	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
-	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
-	"github.com/cristiano-pacheco/pingo/pkg/kafka"
-	kafka_mocks "github.com/cristiano-pacheco/pingo/pkg/kafka/mocks"
+	outbox_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/outbox/mocks"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
 
 type UserUpdatedProducerTestSuite struct {
 	suite.Suite
-	sut          *producer.UserUpdatedProducer
-	producerMock *kafka_mocks.MockProducer
-	builderMock  *kafka_mocks.MockBuilder
-	logger       logger.Logger
+	sut                 *producer.UserUpdatedProducer
+	outboxPublisherMock *outbox_mocks.MockPublisherI
 }
 
 func (s *UserUpdatedProducerTestSuite) SetupTest() {
-	s.producerMock = kafka_mocks.NewMockProducer(s.T())
-	s.builderMock = kafka_mocks.NewMockBuilder(s.T())
-
-	loggerConfig := config.Config{
-		Log: config.Log{
-			LogLevel: "disabled",
-		},
-		OpenTelemetry: config.OpenTelemetry{
-			Enabled: false,
-		},
-	}
-	s.logger = logger.New(loggerConfig)
-
-	s.builderMock.On("BuildProducer", event.IdentityUserUpdatedTopic).Return(s.producerMock)
+	s.outboxPublisherMock = outbox_mocks.NewMockPublisherI(s.T())
 
-	lifecycle := &mockLifecycle{}
-	s.sut = producer.NewUserUpdatedProducer(
-		lifecycle,
-		s.logger,
-		s.builderMock,
-	)
+	s.sut = producer.NewUserUpdatedProducer(s.outboxPublisherMock)
 }
 
 func TestUserUpdatedProducerSuite(t *testing.T) {
@@ -64,11 +42,12 @@ func (s *UserUpdatedProducerTestSuite) TestProduce_ValidMessage_ProducesSuccessf
 	expectedMessageBytes, err := json.Marshal(userUpdatedMessage)
 	s.Require().NoError(err)
 
-	expectedKafkaMessage := kafka.Message{
+	expectedKafkaMessage := broker.Message{
+		Topic: event.IdentityUserUpdatedTopic,
 		Value: expectedMessageBytes,
 	}
 
-	s.producerMock.On("Produce", mock.Anything, expectedKafkaMessage).Return(nil)
+	s.outboxPublisherMock.On("Publish", mock.Anything, expectedKafkaMessage).Return(nil)
 
 	// Act
 	err = s.sut.Produce(ctx, userUpdatedMessage)
@@ -89,12 +68,13 @@ func (s *UserUpdatedProducerTestSuite) TestProduce_ProducerFails_ReturnsError()
 	expectedMessageBytes, err := json.Marshal(userUpdatedMessage)
 	s.Require().NoError(err)
 
-	expectedKafkaMessage := kafka.Message{
+	expectedKafkaMessage := broker.Message{
+		Topic: event.IdentityUserUpdatedTopic,
 		Value: expectedMessageBytes,
 	}
 
-	producerError := errors.New("failed to produce message")
-	s.producerMock.On("Produce", mock.Anything, expectedKafkaMessage).Return(producerError)
+	producerError := errors.New("database error")
+	s.outboxPublisherMock.On("Publish", mock.Anything, expectedKafkaMessage).Return(producerError)
 
 	// Act
 	err = s.sut.Produce(ctx, userUpdatedMessage)
@@ -116,11 +96,12 @@ func (s *UserUpdatedProducerTestSuite) TestProduce_ZeroUserID_ProducesSuccessful
 	expectedMessageBytes, err := json.Marshal(userUpdatedMessage)
 	s.Require().NoError(err)
 
-	expectedKafkaMessage := kafka.Message{
+	expectedKafkaMessage := broker.Message{
+		Topic: event.IdentityUserUpdatedTopic,
 		Value: expectedMessageBytes,
 	}
 
-	s.producerMock.On("Produce", mock.Anything, expectedKafkaMessage).Return(nil)
+	s.outboxPublisherMock.On("Publish", mock.Anything, expectedKafkaMessage).Return(nil)
 
 	// Act
 	err = s.sut.Produce(ctx, userUpdatedMessage)
@@ -141,11 +122,12 @@ func (s *UserUpdatedProducerTestSuite) TestProduce_LargeUserID_ProducesSuccessfu
 	expectedMessageBytes, err := json.Marshal(userUpdatedMessage)
 	s.Require().NoError(err)
 
-	expectedKafkaMessage := kafka.Message{
+	expectedKafkaMessage := broker.Message{
+		Topic: event.IdentityUserUpdatedTopic,
 		Value: expectedMessageBytes,
 	}
 
-	s.producerMock.On("Produce", mock.Anything, expectedKafkaMessage).Return(nil)
+	s.outboxPublisherMock.On("Publish", mock.Anything, expectedKafkaMessage).Return(nil)
 
 	// Act
 	err = s.sut.Produce(ctx, userUpdatedMessage)
@@ -0,0 +1,25 @@
+package dto
+
+import "time"
+
+type CreateAPIKeyRequest struct {
+	Name      string     `json:"name"`
+	Scope     string     `json:"scope"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+type APIKeyResponse struct {
+	APIKeyID   uint64     `json:"api_key_id"`
+	Name       string     `json:"name"`
+	Scope      string     `json:"scope"`
+	KeyPrefix  string     `json:"key_prefix"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// CreateAPIKeyResponse is the only response that carries the key itself.
+type CreateAPIKeyResponse struct {
+	APIKeyResponse
+	Key string `json:"key"`
+}
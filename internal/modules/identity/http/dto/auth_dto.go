@@ -5,15 +5,54 @@ type AuthLoginRequest struct {
 	Password string `json:"password"`
 }
 
+// AuthLoginResponse tells with TOTPRequired that the code of the authenticator app is expected
+// instead of an emailed code. ChallengeToken is sent back along with the code.
 type AuthLoginResponse struct {
-	UserID uint64 `json:"user_id"`
+	UserID         uint64 `json:"user_id"`
+	TOTPRequired   bool   `json:"totp_required"`
+	ChallengeToken string `json:"challenge_token"`
 }
 
 type AuthGenerateJWTRequest struct {
-	UserID uint64 `json:"user_id"`
-	Code   string `json:"code"`
+	UserID         uint64 `json:"user_id"`
+	ChallengeToken string `json:"challenge_token"`
+	Code           string `json:"code"`
 }
 
 type AuthGenerateJWTResponse struct {
-	Token string `json:"token"`
+	Token        string `json:"token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+type AuthRefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type AuthPasswordForgotRequest struct {
+	Email string `json:"email"`
+}
+
+type AuthPasswordResetRequest struct {
+	UserID   uint64 `json:"user_id"`
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
+type AuthMagicLinkRequest struct {
+	Email string `json:"email"`
+}
+
+type AuthMagicLinkLoginRequest struct {
+	UserID uint64 `json:"user_id"`
+	Token  string `json:"token"`
+}
+
+// AuthMagicLinkLoginResponse has the token pair of the new session, unless TOTPRequired is set:
+// then the login goes on at /api/v1/auth/token with ChallengeToken and a code of the authenticator app.
+type AuthMagicLinkLoginResponse struct {
+	Token          string `json:"token,omitempty"`
+	RefreshToken   string `json:"refresh_token,omitempty"`
+	UserID         uint64 `json:"user_id"`
+	TOTPRequired   bool   `json:"totp_required"`
+	ChallengeToken string `json:"challenge_token,omitempty"`
 }
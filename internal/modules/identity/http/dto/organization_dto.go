@@ -0,0 +1,33 @@
+package dto
+
+import "time"
+
+type CreateOrganizationRequest struct {
+	Name string `json:"name"`
+}
+
+type OrganizationResponse struct {
+	OrganizationID uint64 `json:"organization_id"`
+	Name           string `json:"name"`
+	Role           string `json:"role"`
+}
+
+type OrganizationMemberResponse struct {
+	UserID    uint64    `json:"user_id"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type UpdateOrganizationMemberRequest struct {
+	Role string `json:"role"`
+}
+
+type InviteOrganizationMemberRequest struct {
+	Email string `json:"email"`
+	Role  string `json:"role"`
+}
+
+type AcceptOrganizationInvitationRequest struct {
+	InvitationID uint64 `json:"invitation_id"`
+	Token        string `json:"token"`
+}
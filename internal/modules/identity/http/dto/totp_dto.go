@@ -0,0 +1,15 @@
+package dto
+
+type TOTPEnrollResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+type TOTPCodeRequest struct {
+	Code string `json:"code"`
+}
+
+// TOTPConfirmResponse is the only response that carries the recovery codes.
+type TOTPConfirmResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/http/dto"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/usecase"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/sdk/http/request"
+	"github.com/cristiano-pacheco/pingo/internal/shared/sdk/http/response"
+	"github.com/gofiber/fiber/v2"
+)
+
+type APIKeyHandler struct {
+	apiKeyCreateUseCase *usecase.APIKeyCreateUseCase
+	apiKeyListUseCase   *usecase.APIKeyListUseCase
+	apiKeyDeleteUseCase *usecase.APIKeyDeleteUseCase
+	logger              logger.Logger
+}
+
+func NewAPIKeyHandler(
+	apiKeyCreateUseCase *usecase.APIKeyCreateUseCase,
+	apiKeyListUseCase *usecase.APIKeyListUseCase,
+	apiKeyDeleteUseCase *usecase.APIKeyDeleteUseCase,
+	logger logger.Logger,
+) *APIKeyHandler {
+	return &APIKeyHandler{
+		apiKeyCreateUseCase: apiKeyCreateUseCase,
+		apiKeyListUseCase:   apiKeyListUseCase,
+		apiKeyDeleteUseCase: apiKeyDeleteUseCase,
+		logger:              logger,
+	}
+}
+
+// @Summary		Create API key
+// @Description	Creates an API key of the authenticated user, the key is only returned in this response
+// @Tags		API Keys
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		request	body	dto.CreateAPIKeyRequest	true	"API key data"
+// @Success		201	{object}	response.Envelope[dto.CreateAPIKeyResponse]	"Successfully created API key"
+// @Failure		400	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/api-keys [post]
+func (h *APIKeyHandler) CreateAPIKey(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	var createAPIKeyRequest dto.CreateAPIKeyRequest
+	if err := c.BodyParser(&createAPIKeyRequest); err != nil {
+		h.logger.Error().Msgf("Failed to parse request body: %v", err)
+		return err
+	}
+
+	userID, ok := request.UserIDFromContext(ctx)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "UserID not found")
+	}
+
+	input := usecase.APIKeyCreateInput{
+		UserID:    userID,
+		Name:      createAPIKeyRequest.Name,
+		Scope:     createAPIKeyRequest.Scope,
+		ExpiresAt: createAPIKeyRequest.ExpiresAt,
+	}
+
+	output, err := h.apiKeyCreateUseCase.Execute(ctx, input)
+	if err != nil {
+		h.logger.Error().Msgf("Failed to create API key: %v", err)
+		return err
+	}
+
+	createAPIKeyResponse := dto.CreateAPIKeyResponse{
+		APIKeyResponse: toAPIKeyResponse(output.APIKeyOutput),
+		Key:            output.Key,
+	}
+
+	res := response.NewEnvelope(createAPIKeyResponse)
+	return c.Status(http.StatusCreated).JSON(res)
+}
+
+// @Summary		List API keys
+// @Description	Lists the API keys of the authenticated user
+// @Tags		API Keys
+// @Produce		json
+// @Security 	BearerAuth
+// @Success		200	{object}	response.Envelope[[]dto.APIKeyResponse]	"Successfully retrieved API keys"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/api-keys [get]
+func (h *APIKeyHandler) ListAPIKeys(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	userID, ok := request.UserIDFromContext(ctx)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "UserID not found")
+	}
+
+	output, err := h.apiKeyListUseCase.Execute(ctx, usecase.APIKeyListInput{UserID: userID})
+	if err != nil {
+		h.logger.Error().Msgf("Failed to list API keys: %v", err)
+		return err
+	}
+
+	apiKeys := make([]dto.APIKeyResponse, len(output.APIKeys))
+	for i, apiKey := range output.APIKeys {
+		apiKeys[i] = toAPIKeyResponse(apiKey)
+	}
+
+	res := response.NewEnvelope(apiKeys)
+	return c.Status(http.StatusOK).JSON(res)
+}
+
+// @Summary		Delete API key
+// @Description	Deletes an API key of the authenticated user, the key stops working immediately
+// @Tags		API Keys
+// @Security 	BearerAuth
+// @Param		id	path	int	true	"API key ID"
+// @Success		204		"Successfully deleted API key"
+// @Failure		400	{object}	errs.Error	"Invalid API key ID"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		404	{object}	errs.Error	"API key not found"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/api-keys/{id} [delete]
+func (h *APIKeyHandler) DeleteAPIKey(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	userID, ok := request.UserIDFromContext(ctx)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "UserID not found")
+	}
+
+	apiKeyID, err := parseIDParam(c, "id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "Invalid API key ID")
+	}
+
+	err = h.apiKeyDeleteUseCase.Execute(ctx, usecase.APIKeyDeleteInput{UserID: userID, APIKeyID: apiKeyID})
+	if err != nil {
+		h.logger.Error().Msgf("Failed to delete API key: %v", err)
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+func toAPIKeyResponse(output usecase.APIKeyOutput) dto.APIKeyResponse {
+	return dto.APIKeyResponse{
+		APIKeyID:   output.APIKeyID,
+		Name:       output.Name,
+		Scope:      output.Scope,
+		KeyPrefix:  output.KeyPrefix,
+		ExpiresAt:  output.ExpiresAt,
+		LastUsedAt: output.LastUsedAt,
+		CreatedAt:  output.CreatedAt,
+	}
+}
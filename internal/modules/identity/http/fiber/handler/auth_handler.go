@@ -5,27 +5,46 @@ import (
 
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/http/dto"
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/usecase"
+	"github.com/cristiano-pacheco/pingo/internal/shared/sdk/http/request"
 	"github.com/cristiano-pacheco/pingo/internal/shared/sdk/http/response"
 	"github.com/gofiber/fiber/v2"
 )
 
 type AuthHandler struct {
-	authLoginUseCase         *usecase.AuthLoginUseCase
-	authGenerateTokenUseCase *usecase.AuthGenerateTokenUseCase
+	authLoginUseCase            *usecase.AuthLoginUseCase
+	authGenerateTokenUseCase    *usecase.AuthGenerateTokenUseCase
+	authRefreshTokenUseCase     *usecase.AuthRefreshTokenUseCase
+	authLogoutUseCase           *usecase.AuthLogoutUseCase
+	authPasswordForgotUseCase   *usecase.AuthPasswordForgotUseCase
+	authPasswordResetUseCase    *usecase.AuthPasswordResetUseCase
+	authMagicLinkRequestUseCase *usecase.AuthMagicLinkRequestUseCase
+	authMagicLinkLoginUseCase   *usecase.AuthMagicLinkLoginUseCase
 }
 
 func NewAuthHandler(
 	authLoginUseCase *usecase.AuthLoginUseCase,
 	authGenerateTokenUseCase *usecase.AuthGenerateTokenUseCase,
+	authRefreshTokenUseCase *usecase.AuthRefreshTokenUseCase,
+	authLogoutUseCase *usecase.AuthLogoutUseCase,
+	authPasswordForgotUseCase *usecase.AuthPasswordForgotUseCase,
+	authPasswordResetUseCase *usecase.AuthPasswordResetUseCase,
+	authMagicLinkRequestUseCase *usecase.AuthMagicLinkRequestUseCase,
+	authMagicLinkLoginUseCase *usecase.AuthMagicLinkLoginUseCase,
 ) *AuthHandler {
 	return &AuthHandler{
-		authLoginUseCase:         authLoginUseCase,
-		authGenerateTokenUseCase: authGenerateTokenUseCase,
+		authLoginUseCase:            authLoginUseCase,
+		authGenerateTokenUseCase:    authGenerateTokenUseCase,
+		authRefreshTokenUseCase:     authRefreshTokenUseCase,
+		authLogoutUseCase:           authLogoutUseCase,
+		authPasswordForgotUseCase:   authPasswordForgotUseCase,
+		authPasswordResetUseCase:    authPasswordResetUseCase,
+		authMagicLinkRequestUseCase: authMagicLinkRequestUseCase,
+		authMagicLinkLoginUseCase:   authMagicLinkLoginUseCase,
 	}
 }
 
 // @Summary		Authenticate the user
-// @Description	Authenticates user credentials and send the verification code
+// @Description	Authenticates user credentials and send the verification code unless an authenticator app is enrolled
 // @Tags		Authentication
 // @Accept		json
 // @Produce		json
@@ -33,6 +52,7 @@ func NewAuthHandler(
 // @Success		200	{object}	response.Envelope[dto.AuthLoginResponse]	"Successfully generated token"
 // @Failure		400	{object}	errs.Error	"Invalid request format or validation error"
 // @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"Password login is disabled"
 // @Failure		404	{object}	errs.Error	"User not found"
 // @Failure		500	{object}	errs.Error	"Internal server error"
 // @Router		/api/v1/auth/login [post]
@@ -50,20 +70,26 @@ func (h *AuthHandler) Login(c *fiber.Ctx) error {
 	if err != nil {
 		return err
 	}
-	res := response.NewEnvelope(dto.AuthLoginResponse{UserID: output.UserID})
+	authLoginResponse := dto.AuthLoginResponse{
+		UserID:         output.UserID,
+		TOTPRequired:   output.TOTPRequired,
+		ChallengeToken: output.ChallengeToken,
+	}
+	res := response.NewEnvelope(authLoginResponse)
 	return c.Status(http.StatusOK).JSON(res)
 }
 
 // @Summary		Generate authentication token
-// @Description	Generate the JWT token for the user
+// @Description	Generate the JWT token for the user with the challenge returned by the login and the code
 // @Tags		Authentication
 // @Accept		json
 // @Produce		json
-// @Param		request	body	dto.AuthGenerateJWTRequest	true	"Login credentials (email and password)"
+// @Param		request	body	dto.AuthGenerateJWTRequest	true	"User ID, login challenge and code"
 // @Success		200	{object}	response.Envelope[dto.AuthGenerateJWTResponse]	"Successfully generated token"
 // @Failure		400	{object}	errs.Error	"Invalid request format or validation error"
-// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		401	{object}	errs.Error	"Invalid credentials or login challenge"
 // @Failure		404	{object}	errs.Error	"User not found"
+// @Failure		429	{object}	errs.Error	"Too many invalid authenticator codes, try again later"
 // @Failure		500	{object}	errs.Error	"Internal server error"
 // @Router		/api/v1/auth/token [post]
 func (h *AuthHandler) GenerateJWT(c *fiber.Ctx) error {
@@ -73,8 +99,9 @@ func (h *AuthHandler) GenerateJWT(c *fiber.Ctx) error {
 		return err
 	}
 	input := usecase.GenerateTokenInput{
-		UserID: generateJWTRequest.UserID,
-		Code:   generateJWTRequest.Code,
+		UserID:         generateJWTRequest.UserID,
+		ChallengeToken: generateJWTRequest.ChallengeToken,
+		Code:           generateJWTRequest.Code,
 	}
 	output, err := h.authGenerateTokenUseCase.Execute(ctx, input)
 	if err != nil {
@@ -82,8 +109,214 @@ func (h *AuthHandler) GenerateJWT(c *fiber.Ctx) error {
 	}
 
 	generateJWTResponse := dto.AuthGenerateJWTResponse{
-		Token: output.Token,
+		Token:        output.Token,
+		RefreshToken: output.RefreshToken,
 	}
 	res := response.NewEnvelope(generateJWTResponse)
 	return c.Status(http.StatusOK).JSON(res)
 }
+
+// @Summary		Refresh authentication token
+// @Description	Exchanges a refresh token for a new token pair, every refresh token can only be used once
+// @Tags		Authentication
+// @Accept		json
+// @Produce		json
+// @Param		request	body	dto.AuthRefreshTokenRequest	true	"Refresh token"
+// @Success		200	{object}	response.Envelope[dto.AuthGenerateJWTResponse]	"Successfully refreshed token"
+// @Failure		400	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		401	{object}	errs.Error	"Invalid refresh token"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/auth/refresh [post]
+func (h *AuthHandler) RefreshToken(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	var refreshTokenRequest dto.AuthRefreshTokenRequest
+	if err := c.BodyParser(&refreshTokenRequest); err != nil {
+		return err
+	}
+	input := usecase.AuthRefreshTokenInput{
+		RefreshToken: refreshTokenRequest.RefreshToken,
+	}
+	output, err := h.authRefreshTokenUseCase.Execute(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	refreshTokenResponse := dto.AuthGenerateJWTResponse{
+		Token:        output.Token,
+		RefreshToken: output.RefreshToken,
+	}
+	res := response.NewEnvelope(refreshTokenResponse)
+	return c.Status(http.StatusOK).JSON(res)
+}
+
+// @Summary		Request a magic link
+// @Description	Sends a sign in link to the email if it belongs to an active user.
+// @Description	The response is the same whether or not the email has an account.
+// @Tags		Authentication
+// @Accept		json
+// @Param		request	body	dto.AuthMagicLinkRequest	true	"Email of the account"
+// @Success		202		"Magic link requested"
+// @Failure		400	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		403	{object}	errs.Error	"Magic link login is disabled"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/auth/magic-link [post]
+func (h *AuthHandler) RequestMagicLink(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	var magicLinkRequest dto.AuthMagicLinkRequest
+	if err := c.BodyParser(&magicLinkRequest); err != nil {
+		return err
+	}
+	input := usecase.AuthMagicLinkRequestInput{
+		Email: magicLinkRequest.Email,
+	}
+	err := h.authMagicLinkRequestUseCase.Execute(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusAccepted)
+}
+
+// @Summary		Sign in with a magic link
+// @Description	Exchanges the token of a magic link for a token pair, every magic link can only be used once.
+// @Description	Users with an authenticator app get a login challenge for /api/v1/auth/token instead.
+// @Tags		Authentication
+// @Accept		json
+// @Produce		json
+// @Param		request	body	dto.AuthMagicLinkLoginRequest	true	"User ID and token of the magic link"
+// @Success		200	{object}	response.Envelope[dto.AuthMagicLinkLoginResponse]	"Tokens or challenge"
+// @Failure		400	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		401	{object}	errs.Error	"Invalid or expired magic link"
+// @Failure		403	{object}	errs.Error	"Magic link login is disabled"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/auth/magic-link/token [post]
+func (h *AuthHandler) MagicLinkLogin(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	var magicLinkLoginRequest dto.AuthMagicLinkLoginRequest
+	if err := c.BodyParser(&magicLinkLoginRequest); err != nil {
+		return err
+	}
+	input := usecase.AuthMagicLinkLoginInput{
+		UserID: magicLinkLoginRequest.UserID,
+		Token:  magicLinkLoginRequest.Token,
+	}
+	output, err := h.authMagicLinkLoginUseCase.Execute(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	magicLinkLoginResponse := dto.AuthMagicLinkLoginResponse{
+		Token:          output.Token,
+		RefreshToken:   output.RefreshToken,
+		UserID:         output.UserID,
+		TOTPRequired:   output.TOTPRequired,
+		ChallengeToken: output.ChallengeToken,
+	}
+	res := response.NewEnvelope(magicLinkLoginResponse)
+	return c.Status(http.StatusOK).JSON(res)
+}
+
+// @Summary		Request a password reset
+// @Description	Sends a password reset link to the email if it belongs to an active user.
+// @Description	The response is the same whether or not the email has an account.
+// @Tags		Authentication
+// @Accept		json
+// @Param		request	body	dto.AuthPasswordForgotRequest	true	"Email of the account"
+// @Success		202		"Password reset requested"
+// @Failure		400	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/auth/password/forgot [post]
+func (h *AuthHandler) ForgotPassword(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	var passwordForgotRequest dto.AuthPasswordForgotRequest
+	if err := c.BodyParser(&passwordForgotRequest); err != nil {
+		return err
+	}
+	input := usecase.AuthPasswordForgotInput{
+		Email: passwordForgotRequest.Email,
+	}
+	err := h.authPasswordForgotUseCase.Execute(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusAccepted)
+}
+
+// @Summary		Reset the password
+// @Description	Sets a new password with the reset email token, ends every session and deletes the API keys
+// @Tags		Authentication
+// @Accept		json
+// @Param		request	body	dto.AuthPasswordResetRequest	true	"Password reset token and new password"
+// @Success		204		"Password successfully reset"
+// @Failure		400	{object}	errs.Error	"Invalid or expired token, or invalid password"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/auth/password/reset [post]
+func (h *AuthHandler) ResetPassword(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	var passwordResetRequest dto.AuthPasswordResetRequest
+	if err := c.BodyParser(&passwordResetRequest); err != nil {
+		return err
+	}
+	input := usecase.AuthPasswordResetInput{
+		UserID:   passwordResetRequest.UserID,
+		Token:    passwordResetRequest.Token,
+		Password: passwordResetRequest.Password,
+	}
+	err := h.authPasswordResetUseCase.Execute(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary		Log out
+// @Description	Revokes the session of the access token, its access and refresh tokens stop working
+// @Tags		Authentication
+// @Security 	BearerAuth
+// @Success		204		"Successfully logged out"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/auth/logout [post]
+func (h *AuthHandler) Logout(c *fiber.Ctx) error {
+	return h.logout(c, false)
+}
+
+// @Summary		Log out of all devices
+// @Description	Revokes every session of the authenticated user, API keys are not affected
+// @Tags		Authentication
+// @Security 	BearerAuth
+// @Success		204		"Successfully logged out of all devices"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/auth/logout/all [post]
+func (h *AuthHandler) LogoutAll(c *fiber.Ctx) error {
+	return h.logout(c, true)
+}
+
+func (h *AuthHandler) logout(c *fiber.Ctx, allSessions bool) error {
+	ctx := c.UserContext()
+
+	userID, ok := request.UserIDFromContext(ctx)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "UserID not found")
+	}
+
+	sessionID, ok := request.SessionIDFromContext(ctx)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "Session not found")
+	}
+
+	input := usecase.AuthLogoutInput{
+		UserID:      userID,
+		SessionID:   sessionID,
+		AllSessions: allSessions,
+	}
+	err := h.authLogoutUseCase.Execute(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
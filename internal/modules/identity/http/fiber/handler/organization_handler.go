@@ -0,0 +1,371 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/http/dto"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/usecase"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/sdk/http/request"
+	"github.com/cristiano-pacheco/pingo/internal/shared/sdk/http/response"
+	"github.com/gofiber/fiber/v2"
+)
+
+type OrganizationHandler struct {
+	organizationCreateUseCase           *usecase.OrganizationCreateUseCase
+	organizationListUseCase             *usecase.OrganizationListUseCase
+	organizationMemberListUseCase       *usecase.OrganizationMemberListUseCase
+	organizationMemberUpdateUseCase     *usecase.OrganizationMemberUpdateUseCase
+	organizationMemberRemoveUseCase     *usecase.OrganizationMemberRemoveUseCase
+	organizationInviteUseCase           *usecase.OrganizationInviteUseCase
+	organizationInvitationAcceptUseCase *usecase.OrganizationInvitationAcceptUseCase
+	logger                              logger.Logger
+}
+
+func NewOrganizationHandler(
+	organizationCreateUseCase *usecase.OrganizationCreateUseCase,
+	organizationListUseCase *usecase.OrganizationListUseCase,
+	organizationMemberListUseCase *usecase.OrganizationMemberListUseCase,
+	organizationMemberUpdateUseCase *usecase.OrganizationMemberUpdateUseCase,
+	organizationMemberRemoveUseCase *usecase.OrganizationMemberRemoveUseCase,
+	organizationInviteUseCase *usecase.OrganizationInviteUseCase,
+	organizationInvitationAcceptUseCase *usecase.OrganizationInvitationAcceptUseCase,
+	logger logger.Logger,
+) *OrganizationHandler {
+	return &OrganizationHandler{
+		organizationCreateUseCase:           organizationCreateUseCase,
+		organizationListUseCase:             organizationListUseCase,
+		organizationMemberListUseCase:       organizationMemberListUseCase,
+		organizationMemberUpdateUseCase:     organizationMemberUpdateUseCase,
+		organizationMemberRemoveUseCase:     organizationMemberRemoveUseCase,
+		organizationInviteUseCase:           organizationInviteUseCase,
+		organizationInvitationAcceptUseCase: organizationInvitationAcceptUseCase,
+		logger:                              logger,
+	}
+}
+
+// @Summary		Create organization
+// @Description	Creates an organization owned by the authenticated user
+// @Tags		Organizations
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		request	body	dto.CreateOrganizationRequest	true	"Organization data"
+// @Success		201	{object}	response.Envelope[dto.OrganizationResponse]	"Successfully created organization"
+// @Failure		400	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/organizations [post]
+func (h *OrganizationHandler) CreateOrganization(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	var createOrganizationRequest dto.CreateOrganizationRequest
+	if err := c.BodyParser(&createOrganizationRequest); err != nil {
+		h.logger.Error().Msgf("Failed to parse request body: %v", err)
+		return err
+	}
+
+	userID, ok := request.UserIDFromContext(ctx)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "UserID not found")
+	}
+
+	input := usecase.OrganizationCreateInput{
+		UserID: userID,
+		Name:   createOrganizationRequest.Name,
+	}
+
+	output, err := h.organizationCreateUseCase.Execute(ctx, input)
+	if err != nil {
+		h.logger.Error().Msgf("Failed to create organization: %v", err)
+		return err
+	}
+
+	res := response.NewEnvelope(toOrganizationResponse(output))
+	return c.Status(http.StatusCreated).JSON(res)
+}
+
+// @Summary		List organizations
+// @Description	Lists the organizations the authenticated user is a member of
+// @Tags		Organizations
+// @Produce		json
+// @Security 	BearerAuth
+// @Success		200	{object}	response.Envelope[[]dto.OrganizationResponse]	"Successfully retrieved organizations"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/organizations [get]
+func (h *OrganizationHandler) ListOrganizations(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	userID, ok := request.UserIDFromContext(ctx)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "UserID not found")
+	}
+
+	output, err := h.organizationListUseCase.Execute(ctx, usecase.OrganizationListInput{UserID: userID})
+	if err != nil {
+		h.logger.Error().Msgf("Failed to list organizations: %v", err)
+		return err
+	}
+
+	organizations := make([]dto.OrganizationResponse, len(output.Organizations))
+	for i, organization := range output.Organizations {
+		organizations[i] = toOrganizationResponse(organization)
+	}
+
+	res := response.NewEnvelope(organizations)
+	return c.Status(http.StatusOK).JSON(res)
+}
+
+// @Summary		List organization members
+// @Description	Lists the members of an organization, available to every member
+// @Tags		Organizations
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id	path	int	true	"Organization ID"
+// @Success		200	{object}	response.Envelope[[]dto.OrganizationMemberResponse]	"Successfully retrieved members"
+// @Failure		400	{object}	errs.Error	"Invalid organization ID"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"Not a member of the organization"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/organizations/{id}/members [get]
+func (h *OrganizationHandler) ListMembers(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	userID, ok := request.UserIDFromContext(ctx)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "UserID not found")
+	}
+
+	organizationID, err := parseIDParam(c, "id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "Invalid organization ID")
+	}
+
+	input := usecase.OrganizationMemberListInput{UserID: userID, OrganizationID: organizationID}
+	output, err := h.organizationMemberListUseCase.Execute(ctx, input)
+	if err != nil {
+		h.logger.Error().Msgf("Failed to list organization members: %v", err)
+		return err
+	}
+
+	members := make([]dto.OrganizationMemberResponse, len(output.Members))
+	for i, member := range output.Members {
+		members[i] = toOrganizationMemberResponse(member)
+	}
+
+	res := response.NewEnvelope(members)
+	return c.Status(http.StatusOK).JSON(res)
+}
+
+// @Summary		Update organization member
+// @Description	Changes the role of a member, admins manage the members up to their own role
+// @Tags		Organizations
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id		path	int	true	"Organization ID"
+// @Param		user_id	path	int	true	"Member user ID"
+// @Param		request	body	dto.UpdateOrganizationMemberRequest	true	"Member role"
+// @Success		200	{object}	response.Envelope[dto.OrganizationMemberResponse]	"Successfully updated member"
+// @Failure		400	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"The organization role does not allow this action"
+// @Failure		404	{object}	errs.Error	"Member not found"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/organizations/{id}/members/{user_id} [put]
+func (h *OrganizationHandler) UpdateMember(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	var updateMemberRequest dto.UpdateOrganizationMemberRequest
+	if err := c.BodyParser(&updateMemberRequest); err != nil {
+		h.logger.Error().Msgf("Failed to parse request body: %v", err)
+		return err
+	}
+
+	userID, ok := request.UserIDFromContext(ctx)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "UserID not found")
+	}
+
+	organizationID, err := parseIDParam(c, "id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "Invalid organization ID")
+	}
+
+	memberUserID, err := parseIDParam(c, "user_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "Invalid member user ID")
+	}
+
+	input := usecase.OrganizationMemberUpdateInput{
+		UserID:         userID,
+		OrganizationID: organizationID,
+		MemberUserID:   memberUserID,
+		Role:           updateMemberRequest.Role,
+	}
+
+	output, err := h.organizationMemberUpdateUseCase.Execute(ctx, input)
+	if err != nil {
+		h.logger.Error().Msgf("Failed to update organization member: %v", err)
+		return err
+	}
+
+	res := response.NewEnvelope(toOrganizationMemberResponse(output))
+	return c.Status(http.StatusOK).JSON(res)
+}
+
+// @Summary		Remove organization member
+// @Description	Removes a member from an organization, every member can remove itself
+// @Tags		Organizations
+// @Security 	BearerAuth
+// @Param		id		path	int	true	"Organization ID"
+// @Param		user_id	path	int	true	"Member user ID"
+// @Success		204		"Successfully removed member"
+// @Failure		400	{object}	errs.Error	"Invalid request or the last owner"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"The organization role does not allow this action"
+// @Failure		404	{object}	errs.Error	"Member not found"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/organizations/{id}/members/{user_id} [delete]
+func (h *OrganizationHandler) RemoveMember(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	userID, ok := request.UserIDFromContext(ctx)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "UserID not found")
+	}
+
+	organizationID, err := parseIDParam(c, "id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "Invalid organization ID")
+	}
+
+	memberUserID, err := parseIDParam(c, "user_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "Invalid member user ID")
+	}
+
+	input := usecase.OrganizationMemberRemoveInput{
+		UserID:         userID,
+		OrganizationID: organizationID,
+		MemberUserID:   memberUserID,
+	}
+
+	err = h.organizationMemberRemoveUseCase.Execute(ctx, input)
+	if err != nil {
+		h.logger.Error().Msgf("Failed to remove organization member: %v", err)
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary		Invite organization member
+// @Description	Invites a registered user to an organization by email, available to admins and owners.
+// @Description	The response is the same whether or not the email has an account.
+// @Tags		Organizations
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id		path	int	true	"Organization ID"
+// @Param		request	body	dto.InviteOrganizationMemberRequest	true	"Invitation data"
+// @Success		202		"Invitation requested"
+// @Failure		400	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"The organization role does not allow this action"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/organizations/{id}/invitations [post]
+func (h *OrganizationHandler) InviteMember(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	var inviteRequest dto.InviteOrganizationMemberRequest
+	if err := c.BodyParser(&inviteRequest); err != nil {
+		h.logger.Error().Msgf("Failed to parse request body: %v", err)
+		return err
+	}
+
+	userID, ok := request.UserIDFromContext(ctx)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "UserID not found")
+	}
+
+	organizationID, err := parseIDParam(c, "id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "Invalid organization ID")
+	}
+
+	input := usecase.OrganizationInviteInput{
+		UserID:         userID,
+		OrganizationID: organizationID,
+		Email:          inviteRequest.Email,
+		Role:           inviteRequest.Role,
+	}
+
+	err = h.organizationInviteUseCase.Execute(ctx, input)
+	if err != nil {
+		h.logger.Error().Msgf("Failed to invite organization member: %v", err)
+		return err
+	}
+
+	return c.SendStatus(http.StatusAccepted)
+}
+
+// @Summary		Accept organization invitation
+// @Description	Joins the organization the authenticated user was invited to
+// @Tags		Organizations
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		request	body	dto.AcceptOrganizationInvitationRequest	true	"Invitation and its token"
+// @Success		200	{object}	response.Envelope[dto.OrganizationMemberResponse]	"Successfully joined organization"
+// @Failure		400	{object}	errs.Error	"Invalid invitation token"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/organizations/invitations/accept [post]
+func (h *OrganizationHandler) AcceptInvitation(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	var acceptRequest dto.AcceptOrganizationInvitationRequest
+	if err := c.BodyParser(&acceptRequest); err != nil {
+		h.logger.Error().Msgf("Failed to parse request body: %v", err)
+		return err
+	}
+
+	userID, ok := request.UserIDFromContext(ctx)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "UserID not found")
+	}
+
+	input := usecase.OrganizationInvitationAcceptInput{
+		UserID:       userID,
+		InvitationID: acceptRequest.InvitationID,
+		Token:        acceptRequest.Token,
+	}
+
+	output, err := h.organizationInvitationAcceptUseCase.Execute(ctx, input)
+	if err != nil {
+		h.logger.Error().Msgf("Failed to accept organization invitation: %v", err)
+		return err
+	}
+
+	res := response.NewEnvelope(toOrganizationMemberResponse(output))
+	return c.Status(http.StatusOK).JSON(res)
+}
+
+func parseIDParam(c *fiber.Ctx, key string) (uint64, error) {
+	return strconv.ParseUint(c.Params(key), 10, 64)
+}
+
+func toOrganizationResponse(output usecase.OrganizationOutput) dto.OrganizationResponse {
+	return dto.OrganizationResponse{
+		OrganizationID: output.OrganizationID,
+		Name:           output.Name,
+		Role:           output.Role,
+	}
+}
+
+func toOrganizationMemberResponse(output usecase.OrganizationMemberOutput) dto.OrganizationMemberResponse {
+	return dto.OrganizationMemberResponse{
+		UserID:    output.UserID,
+		Role:      output.Role,
+		CreatedAt: output.CreatedAt,
+	}
+}
@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/http/dto"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/usecase"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/sdk/http/request"
+	"github.com/cristiano-pacheco/pingo/internal/shared/sdk/http/response"
+	"github.com/gofiber/fiber/v2"
+)
+
+type TOTPHandler struct {
+	totpEnrollUseCase  *usecase.TOTPEnrollUseCase
+	totpConfirmUseCase *usecase.TOTPConfirmUseCase
+	totpDisableUseCase *usecase.TOTPDisableUseCase
+	logger             logger.Logger
+}
+
+func NewTOTPHandler(
+	totpEnrollUseCase *usecase.TOTPEnrollUseCase,
+	totpConfirmUseCase *usecase.TOTPConfirmUseCase,
+	totpDisableUseCase *usecase.TOTPDisableUseCase,
+	logger logger.Logger,
+) *TOTPHandler {
+	return &TOTPHandler{
+		totpEnrollUseCase:  totpEnrollUseCase,
+		totpConfirmUseCase: totpConfirmUseCase,
+		totpDisableUseCase: totpDisableUseCase,
+		logger:             logger,
+	}
+}
+
+// @Summary		Enroll an authenticator app
+// @Description	Generates the TOTP secret of an authenticator app, used for logging in once confirmed
+// @Tags		TOTP
+// @Produce		json
+// @Security 	BearerAuth
+// @Success		201	{object}	response.Envelope[dto.TOTPEnrollResponse]	"Secret and QR provisioning URI"
+// @Failure		400	{object}	errs.Error	"An authenticator app is already enrolled"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Failure		503	{object}	errs.Error	"Authenticator apps are not configured"
+// @Router		/api/v1/auth/totp [post]
+func (h *TOTPHandler) Enroll(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	userID, ok := request.UserIDFromContext(ctx)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "UserID not found")
+	}
+
+	output, err := h.totpEnrollUseCase.Execute(ctx, usecase.TOTPEnrollInput{UserID: userID})
+	if err != nil {
+		h.logger.Error().Msgf("Failed to enroll authenticator app: %v", err)
+		return err
+	}
+
+	totpEnrollResponse := dto.TOTPEnrollResponse{
+		Secret:          output.Secret,
+		ProvisioningURI: output.ProvisioningURI,
+	}
+
+	res := response.NewEnvelope(totpEnrollResponse)
+	return c.Status(http.StatusCreated).JSON(res)
+}
+
+// @Summary		Confirm the authenticator app
+// @Description	Confirms the authenticator app with one of its codes, the recovery codes are only returned here
+// @Tags		TOTP
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		request	body	dto.TOTPCodeRequest	true	"Code of the authenticator app"
+// @Success		200	{object}	response.Envelope[dto.TOTPConfirmResponse]	"Successfully confirmed"
+// @Failure		400	{object}	errs.Error	"Invalid code or no pending enrollment"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/auth/totp/confirm [post]
+func (h *TOTPHandler) Confirm(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	var totpCodeRequest dto.TOTPCodeRequest
+	if err := c.BodyParser(&totpCodeRequest); err != nil {
+		h.logger.Error().Msgf("Failed to parse request body: %v", err)
+		return err
+	}
+
+	userID, ok := request.UserIDFromContext(ctx)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "UserID not found")
+	}
+
+	input := usecase.TOTPConfirmInput{UserID: userID, Code: totpCodeRequest.Code}
+	output, err := h.totpConfirmUseCase.Execute(ctx, input)
+	if err != nil {
+		h.logger.Error().Msgf("Failed to confirm authenticator app: %v", err)
+		return err
+	}
+
+	res := response.NewEnvelope(dto.TOTPConfirmResponse{RecoveryCodes: output.RecoveryCodes})
+	return c.Status(http.StatusOK).JSON(res)
+}
+
+// @Summary		Disable the authenticator app
+// @Description	Removes the authenticator app and its recovery codes, the login goes back to emailed codes
+// @Tags		TOTP
+// @Accept		json
+// @Security 	BearerAuth
+// @Param		request	body	dto.TOTPCodeRequest	true	"Code of the authenticator app or a recovery code"
+// @Success		204		"Successfully disabled authenticator app"
+// @Failure		400	{object}	errs.Error	"Invalid code or no authenticator app enrolled"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		429	{object}	errs.Error	"Too many invalid codes, try again later"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/auth/totp/disable [post]
+func (h *TOTPHandler) Disable(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	var totpCodeRequest dto.TOTPCodeRequest
+	if err := c.BodyParser(&totpCodeRequest); err != nil {
+		h.logger.Error().Msgf("Failed to parse request body: %v", err)
+		return err
+	}
+
+	userID, ok := request.UserIDFromContext(ctx)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "UserID not found")
+	}
+
+	input := usecase.TOTPDisableInput{UserID: userID, Code: totpCodeRequest.Code}
+	if err := h.totpDisableUseCase.Execute(ctx, input); err != nil {
+		h.logger.Error().Msgf("Failed to disable authenticator app: %v", err)
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
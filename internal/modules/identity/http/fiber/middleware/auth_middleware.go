@@ -8,6 +8,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/golang-jwt/jwt/v5"
 
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
 	internal_jwt "github.com/cristiano-pacheco/pingo/internal/shared/modules/jwt"
@@ -19,6 +20,8 @@ import (
 type AuthMiddleware struct {
 	privateKeyRegistry    registry.PrivateKeyRegistryI
 	userActivationService service.UserActivationServiceI
+	apiKeyService         service.APIKeyServiceI
+	sessionService        service.SessionServiceI
 	jwtParser             *jwt.Parser
 	logger                logger.Logger
 }
@@ -26,40 +29,55 @@ type AuthMiddleware struct {
 func NewAuthMiddleware(
 	privateKeyRegistry registry.PrivateKeyRegistryI,
 	userActivationService service.UserActivationServiceI,
+	apiKeyService service.APIKeyServiceI,
+	sessionService service.SessionServiceI,
 	jwtParser *jwt.Parser,
 	logger logger.Logger,
 ) *AuthMiddleware {
 	return &AuthMiddleware{
 		privateKeyRegistry,
 		userActivationService,
+		apiKeyService,
+		sessionService,
 		jwtParser,
 		logger,
 	}
 }
 
+// Middleware authenticates the request with the bearer token, which is either a JWT or an API key.
+// JWTs are only accepted while the session they were issued for is active.
 func (m *AuthMiddleware) Middleware() fiber.Handler {
+	return m.authenticate(true)
+}
+
+// SessionMiddleware authenticates the request with the JWT of an active session and rejects API keys.
+// It guards the routes managing credentials and the account, so a leaked API key can't create
+// other keys, turn off the second factor or take over the account.
+func (m *AuthMiddleware) SessionMiddleware() fiber.Handler {
+	return m.authenticate(false)
+}
+
+func (m *AuthMiddleware) authenticate(allowAPIKeys bool) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		bearerToken := c.Get("Authorization")
 		if !strings.HasPrefix(bearerToken, "Bearer ") {
 			return fiber.ErrUnauthorized
 		}
 
-		jwtToken := strings.TrimSpace(bearerToken[7:])
-		pk := m.privateKeyRegistry.Get()
+		token := strings.TrimSpace(bearerToken[7:])
 
-		tokenKeyFunc := func(_ *jwt.Token) (interface{}, error) {
-			return &pk.PublicKey, nil
+		var userID, sessionID uint64
+		var err error
+		switch {
+		case service.IsAPIKey(token) && !allowAPIKeys:
+			return errs.ErrAPIKeyNotAllowed
+		case service.IsAPIKey(token):
+			userID, err = m.authenticateAPIKey(c, token)
+		default:
+			userID, sessionID, err = m.authenticateJWT(c, token)
 		}
-
-		var claims internal_jwt.Claims
-		token, err := m.jwtParser.ParseWithClaims(jwtToken, &claims, tokenKeyFunc)
-		if err != nil || !token.Valid {
-			return errs.ErrInvalidToken
-		}
-
-		userID, err := strconv.ParseUint(claims.Subject, 10, 64)
 		if err != nil {
-			return errs.ErrInvalidToken
+			return err
 		}
 
 		ctx := c.UserContext()
@@ -73,8 +91,61 @@ func (m *AuthMiddleware) Middleware() fiber.Handler {
 		}
 
 		newCtx := context.WithValue(ctx, request.UserIDKey, userID)
+		if sessionID != 0 {
+			newCtx = context.WithValue(newCtx, request.SessionIDKey, sessionID)
+		}
 		c.SetUserContext(newCtx)
 
 		return c.Next()
 	}
 }
+
+// authenticateJWT returns the user and the session the JWT was issued for.
+func (m *AuthMiddleware) authenticateJWT(c *fiber.Ctx, jwtToken string) (uint64, uint64, error) {
+	pk := m.privateKeyRegistry.Get()
+
+	tokenKeyFunc := func(_ *jwt.Token) (interface{}, error) {
+		return &pk.PublicKey, nil
+	}
+
+	var claims internal_jwt.Claims
+	token, err := m.jwtParser.ParseWithClaims(jwtToken, &claims, tokenKeyFunc)
+	if err != nil || !token.Valid || claims.SessionID == 0 {
+		return 0, 0, errs.ErrInvalidToken
+	}
+
+	userID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		return 0, 0, errs.ErrInvalidToken
+	}
+
+	isActive, err := m.sessionService.IsActive(c.UserContext(), userID, claims.SessionID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if !isActive {
+		return 0, 0, errs.ErrInvalidToken
+	}
+
+	return userID, claims.SessionID, nil
+}
+
+// authenticateAPIKey returns the owner of the API key when its scope allows the method of the request.
+func (m *AuthMiddleware) authenticateAPIKey(c *fiber.Ctx, key string) (uint64, error) {
+	apiKey, err := m.apiKeyService.Authenticate(c.UserContext(), key)
+	if err != nil {
+		return 0, err
+	}
+
+	scope, err := enum.NewAPIKeyScopeEnum(apiKey.Scope)
+	if err != nil {
+		return 0, err
+	}
+
+	if !scope.AllowsMethod(c.Method()) {
+		return 0, errs.ErrAPIKeyScopeDenied
+	}
+
+	return apiKey.UserID, nil
+}
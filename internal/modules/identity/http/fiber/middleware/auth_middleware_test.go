@@ -0,0 +1,213 @@
+package middleware_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/http/fiber/middleware"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	internal_jwt "github.com/cristiano-pacheco/pingo/internal/shared/modules/jwt"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	registry_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/registry/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/shared/sdk/http/request"
+	"github.com/cristiano-pacheco/pingo/pkg/errs"
+)
+
+const (
+	testUserID    = uint64(42)
+	testSessionID = uint64(7)
+	testAPIKey    = "pingo_0a1b2c3d_secret"
+)
+
+type AuthMiddlewareTestSuite struct {
+	suite.Suite
+	privateKey                *rsa.PrivateKey
+	privateKeyRegistryMock    *registry_mocks.MockPrivateKeyRegistryI
+	userActivationServiceMock *mocks.MockUserActivationServiceI
+	apiKeyServiceMock         *mocks.MockAPIKeyServiceI
+	sessionServiceMock        *mocks.MockSessionServiceI
+	sut                       *middleware.AuthMiddleware
+}
+
+func (s *AuthMiddlewareTestSuite) SetupSuite() {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.Require().NoError(err)
+	s.privateKey = privateKey
+}
+
+func (s *AuthMiddlewareTestSuite) SetupTest() {
+	s.privateKeyRegistryMock = registry_mocks.NewMockPrivateKeyRegistryI(s.T())
+	s.userActivationServiceMock = mocks.NewMockUserActivationServiceI(s.T())
+	s.apiKeyServiceMock = mocks.NewMockAPIKeyServiceI(s.T())
+	s.sessionServiceMock = mocks.NewMockSessionServiceI(s.T())
+
+	s.privateKeyRegistryMock.On("Get").Return(s.privateKey).Maybe()
+
+	s.sut = middleware.NewAuthMiddleware(
+		s.privateKeyRegistryMock,
+		s.userActivationServiceMock,
+		s.apiKeyServiceMock,
+		s.sessionServiceMock,
+		internal_jwt.NewParser(),
+		logger.New(config.Config{Log: config.Log{LogLevel: "disabled"}}),
+	)
+}
+
+func TestAuthMiddlewareSuite(t *testing.T) {
+	suite.Run(t, new(AuthMiddlewareTestSuite))
+}
+
+// do sends a request through handler to a route answering with the authenticated user and session.
+func (s *AuthMiddlewareTestSuite) do(handler fiber.Handler, method, token string) (int, string) {
+	app := fiber.New(fiber.Config{
+		ErrorHandler: func(c *fiber.Ctx, err error) error {
+			var customErr *errs.Error
+			if errors.As(err, &customErr) {
+				return c.Status(customErr.Status).SendString(customErr.Code)
+			}
+			var fiberErr *fiber.Error
+			if errors.As(err, &fiberErr) {
+				return c.SendStatus(fiberErr.Code)
+			}
+			return c.SendStatus(http.StatusInternalServerError)
+		},
+	})
+	app.Add(method, "/api/v1/api-keys", handler, func(c *fiber.Ctx) error {
+		userID, _ := c.UserContext().Value(request.UserIDKey).(uint64)
+		sessionID, _ := c.UserContext().Value(request.SessionIDKey).(uint64)
+		return c.SendString(strconv.FormatUint(userID, 10) + ":" + strconv.FormatUint(sessionID, 10))
+	})
+
+	req := httptest.NewRequest(method, "/api/v1/api-keys", nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := app.Test(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	body := make([]byte, 64)
+	n, _ := resp.Body.Read(body)
+	return resp.StatusCode, string(body[:n])
+}
+
+func (s *AuthMiddlewareTestSuite) sessionToken() string {
+	now := time.Now()
+	claims := internal_jwt.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   strconv.FormatUint(testUserID, 10),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+		},
+		SessionID: testSessionID,
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, claims).SignedString(s.privateKey)
+	s.Require().NoError(err)
+	return token
+}
+
+func (s *AuthMiddlewareTestSuite) activeSession() {
+	s.sessionServiceMock.On("IsActive", mock.Anything, testUserID, testSessionID).Return(true, nil).Once()
+	s.userActivationServiceMock.On("IsUserActivated", mock.Anything, testUserID).Return(true, nil).Once()
+}
+
+func (s *AuthMiddlewareTestSuite) validAPIKey(scope string) {
+	apiKey := model.APIKeyModel{ID: 1, UserID: testUserID, Scope: scope}
+	s.apiKeyServiceMock.On("Authenticate", mock.Anything, testAPIKey).Return(apiKey, nil).Once()
+}
+
+func (s *AuthMiddlewareTestSuite) TestMiddleware_SessionJWT_AuthenticatesUserAndSession() {
+	// Arrange
+	s.activeSession()
+
+	// Act
+	status, body := s.do(s.sut.Middleware(), http.MethodGet, s.sessionToken())
+
+	// Assert
+	s.Equal(http.StatusOK, status)
+	s.Equal("42:7", body)
+}
+
+func (s *AuthMiddlewareTestSuite) TestMiddleware_WriteAPIKey_AuthenticatesUserWithoutSession() {
+	// Arrange
+	s.validAPIKey(enum.APIKeyScopeWrite)
+	s.userActivationServiceMock.On("IsUserActivated", mock.Anything, testUserID).Return(true, nil).Once()
+
+	// Act
+	status, body := s.do(s.sut.Middleware(), http.MethodPost, testAPIKey)
+
+	// Assert
+	s.Equal(http.StatusOK, status)
+	s.Equal("42:0", body)
+}
+
+func (s *AuthMiddlewareTestSuite) TestMiddleware_ReadAPIKeyOnWriteMethod_ReturnsForbidden() {
+	// Arrange
+	s.validAPIKey(enum.APIKeyScopeRead)
+
+	// Act
+	status, body := s.do(s.sut.Middleware(), http.MethodDelete, testAPIKey)
+
+	// Assert
+	s.Equal(http.StatusForbidden, status)
+	s.Equal("IDENTITY_23", body)
+}
+
+func (s *AuthMiddlewareTestSuite) TestMiddleware_NoBearerToken_ReturnsUnauthorized() {
+	// Act
+	status, _ := s.do(s.sut.Middleware(), http.MethodGet, "")
+
+	// Assert
+	s.Equal(http.StatusUnauthorized, status)
+}
+
+func (s *AuthMiddlewareTestSuite) TestSessionMiddleware_SessionJWT_AuthenticatesUserAndSession() {
+	// Arrange
+	s.activeSession()
+
+	// Act
+	status, body := s.do(s.sut.SessionMiddleware(), http.MethodPost, s.sessionToken())
+
+	// Assert
+	s.Equal(http.StatusOK, status)
+	s.Equal("42:7", body)
+}
+
+func (s *AuthMiddlewareTestSuite) TestSessionMiddleware_APIKey_ReturnsForbiddenForEveryMethod() {
+	methods := []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete}
+
+	for _, method := range methods {
+		// Act
+		status, body := s.do(s.sut.SessionMiddleware(), method, testAPIKey)
+
+		// Assert
+		s.Equal(http.StatusForbidden, status, method)
+		s.Equal("IDENTITY_34", body, method)
+	}
+	s.apiKeyServiceMock.AssertNotCalled(s.T(), "Authenticate", mock.Anything, mock.Anything)
+}
+
+func (s *AuthMiddlewareTestSuite) TestSessionMiddleware_RevokedSession_ReturnsUnauthorized() {
+	// Arrange
+	s.sessionServiceMock.On("IsActive", mock.Anything, testUserID, testSessionID).Return(false, nil).Once()
+
+	// Act
+	status, _ := s.do(s.sut.SessionMiddleware(), http.MethodPost, s.sessionToken())
+
+	// Assert
+	s.Equal(http.StatusUnauthorized, status)
+}
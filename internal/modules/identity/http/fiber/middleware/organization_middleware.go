@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+	"github.com/cristiano-pacheco/pingo/internal/shared/sdk/http/request"
+)
+
+// OrganizationIDHeader selects the organization a request is made in.
+const OrganizationIDHeader = "X-Organization-ID"
+
+type OrganizationMiddleware struct {
+	organizationAuthorizationService service.OrganizationAuthorizationServiceI
+}
+
+func NewOrganizationMiddleware(
+	organizationAuthorizationService service.OrganizationAuthorizationServiceI,
+) *OrganizationMiddleware {
+	return &OrganizationMiddleware{
+		organizationAuthorizationService,
+	}
+}
+
+// RequireRole authorizes the requests made in an organization, the caller must be a member whose role
+// includes the given role. Requests without the organization header are made in the personal space
+// of the caller and pass through. It must run after the auth middleware.
+func (m *OrganizationMiddleware) RequireRole(role string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		organizationIDStr := c.Get(OrganizationIDHeader)
+		if organizationIDStr == "" {
+			return c.Next()
+		}
+
+		organizationID, err := strconv.ParseUint(organizationIDStr, 10, 64)
+		if err != nil || organizationID == 0 {
+			return errs.ErrInvalidOrganizationID
+		}
+
+		ctx := c.UserContext()
+		userID, ok := request.UserIDFromContext(ctx)
+		if !ok {
+			return fiber.ErrUnauthorized
+		}
+
+		_, err = m.organizationAuthorizationService.Authorize(ctx, userID, organizationID, role)
+		if err != nil {
+			return err
+		}
+
+		newCtx := context.WithValue(ctx, request.OrganizationIDKey, organizationID)
+		c.SetUserContext(newCtx)
+
+		return c.Next()
+	}
+}
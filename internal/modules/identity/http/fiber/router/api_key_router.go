@@ -0,0 +1,19 @@
+package router
+
+import (
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/http/fiber/handler"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/http/fiber/middleware"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/http/router"
+)
+
+func SetupAPIKeyRoutes(
+	router *router.FiberRouter,
+	handler *handler.APIKeyHandler,
+	authMiddleware *middleware.AuthMiddleware,
+) {
+	r := router.Router()
+
+	r.Get("/api/v1/api-keys", authMiddleware.SessionMiddleware(), handler.ListAPIKeys)
+	r.Post("/api/v1/api-keys", authMiddleware.SessionMiddleware(), handler.CreateAPIKey)
+	r.Delete("/api/v1/api-keys/:id", authMiddleware.SessionMiddleware(), handler.DeleteAPIKey)
+}
@@ -2,11 +2,19 @@ package router
 
 import (
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/http/fiber/handler"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/http/fiber/middleware"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/http/router"
 )
 
-func SetupAuthRoutes(r *router.FiberRouter, h *handler.AuthHandler) {
+func SetupAuthRoutes(r *router.FiberRouter, h *handler.AuthHandler, authMiddleware *middleware.AuthMiddleware) {
 	router := r.Router()
 	router.Post("/api/v1/auth/login", h.Login)
 	router.Post("/api/v1/auth/token", h.GenerateJWT)
+	router.Post("/api/v1/auth/refresh", h.RefreshToken)
+	router.Post("/api/v1/auth/magic-link", h.RequestMagicLink)
+	router.Post("/api/v1/auth/magic-link/token", h.MagicLinkLogin)
+	router.Post("/api/v1/auth/password/forgot", h.ForgotPassword)
+	router.Post("/api/v1/auth/password/reset", h.ResetPassword)
+	router.Post("/api/v1/auth/logout", authMiddleware.SessionMiddleware(), h.Logout)
+	router.Post("/api/v1/auth/logout/all", authMiddleware.SessionMiddleware(), h.LogoutAll)
 }
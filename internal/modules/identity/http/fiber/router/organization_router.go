@@ -0,0 +1,23 @@
+package router
+
+import (
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/http/fiber/handler"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/http/fiber/middleware"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/http/router"
+)
+
+func SetupOrganizationRoutes(
+	router *router.FiberRouter,
+	handler *handler.OrganizationHandler,
+	authMiddleware *middleware.AuthMiddleware,
+) {
+	r := router.Router()
+
+	r.Get("/api/v1/organizations", authMiddleware.SessionMiddleware(), handler.ListOrganizations)
+	r.Post("/api/v1/organizations", authMiddleware.SessionMiddleware(), handler.CreateOrganization)
+	r.Post("/api/v1/organizations/invitations/accept", authMiddleware.SessionMiddleware(), handler.AcceptInvitation)
+	r.Get("/api/v1/organizations/:id/members", authMiddleware.SessionMiddleware(), handler.ListMembers)
+	r.Put("/api/v1/organizations/:id/members/:user_id", authMiddleware.SessionMiddleware(), handler.UpdateMember)
+	r.Delete("/api/v1/organizations/:id/members/:user_id", authMiddleware.SessionMiddleware(), handler.RemoveMember)
+	r.Post("/api/v1/organizations/:id/invitations", authMiddleware.SessionMiddleware(), handler.InviteMember)
+}
@@ -0,0 +1,19 @@
+package router
+
+import (
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/http/fiber/handler"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/http/fiber/middleware"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/http/router"
+)
+
+func SetupTOTPRoutes(
+	router *router.FiberRouter,
+	handler *handler.TOTPHandler,
+	authMiddleware *middleware.AuthMiddleware,
+) {
+	r := router.Router()
+
+	r.Post("/api/v1/auth/totp", authMiddleware.SessionMiddleware(), handler.Enroll)
+	r.Post("/api/v1/auth/totp/confirm", authMiddleware.SessionMiddleware(), handler.Confirm)
+	r.Post("/api/v1/auth/totp/disable", authMiddleware.SessionMiddleware(), handler.Disable)
+}
@@ -15,5 +15,5 @@ func SetupUserRoutes(
 	r.Post("/api/v1/users", handler.CreateUser)
 	r.Post("/api/v1/users/activate", handler.ActivateUser)
 
-	r.Put("/api/v1/users", authMiddleware.Middleware(), handler.UpdateUser)
+	r.Put("/api/v1/users", authMiddleware.SessionMiddleware(), handler.UpdateUser)
 }
@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+type APIKeyModel struct {
+	ID         uint64 `gorm:"primarykey"`
+	UserID     uint64
+	Name       string
+	Scope      string `gorm:"type:varchar(20)"`
+	KeyPrefix  string `gorm:"type:varchar(50)"`
+	KeyHash    []byte `gorm:"type:bytea"`
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	CreatedAt  time.Time
+}
+
+func (*APIKeyModel) TableName() string {
+	return "api_keys"
+}
@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+type OrganizationInvitationModel struct {
+	ID              uint64 `gorm:"primarykey"`
+	OrganizationID  uint64
+	UserID          uint64
+	OneTimeTokenID  uint64
+	Role            string `gorm:"type:varchar(20)"`
+	InvitedByUserID uint64
+	CreatedAt       time.Time
+}
+
+func (*OrganizationInvitationModel) TableName() string {
+	return "organization_invitations"
+}
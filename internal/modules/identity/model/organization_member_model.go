@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+type OrganizationMemberModel struct {
+	OrganizationID uint64 `gorm:"primaryKey;autoIncrement:false"`
+	UserID         uint64 `gorm:"primaryKey;autoIncrement:false"`
+	Role           string `gorm:"type:varchar(20)"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (*OrganizationMemberModel) TableName() string {
+	return "organization_members"
+}
@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+type OrganizationModel struct {
+	ID        uint64 `gorm:"primarykey"`
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (*OrganizationModel) TableName() string {
+	return "organizations"
+}
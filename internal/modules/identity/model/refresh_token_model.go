@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+type RefreshTokenModel struct {
+	ID        uint64 `gorm:"primarykey"`
+	SessionID uint64
+	TokenHash []byte `gorm:"type:bytea"`
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+func (*RefreshTokenModel) TableName() string {
+	return "refresh_tokens"
+}
@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+type SessionModel struct {
+	ID        uint64 `gorm:"primarykey"`
+	UserID    uint64
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+func (*SessionModel) TableName() string {
+	return "sessions"
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+type TOTPEnrollmentModel struct {
+	ID              uint64 `gorm:"primarykey"`
+	UserID          uint64
+	SecretEncrypted []byte `gorm:"type:bytea"`
+	ConfirmedAt     *time.Time
+	LastUsedStep    int64
+	FailedAttempts  int
+	LockedUntil     *time.Time
+	CreatedAt       time.Time
+}
+
+func (*TOTPEnrollmentModel) TableName() string {
+	return "totp_enrollments"
+}
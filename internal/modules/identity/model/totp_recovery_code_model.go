@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+type TOTPRecoveryCodeModel struct {
+	ID        uint64 `gorm:"primarykey"`
+	UserID    uint64
+	CodeHash  []byte `gorm:"type:bytea"`
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+func (*TOTPRecoveryCodeModel) TableName() string {
+	return "totp_recovery_codes"
+}
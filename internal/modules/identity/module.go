@@ -11,9 +11,9 @@ import (
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/usecase"
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/validator"
-	shared_kafka "github.com/cristiano-pacheco/pingo/internal/shared/modules/kafka"
+	shared_broker "github.com/cristiano-pacheco/pingo/internal/shared/modules/broker"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
-	"github.com/cristiano-pacheco/pingo/pkg/kafka"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
 	"go.uber.org/fx"
 )
 
@@ -22,6 +22,9 @@ var Module = fx.Module(
 	fx.Provide(
 		handler.NewAuthHandler,
 		handler.NewUserHandler,
+		handler.NewOrganizationHandler,
+		handler.NewAPIKeyHandler,
+		handler.NewTOTPHandler,
 
 		fx.Annotate(
 			repository.NewUserRepository,
@@ -31,6 +34,38 @@ var Module = fx.Module(
 			repository.NewOneTimeTokenRepository,
 			fx.As(new(repository.OneTimeTokenRepositoryI)),
 		),
+		fx.Annotate(
+			repository.NewOrganizationRepository,
+			fx.As(new(repository.OrganizationRepositoryI)),
+		),
+		fx.Annotate(
+			repository.NewOrganizationMemberRepository,
+			fx.As(new(repository.OrganizationMemberRepositoryI)),
+		),
+		fx.Annotate(
+			repository.NewOrganizationInvitationRepository,
+			fx.As(new(repository.OrganizationInvitationRepositoryI)),
+		),
+		fx.Annotate(
+			repository.NewAPIKeyRepository,
+			fx.As(new(repository.APIKeyRepositoryI)),
+		),
+		fx.Annotate(
+			repository.NewSessionRepository,
+			fx.As(new(repository.SessionRepositoryI)),
+		),
+		fx.Annotate(
+			repository.NewRefreshTokenRepository,
+			fx.As(new(repository.RefreshTokenRepositoryI)),
+		),
+		fx.Annotate(
+			repository.NewTOTPEnrollmentRepository,
+			fx.As(new(repository.TOTPEnrollmentRepositoryI)),
+		),
+		fx.Annotate(
+			repository.NewTOTPRecoveryCodeRepository,
+			fx.As(new(repository.TOTPRecoveryCodeRepositoryI)),
+		),
 
 		fx.Annotate(
 			service.NewSendEmailConfirmationService,
@@ -56,6 +91,38 @@ var Module = fx.Module(
 			service.NewUserActivationService,
 			fx.As(new(service.UserActivationServiceI)),
 		),
+		fx.Annotate(
+			service.NewOrganizationAuthorizationService,
+			fx.As(new(service.OrganizationAuthorizationServiceI)),
+		),
+		fx.Annotate(
+			service.NewSendOrganizationInvitationService,
+			fx.As(new(service.SendOrganizationInvitationServiceI)),
+		),
+		fx.Annotate(
+			service.NewOneTimeLinkService,
+			fx.As(new(service.OneTimeLinkServiceI)),
+		),
+		fx.Annotate(
+			service.NewLoginChallengeService,
+			fx.As(new(service.LoginChallengeServiceI)),
+		),
+		fx.Annotate(
+			service.NewAPIKeyService,
+			fx.As(new(service.APIKeyServiceI)),
+		),
+		fx.Annotate(
+			service.NewSessionService,
+			fx.As(new(service.SessionServiceI)),
+		),
+		fx.Annotate(
+			service.NewTOTPService,
+			fx.As(new(service.TOTPServiceI)),
+		),
+		fx.Annotate(
+			service.NewTOTPVerificationService,
+			fx.As(new(service.TOTPVerificationServiceI)),
+		),
 
 		fx.Annotate(
 			validator.NewPasswordValidator,
@@ -71,9 +138,29 @@ var Module = fx.Module(
 		usecase.NewUserCreateUseCase,
 		usecase.NewAuthLoginUseCase,
 		usecase.NewAuthGenerateTokenUseCase,
+		usecase.NewAuthRefreshTokenUseCase,
+		usecase.NewAuthLogoutUseCase,
+		usecase.NewAuthPasswordForgotUseCase,
+		usecase.NewAuthPasswordResetUseCase,
+		usecase.NewAuthMagicLinkRequestUseCase,
+		usecase.NewAuthMagicLinkLoginUseCase,
 		usecase.NewUserUpdateUseCase,
+		usecase.NewOrganizationCreateUseCase,
+		usecase.NewOrganizationListUseCase,
+		usecase.NewOrganizationMemberListUseCase,
+		usecase.NewOrganizationMemberUpdateUseCase,
+		usecase.NewOrganizationMemberRemoveUseCase,
+		usecase.NewOrganizationInviteUseCase,
+		usecase.NewOrganizationInvitationAcceptUseCase,
+		usecase.NewAPIKeyCreateUseCase,
+		usecase.NewAPIKeyListUseCase,
+		usecase.NewAPIKeyDeleteUseCase,
+		usecase.NewTOTPEnrollUseCase,
+		usecase.NewTOTPConfirmUseCase,
+		usecase.NewTOTPDisableUseCase,
 
 		middleware.NewAuthMiddleware,
+		middleware.NewOrganizationMiddleware,
 
 		fx.Annotate(
 			producer.NewUserAuthenticatedProducer,
@@ -87,25 +174,36 @@ var Module = fx.Module(
 			producer.NewUserUpdatedProducer,
 			fx.As(new(producer.UserUpdatedProducerI)),
 		),
+		fx.Annotate(
+			producer.NewOneTimeLinkRequestedProducer,
+			fx.As(new(producer.OneTimeLinkRequestedProducerI)),
+		),
 
 		consumer.NewUserCreatedConsumer,
 		consumer.NewUserAuthenticatedConsumer,
+		consumer.NewOneTimeLinkRequestedConsumer,
 	),
 	fx.Invoke(
 		router.SetupUserRoutes,
 		router.SetupAuthRoutes,
+		router.SetupOrganizationRoutes,
+		router.SetupAPIKeyRoutes,
+		router.SetupTOTPRoutes,
 		consumer.NewUserCreatedConsumer,
 		registerConsumerRunners,
 	),
 )
 
 func registerConsumerRunners(
-	builder kafka.Builder,
+	builder broker.Builder,
+	consumerRegistry *shared_broker.ConsumerRegistry,
 	logger logger.Logger,
 	lc fx.Lifecycle,
 	userCreatedConsumer *consumer.UserCreatedConsumer,
 	userAuthenticatedConsumer *consumer.UserAuthenticatedConsumer,
+	oneTimeLinkRequestedConsumer *consumer.OneTimeLinkRequestedConsumer,
 ) {
-	shared_kafka.NewConsumerRunner(builder, userCreatedConsumer, logger, lc)
-	shared_kafka.NewConsumerRunner(builder, userAuthenticatedConsumer, logger, lc)
+	shared_broker.NewConsumerRunner(builder, userCreatedConsumer, consumerRegistry, logger, lc)
+	shared_broker.NewConsumerRunner(builder, userAuthenticatedConsumer, consumerRegistry, logger, lc)
+	shared_broker.NewConsumerRunner(builder, oneTimeLinkRequestedConsumer, consumerRegistry, logger, lc)
 }
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	"github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+	"gorm.io/gorm"
+)
+
+type APIKeyRepositoryI interface {
+	FindAllByUserID(ctx context.Context, userID uint64) ([]model.APIKeyModel, error)
+	FindByKeyPrefix(ctx context.Context, keyPrefix string) (model.APIKeyModel, error)
+	Create(ctx context.Context, apiKey model.APIKeyModel) (model.APIKeyModel, error)
+	UpdateLastUsedAt(ctx context.Context, apiKeyID uint64, lastUsedAt time.Time) error
+	Delete(ctx context.Context, userID, apiKeyID uint64) error
+	DeleteAllByUserID(ctx context.Context, userID uint64) error
+}
+
+type APIKeyRepository struct {
+	*database.PingoDB
+}
+
+var _ APIKeyRepositoryI = (*APIKeyRepository)(nil)
+
+func NewAPIKeyRepository(db *database.PingoDB) *APIKeyRepository {
+	return &APIKeyRepository{db}
+}
+
+func (r *APIKeyRepository) FindAllByUserID(ctx context.Context, userID uint64) ([]model.APIKeyModel, error) {
+	ctx, otelSpan := trace.Span(ctx, "APIKeyRepository.FindAllByUserID")
+	defer otelSpan.End()
+
+	return gorm.G[model.APIKeyModel](r.Conn(ctx)).
+		Where("user_id = ?", userID).
+		Order("id").
+		Find(ctx)
+}
+
+func (r *APIKeyRepository) FindByKeyPrefix(ctx context.Context, keyPrefix string) (model.APIKeyModel, error) {
+	ctx, otelSpan := trace.Span(ctx, "APIKeyRepository.FindByKeyPrefix")
+	defer otelSpan.End()
+
+	apiKey, err := gorm.G[model.APIKeyModel](r.Conn(ctx)).
+		Where("key_prefix = ?", keyPrefix).
+		First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.APIKeyModel{}, errs.ErrRecordNotFound
+		}
+		return model.APIKeyModel{}, err
+	}
+	return apiKey, nil
+}
+
+func (r *APIKeyRepository) Create(ctx context.Context, apiKey model.APIKeyModel) (model.APIKeyModel, error) {
+	ctx, otelSpan := trace.Span(ctx, "APIKeyRepository.Create")
+	defer otelSpan.End()
+
+	err := gorm.G[model.APIKeyModel](r.Conn(ctx)).Create(ctx, &apiKey)
+	return apiKey, err
+}
+
+func (r *APIKeyRepository) UpdateLastUsedAt(ctx context.Context, apiKeyID uint64, lastUsedAt time.Time) error {
+	ctx, otelSpan := trace.Span(ctx, "APIKeyRepository.UpdateLastUsedAt")
+	defer otelSpan.End()
+
+	return r.Conn(ctx).
+		Model(&model.APIKeyModel{}).
+		Where("id = ?", apiKeyID).
+		Update("last_used_at", lastUsedAt).
+		Error
+}
+
+func (r *APIKeyRepository) Delete(ctx context.Context, userID, apiKeyID uint64) error {
+	ctx, otelSpan := trace.Span(ctx, "APIKeyRepository.Delete")
+	defer otelSpan.End()
+
+	rowsAffected, err := gorm.G[model.APIKeyModel](r.Conn(ctx)).
+		Where("id = ? AND user_id = ?", apiKeyID, userID).
+		Delete(ctx)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errs.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *APIKeyRepository) DeleteAllByUserID(ctx context.Context, userID uint64) error {
+	ctx, otelSpan := trace.Span(ctx, "APIKeyRepository.DeleteAllByUserID")
+	defer otelSpan.End()
+
+	_, err := gorm.G[model.APIKeyModel](r.Conn(ctx)).
+		Where("user_id = ?", userID).
+		Delete(ctx)
+	return err
+}
@@ -0,0 +1,355 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockAPIKeyRepositoryI is an autogenerated mock type for the APIKeyRepositoryI type
+type MockAPIKeyRepositoryI struct {
+	mock.Mock
+}
+
+type MockAPIKeyRepositoryI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAPIKeyRepositoryI) EXPECT() *MockAPIKeyRepositoryI_Expecter {
+	return &MockAPIKeyRepositoryI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, apiKey
+func (_m *MockAPIKeyRepositoryI) Create(ctx context.Context, apiKey model.APIKeyModel) (model.APIKeyModel, error) {
+	ret := _m.Called(ctx, apiKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.APIKeyModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.APIKeyModel) (model.APIKeyModel, error)); ok {
+		return rf(ctx, apiKey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.APIKeyModel) model.APIKeyModel); ok {
+		r0 = rf(ctx, apiKey)
+	} else {
+		r0 = ret.Get(0).(model.APIKeyModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.APIKeyModel) error); ok {
+		r1 = rf(ctx, apiKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPIKeyRepositoryI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockAPIKeyRepositoryI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - apiKey model.APIKeyModel
+func (_e *MockAPIKeyRepositoryI_Expecter) Create(ctx interface{}, apiKey interface{}) *MockAPIKeyRepositoryI_Create_Call {
+	return &MockAPIKeyRepositoryI_Create_Call{Call: _e.mock.On("Create", ctx, apiKey)}
+}
+
+func (_c *MockAPIKeyRepositoryI_Create_Call) Run(run func(ctx context.Context, apiKey model.APIKeyModel)) *MockAPIKeyRepositoryI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.APIKeyModel))
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepositoryI_Create_Call) Return(_a0 model.APIKeyModel, _a1 error) *MockAPIKeyRepositoryI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPIKeyRepositoryI_Create_Call) RunAndReturn(run func(context.Context, model.APIKeyModel) (model.APIKeyModel, error)) *MockAPIKeyRepositoryI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, userID, apiKeyID
+func (_m *MockAPIKeyRepositoryI) Delete(ctx context.Context, userID uint64, apiKeyID uint64) error {
+	ret := _m.Called(ctx, userID, apiKeyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) error); ok {
+		r0 = rf(ctx, userID, apiKeyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPIKeyRepositoryI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockAPIKeyRepositoryI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - apiKeyID uint64
+func (_e *MockAPIKeyRepositoryI_Expecter) Delete(ctx interface{}, userID interface{}, apiKeyID interface{}) *MockAPIKeyRepositoryI_Delete_Call {
+	return &MockAPIKeyRepositoryI_Delete_Call{Call: _e.mock.On("Delete", ctx, userID, apiKeyID)}
+}
+
+func (_c *MockAPIKeyRepositoryI_Delete_Call) Run(run func(ctx context.Context, userID uint64, apiKeyID uint64)) *MockAPIKeyRepositoryI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepositoryI_Delete_Call) Return(_a0 error) *MockAPIKeyRepositoryI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPIKeyRepositoryI_Delete_Call) RunAndReturn(run func(context.Context, uint64, uint64) error) *MockAPIKeyRepositoryI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAllByUserID provides a mock function with given fields: ctx, userID
+func (_m *MockAPIKeyRepositoryI) DeleteAllByUserID(ctx context.Context, userID uint64) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAllByUserID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPIKeyRepositoryI_DeleteAllByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAllByUserID'
+type MockAPIKeyRepositoryI_DeleteAllByUserID_Call struct {
+	*mock.Call
+}
+
+// DeleteAllByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockAPIKeyRepositoryI_Expecter) DeleteAllByUserID(ctx interface{}, userID interface{}) *MockAPIKeyRepositoryI_DeleteAllByUserID_Call {
+	return &MockAPIKeyRepositoryI_DeleteAllByUserID_Call{Call: _e.mock.On("DeleteAllByUserID", ctx, userID)}
+}
+
+func (_c *MockAPIKeyRepositoryI_DeleteAllByUserID_Call) Run(run func(ctx context.Context, userID uint64)) *MockAPIKeyRepositoryI_DeleteAllByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepositoryI_DeleteAllByUserID_Call) Return(_a0 error) *MockAPIKeyRepositoryI_DeleteAllByUserID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPIKeyRepositoryI_DeleteAllByUserID_Call) RunAndReturn(run func(context.Context, uint64) error) *MockAPIKeyRepositoryI_DeleteAllByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAllByUserID provides a mock function with given fields: ctx, userID
+func (_m *MockAPIKeyRepositoryI) FindAllByUserID(ctx context.Context, userID uint64) ([]model.APIKeyModel, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllByUserID")
+	}
+
+	var r0 []model.APIKeyModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]model.APIKeyModel, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []model.APIKeyModel); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.APIKeyModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPIKeyRepositoryI_FindAllByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAllByUserID'
+type MockAPIKeyRepositoryI_FindAllByUserID_Call struct {
+	*mock.Call
+}
+
+// FindAllByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockAPIKeyRepositoryI_Expecter) FindAllByUserID(ctx interface{}, userID interface{}) *MockAPIKeyRepositoryI_FindAllByUserID_Call {
+	return &MockAPIKeyRepositoryI_FindAllByUserID_Call{Call: _e.mock.On("FindAllByUserID", ctx, userID)}
+}
+
+func (_c *MockAPIKeyRepositoryI_FindAllByUserID_Call) Run(run func(ctx context.Context, userID uint64)) *MockAPIKeyRepositoryI_FindAllByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepositoryI_FindAllByUserID_Call) Return(_a0 []model.APIKeyModel, _a1 error) *MockAPIKeyRepositoryI_FindAllByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPIKeyRepositoryI_FindAllByUserID_Call) RunAndReturn(run func(context.Context, uint64) ([]model.APIKeyModel, error)) *MockAPIKeyRepositoryI_FindAllByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByKeyPrefix provides a mock function with given fields: ctx, keyPrefix
+func (_m *MockAPIKeyRepositoryI) FindByKeyPrefix(ctx context.Context, keyPrefix string) (model.APIKeyModel, error) {
+	ret := _m.Called(ctx, keyPrefix)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByKeyPrefix")
+	}
+
+	var r0 model.APIKeyModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.APIKeyModel, error)); ok {
+		return rf(ctx, keyPrefix)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.APIKeyModel); ok {
+		r0 = rf(ctx, keyPrefix)
+	} else {
+		r0 = ret.Get(0).(model.APIKeyModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, keyPrefix)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPIKeyRepositoryI_FindByKeyPrefix_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByKeyPrefix'
+type MockAPIKeyRepositoryI_FindByKeyPrefix_Call struct {
+	*mock.Call
+}
+
+// FindByKeyPrefix is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keyPrefix string
+func (_e *MockAPIKeyRepositoryI_Expecter) FindByKeyPrefix(ctx interface{}, keyPrefix interface{}) *MockAPIKeyRepositoryI_FindByKeyPrefix_Call {
+	return &MockAPIKeyRepositoryI_FindByKeyPrefix_Call{Call: _e.mock.On("FindByKeyPrefix", ctx, keyPrefix)}
+}
+
+func (_c *MockAPIKeyRepositoryI_FindByKeyPrefix_Call) Run(run func(ctx context.Context, keyPrefix string)) *MockAPIKeyRepositoryI_FindByKeyPrefix_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepositoryI_FindByKeyPrefix_Call) Return(_a0 model.APIKeyModel, _a1 error) *MockAPIKeyRepositoryI_FindByKeyPrefix_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPIKeyRepositoryI_FindByKeyPrefix_Call) RunAndReturn(run func(context.Context, string) (model.APIKeyModel, error)) *MockAPIKeyRepositoryI_FindByKeyPrefix_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLastUsedAt provides a mock function with given fields: ctx, apiKeyID, lastUsedAt
+func (_m *MockAPIKeyRepositoryI) UpdateLastUsedAt(ctx context.Context, apiKeyID uint64, lastUsedAt time.Time) error {
+	ret := _m.Called(ctx, apiKeyID, lastUsedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLastUsedAt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time) error); ok {
+		r0 = rf(ctx, apiKeyID, lastUsedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPIKeyRepositoryI_UpdateLastUsedAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLastUsedAt'
+type MockAPIKeyRepositoryI_UpdateLastUsedAt_Call struct {
+	*mock.Call
+}
+
+// UpdateLastUsedAt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - apiKeyID uint64
+//   - lastUsedAt time.Time
+func (_e *MockAPIKeyRepositoryI_Expecter) UpdateLastUsedAt(ctx interface{}, apiKeyID interface{}, lastUsedAt interface{}) *MockAPIKeyRepositoryI_UpdateLastUsedAt_Call {
+	return &MockAPIKeyRepositoryI_UpdateLastUsedAt_Call{Call: _e.mock.On("UpdateLastUsedAt", ctx, apiKeyID, lastUsedAt)}
+}
+
+func (_c *MockAPIKeyRepositoryI_UpdateLastUsedAt_Call) Run(run func(ctx context.Context, apiKeyID uint64, lastUsedAt time.Time)) *MockAPIKeyRepositoryI_UpdateLastUsedAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepositoryI_UpdateLastUsedAt_Call) Return(_a0 error) *MockAPIKeyRepositoryI_UpdateLastUsedAt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPIKeyRepositoryI_UpdateLastUsedAt_Call) RunAndReturn(run func(context.Context, uint64, time.Time) error) *MockAPIKeyRepositoryI_UpdateLastUsedAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAPIKeyRepositoryI creates a new instance of MockAPIKeyRepositoryI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAPIKeyRepositoryI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAPIKeyRepositoryI {
+	mock := &MockAPIKeyRepositoryI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
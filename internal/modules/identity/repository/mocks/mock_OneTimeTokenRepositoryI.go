@@ -129,6 +129,54 @@ func (_c *MockOneTimeTokenRepositoryI_Delete_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// DeleteByID provides a mock function with given fields: ctx, userID, tokenID
+func (_m *MockOneTimeTokenRepositoryI) DeleteByID(ctx context.Context, userID uint64, tokenID uint64) error {
+	ret := _m.Called(ctx, userID, tokenID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) error); ok {
+		r0 = rf(ctx, userID, tokenID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockOneTimeTokenRepositoryI_DeleteByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByID'
+type MockOneTimeTokenRepositoryI_DeleteByID_Call struct {
+	*mock.Call
+}
+
+// DeleteByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - tokenID uint64
+func (_e *MockOneTimeTokenRepositoryI_Expecter) DeleteByID(ctx interface{}, userID interface{}, tokenID interface{}) *MockOneTimeTokenRepositoryI_DeleteByID_Call {
+	return &MockOneTimeTokenRepositoryI_DeleteByID_Call{Call: _e.mock.On("DeleteByID", ctx, userID, tokenID)}
+}
+
+func (_c *MockOneTimeTokenRepositoryI_DeleteByID_Call) Run(run func(ctx context.Context, userID uint64, tokenID uint64)) *MockOneTimeTokenRepositoryI_DeleteByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockOneTimeTokenRepositoryI_DeleteByID_Call) Return(_a0 error) *MockOneTimeTokenRepositoryI_DeleteByID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockOneTimeTokenRepositoryI_DeleteByID_Call) RunAndReturn(run func(context.Context, uint64, uint64) error) *MockOneTimeTokenRepositoryI_DeleteByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Find provides a mock function with given fields: ctx, userID, tokenTypeEnum
 func (_m *MockOneTimeTokenRepositoryI) Find(ctx context.Context, userID uint64, tokenTypeEnum enum.TokenTypeEnum) (model.OneTimeTokenModel, error) {
 	ret := _m.Called(ctx, userID, tokenTypeEnum)
@@ -187,6 +235,65 @@ func (_c *MockOneTimeTokenRepositoryI_Find_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// FindByID provides a mock function with given fields: ctx, userID, tokenID, tokenTypeEnum
+func (_m *MockOneTimeTokenRepositoryI) FindByID(ctx context.Context, userID uint64, tokenID uint64, tokenTypeEnum enum.TokenTypeEnum) (model.OneTimeTokenModel, error) {
+	ret := _m.Called(ctx, userID, tokenID, tokenTypeEnum)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 model.OneTimeTokenModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, enum.TokenTypeEnum) (model.OneTimeTokenModel, error)); ok {
+		return rf(ctx, userID, tokenID, tokenTypeEnum)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, enum.TokenTypeEnum) model.OneTimeTokenModel); ok {
+		r0 = rf(ctx, userID, tokenID, tokenTypeEnum)
+	} else {
+		r0 = ret.Get(0).(model.OneTimeTokenModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, enum.TokenTypeEnum) error); ok {
+		r1 = rf(ctx, userID, tokenID, tokenTypeEnum)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOneTimeTokenRepositoryI_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockOneTimeTokenRepositoryI_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - tokenID uint64
+//   - tokenTypeEnum enum.TokenTypeEnum
+func (_e *MockOneTimeTokenRepositoryI_Expecter) FindByID(ctx interface{}, userID interface{}, tokenID interface{}, tokenTypeEnum interface{}) *MockOneTimeTokenRepositoryI_FindByID_Call {
+	return &MockOneTimeTokenRepositoryI_FindByID_Call{Call: _e.mock.On("FindByID", ctx, userID, tokenID, tokenTypeEnum)}
+}
+
+func (_c *MockOneTimeTokenRepositoryI_FindByID_Call) Run(run func(ctx context.Context, userID uint64, tokenID uint64, tokenTypeEnum enum.TokenTypeEnum)) *MockOneTimeTokenRepositoryI_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(enum.TokenTypeEnum))
+	})
+	return _c
+}
+
+func (_c *MockOneTimeTokenRepositoryI_FindByID_Call) Return(_a0 model.OneTimeTokenModel, _a1 error) *MockOneTimeTokenRepositoryI_FindByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOneTimeTokenRepositoryI_FindByID_Call) RunAndReturn(run func(context.Context, uint64, uint64, enum.TokenTypeEnum) (model.OneTimeTokenModel, error)) *MockOneTimeTokenRepositoryI_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockOneTimeTokenRepositoryI creates a new instance of MockOneTimeTokenRepositoryI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockOneTimeTokenRepositoryI(t interface {
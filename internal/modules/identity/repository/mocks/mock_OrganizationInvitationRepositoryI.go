@@ -0,0 +1,200 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockOrganizationInvitationRepositoryI is an autogenerated mock type for the OrganizationInvitationRepositoryI type
+type MockOrganizationInvitationRepositoryI struct {
+	mock.Mock
+}
+
+type MockOrganizationInvitationRepositoryI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOrganizationInvitationRepositoryI) EXPECT() *MockOrganizationInvitationRepositoryI_Expecter {
+	return &MockOrganizationInvitationRepositoryI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, invitation
+func (_m *MockOrganizationInvitationRepositoryI) Create(ctx context.Context, invitation model.OrganizationInvitationModel) (model.OrganizationInvitationModel, error) {
+	ret := _m.Called(ctx, invitation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.OrganizationInvitationModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrganizationInvitationModel) (model.OrganizationInvitationModel, error)); ok {
+		return rf(ctx, invitation)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrganizationInvitationModel) model.OrganizationInvitationModel); ok {
+		r0 = rf(ctx, invitation)
+	} else {
+		r0 = ret.Get(0).(model.OrganizationInvitationModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.OrganizationInvitationModel) error); ok {
+		r1 = rf(ctx, invitation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOrganizationInvitationRepositoryI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockOrganizationInvitationRepositoryI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - invitation model.OrganizationInvitationModel
+func (_e *MockOrganizationInvitationRepositoryI_Expecter) Create(ctx interface{}, invitation interface{}) *MockOrganizationInvitationRepositoryI_Create_Call {
+	return &MockOrganizationInvitationRepositoryI_Create_Call{Call: _e.mock.On("Create", ctx, invitation)}
+}
+
+func (_c *MockOrganizationInvitationRepositoryI_Create_Call) Run(run func(ctx context.Context, invitation model.OrganizationInvitationModel)) *MockOrganizationInvitationRepositoryI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.OrganizationInvitationModel))
+	})
+	return _c
+}
+
+func (_c *MockOrganizationInvitationRepositoryI_Create_Call) Return(_a0 model.OrganizationInvitationModel, _a1 error) *MockOrganizationInvitationRepositoryI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOrganizationInvitationRepositoryI_Create_Call) RunAndReturn(run func(context.Context, model.OrganizationInvitationModel) (model.OrganizationInvitationModel, error)) *MockOrganizationInvitationRepositoryI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, userID, invitationID
+func (_m *MockOrganizationInvitationRepositoryI) Delete(ctx context.Context, userID uint64, invitationID uint64) error {
+	ret := _m.Called(ctx, userID, invitationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) error); ok {
+		r0 = rf(ctx, userID, invitationID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockOrganizationInvitationRepositoryI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockOrganizationInvitationRepositoryI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - invitationID uint64
+func (_e *MockOrganizationInvitationRepositoryI_Expecter) Delete(ctx interface{}, userID interface{}, invitationID interface{}) *MockOrganizationInvitationRepositoryI_Delete_Call {
+	return &MockOrganizationInvitationRepositoryI_Delete_Call{Call: _e.mock.On("Delete", ctx, userID, invitationID)}
+}
+
+func (_c *MockOrganizationInvitationRepositoryI_Delete_Call) Run(run func(ctx context.Context, userID uint64, invitationID uint64)) *MockOrganizationInvitationRepositoryI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockOrganizationInvitationRepositoryI_Delete_Call) Return(_a0 error) *MockOrganizationInvitationRepositoryI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockOrganizationInvitationRepositoryI_Delete_Call) RunAndReturn(run func(context.Context, uint64, uint64) error) *MockOrganizationInvitationRepositoryI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function with given fields: ctx, userID, invitationID
+func (_m *MockOrganizationInvitationRepositoryI) FindByID(ctx context.Context, userID uint64, invitationID uint64) (model.OrganizationInvitationModel, error) {
+	ret := _m.Called(ctx, userID, invitationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 model.OrganizationInvitationModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) (model.OrganizationInvitationModel, error)); ok {
+		return rf(ctx, userID, invitationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) model.OrganizationInvitationModel); ok {
+		r0 = rf(ctx, userID, invitationID)
+	} else {
+		r0 = ret.Get(0).(model.OrganizationInvitationModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, userID, invitationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOrganizationInvitationRepositoryI_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockOrganizationInvitationRepositoryI_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - invitationID uint64
+func (_e *MockOrganizationInvitationRepositoryI_Expecter) FindByID(ctx interface{}, userID interface{}, invitationID interface{}) *MockOrganizationInvitationRepositoryI_FindByID_Call {
+	return &MockOrganizationInvitationRepositoryI_FindByID_Call{Call: _e.mock.On("FindByID", ctx, userID, invitationID)}
+}
+
+func (_c *MockOrganizationInvitationRepositoryI_FindByID_Call) Run(run func(ctx context.Context, userID uint64, invitationID uint64)) *MockOrganizationInvitationRepositoryI_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockOrganizationInvitationRepositoryI_FindByID_Call) Return(_a0 model.OrganizationInvitationModel, _a1 error) *MockOrganizationInvitationRepositoryI_FindByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOrganizationInvitationRepositoryI_FindByID_Call) RunAndReturn(run func(context.Context, uint64, uint64) (model.OrganizationInvitationModel, error)) *MockOrganizationInvitationRepositoryI_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockOrganizationInvitationRepositoryI creates a new instance of MockOrganizationInvitationRepositoryI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOrganizationInvitationRepositoryI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOrganizationInvitationRepositoryI {
+	mock := &MockOrganizationInvitationRepositoryI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
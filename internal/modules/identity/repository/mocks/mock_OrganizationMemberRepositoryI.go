@@ -0,0 +1,358 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockOrganizationMemberRepositoryI is an autogenerated mock type for the OrganizationMemberRepositoryI type
+type MockOrganizationMemberRepositoryI struct {
+	mock.Mock
+}
+
+type MockOrganizationMemberRepositoryI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOrganizationMemberRepositoryI) EXPECT() *MockOrganizationMemberRepositoryI_Expecter {
+	return &MockOrganizationMemberRepositoryI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, member
+func (_m *MockOrganizationMemberRepositoryI) Create(ctx context.Context, member model.OrganizationMemberModel) error {
+	ret := _m.Called(ctx, member)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrganizationMemberModel) error); ok {
+		r0 = rf(ctx, member)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockOrganizationMemberRepositoryI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockOrganizationMemberRepositoryI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - member model.OrganizationMemberModel
+func (_e *MockOrganizationMemberRepositoryI_Expecter) Create(ctx interface{}, member interface{}) *MockOrganizationMemberRepositoryI_Create_Call {
+	return &MockOrganizationMemberRepositoryI_Create_Call{Call: _e.mock.On("Create", ctx, member)}
+}
+
+func (_c *MockOrganizationMemberRepositoryI_Create_Call) Run(run func(ctx context.Context, member model.OrganizationMemberModel)) *MockOrganizationMemberRepositoryI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.OrganizationMemberModel))
+	})
+	return _c
+}
+
+func (_c *MockOrganizationMemberRepositoryI_Create_Call) Return(_a0 error) *MockOrganizationMemberRepositoryI_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockOrganizationMemberRepositoryI_Create_Call) RunAndReturn(run func(context.Context, model.OrganizationMemberModel) error) *MockOrganizationMemberRepositoryI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, organizationID, userID
+func (_m *MockOrganizationMemberRepositoryI) Delete(ctx context.Context, organizationID uint64, userID uint64) error {
+	ret := _m.Called(ctx, organizationID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) error); ok {
+		r0 = rf(ctx, organizationID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockOrganizationMemberRepositoryI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockOrganizationMemberRepositoryI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationID uint64
+//   - userID uint64
+func (_e *MockOrganizationMemberRepositoryI_Expecter) Delete(ctx interface{}, organizationID interface{}, userID interface{}) *MockOrganizationMemberRepositoryI_Delete_Call {
+	return &MockOrganizationMemberRepositoryI_Delete_Call{Call: _e.mock.On("Delete", ctx, organizationID, userID)}
+}
+
+func (_c *MockOrganizationMemberRepositoryI_Delete_Call) Run(run func(ctx context.Context, organizationID uint64, userID uint64)) *MockOrganizationMemberRepositoryI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockOrganizationMemberRepositoryI_Delete_Call) Return(_a0 error) *MockOrganizationMemberRepositoryI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockOrganizationMemberRepositoryI_Delete_Call) RunAndReturn(run func(context.Context, uint64, uint64) error) *MockOrganizationMemberRepositoryI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function with given fields: ctx, organizationID, userID
+func (_m *MockOrganizationMemberRepositoryI) Find(ctx context.Context, organizationID uint64, userID uint64) (model.OrganizationMemberModel, error) {
+	ret := _m.Called(ctx, organizationID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 model.OrganizationMemberModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) (model.OrganizationMemberModel, error)); ok {
+		return rf(ctx, organizationID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) model.OrganizationMemberModel); ok {
+		r0 = rf(ctx, organizationID, userID)
+	} else {
+		r0 = ret.Get(0).(model.OrganizationMemberModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, organizationID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOrganizationMemberRepositoryI_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockOrganizationMemberRepositoryI_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationID uint64
+//   - userID uint64
+func (_e *MockOrganizationMemberRepositoryI_Expecter) Find(ctx interface{}, organizationID interface{}, userID interface{}) *MockOrganizationMemberRepositoryI_Find_Call {
+	return &MockOrganizationMemberRepositoryI_Find_Call{Call: _e.mock.On("Find", ctx, organizationID, userID)}
+}
+
+func (_c *MockOrganizationMemberRepositoryI_Find_Call) Run(run func(ctx context.Context, organizationID uint64, userID uint64)) *MockOrganizationMemberRepositoryI_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockOrganizationMemberRepositoryI_Find_Call) Return(_a0 model.OrganizationMemberModel, _a1 error) *MockOrganizationMemberRepositoryI_Find_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOrganizationMemberRepositoryI_Find_Call) RunAndReturn(run func(context.Context, uint64, uint64) (model.OrganizationMemberModel, error)) *MockOrganizationMemberRepositoryI_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function with given fields: ctx, organizationID
+func (_m *MockOrganizationMemberRepositoryI) FindAll(ctx context.Context, organizationID uint64) ([]model.OrganizationMemberModel, error) {
+	ret := _m.Called(ctx, organizationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []model.OrganizationMemberModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]model.OrganizationMemberModel, error)); ok {
+		return rf(ctx, organizationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []model.OrganizationMemberModel); ok {
+		r0 = rf(ctx, organizationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.OrganizationMemberModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, organizationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOrganizationMemberRepositoryI_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockOrganizationMemberRepositoryI_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationID uint64
+func (_e *MockOrganizationMemberRepositoryI_Expecter) FindAll(ctx interface{}, organizationID interface{}) *MockOrganizationMemberRepositoryI_FindAll_Call {
+	return &MockOrganizationMemberRepositoryI_FindAll_Call{Call: _e.mock.On("FindAll", ctx, organizationID)}
+}
+
+func (_c *MockOrganizationMemberRepositoryI_FindAll_Call) Run(run func(ctx context.Context, organizationID uint64)) *MockOrganizationMemberRepositoryI_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockOrganizationMemberRepositoryI_FindAll_Call) Return(_a0 []model.OrganizationMemberModel, _a1 error) *MockOrganizationMemberRepositoryI_FindAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOrganizationMemberRepositoryI_FindAll_Call) RunAndReturn(run func(context.Context, uint64) ([]model.OrganizationMemberModel, error)) *MockOrganizationMemberRepositoryI_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockByRole provides a mock function with given fields: ctx, organizationID, role
+func (_m *MockOrganizationMemberRepositoryI) LockByRole(ctx context.Context, organizationID uint64, role string) ([]model.OrganizationMemberModel, error) {
+	ret := _m.Called(ctx, organizationID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockByRole")
+	}
+
+	var r0 []model.OrganizationMemberModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) ([]model.OrganizationMemberModel, error)); ok {
+		return rf(ctx, organizationID, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) []model.OrganizationMemberModel); ok {
+		r0 = rf(ctx, organizationID, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.OrganizationMemberModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string) error); ok {
+		r1 = rf(ctx, organizationID, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOrganizationMemberRepositoryI_LockByRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockByRole'
+type MockOrganizationMemberRepositoryI_LockByRole_Call struct {
+	*mock.Call
+}
+
+// LockByRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationID uint64
+//   - role string
+func (_e *MockOrganizationMemberRepositoryI_Expecter) LockByRole(ctx interface{}, organizationID interface{}, role interface{}) *MockOrganizationMemberRepositoryI_LockByRole_Call {
+	return &MockOrganizationMemberRepositoryI_LockByRole_Call{Call: _e.mock.On("LockByRole", ctx, organizationID, role)}
+}
+
+func (_c *MockOrganizationMemberRepositoryI_LockByRole_Call) Run(run func(ctx context.Context, organizationID uint64, role string)) *MockOrganizationMemberRepositoryI_LockByRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockOrganizationMemberRepositoryI_LockByRole_Call) Return(_a0 []model.OrganizationMemberModel, _a1 error) *MockOrganizationMemberRepositoryI_LockByRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOrganizationMemberRepositoryI_LockByRole_Call) RunAndReturn(run func(context.Context, uint64, string) ([]model.OrganizationMemberModel, error)) *MockOrganizationMemberRepositoryI_LockByRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateRole provides a mock function with given fields: ctx, organizationID, userID, role
+func (_m *MockOrganizationMemberRepositoryI) UpdateRole(ctx context.Context, organizationID uint64, userID uint64, role string) error {
+	ret := _m.Called(ctx, organizationID, userID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, string) error); ok {
+		r0 = rf(ctx, organizationID, userID, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockOrganizationMemberRepositoryI_UpdateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRole'
+type MockOrganizationMemberRepositoryI_UpdateRole_Call struct {
+	*mock.Call
+}
+
+// UpdateRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationID uint64
+//   - userID uint64
+//   - role string
+func (_e *MockOrganizationMemberRepositoryI_Expecter) UpdateRole(ctx interface{}, organizationID interface{}, userID interface{}, role interface{}) *MockOrganizationMemberRepositoryI_UpdateRole_Call {
+	return &MockOrganizationMemberRepositoryI_UpdateRole_Call{Call: _e.mock.On("UpdateRole", ctx, organizationID, userID, role)}
+}
+
+func (_c *MockOrganizationMemberRepositoryI_UpdateRole_Call) Run(run func(ctx context.Context, organizationID uint64, userID uint64, role string)) *MockOrganizationMemberRepositoryI_UpdateRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockOrganizationMemberRepositoryI_UpdateRole_Call) Return(_a0 error) *MockOrganizationMemberRepositoryI_UpdateRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockOrganizationMemberRepositoryI_UpdateRole_Call) RunAndReturn(run func(context.Context, uint64, uint64, string) error) *MockOrganizationMemberRepositoryI_UpdateRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockOrganizationMemberRepositoryI creates a new instance of MockOrganizationMemberRepositoryI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOrganizationMemberRepositoryI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOrganizationMemberRepositoryI {
+	mock := &MockOrganizationMemberRepositoryI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
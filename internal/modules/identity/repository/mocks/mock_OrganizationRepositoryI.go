@@ -0,0 +1,212 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	mock "github.com/stretchr/testify/mock"
+
+	repository "github.com/cristiano-pacheco/pingo/internal/modules/identity/repository"
+)
+
+// MockOrganizationRepositoryI is an autogenerated mock type for the OrganizationRepositoryI type
+type MockOrganizationRepositoryI struct {
+	mock.Mock
+}
+
+type MockOrganizationRepositoryI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOrganizationRepositoryI) EXPECT() *MockOrganizationRepositoryI_Expecter {
+	return &MockOrganizationRepositoryI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, organization
+func (_m *MockOrganizationRepositoryI) Create(ctx context.Context, organization model.OrganizationModel) (model.OrganizationModel, error) {
+	ret := _m.Called(ctx, organization)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.OrganizationModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrganizationModel) (model.OrganizationModel, error)); ok {
+		return rf(ctx, organization)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrganizationModel) model.OrganizationModel); ok {
+		r0 = rf(ctx, organization)
+	} else {
+		r0 = ret.Get(0).(model.OrganizationModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.OrganizationModel) error); ok {
+		r1 = rf(ctx, organization)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOrganizationRepositoryI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockOrganizationRepositoryI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organization model.OrganizationModel
+func (_e *MockOrganizationRepositoryI_Expecter) Create(ctx interface{}, organization interface{}) *MockOrganizationRepositoryI_Create_Call {
+	return &MockOrganizationRepositoryI_Create_Call{Call: _e.mock.On("Create", ctx, organization)}
+}
+
+func (_c *MockOrganizationRepositoryI_Create_Call) Run(run func(ctx context.Context, organization model.OrganizationModel)) *MockOrganizationRepositoryI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.OrganizationModel))
+	})
+	return _c
+}
+
+func (_c *MockOrganizationRepositoryI_Create_Call) Return(_a0 model.OrganizationModel, _a1 error) *MockOrganizationRepositoryI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOrganizationRepositoryI_Create_Call) RunAndReturn(run func(context.Context, model.OrganizationModel) (model.OrganizationModel, error)) *MockOrganizationRepositoryI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAllByUserID provides a mock function with given fields: ctx, userID
+func (_m *MockOrganizationRepositoryI) FindAllByUserID(ctx context.Context, userID uint64) ([]repository.UserOrganization, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllByUserID")
+	}
+
+	var r0 []repository.UserOrganization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]repository.UserOrganization, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []repository.UserOrganization); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.UserOrganization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOrganizationRepositoryI_FindAllByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAllByUserID'
+type MockOrganizationRepositoryI_FindAllByUserID_Call struct {
+	*mock.Call
+}
+
+// FindAllByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockOrganizationRepositoryI_Expecter) FindAllByUserID(ctx interface{}, userID interface{}) *MockOrganizationRepositoryI_FindAllByUserID_Call {
+	return &MockOrganizationRepositoryI_FindAllByUserID_Call{Call: _e.mock.On("FindAllByUserID", ctx, userID)}
+}
+
+func (_c *MockOrganizationRepositoryI_FindAllByUserID_Call) Run(run func(ctx context.Context, userID uint64)) *MockOrganizationRepositoryI_FindAllByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockOrganizationRepositoryI_FindAllByUserID_Call) Return(_a0 []repository.UserOrganization, _a1 error) *MockOrganizationRepositoryI_FindAllByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOrganizationRepositoryI_FindAllByUserID_Call) RunAndReturn(run func(context.Context, uint64) ([]repository.UserOrganization, error)) *MockOrganizationRepositoryI_FindAllByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function with given fields: ctx, organizationID
+func (_m *MockOrganizationRepositoryI) FindByID(ctx context.Context, organizationID uint64) (model.OrganizationModel, error) {
+	ret := _m.Called(ctx, organizationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 model.OrganizationModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (model.OrganizationModel, error)); ok {
+		return rf(ctx, organizationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) model.OrganizationModel); ok {
+		r0 = rf(ctx, organizationID)
+	} else {
+		r0 = ret.Get(0).(model.OrganizationModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, organizationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOrganizationRepositoryI_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockOrganizationRepositoryI_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationID uint64
+func (_e *MockOrganizationRepositoryI_Expecter) FindByID(ctx interface{}, organizationID interface{}) *MockOrganizationRepositoryI_FindByID_Call {
+	return &MockOrganizationRepositoryI_FindByID_Call{Call: _e.mock.On("FindByID", ctx, organizationID)}
+}
+
+func (_c *MockOrganizationRepositoryI_FindByID_Call) Run(run func(ctx context.Context, organizationID uint64)) *MockOrganizationRepositoryI_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockOrganizationRepositoryI_FindByID_Call) Return(_a0 model.OrganizationModel, _a1 error) *MockOrganizationRepositoryI_FindByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOrganizationRepositoryI_FindByID_Call) RunAndReturn(run func(context.Context, uint64) (model.OrganizationModel, error)) *MockOrganizationRepositoryI_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockOrganizationRepositoryI creates a new instance of MockOrganizationRepositoryI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOrganizationRepositoryI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOrganizationRepositoryI {
+	mock := &MockOrganizationRepositoryI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,201 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockRefreshTokenRepositoryI is an autogenerated mock type for the RefreshTokenRepositoryI type
+type MockRefreshTokenRepositoryI struct {
+	mock.Mock
+}
+
+type MockRefreshTokenRepositoryI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRefreshTokenRepositoryI) EXPECT() *MockRefreshTokenRepositoryI_Expecter {
+	return &MockRefreshTokenRepositoryI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, refreshToken
+func (_m *MockRefreshTokenRepositoryI) Create(ctx context.Context, refreshToken model.RefreshTokenModel) (model.RefreshTokenModel, error) {
+	ret := _m.Called(ctx, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.RefreshTokenModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.RefreshTokenModel) (model.RefreshTokenModel, error)); ok {
+		return rf(ctx, refreshToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.RefreshTokenModel) model.RefreshTokenModel); ok {
+		r0 = rf(ctx, refreshToken)
+	} else {
+		r0 = ret.Get(0).(model.RefreshTokenModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.RefreshTokenModel) error); ok {
+		r1 = rf(ctx, refreshToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRefreshTokenRepositoryI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockRefreshTokenRepositoryI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refreshToken model.RefreshTokenModel
+func (_e *MockRefreshTokenRepositoryI_Expecter) Create(ctx interface{}, refreshToken interface{}) *MockRefreshTokenRepositoryI_Create_Call {
+	return &MockRefreshTokenRepositoryI_Create_Call{Call: _e.mock.On("Create", ctx, refreshToken)}
+}
+
+func (_c *MockRefreshTokenRepositoryI_Create_Call) Run(run func(ctx context.Context, refreshToken model.RefreshTokenModel)) *MockRefreshTokenRepositoryI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.RefreshTokenModel))
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepositoryI_Create_Call) Return(_a0 model.RefreshTokenModel, _a1 error) *MockRefreshTokenRepositoryI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepositoryI_Create_Call) RunAndReturn(run func(context.Context, model.RefreshTokenModel) (model.RefreshTokenModel, error)) *MockRefreshTokenRepositoryI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByTokenHash provides a mock function with given fields: ctx, tokenHash
+func (_m *MockRefreshTokenRepositoryI) FindByTokenHash(ctx context.Context, tokenHash []byte) (model.RefreshTokenModel, error) {
+	ret := _m.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByTokenHash")
+	}
+
+	var r0 model.RefreshTokenModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) (model.RefreshTokenModel, error)); ok {
+		return rf(ctx, tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) model.RefreshTokenModel); ok {
+		r0 = rf(ctx, tokenHash)
+	} else {
+		r0 = ret.Get(0).(model.RefreshTokenModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRefreshTokenRepositoryI_FindByTokenHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByTokenHash'
+type MockRefreshTokenRepositoryI_FindByTokenHash_Call struct {
+	*mock.Call
+}
+
+// FindByTokenHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash []byte
+func (_e *MockRefreshTokenRepositoryI_Expecter) FindByTokenHash(ctx interface{}, tokenHash interface{}) *MockRefreshTokenRepositoryI_FindByTokenHash_Call {
+	return &MockRefreshTokenRepositoryI_FindByTokenHash_Call{Call: _e.mock.On("FindByTokenHash", ctx, tokenHash)}
+}
+
+func (_c *MockRefreshTokenRepositoryI_FindByTokenHash_Call) Run(run func(ctx context.Context, tokenHash []byte)) *MockRefreshTokenRepositoryI_FindByTokenHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]byte))
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepositoryI_FindByTokenHash_Call) Return(_a0 model.RefreshTokenModel, _a1 error) *MockRefreshTokenRepositoryI_FindByTokenHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepositoryI_FindByTokenHash_Call) RunAndReturn(run func(context.Context, []byte) (model.RefreshTokenModel, error)) *MockRefreshTokenRepositoryI_FindByTokenHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkUsed provides a mock function with given fields: ctx, refreshTokenID, usedAt
+func (_m *MockRefreshTokenRepositoryI) MarkUsed(ctx context.Context, refreshTokenID uint64, usedAt time.Time) error {
+	ret := _m.Called(ctx, refreshTokenID, usedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkUsed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time) error); ok {
+		r0 = rf(ctx, refreshTokenID, usedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRefreshTokenRepositoryI_MarkUsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkUsed'
+type MockRefreshTokenRepositoryI_MarkUsed_Call struct {
+	*mock.Call
+}
+
+// MarkUsed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refreshTokenID uint64
+//   - usedAt time.Time
+func (_e *MockRefreshTokenRepositoryI_Expecter) MarkUsed(ctx interface{}, refreshTokenID interface{}, usedAt interface{}) *MockRefreshTokenRepositoryI_MarkUsed_Call {
+	return &MockRefreshTokenRepositoryI_MarkUsed_Call{Call: _e.mock.On("MarkUsed", ctx, refreshTokenID, usedAt)}
+}
+
+func (_c *MockRefreshTokenRepositoryI_MarkUsed_Call) Run(run func(ctx context.Context, refreshTokenID uint64, usedAt time.Time)) *MockRefreshTokenRepositoryI_MarkUsed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepositoryI_MarkUsed_Call) Return(_a0 error) *MockRefreshTokenRepositoryI_MarkUsed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepositoryI_MarkUsed_Call) RunAndReturn(run func(context.Context, uint64, time.Time) error) *MockRefreshTokenRepositoryI_MarkUsed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRefreshTokenRepositoryI creates a new instance of MockRefreshTokenRepositoryI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRefreshTokenRepositoryI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRefreshTokenRepositoryI {
+	mock := &MockRefreshTokenRepositoryI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,250 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockSessionRepositoryI is an autogenerated mock type for the SessionRepositoryI type
+type MockSessionRepositoryI struct {
+	mock.Mock
+}
+
+type MockSessionRepositoryI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSessionRepositoryI) EXPECT() *MockSessionRepositoryI_Expecter {
+	return &MockSessionRepositoryI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, session
+func (_m *MockSessionRepositoryI) Create(ctx context.Context, session model.SessionModel) (model.SessionModel, error) {
+	ret := _m.Called(ctx, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.SessionModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.SessionModel) (model.SessionModel, error)); ok {
+		return rf(ctx, session)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.SessionModel) model.SessionModel); ok {
+		r0 = rf(ctx, session)
+	} else {
+		r0 = ret.Get(0).(model.SessionModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.SessionModel) error); ok {
+		r1 = rf(ctx, session)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSessionRepositoryI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockSessionRepositoryI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session model.SessionModel
+func (_e *MockSessionRepositoryI_Expecter) Create(ctx interface{}, session interface{}) *MockSessionRepositoryI_Create_Call {
+	return &MockSessionRepositoryI_Create_Call{Call: _e.mock.On("Create", ctx, session)}
+}
+
+func (_c *MockSessionRepositoryI_Create_Call) Run(run func(ctx context.Context, session model.SessionModel)) *MockSessionRepositoryI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.SessionModel))
+	})
+	return _c
+}
+
+func (_c *MockSessionRepositoryI_Create_Call) Return(_a0 model.SessionModel, _a1 error) *MockSessionRepositoryI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSessionRepositoryI_Create_Call) RunAndReturn(run func(context.Context, model.SessionModel) (model.SessionModel, error)) *MockSessionRepositoryI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function with given fields: ctx, sessionID
+func (_m *MockSessionRepositoryI) FindByID(ctx context.Context, sessionID uint64) (model.SessionModel, error) {
+	ret := _m.Called(ctx, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 model.SessionModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (model.SessionModel, error)); ok {
+		return rf(ctx, sessionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) model.SessionModel); ok {
+		r0 = rf(ctx, sessionID)
+	} else {
+		r0 = ret.Get(0).(model.SessionModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSessionRepositoryI_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockSessionRepositoryI_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID uint64
+func (_e *MockSessionRepositoryI_Expecter) FindByID(ctx interface{}, sessionID interface{}) *MockSessionRepositoryI_FindByID_Call {
+	return &MockSessionRepositoryI_FindByID_Call{Call: _e.mock.On("FindByID", ctx, sessionID)}
+}
+
+func (_c *MockSessionRepositoryI_FindByID_Call) Run(run func(ctx context.Context, sessionID uint64)) *MockSessionRepositoryI_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockSessionRepositoryI_FindByID_Call) Return(_a0 model.SessionModel, _a1 error) *MockSessionRepositoryI_FindByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSessionRepositoryI_FindByID_Call) RunAndReturn(run func(context.Context, uint64) (model.SessionModel, error)) *MockSessionRepositoryI_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function with given fields: ctx, userID, sessionID, revokedAt
+func (_m *MockSessionRepositoryI) Revoke(ctx context.Context, userID uint64, sessionID uint64, revokedAt time.Time) error {
+	ret := _m.Called(ctx, userID, sessionID, revokedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, time.Time) error); ok {
+		r0 = rf(ctx, userID, sessionID, revokedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSessionRepositoryI_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type MockSessionRepositoryI_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - sessionID uint64
+//   - revokedAt time.Time
+func (_e *MockSessionRepositoryI_Expecter) Revoke(ctx interface{}, userID interface{}, sessionID interface{}, revokedAt interface{}) *MockSessionRepositoryI_Revoke_Call {
+	return &MockSessionRepositoryI_Revoke_Call{Call: _e.mock.On("Revoke", ctx, userID, sessionID, revokedAt)}
+}
+
+func (_c *MockSessionRepositoryI_Revoke_Call) Run(run func(ctx context.Context, userID uint64, sessionID uint64, revokedAt time.Time)) *MockSessionRepositoryI_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockSessionRepositoryI_Revoke_Call) Return(_a0 error) *MockSessionRepositoryI_Revoke_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSessionRepositoryI_Revoke_Call) RunAndReturn(run func(context.Context, uint64, uint64, time.Time) error) *MockSessionRepositoryI_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAllByUserID provides a mock function with given fields: ctx, userID, revokedAt
+func (_m *MockSessionRepositoryI) RevokeAllByUserID(ctx context.Context, userID uint64, revokedAt time.Time) error {
+	ret := _m.Called(ctx, userID, revokedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAllByUserID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time) error); ok {
+		r0 = rf(ctx, userID, revokedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSessionRepositoryI_RevokeAllByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAllByUserID'
+type MockSessionRepositoryI_RevokeAllByUserID_Call struct {
+	*mock.Call
+}
+
+// RevokeAllByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - revokedAt time.Time
+func (_e *MockSessionRepositoryI_Expecter) RevokeAllByUserID(ctx interface{}, userID interface{}, revokedAt interface{}) *MockSessionRepositoryI_RevokeAllByUserID_Call {
+	return &MockSessionRepositoryI_RevokeAllByUserID_Call{Call: _e.mock.On("RevokeAllByUserID", ctx, userID, revokedAt)}
+}
+
+func (_c *MockSessionRepositoryI_RevokeAllByUserID_Call) Run(run func(ctx context.Context, userID uint64, revokedAt time.Time)) *MockSessionRepositoryI_RevokeAllByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockSessionRepositoryI_RevokeAllByUserID_Call) Return(_a0 error) *MockSessionRepositoryI_RevokeAllByUserID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSessionRepositoryI_RevokeAllByUserID_Call) RunAndReturn(run func(context.Context, uint64, time.Time) error) *MockSessionRepositoryI_RevokeAllByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSessionRepositoryI creates a new instance of MockSessionRepositoryI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSessionRepositoryI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSessionRepositoryI {
+	mock := &MockSessionRepositoryI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
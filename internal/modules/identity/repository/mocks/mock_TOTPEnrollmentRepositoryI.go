@@ -0,0 +1,393 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockTOTPEnrollmentRepositoryI is an autogenerated mock type for the TOTPEnrollmentRepositoryI type
+type MockTOTPEnrollmentRepositoryI struct {
+	mock.Mock
+}
+
+type MockTOTPEnrollmentRepositoryI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTOTPEnrollmentRepositoryI) EXPECT() *MockTOTPEnrollmentRepositoryI_Expecter {
+	return &MockTOTPEnrollmentRepositoryI_Expecter{mock: &_m.Mock}
+}
+
+// Confirm provides a mock function with given fields: ctx, userID, confirmedAt, step
+func (_m *MockTOTPEnrollmentRepositoryI) Confirm(ctx context.Context, userID uint64, confirmedAt time.Time, step int64) error {
+	ret := _m.Called(ctx, userID, confirmedAt, step)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Confirm")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time, int64) error); ok {
+		r0 = rf(ctx, userID, confirmedAt, step)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTOTPEnrollmentRepositoryI_Confirm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Confirm'
+type MockTOTPEnrollmentRepositoryI_Confirm_Call struct {
+	*mock.Call
+}
+
+// Confirm is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - confirmedAt time.Time
+//   - step int64
+func (_e *MockTOTPEnrollmentRepositoryI_Expecter) Confirm(ctx interface{}, userID interface{}, confirmedAt interface{}, step interface{}) *MockTOTPEnrollmentRepositoryI_Confirm_Call {
+	return &MockTOTPEnrollmentRepositoryI_Confirm_Call{Call: _e.mock.On("Confirm", ctx, userID, confirmedAt, step)}
+}
+
+func (_c *MockTOTPEnrollmentRepositoryI_Confirm_Call) Run(run func(ctx context.Context, userID uint64, confirmedAt time.Time, step int64)) *MockTOTPEnrollmentRepositoryI_Confirm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Time), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTOTPEnrollmentRepositoryI_Confirm_Call) Return(_a0 error) *MockTOTPEnrollmentRepositoryI_Confirm_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTOTPEnrollmentRepositoryI_Confirm_Call) RunAndReturn(run func(context.Context, uint64, time.Time, int64) error) *MockTOTPEnrollmentRepositoryI_Confirm_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, enrollment
+func (_m *MockTOTPEnrollmentRepositoryI) Create(ctx context.Context, enrollment model.TOTPEnrollmentModel) (model.TOTPEnrollmentModel, error) {
+	ret := _m.Called(ctx, enrollment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.TOTPEnrollmentModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.TOTPEnrollmentModel) (model.TOTPEnrollmentModel, error)); ok {
+		return rf(ctx, enrollment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.TOTPEnrollmentModel) model.TOTPEnrollmentModel); ok {
+		r0 = rf(ctx, enrollment)
+	} else {
+		r0 = ret.Get(0).(model.TOTPEnrollmentModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.TOTPEnrollmentModel) error); ok {
+		r1 = rf(ctx, enrollment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTOTPEnrollmentRepositoryI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockTOTPEnrollmentRepositoryI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enrollment model.TOTPEnrollmentModel
+func (_e *MockTOTPEnrollmentRepositoryI_Expecter) Create(ctx interface{}, enrollment interface{}) *MockTOTPEnrollmentRepositoryI_Create_Call {
+	return &MockTOTPEnrollmentRepositoryI_Create_Call{Call: _e.mock.On("Create", ctx, enrollment)}
+}
+
+func (_c *MockTOTPEnrollmentRepositoryI_Create_Call) Run(run func(ctx context.Context, enrollment model.TOTPEnrollmentModel)) *MockTOTPEnrollmentRepositoryI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.TOTPEnrollmentModel))
+	})
+	return _c
+}
+
+func (_c *MockTOTPEnrollmentRepositoryI_Create_Call) Return(_a0 model.TOTPEnrollmentModel, _a1 error) *MockTOTPEnrollmentRepositoryI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTOTPEnrollmentRepositoryI_Create_Call) RunAndReturn(run func(context.Context, model.TOTPEnrollmentModel) (model.TOTPEnrollmentModel, error)) *MockTOTPEnrollmentRepositoryI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteByUserID provides a mock function with given fields: ctx, userID
+func (_m *MockTOTPEnrollmentRepositoryI) DeleteByUserID(ctx context.Context, userID uint64) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByUserID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTOTPEnrollmentRepositoryI_DeleteByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByUserID'
+type MockTOTPEnrollmentRepositoryI_DeleteByUserID_Call struct {
+	*mock.Call
+}
+
+// DeleteByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockTOTPEnrollmentRepositoryI_Expecter) DeleteByUserID(ctx interface{}, userID interface{}) *MockTOTPEnrollmentRepositoryI_DeleteByUserID_Call {
+	return &MockTOTPEnrollmentRepositoryI_DeleteByUserID_Call{Call: _e.mock.On("DeleteByUserID", ctx, userID)}
+}
+
+func (_c *MockTOTPEnrollmentRepositoryI_DeleteByUserID_Call) Run(run func(ctx context.Context, userID uint64)) *MockTOTPEnrollmentRepositoryI_DeleteByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockTOTPEnrollmentRepositoryI_DeleteByUserID_Call) Return(_a0 error) *MockTOTPEnrollmentRepositoryI_DeleteByUserID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTOTPEnrollmentRepositoryI_DeleteByUserID_Call) RunAndReturn(run func(context.Context, uint64) error) *MockTOTPEnrollmentRepositoryI_DeleteByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUserID provides a mock function with given fields: ctx, userID
+func (_m *MockTOTPEnrollmentRepositoryI) FindByUserID(ctx context.Context, userID uint64) (model.TOTPEnrollmentModel, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserID")
+	}
+
+	var r0 model.TOTPEnrollmentModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (model.TOTPEnrollmentModel, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) model.TOTPEnrollmentModel); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(model.TOTPEnrollmentModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTOTPEnrollmentRepositoryI_FindByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserID'
+type MockTOTPEnrollmentRepositoryI_FindByUserID_Call struct {
+	*mock.Call
+}
+
+// FindByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockTOTPEnrollmentRepositoryI_Expecter) FindByUserID(ctx interface{}, userID interface{}) *MockTOTPEnrollmentRepositoryI_FindByUserID_Call {
+	return &MockTOTPEnrollmentRepositoryI_FindByUserID_Call{Call: _e.mock.On("FindByUserID", ctx, userID)}
+}
+
+func (_c *MockTOTPEnrollmentRepositoryI_FindByUserID_Call) Run(run func(ctx context.Context, userID uint64)) *MockTOTPEnrollmentRepositoryI_FindByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockTOTPEnrollmentRepositoryI_FindByUserID_Call) Return(_a0 model.TOTPEnrollmentModel, _a1 error) *MockTOTPEnrollmentRepositoryI_FindByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTOTPEnrollmentRepositoryI_FindByUserID_Call) RunAndReturn(run func(context.Context, uint64) (model.TOTPEnrollmentModel, error)) *MockTOTPEnrollmentRepositoryI_FindByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordFailedAttempt provides a mock function with given fields: ctx, userID, maxAttempts, lockedUntil
+func (_m *MockTOTPEnrollmentRepositoryI) RecordFailedAttempt(ctx context.Context, userID uint64, maxAttempts int, lockedUntil time.Time) error {
+	ret := _m.Called(ctx, userID, maxAttempts, lockedUntil)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordFailedAttempt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, int, time.Time) error); ok {
+		r0 = rf(ctx, userID, maxAttempts, lockedUntil)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTOTPEnrollmentRepositoryI_RecordFailedAttempt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordFailedAttempt'
+type MockTOTPEnrollmentRepositoryI_RecordFailedAttempt_Call struct {
+	*mock.Call
+}
+
+// RecordFailedAttempt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - maxAttempts int
+//   - lockedUntil time.Time
+func (_e *MockTOTPEnrollmentRepositoryI_Expecter) RecordFailedAttempt(ctx interface{}, userID interface{}, maxAttempts interface{}, lockedUntil interface{}) *MockTOTPEnrollmentRepositoryI_RecordFailedAttempt_Call {
+	return &MockTOTPEnrollmentRepositoryI_RecordFailedAttempt_Call{Call: _e.mock.On("RecordFailedAttempt", ctx, userID, maxAttempts, lockedUntil)}
+}
+
+func (_c *MockTOTPEnrollmentRepositoryI_RecordFailedAttempt_Call) Run(run func(ctx context.Context, userID uint64, maxAttempts int, lockedUntil time.Time)) *MockTOTPEnrollmentRepositoryI_RecordFailedAttempt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(int), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockTOTPEnrollmentRepositoryI_RecordFailedAttempt_Call) Return(_a0 error) *MockTOTPEnrollmentRepositoryI_RecordFailedAttempt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTOTPEnrollmentRepositoryI_RecordFailedAttempt_Call) RunAndReturn(run func(context.Context, uint64, int, time.Time) error) *MockTOTPEnrollmentRepositoryI_RecordFailedAttempt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetFailedAttempts provides a mock function with given fields: ctx, userID
+func (_m *MockTOTPEnrollmentRepositoryI) ResetFailedAttempts(ctx context.Context, userID uint64) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetFailedAttempts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTOTPEnrollmentRepositoryI_ResetFailedAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetFailedAttempts'
+type MockTOTPEnrollmentRepositoryI_ResetFailedAttempts_Call struct {
+	*mock.Call
+}
+
+// ResetFailedAttempts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockTOTPEnrollmentRepositoryI_Expecter) ResetFailedAttempts(ctx interface{}, userID interface{}) *MockTOTPEnrollmentRepositoryI_ResetFailedAttempts_Call {
+	return &MockTOTPEnrollmentRepositoryI_ResetFailedAttempts_Call{Call: _e.mock.On("ResetFailedAttempts", ctx, userID)}
+}
+
+func (_c *MockTOTPEnrollmentRepositoryI_ResetFailedAttempts_Call) Run(run func(ctx context.Context, userID uint64)) *MockTOTPEnrollmentRepositoryI_ResetFailedAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockTOTPEnrollmentRepositoryI_ResetFailedAttempts_Call) Return(_a0 error) *MockTOTPEnrollmentRepositoryI_ResetFailedAttempts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTOTPEnrollmentRepositoryI_ResetFailedAttempts_Call) RunAndReturn(run func(context.Context, uint64) error) *MockTOTPEnrollmentRepositoryI_ResetFailedAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLastUsedStep provides a mock function with given fields: ctx, userID, step
+func (_m *MockTOTPEnrollmentRepositoryI) UpdateLastUsedStep(ctx context.Context, userID uint64, step int64) error {
+	ret := _m.Called(ctx, userID, step)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLastUsedStep")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, int64) error); ok {
+		r0 = rf(ctx, userID, step)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTOTPEnrollmentRepositoryI_UpdateLastUsedStep_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLastUsedStep'
+type MockTOTPEnrollmentRepositoryI_UpdateLastUsedStep_Call struct {
+	*mock.Call
+}
+
+// UpdateLastUsedStep is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - step int64
+func (_e *MockTOTPEnrollmentRepositoryI_Expecter) UpdateLastUsedStep(ctx interface{}, userID interface{}, step interface{}) *MockTOTPEnrollmentRepositoryI_UpdateLastUsedStep_Call {
+	return &MockTOTPEnrollmentRepositoryI_UpdateLastUsedStep_Call{Call: _e.mock.On("UpdateLastUsedStep", ctx, userID, step)}
+}
+
+func (_c *MockTOTPEnrollmentRepositoryI_UpdateLastUsedStep_Call) Run(run func(ctx context.Context, userID uint64, step int64)) *MockTOTPEnrollmentRepositoryI_UpdateLastUsedStep_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTOTPEnrollmentRepositoryI_UpdateLastUsedStep_Call) Return(_a0 error) *MockTOTPEnrollmentRepositoryI_UpdateLastUsedStep_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTOTPEnrollmentRepositoryI_UpdateLastUsedStep_Call) RunAndReturn(run func(context.Context, uint64, int64) error) *MockTOTPEnrollmentRepositoryI_UpdateLastUsedStep_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTOTPEnrollmentRepositoryI creates a new instance of MockTOTPEnrollmentRepositoryI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTOTPEnrollmentRepositoryI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTOTPEnrollmentRepositoryI {
+	mock := &MockTOTPEnrollmentRepositoryI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
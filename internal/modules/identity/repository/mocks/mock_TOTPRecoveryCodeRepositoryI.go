@@ -0,0 +1,182 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockTOTPRecoveryCodeRepositoryI is an autogenerated mock type for the TOTPRecoveryCodeRepositoryI type
+type MockTOTPRecoveryCodeRepositoryI struct {
+	mock.Mock
+}
+
+type MockTOTPRecoveryCodeRepositoryI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTOTPRecoveryCodeRepositoryI) EXPECT() *MockTOTPRecoveryCodeRepositoryI_Expecter {
+	return &MockTOTPRecoveryCodeRepositoryI_Expecter{mock: &_m.Mock}
+}
+
+// CreateMany provides a mock function with given fields: ctx, recoveryCodes
+func (_m *MockTOTPRecoveryCodeRepositoryI) CreateMany(ctx context.Context, recoveryCodes []model.TOTPRecoveryCodeModel) error {
+	ret := _m.Called(ctx, recoveryCodes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMany")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []model.TOTPRecoveryCodeModel) error); ok {
+		r0 = rf(ctx, recoveryCodes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTOTPRecoveryCodeRepositoryI_CreateMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMany'
+type MockTOTPRecoveryCodeRepositoryI_CreateMany_Call struct {
+	*mock.Call
+}
+
+// CreateMany is a helper method to define mock.On call
+//   - ctx context.Context
+//   - recoveryCodes []model.TOTPRecoveryCodeModel
+func (_e *MockTOTPRecoveryCodeRepositoryI_Expecter) CreateMany(ctx interface{}, recoveryCodes interface{}) *MockTOTPRecoveryCodeRepositoryI_CreateMany_Call {
+	return &MockTOTPRecoveryCodeRepositoryI_CreateMany_Call{Call: _e.mock.On("CreateMany", ctx, recoveryCodes)}
+}
+
+func (_c *MockTOTPRecoveryCodeRepositoryI_CreateMany_Call) Run(run func(ctx context.Context, recoveryCodes []model.TOTPRecoveryCodeModel)) *MockTOTPRecoveryCodeRepositoryI_CreateMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]model.TOTPRecoveryCodeModel))
+	})
+	return _c
+}
+
+func (_c *MockTOTPRecoveryCodeRepositoryI_CreateMany_Call) Return(_a0 error) *MockTOTPRecoveryCodeRepositoryI_CreateMany_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTOTPRecoveryCodeRepositoryI_CreateMany_Call) RunAndReturn(run func(context.Context, []model.TOTPRecoveryCodeModel) error) *MockTOTPRecoveryCodeRepositoryI_CreateMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteByUserID provides a mock function with given fields: ctx, userID
+func (_m *MockTOTPRecoveryCodeRepositoryI) DeleteByUserID(ctx context.Context, userID uint64) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByUserID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTOTPRecoveryCodeRepositoryI_DeleteByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByUserID'
+type MockTOTPRecoveryCodeRepositoryI_DeleteByUserID_Call struct {
+	*mock.Call
+}
+
+// DeleteByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockTOTPRecoveryCodeRepositoryI_Expecter) DeleteByUserID(ctx interface{}, userID interface{}) *MockTOTPRecoveryCodeRepositoryI_DeleteByUserID_Call {
+	return &MockTOTPRecoveryCodeRepositoryI_DeleteByUserID_Call{Call: _e.mock.On("DeleteByUserID", ctx, userID)}
+}
+
+func (_c *MockTOTPRecoveryCodeRepositoryI_DeleteByUserID_Call) Run(run func(ctx context.Context, userID uint64)) *MockTOTPRecoveryCodeRepositoryI_DeleteByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockTOTPRecoveryCodeRepositoryI_DeleteByUserID_Call) Return(_a0 error) *MockTOTPRecoveryCodeRepositoryI_DeleteByUserID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTOTPRecoveryCodeRepositoryI_DeleteByUserID_Call) RunAndReturn(run func(context.Context, uint64) error) *MockTOTPRecoveryCodeRepositoryI_DeleteByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkUsed provides a mock function with given fields: ctx, userID, codeHash, usedAt
+func (_m *MockTOTPRecoveryCodeRepositoryI) MarkUsed(ctx context.Context, userID uint64, codeHash []byte, usedAt time.Time) error {
+	ret := _m.Called(ctx, userID, codeHash, usedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkUsed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, []byte, time.Time) error); ok {
+		r0 = rf(ctx, userID, codeHash, usedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTOTPRecoveryCodeRepositoryI_MarkUsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkUsed'
+type MockTOTPRecoveryCodeRepositoryI_MarkUsed_Call struct {
+	*mock.Call
+}
+
+// MarkUsed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - codeHash []byte
+//   - usedAt time.Time
+func (_e *MockTOTPRecoveryCodeRepositoryI_Expecter) MarkUsed(ctx interface{}, userID interface{}, codeHash interface{}, usedAt interface{}) *MockTOTPRecoveryCodeRepositoryI_MarkUsed_Call {
+	return &MockTOTPRecoveryCodeRepositoryI_MarkUsed_Call{Call: _e.mock.On("MarkUsed", ctx, userID, codeHash, usedAt)}
+}
+
+func (_c *MockTOTPRecoveryCodeRepositoryI_MarkUsed_Call) Run(run func(ctx context.Context, userID uint64, codeHash []byte, usedAt time.Time)) *MockTOTPRecoveryCodeRepositoryI_MarkUsed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].([]byte), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockTOTPRecoveryCodeRepositoryI_MarkUsed_Call) Return(_a0 error) *MockTOTPRecoveryCodeRepositoryI_MarkUsed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTOTPRecoveryCodeRepositoryI_MarkUsed_Call) RunAndReturn(run func(context.Context, uint64, []byte, time.Time) error) *MockTOTPRecoveryCodeRepositoryI_MarkUsed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTOTPRecoveryCodeRepositoryI creates a new instance of MockTOTPRecoveryCodeRepositoryI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTOTPRecoveryCodeRepositoryI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTOTPRecoveryCodeRepositoryI {
+	mock := &MockTOTPRecoveryCodeRepositoryI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -15,8 +15,14 @@ import (
 
 type OneTimeTokenRepositoryI interface {
 	Find(ctx context.Context, userID uint64, tokenTypeEnum enum.TokenTypeEnum) (model.OneTimeTokenModel, error)
+	FindByID(
+		ctx context.Context,
+		userID, tokenID uint64,
+		tokenTypeEnum enum.TokenTypeEnum,
+	) (model.OneTimeTokenModel, error)
 	Create(ctx context.Context, token model.OneTimeTokenModel) (model.OneTimeTokenModel, error)
 	Delete(ctx context.Context, userID uint64, tokenTypeEnum enum.TokenTypeEnum) error
+	DeleteByID(ctx context.Context, userID, tokenID uint64) error
 }
 
 type OneTimeTokenRepository struct {
@@ -54,6 +60,31 @@ func (r *OneTimeTokenRepository) Find(
 	return token, nil
 }
 
+// FindByID finds a token that has not expired, for the token types a user can hold more than one of.
+func (r *OneTimeTokenRepository) FindByID(
+	ctx context.Context,
+	userID, tokenID uint64,
+	tokenTypeEnum enum.TokenTypeEnum,
+) (model.OneTimeTokenModel, error) {
+	ctx, otelSpan := trace.Span(ctx, "OneTimeTokenRepository.FindByID")
+	defer otelSpan.End()
+
+	now := time.Now()
+	token, err := gorm.G[model.OneTimeTokenModel](r.Conn(ctx)).
+		Where("id = ? AND user_id = ?", tokenID, userID).
+		Where("token_type = ?", tokenTypeEnum.String()).
+		Where("expires_at > ?", now).
+		First(ctx)
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.OneTimeTokenModel{}, errs.ErrRecordNotFound
+		}
+		return model.OneTimeTokenModel{}, err
+	}
+	return token, nil
+}
+
 func (r *OneTimeTokenRepository) Create(
 	ctx context.Context,
 	token model.OneTimeTokenModel,
@@ -61,7 +92,7 @@ func (r *OneTimeTokenRepository) Create(
 	ctx, otelSpan := trace.Span(ctx, "OneTimeTokenRepository.Create")
 	defer otelSpan.End()
 
-	err := gorm.G[model.OneTimeTokenModel](r.DB).Create(ctx, &token)
+	err := gorm.G[model.OneTimeTokenModel](r.Conn(ctx)).Create(ctx, &token)
 	return token, err
 }
 
@@ -69,7 +100,7 @@ func (r *OneTimeTokenRepository) Delete(ctx context.Context, userID uint64, toke
 	ctx, otelSpan := trace.Span(ctx, "OneTimeTokenRepository.Delete")
 	defer otelSpan.End()
 
-	rowsAffected, err := gorm.G[model.OneTimeTokenModel](r.DB).
+	rowsAffected, err := gorm.G[model.OneTimeTokenModel](r.Conn(ctx)).
 		Where("user_id = ?", userID).
 		Where("token_type = ?", tokenTypeEnum.String()).
 		Delete(ctx)
@@ -81,3 +112,19 @@ func (r *OneTimeTokenRepository) Delete(ctx context.Context, userID uint64, toke
 	}
 	return nil
 }
+
+func (r *OneTimeTokenRepository) DeleteByID(ctx context.Context, userID, tokenID uint64) error {
+	ctx, otelSpan := trace.Span(ctx, "OneTimeTokenRepository.DeleteByID")
+	defer otelSpan.End()
+
+	rowsAffected, err := gorm.G[model.OneTimeTokenModel](r.Conn(ctx)).
+		Where("id = ? AND user_id = ?", tokenID, userID).
+		Delete(ctx)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errs.ErrRecordNotFound
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	"github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+	"gorm.io/gorm"
+)
+
+// OrganizationInvitationRepositoryI only reaches the invitations sent to the given user.
+type OrganizationInvitationRepositoryI interface {
+	FindByID(ctx context.Context, userID, invitationID uint64) (model.OrganizationInvitationModel, error)
+	Create(
+		ctx context.Context,
+		invitation model.OrganizationInvitationModel,
+	) (model.OrganizationInvitationModel, error)
+	Delete(ctx context.Context, userID, invitationID uint64) error
+}
+
+type OrganizationInvitationRepository struct {
+	*database.PingoDB
+}
+
+var _ OrganizationInvitationRepositoryI = (*OrganizationInvitationRepository)(nil)
+
+func NewOrganizationInvitationRepository(db *database.PingoDB) *OrganizationInvitationRepository {
+	return &OrganizationInvitationRepository{db}
+}
+
+func (r *OrganizationInvitationRepository) FindByID(
+	ctx context.Context,
+	userID, invitationID uint64,
+) (model.OrganizationInvitationModel, error) {
+	ctx, otelSpan := trace.Span(ctx, "OrganizationInvitationRepository.FindByID")
+	defer otelSpan.End()
+
+	invitation, err := gorm.G[model.OrganizationInvitationModel](r.Conn(ctx)).
+		Where("id = ? AND user_id = ?", invitationID, userID).
+		First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.OrganizationInvitationModel{}, errs.ErrRecordNotFound
+		}
+		return model.OrganizationInvitationModel{}, err
+	}
+	return invitation, nil
+}
+
+func (r *OrganizationInvitationRepository) Create(
+	ctx context.Context,
+	invitation model.OrganizationInvitationModel,
+) (model.OrganizationInvitationModel, error) {
+	ctx, otelSpan := trace.Span(ctx, "OrganizationInvitationRepository.Create")
+	defer otelSpan.End()
+
+	err := gorm.G[model.OrganizationInvitationModel](r.Conn(ctx)).Create(ctx, &invitation)
+	return invitation, err
+}
+
+func (r *OrganizationInvitationRepository) Delete(ctx context.Context, userID, invitationID uint64) error {
+	ctx, otelSpan := trace.Span(ctx, "OrganizationInvitationRepository.Delete")
+	defer otelSpan.End()
+
+	rowsAffected, err := gorm.G[model.OrganizationInvitationModel](r.Conn(ctx)).
+		Where("id = ? AND user_id = ?", invitationID, userID).
+		Delete(ctx)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errs.ErrRecordNotFound
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	"github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+	"gorm.io/gorm"
+)
+
+// lockByRoleQuery locks the members with the role, a row updated or deleted by a concurrent
+// transaction is checked again once that transaction commits.
+const lockByRoleQuery = `
+SELECT *
+FROM organization_members
+WHERE organization_id = @organization_id AND role = @role
+ORDER BY user_id
+FOR UPDATE`
+
+type OrganizationMemberRepositoryI interface {
+	Find(ctx context.Context, organizationID, userID uint64) (model.OrganizationMemberModel, error)
+	FindAll(ctx context.Context, organizationID uint64) ([]model.OrganizationMemberModel, error)
+	LockByRole(ctx context.Context, organizationID uint64, role string) ([]model.OrganizationMemberModel, error)
+	Create(ctx context.Context, member model.OrganizationMemberModel) error
+	UpdateRole(ctx context.Context, organizationID, userID uint64, role string) error
+	Delete(ctx context.Context, organizationID, userID uint64) error
+}
+
+type OrganizationMemberRepository struct {
+	*database.PingoDB
+}
+
+var _ OrganizationMemberRepositoryI = (*OrganizationMemberRepository)(nil)
+
+func NewOrganizationMemberRepository(db *database.PingoDB) *OrganizationMemberRepository {
+	return &OrganizationMemberRepository{db}
+}
+
+func (r *OrganizationMemberRepository) Find(
+	ctx context.Context,
+	organizationID, userID uint64,
+) (model.OrganizationMemberModel, error) {
+	ctx, otelSpan := trace.Span(ctx, "OrganizationMemberRepository.Find")
+	defer otelSpan.End()
+
+	member, err := gorm.G[model.OrganizationMemberModel](r.Conn(ctx)).
+		Where("organization_id = ? AND user_id = ?", organizationID, userID).
+		First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.OrganizationMemberModel{}, errs.ErrRecordNotFound
+		}
+		return model.OrganizationMemberModel{}, err
+	}
+	return member, nil
+}
+
+func (r *OrganizationMemberRepository) FindAll(
+	ctx context.Context,
+	organizationID uint64,
+) ([]model.OrganizationMemberModel, error) {
+	ctx, otelSpan := trace.Span(ctx, "OrganizationMemberRepository.FindAll")
+	defer otelSpan.End()
+
+	members, err := gorm.G[model.OrganizationMemberModel](r.Conn(ctx)).
+		Where("organization_id = ?", organizationID).
+		Order("user_id ASC").
+		Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// LockByRole finds the members with the role and locks them until the end of the transaction of the
+// context, so concurrent changes to those members are serialized.
+func (r *OrganizationMemberRepository) LockByRole(
+	ctx context.Context,
+	organizationID uint64,
+	role string,
+) ([]model.OrganizationMemberModel, error) {
+	ctx, otelSpan := trace.Span(ctx, "OrganizationMemberRepository.LockByRole")
+	defer otelSpan.End()
+
+	var members []model.OrganizationMemberModel
+	err := r.Conn(ctx).
+		Raw(lockByRoleQuery, map[string]any{"organization_id": organizationID, "role": role}).
+		Scan(&members).Error
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+func (r *OrganizationMemberRepository) Create(ctx context.Context, member model.OrganizationMemberModel) error {
+	ctx, otelSpan := trace.Span(ctx, "OrganizationMemberRepository.Create")
+	defer otelSpan.End()
+
+	return gorm.G[model.OrganizationMemberModel](r.Conn(ctx)).Create(ctx, &member)
+}
+
+func (r *OrganizationMemberRepository) UpdateRole(
+	ctx context.Context,
+	organizationID, userID uint64,
+	role string,
+) error {
+	ctx, otelSpan := trace.Span(ctx, "OrganizationMemberRepository.UpdateRole")
+	defer otelSpan.End()
+
+	result := r.Conn(ctx).
+		Model(&model.OrganizationMemberModel{}).
+		Where("organization_id = ? AND user_id = ?", organizationID, userID).
+		Update("role", role)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errs.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *OrganizationMemberRepository) Delete(ctx context.Context, organizationID, userID uint64) error {
+	ctx, otelSpan := trace.Span(ctx, "OrganizationMemberRepository.Delete")
+	defer otelSpan.End()
+
+	rowsAffected, err := gorm.G[model.OrganizationMemberModel](r.Conn(ctx)).
+		Where("organization_id = ? AND user_id = ?", organizationID, userID).
+		Delete(ctx)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errs.ErrRecordNotFound
+	}
+	return nil
+}
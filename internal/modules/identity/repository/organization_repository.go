@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	"github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+	"gorm.io/gorm"
+)
+
+// UserOrganization is an organization together with the role the user has in it.
+type UserOrganization struct {
+	OrganizationID uint64
+	Name           string
+	Role           string
+}
+
+type OrganizationRepositoryI interface {
+	FindByID(ctx context.Context, organizationID uint64) (model.OrganizationModel, error)
+	FindAllByUserID(ctx context.Context, userID uint64) ([]UserOrganization, error)
+	Create(ctx context.Context, organization model.OrganizationModel) (model.OrganizationModel, error)
+}
+
+type OrganizationRepository struct {
+	*database.PingoDB
+}
+
+var _ OrganizationRepositoryI = (*OrganizationRepository)(nil)
+
+func NewOrganizationRepository(db *database.PingoDB) *OrganizationRepository {
+	return &OrganizationRepository{db}
+}
+
+func (r *OrganizationRepository) FindByID(
+	ctx context.Context,
+	organizationID uint64,
+) (model.OrganizationModel, error) {
+	ctx, otelSpan := trace.Span(ctx, "OrganizationRepository.FindByID")
+	defer otelSpan.End()
+
+	organization, err := gorm.G[model.OrganizationModel](r.Conn(ctx)).
+		Where("id = ?", organizationID).
+		First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.OrganizationModel{}, errs.ErrRecordNotFound
+		}
+		return model.OrganizationModel{}, err
+	}
+	return organization, nil
+}
+
+func (r *OrganizationRepository) FindAllByUserID(ctx context.Context, userID uint64) ([]UserOrganization, error) {
+	ctx, otelSpan := trace.Span(ctx, "OrganizationRepository.FindAllByUserID")
+	defer otelSpan.End()
+
+	var organizations []UserOrganization
+	err := r.Conn(ctx).
+		Table("organizations o").
+		Select("o.id AS organization_id, o.name, m.role").
+		Joins("JOIN organization_members m ON m.organization_id = o.id").
+		Where("m.user_id = ?", userID).
+		Order("o.id ASC").
+		Scan(&organizations).Error
+	if err != nil {
+		return nil, err
+	}
+	return organizations, nil
+}
+
+func (r *OrganizationRepository) Create(
+	ctx context.Context,
+	organization model.OrganizationModel,
+) (model.OrganizationModel, error) {
+	ctx, otelSpan := trace.Span(ctx, "OrganizationRepository.Create")
+	defer otelSpan.End()
+
+	err := gorm.G[model.OrganizationModel](r.Conn(ctx)).Create(ctx, &organization)
+	return organization, err
+}
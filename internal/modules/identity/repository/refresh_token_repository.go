@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	"github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+	"gorm.io/gorm"
+)
+
+type RefreshTokenRepositoryI interface {
+	FindByTokenHash(ctx context.Context, tokenHash []byte) (model.RefreshTokenModel, error)
+	Create(ctx context.Context, refreshToken model.RefreshTokenModel) (model.RefreshTokenModel, error)
+	MarkUsed(ctx context.Context, refreshTokenID uint64, usedAt time.Time) error
+}
+
+type RefreshTokenRepository struct {
+	*database.PingoDB
+}
+
+var _ RefreshTokenRepositoryI = (*RefreshTokenRepository)(nil)
+
+func NewRefreshTokenRepository(db *database.PingoDB) *RefreshTokenRepository {
+	return &RefreshTokenRepository{db}
+}
+
+func (r *RefreshTokenRepository) FindByTokenHash(
+	ctx context.Context,
+	tokenHash []byte,
+) (model.RefreshTokenModel, error) {
+	ctx, otelSpan := trace.Span(ctx, "RefreshTokenRepository.FindByTokenHash")
+	defer otelSpan.End()
+
+	refreshToken, err := gorm.G[model.RefreshTokenModel](r.Conn(ctx)).
+		Where("token_hash = ?", tokenHash).
+		First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.RefreshTokenModel{}, errs.ErrRecordNotFound
+		}
+		return model.RefreshTokenModel{}, err
+	}
+	return refreshToken, nil
+}
+
+func (r *RefreshTokenRepository) Create(
+	ctx context.Context,
+	refreshToken model.RefreshTokenModel,
+) (model.RefreshTokenModel, error) {
+	ctx, otelSpan := trace.Span(ctx, "RefreshTokenRepository.Create")
+	defer otelSpan.End()
+
+	err := gorm.G[model.RefreshTokenModel](r.Conn(ctx)).Create(ctx, &refreshToken)
+	return refreshToken, err
+}
+
+// MarkUsed marks the refresh token as used, it returns errs.ErrRecordNotFound when the token
+// was already used, so two concurrent refreshes with the same token cannot both succeed.
+func (r *RefreshTokenRepository) MarkUsed(ctx context.Context, refreshTokenID uint64, usedAt time.Time) error {
+	ctx, otelSpan := trace.Span(ctx, "RefreshTokenRepository.MarkUsed")
+	defer otelSpan.End()
+
+	result := r.Conn(ctx).
+		Model(&model.RefreshTokenModel{}).
+		Where("id = ? AND used_at IS NULL", refreshTokenID).
+		Update("used_at", usedAt)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errs.ErrRecordNotFound
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	"github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+	"gorm.io/gorm"
+)
+
+type SessionRepositoryI interface {
+	FindByID(ctx context.Context, sessionID uint64) (model.SessionModel, error)
+	Create(ctx context.Context, session model.SessionModel) (model.SessionModel, error)
+	Revoke(ctx context.Context, userID, sessionID uint64, revokedAt time.Time) error
+	RevokeAllByUserID(ctx context.Context, userID uint64, revokedAt time.Time) error
+}
+
+type SessionRepository struct {
+	*database.PingoDB
+}
+
+var _ SessionRepositoryI = (*SessionRepository)(nil)
+
+func NewSessionRepository(db *database.PingoDB) *SessionRepository {
+	return &SessionRepository{db}
+}
+
+func (r *SessionRepository) FindByID(ctx context.Context, sessionID uint64) (model.SessionModel, error) {
+	ctx, otelSpan := trace.Span(ctx, "SessionRepository.FindByID")
+	defer otelSpan.End()
+
+	session, err := gorm.G[model.SessionModel](r.Conn(ctx)).
+		Where("id = ?", sessionID).
+		First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.SessionModel{}, errs.ErrRecordNotFound
+		}
+		return model.SessionModel{}, err
+	}
+	return session, nil
+}
+
+func (r *SessionRepository) Create(ctx context.Context, session model.SessionModel) (model.SessionModel, error) {
+	ctx, otelSpan := trace.Span(ctx, "SessionRepository.Create")
+	defer otelSpan.End()
+
+	err := gorm.G[model.SessionModel](r.Conn(ctx)).Create(ctx, &session)
+	return session, err
+}
+
+// Revoke revokes a session of the user, revoking an already revoked session is not an error.
+func (r *SessionRepository) Revoke(ctx context.Context, userID, sessionID uint64, revokedAt time.Time) error {
+	ctx, otelSpan := trace.Span(ctx, "SessionRepository.Revoke")
+	defer otelSpan.End()
+
+	return r.Conn(ctx).
+		Model(&model.SessionModel{}).
+		Where("id = ? AND user_id = ? AND revoked_at IS NULL", sessionID, userID).
+		Update("revoked_at", revokedAt).
+		Error
+}
+
+func (r *SessionRepository) RevokeAllByUserID(ctx context.Context, userID uint64, revokedAt time.Time) error {
+	ctx, otelSpan := trace.Span(ctx, "SessionRepository.RevokeAllByUserID")
+	defer otelSpan.End()
+
+	return r.Conn(ctx).
+		Model(&model.SessionModel{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", revokedAt).
+		Error
+}
@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	"github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+	"gorm.io/gorm"
+)
+
+type TOTPEnrollmentRepositoryI interface {
+	FindByUserID(ctx context.Context, userID uint64) (model.TOTPEnrollmentModel, error)
+	Create(ctx context.Context, enrollment model.TOTPEnrollmentModel) (model.TOTPEnrollmentModel, error)
+	Confirm(ctx context.Context, userID uint64, confirmedAt time.Time, step int64) error
+	UpdateLastUsedStep(ctx context.Context, userID uint64, step int64) error
+	RecordFailedAttempt(ctx context.Context, userID uint64, maxAttempts int, lockedUntil time.Time) error
+	ResetFailedAttempts(ctx context.Context, userID uint64) error
+	DeleteByUserID(ctx context.Context, userID uint64) error
+}
+
+type TOTPEnrollmentRepository struct {
+	*database.PingoDB
+}
+
+var _ TOTPEnrollmentRepositoryI = (*TOTPEnrollmentRepository)(nil)
+
+func NewTOTPEnrollmentRepository(db *database.PingoDB) *TOTPEnrollmentRepository {
+	return &TOTPEnrollmentRepository{db}
+}
+
+func (r *TOTPEnrollmentRepository) FindByUserID(
+	ctx context.Context,
+	userID uint64,
+) (model.TOTPEnrollmentModel, error) {
+	ctx, otelSpan := trace.Span(ctx, "TOTPEnrollmentRepository.FindByUserID")
+	defer otelSpan.End()
+
+	enrollment, err := gorm.G[model.TOTPEnrollmentModel](r.Conn(ctx)).
+		Where("user_id = ?", userID).
+		First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.TOTPEnrollmentModel{}, errs.ErrRecordNotFound
+		}
+		return model.TOTPEnrollmentModel{}, err
+	}
+	return enrollment, nil
+}
+
+func (r *TOTPEnrollmentRepository) Create(
+	ctx context.Context,
+	enrollment model.TOTPEnrollmentModel,
+) (model.TOTPEnrollmentModel, error) {
+	ctx, otelSpan := trace.Span(ctx, "TOTPEnrollmentRepository.Create")
+	defer otelSpan.End()
+
+	err := gorm.G[model.TOTPEnrollmentModel](r.Conn(ctx)).Create(ctx, &enrollment)
+	return enrollment, err
+}
+
+// Confirm confirms the enrollment of the user with the time step of the code it was confirmed with,
+// it returns errs.ErrRecordNotFound when there is no enrollment waiting for a confirmation.
+func (r *TOTPEnrollmentRepository) Confirm(
+	ctx context.Context,
+	userID uint64,
+	confirmedAt time.Time,
+	step int64,
+) error {
+	ctx, otelSpan := trace.Span(ctx, "TOTPEnrollmentRepository.Confirm")
+	defer otelSpan.End()
+
+	result := r.Conn(ctx).
+		Model(&model.TOTPEnrollmentModel{}).
+		Where("user_id = ? AND confirmed_at IS NULL", userID).
+		Updates(map[string]interface{}{"confirmed_at": confirmedAt, "last_used_step": step})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errs.ErrRecordNotFound
+	}
+	return nil
+}
+
+// UpdateLastUsedStep records the time step of the last code used, it returns errs.ErrRecordNotFound
+// when the step is not newer than the last one, so a code cannot be used twice.
+func (r *TOTPEnrollmentRepository) UpdateLastUsedStep(ctx context.Context, userID uint64, step int64) error {
+	ctx, otelSpan := trace.Span(ctx, "TOTPEnrollmentRepository.UpdateLastUsedStep")
+	defer otelSpan.End()
+
+	result := r.Conn(ctx).
+		Model(&model.TOTPEnrollmentModel{}).
+		Where("user_id = ? AND last_used_step < ?", userID, step).
+		Update("last_used_step", step)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errs.ErrRecordNotFound
+	}
+	return nil
+}
+
+// RecordFailedAttempt counts an invalid code of the user, the maxAttempts-th one in a row locks the
+// codes until lockedUntil and starts the count over. The count is updated in place, so concurrent
+// attempts are all counted.
+func (r *TOTPEnrollmentRepository) RecordFailedAttempt(
+	ctx context.Context,
+	userID uint64,
+	maxAttempts int,
+	lockedUntil time.Time,
+) error {
+	ctx, otelSpan := trace.Span(ctx, "TOTPEnrollmentRepository.RecordFailedAttempt")
+	defer otelSpan.End()
+
+	return r.Conn(ctx).
+		Model(&model.TOTPEnrollmentModel{}).
+		Where("user_id = ?", userID).
+		Updates(map[string]interface{}{
+			"failed_attempts": gorm.Expr(
+				"CASE WHEN failed_attempts + 1 >= ? THEN 0 ELSE failed_attempts + 1 END",
+				maxAttempts,
+			),
+			"locked_until": gorm.Expr(
+				"CASE WHEN failed_attempts + 1 >= ? THEN ? ELSE locked_until END",
+				maxAttempts,
+				lockedUntil,
+			),
+		}).Error
+}
+
+// ResetFailedAttempts starts the count of invalid codes of the user over after a valid one.
+func (r *TOTPEnrollmentRepository) ResetFailedAttempts(ctx context.Context, userID uint64) error {
+	ctx, otelSpan := trace.Span(ctx, "TOTPEnrollmentRepository.ResetFailedAttempts")
+	defer otelSpan.End()
+
+	return r.Conn(ctx).
+		Model(&model.TOTPEnrollmentModel{}).
+		Where("user_id = ?", userID).
+		Updates(map[string]interface{}{"failed_attempts": 0, "locked_until": nil}).Error
+}
+
+func (r *TOTPEnrollmentRepository) DeleteByUserID(ctx context.Context, userID uint64) error {
+	ctx, otelSpan := trace.Span(ctx, "TOTPEnrollmentRepository.DeleteByUserID")
+	defer otelSpan.End()
+
+	rowsAffected, err := gorm.G[model.TOTPEnrollmentModel](r.Conn(ctx)).
+		Where("user_id = ?", userID).
+		Delete(ctx)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errs.ErrRecordNotFound
+	}
+	return nil
+}
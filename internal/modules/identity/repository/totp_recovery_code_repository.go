@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	"github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+	"gorm.io/gorm"
+)
+
+type TOTPRecoveryCodeRepositoryI interface {
+	CreateMany(ctx context.Context, recoveryCodes []model.TOTPRecoveryCodeModel) error
+	MarkUsed(ctx context.Context, userID uint64, codeHash []byte, usedAt time.Time) error
+	DeleteByUserID(ctx context.Context, userID uint64) error
+}
+
+type TOTPRecoveryCodeRepository struct {
+	*database.PingoDB
+}
+
+var _ TOTPRecoveryCodeRepositoryI = (*TOTPRecoveryCodeRepository)(nil)
+
+func NewTOTPRecoveryCodeRepository(db *database.PingoDB) *TOTPRecoveryCodeRepository {
+	return &TOTPRecoveryCodeRepository{db}
+}
+
+func (r *TOTPRecoveryCodeRepository) CreateMany(
+	ctx context.Context,
+	recoveryCodes []model.TOTPRecoveryCodeModel,
+) error {
+	ctx, otelSpan := trace.Span(ctx, "TOTPRecoveryCodeRepository.CreateMany")
+	defer otelSpan.End()
+
+	return gorm.G[model.TOTPRecoveryCodeModel](r.Conn(ctx)).CreateInBatches(ctx, &recoveryCodes, len(recoveryCodes))
+}
+
+// MarkUsed marks the recovery code of the user as used, it returns errs.ErrRecordNotFound when the
+// user has no unused recovery code with the hash, so every code can only be used once.
+func (r *TOTPRecoveryCodeRepository) MarkUsed(
+	ctx context.Context,
+	userID uint64,
+	codeHash []byte,
+	usedAt time.Time,
+) error {
+	ctx, otelSpan := trace.Span(ctx, "TOTPRecoveryCodeRepository.MarkUsed")
+	defer otelSpan.End()
+
+	result := r.Conn(ctx).
+		Model(&model.TOTPRecoveryCodeModel{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		Update("used_at", usedAt)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errs.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *TOTPRecoveryCodeRepository) DeleteByUserID(ctx context.Context, userID uint64) error {
+	ctx, otelSpan := trace.Span(ctx, "TOTPRecoveryCodeRepository.DeleteByUserID")
+	defer otelSpan.End()
+
+	_, err := gorm.G[model.TOTPRecoveryCodeModel](r.Conn(ctx)).
+		Where("user_id = ?", userID).
+		Delete(ctx)
+	return err
+}
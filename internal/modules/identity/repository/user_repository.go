@@ -62,7 +62,7 @@ func (r *UserRepository) Create(ctx context.Context, user model.UserModel) (mode
 	ctx, otelSpan := trace.Span(ctx, "UserRepository.Create")
 	defer otelSpan.End()
 
-	err := gorm.G[model.UserModel](r.DB).Create(ctx, &user)
+	err := gorm.G[model.UserModel](r.Conn(ctx)).Create(ctx, &user)
 	return user, err
 }
 
@@ -70,7 +70,7 @@ func (r *UserRepository) Update(ctx context.Context, user model.UserModel) error
 	ctx, otelSpan := trace.Span(ctx, "UserRepository.Update")
 	defer otelSpan.End()
 
-	rowsAffected, err := gorm.G[model.UserModel](r.DB).Where("id = ?", user.ID).Updates(ctx, user)
+	rowsAffected, err := gorm.G[model.UserModel](r.Conn(ctx)).Where("id = ?", user.ID).Updates(ctx, user)
 	if rowsAffected == 0 {
 		return errs.ErrRecordNotFound
 	}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/repository"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+)
+
+// APIKeyTokenPrefix starts every API key, it tells them apart from the JWTs sent in the same header.
+const APIKeyTokenPrefix = "pingo_"
+
+const (
+	apiKeyPrefixBytes = 6
+	apiKeySecretBytes = 32
+	// apiKeyLastUsedResolution bounds the writes made to track the last use of a key.
+	apiKeyLastUsedResolution = time.Minute
+)
+
+// GeneratedAPIKey is a new API key. Key is the only place the secret is kept in clear,
+// it is shown to the user once and never stored.
+type GeneratedAPIKey struct {
+	Key       string
+	KeyPrefix string
+	KeyHash   []byte
+}
+
+type APIKeyServiceI interface {
+	Generate() (GeneratedAPIKey, error)
+	Authenticate(ctx context.Context, key string) (model.APIKeyModel, error)
+}
+
+type APIKeyService struct {
+	apiKeyRepository repository.APIKeyRepositoryI
+	hashService      HashServiceI
+	logger           logger.Logger
+}
+
+var _ APIKeyServiceI = (*APIKeyService)(nil)
+
+func NewAPIKeyService(
+	apiKeyRepository repository.APIKeyRepositoryI,
+	hashService HashServiceI,
+	logger logger.Logger,
+) *APIKeyService {
+	return &APIKeyService{
+		apiKeyRepository: apiKeyRepository,
+		hashService:      hashService,
+		logger:           logger,
+	}
+}
+
+// IsAPIKey reports whether the bearer token is an API key rather than a JWT.
+func IsAPIKey(token string) bool {
+	return strings.HasPrefix(token, APIKeyTokenPrefix)
+}
+
+// Generate creates a key in the format pingo_<prefix>_<secret>. The prefix identifies the key
+// and is stored in clear, the secret is only stored hashed.
+func (s *APIKeyService) Generate() (GeneratedAPIKey, error) {
+	randomBytes, err := s.hashService.GenerateRandomBytes()
+	if err != nil {
+		return GeneratedAPIKey{}, err
+	}
+
+	if len(randomBytes) < apiKeyPrefixBytes+apiKeySecretBytes {
+		return GeneratedAPIKey{}, errors.New("not enough random bytes to generate an API key")
+	}
+
+	keyPrefix := APIKeyTokenPrefix + hex.EncodeToString(randomBytes[:apiKeyPrefixBytes])
+	secret := hex.EncodeToString(randomBytes[apiKeyPrefixBytes : apiKeyPrefixBytes+apiKeySecretBytes])
+
+	keyHash, err := s.hashService.GenerateFromPassword([]byte(secret))
+	if err != nil {
+		return GeneratedAPIKey{}, err
+	}
+
+	generatedAPIKey := GeneratedAPIKey{
+		Key:       keyPrefix + "_" + secret,
+		KeyPrefix: keyPrefix,
+		KeyHash:   keyHash,
+	}
+
+	return generatedAPIKey, nil
+}
+
+// Authenticate returns the API key matching the given key when it is not expired,
+// and records that it was used.
+func (s *APIKeyService) Authenticate(ctx context.Context, key string) (model.APIKeyModel, error) {
+	ctx, span := trace.Span(ctx, "APIKeyService.Authenticate")
+	defer span.End()
+
+	if !IsAPIKey(key) {
+		return model.APIKeyModel{}, errs.ErrInvalidAPIKey
+	}
+
+	prefix, secret, found := strings.Cut(strings.TrimPrefix(key, APIKeyTokenPrefix), "_")
+	if !found || prefix == "" || secret == "" {
+		return model.APIKeyModel{}, errs.ErrInvalidAPIKey
+	}
+
+	apiKey, err := s.apiKeyRepository.FindByKeyPrefix(ctx, APIKeyTokenPrefix+prefix)
+	if err != nil {
+		if errors.Is(err, shared_errs.ErrRecordNotFound) {
+			return model.APIKeyModel{}, errs.ErrInvalidAPIKey
+		}
+		s.logger.Error().Msgf("error finding API key by prefix %s: %v", prefix, err)
+		return model.APIKeyModel{}, err
+	}
+
+	now := time.Now().UTC()
+	if apiKey.ExpiresAt != nil && !apiKey.ExpiresAt.After(now) {
+		return model.APIKeyModel{}, errs.ErrInvalidAPIKey
+	}
+
+	if err = s.hashService.CompareHashAndPassword(apiKey.KeyHash, []byte(secret)); err != nil {
+		return model.APIKeyModel{}, errs.ErrInvalidAPIKey
+	}
+
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) >= apiKeyLastUsedResolution {
+		err = s.apiKeyRepository.UpdateLastUsedAt(ctx, apiKey.ID, now)
+		if err != nil {
+			// The key is valid, failing to track its use must not fail the request
+			s.logger.Warn().Msgf("Failed to update the last use of API key ID %d, error: %v", apiKey.ID, err)
+		} else {
+			apiKey.LastUsedAt = &now
+		}
+	}
+
+	return apiKey, nil
+}
@@ -0,0 +1,158 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/repository/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+	service_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/service/mocks"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+)
+
+type APIKeyServiceTestSuite struct {
+	suite.Suite
+	sut                  *service.APIKeyService
+	apiKeyRepositoryMock *repository_mocks.MockAPIKeyRepositoryI
+	hashServiceMock      *service_mocks.MockHashServiceI
+	logger               logger.Logger
+}
+
+func (s *APIKeyServiceTestSuite) SetupTest() {
+	s.apiKeyRepositoryMock = repository_mocks.NewMockAPIKeyRepositoryI(s.T())
+	s.hashServiceMock = service_mocks.NewMockHashServiceI(s.T())
+	s.logger = logger.New(config.Config{Log: config.Log{LogLevel: "disabled"}})
+
+	s.sut = service.NewAPIKeyService(s.apiKeyRepositoryMock, s.hashServiceMock, s.logger)
+}
+
+func TestAPIKeyServiceSuite(t *testing.T) {
+	suite.Run(t, new(APIKeyServiceTestSuite))
+}
+
+func (s *APIKeyServiceTestSuite) TestGenerate_ValidRandomBytes_ReturnsKeyWithHashedSecret() {
+	// Arrange
+	randomBytes := make([]byte, 128)
+	for i := range randomBytes {
+		randomBytes[i] = byte(i)
+	}
+	expectedPrefix := "pingo_000102030405"
+	expectedSecret := "060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425"
+	keyHash := []byte("hashed-secret")
+
+	s.hashServiceMock.On("GenerateRandomBytes").Return(randomBytes, nil)
+	s.hashServiceMock.On("GenerateFromPassword", []byte(expectedSecret)).Return(keyHash, nil)
+
+	// Act
+	result, err := s.sut.Generate()
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(expectedPrefix, result.KeyPrefix)
+	s.Equal(expectedPrefix+"_"+expectedSecret, result.Key)
+	s.Equal(keyHash, result.KeyHash)
+	s.True(service.IsAPIKey(result.Key))
+}
+
+func (s *APIKeyServiceTestSuite) TestAuthenticate_ValidKey_ReturnsAPIKeyAndTracksUse() {
+	// Arrange
+	ctx := context.Background()
+	apiKey := model.APIKeyModel{ID: 4, UserID: 7, KeyPrefix: "pingo_abc123", KeyHash: []byte("hash")}
+
+	s.apiKeyRepositoryMock.On("FindByKeyPrefix", mock.Anything, "pingo_abc123").Return(apiKey, nil)
+	s.hashServiceMock.On("CompareHashAndPassword", apiKey.KeyHash, []byte("secret")).Return(nil)
+	s.apiKeyRepositoryMock.On("UpdateLastUsedAt", mock.Anything, uint64(4), mock.Anything).Return(nil)
+
+	// Act
+	result, err := s.sut.Authenticate(ctx, "pingo_abc123_secret")
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(uint64(7), result.UserID)
+	s.NotNil(result.LastUsedAt)
+}
+
+func (s *APIKeyServiceTestSuite) TestAuthenticate_RecentlyUsed_DoesNotTrackUseAgain() {
+	// Arrange
+	ctx := context.Background()
+	lastUsedAt := time.Now().UTC().Add(-10 * time.Second)
+	apiKey := model.APIKeyModel{ID: 4, UserID: 7, KeyHash: []byte("hash"), LastUsedAt: &lastUsedAt}
+
+	s.apiKeyRepositoryMock.On("FindByKeyPrefix", mock.Anything, "pingo_abc123").Return(apiKey, nil)
+	s.hashServiceMock.On("CompareHashAndPassword", apiKey.KeyHash, []byte("secret")).Return(nil)
+
+	// Act
+	_, err := s.sut.Authenticate(ctx, "pingo_abc123_secret")
+
+	// Assert
+	s.Require().NoError(err)
+	s.apiKeyRepositoryMock.AssertNotCalled(s.T(), "UpdateLastUsedAt", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *APIKeyServiceTestSuite) TestAuthenticate_MalformedKey_ReturnsInvalidAPIKey() {
+	// Arrange
+	ctx := context.Background()
+
+	// Act
+	_, err := s.sut.Authenticate(ctx, "pingo_abc123")
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidAPIKey)
+	s.apiKeyRepositoryMock.AssertNotCalled(s.T(), "FindByKeyPrefix", mock.Anything, mock.Anything)
+}
+
+func (s *APIKeyServiceTestSuite) TestAuthenticate_UnknownPrefix_ReturnsInvalidAPIKey() {
+	// Arrange
+	ctx := context.Background()
+
+	s.apiKeyRepositoryMock.On("FindByKeyPrefix", mock.Anything, "pingo_abc123").
+		Return(model.APIKeyModel{}, shared_errs.ErrRecordNotFound)
+
+	// Act
+	_, err := s.sut.Authenticate(ctx, "pingo_abc123_secret")
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidAPIKey)
+}
+
+func (s *APIKeyServiceTestSuite) TestAuthenticate_ExpiredKey_ReturnsInvalidAPIKey() {
+	// Arrange
+	ctx := context.Background()
+	expiresAt := time.Now().UTC().Add(-time.Hour)
+	apiKey := model.APIKeyModel{ID: 4, UserID: 7, KeyHash: []byte("hash"), ExpiresAt: &expiresAt}
+
+	s.apiKeyRepositoryMock.On("FindByKeyPrefix", mock.Anything, "pingo_abc123").Return(apiKey, nil)
+
+	// Act
+	_, err := s.sut.Authenticate(ctx, "pingo_abc123_secret")
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidAPIKey)
+	s.hashServiceMock.AssertNotCalled(s.T(), "CompareHashAndPassword", mock.Anything, mock.Anything)
+}
+
+func (s *APIKeyServiceTestSuite) TestAuthenticate_WrongSecret_ReturnsInvalidAPIKey() {
+	// Arrange
+	ctx := context.Background()
+	apiKey := model.APIKeyModel{ID: 4, UserID: 7, KeyHash: []byte("hash")}
+
+	s.apiKeyRepositoryMock.On("FindByKeyPrefix", mock.Anything, "pingo_abc123").Return(apiKey, nil)
+	s.hashServiceMock.On("CompareHashAndPassword", apiKey.KeyHash, []byte("wrong")).
+		Return(errors.New("mismatch"))
+
+	// Act
+	_, err := s.sut.Authenticate(ctx, "pingo_abc123_wrong")
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidAPIKey)
+	s.apiKeyRepositoryMock.AssertNotCalled(s.T(), "UpdateLastUsedAt", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -3,11 +3,14 @@ package service
 import (
 	"bytes"
 	"html/template"
+	"path/filepath"
 )
 
 type EmailTemplateServiceI interface {
 	CompileAccountConfirmationTemplate(input AccountConfirmationInput) (string, error)
 	CompileAuthVerificationCodeTemplate(name string, code string) (string, error)
+	CompileOrganizationInvitationTemplate(input OrganizationInvitationInput) (string, error)
+	CompileOneTimeLinkTemplate(input OneTimeLinkInput) (string, error)
 }
 
 type EmailTemplateService struct {
@@ -24,6 +27,23 @@ type AccountConfirmationInput struct {
 	AccountConfirmationLink string
 }
 
+type OrganizationInvitationInput struct {
+	Name             string
+	InviterName      string
+	OrganizationName string
+	Role             string
+	InvitationLink   string
+}
+
+// OneTimeLinkInput renders the email of a one-time link, Template is the file of its content.
+type OneTimeLinkInput struct {
+	Template          string
+	Title             string
+	Name              string
+	Link              string
+	ExpirationMinutes int
+}
+
 func (s *EmailTemplateService) CompileAccountConfirmationTemplate(input AccountConfirmationInput) (string, error) {
 	// Load templates
 	tmpl, err := template.New("layout_default.gohtml").
@@ -77,3 +97,63 @@ func (s *EmailTemplateService) CompileAuthVerificationCodeTemplate(name string,
 	}
 	return buf.String(), nil
 }
+
+func (s *EmailTemplateService) CompileOrganizationInvitationTemplate(
+	input OrganizationInvitationInput,
+) (string, error) {
+	// Load templates
+	tmpl, err := template.New("layout_default.gohtml").
+		ParseFiles(
+			"internal/modules/identity/ui/email/templates/layout_default.gohtml",
+			"internal/modules/identity/ui/email/templates/organization_invitation.gohtml",
+		)
+	if err != nil {
+		return "", err
+	}
+
+	// Prepare data
+	data := map[string]interface{}{
+		"Name":             input.Name,
+		"InviterName":      input.InviterName,
+		"OrganizationName": input.OrganizationName,
+		"Role":             input.Role,
+		"InvitationLink":   input.InvitationLink,
+		"Title":            "Organization Invitation",
+	}
+
+	// Render template
+	var buf bytes.Buffer
+	err = tmpl.ExecuteTemplate(&buf, "htmlBody", data)
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func (s *EmailTemplateService) CompileOneTimeLinkTemplate(input OneTimeLinkInput) (string, error) {
+	// Load templates
+	tmpl, err := template.New("layout_default.gohtml").
+		ParseFiles(
+			"internal/modules/identity/ui/email/templates/layout_default.gohtml",
+			filepath.Join("internal/modules/identity/ui/email/templates", filepath.Base(input.Template)),
+		)
+	if err != nil {
+		return "", err
+	}
+
+	// Prepare data
+	data := map[string]interface{}{
+		"Name":              input.Name,
+		"Link":              input.Link,
+		"ExpirationMinutes": input.ExpirationMinutes,
+		"Title":             input.Title,
+	}
+
+	// Render template
+	var buf bytes.Buffer
+	err = tmpl.ExecuteTemplate(&buf, "htmlBody", data)
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
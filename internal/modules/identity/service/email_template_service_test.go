@@ -337,3 +337,84 @@ func (s *EmailTemplateServiceTestSuite) TestCompileAuthVerificationCodeTemplate_
 		})
 	}
 }
+
+func (s *EmailTemplateServiceTestSuite) TestCompileOrganizationInvitationTemplate_ValidInput_ReturnsCompiledHTML() {
+	// Skip test if project root not found
+	if !s.projectRootFound {
+		s.T().Skip("Project root not found, skipping template tests")
+	}
+
+	// Arrange
+	input := service.OrganizationInvitationInput{
+		Name:             "Jane Smith",
+		InviterName:      "John Doe",
+		OrganizationName: "Acme",
+		Role:             "editor",
+		InvitationLink:   "https://example.com/organizations/invitations/accept?id=1&token=abc123",
+	}
+
+	// Act
+	result, err := s.sut.CompileOrganizationInvitationTemplate(input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Contains(result, "Jane Smith")
+	s.Contains(result, "John Doe invited you to join the Acme organization as editor.")
+	s.Contains(result, "https://example.com/organizations/invitations/accept?id=1&amp;token=abc123")
+	s.Contains(result, "Organization Invitation")
+	s.Contains(result, "<!DOCTYPE html>")
+}
+
+func (s *EmailTemplateServiceTestSuite) TestCompileOneTimeLinkTemplate_PasswordReset_ReturnsCompiledHTML() {
+	// Skip test if project root not found
+	if !s.projectRootFound {
+		s.T().Skip("Project root not found, skipping template tests")
+	}
+
+	// Arrange
+	input := service.OneTimeLinkInput{
+		Template:          service.PasswordResetLink.Template,
+		Title:             service.PasswordResetLink.Subject,
+		Name:              "Jane Smith",
+		Link:              "https://example.com/password/reset?id=1&token=abc123",
+		ExpirationMinutes: 60,
+	}
+
+	// Act
+	result, err := s.sut.CompileOneTimeLinkTemplate(input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Contains(result, "Jane Smith")
+	s.Contains(result, "valid for 60 minutes")
+	s.Contains(result, "https://example.com/password/reset?id=1&amp;token=abc123")
+	s.Contains(result, "Password Reset")
+	s.Contains(result, "<!DOCTYPE html>")
+}
+
+func (s *EmailTemplateServiceTestSuite) TestCompileOneTimeLinkTemplate_MagicLink_ReturnsCompiledHTML() {
+	// Skip test if project root not found
+	if !s.projectRootFound {
+		s.T().Skip("Project root not found, skipping template tests")
+	}
+
+	// Arrange
+	input := service.OneTimeLinkInput{
+		Template:          service.MagicLink.Template,
+		Title:             service.MagicLink.Subject,
+		Name:              "Jane Smith",
+		Link:              "https://example.com/login/magic?id=1&token=abc123",
+		ExpirationMinutes: 15,
+	}
+
+	// Act
+	result, err := s.sut.CompileOneTimeLinkTemplate(input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Contains(result, "Jane Smith")
+	s.Contains(result, "valid for 15 minutes")
+	s.Contains(result, "https://example.com/login/magic?id=1&amp;token=abc123")
+	s.Contains(result, "Sign In Link")
+	s.Contains(result, "<!DOCTYPE html>")
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/repository"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+)
+
+const loginChallengeTTL = 5 * time.Minute
+
+type LoginChallengeServiceI interface {
+	Issue(ctx context.Context, userID uint64) (string, error)
+	Verify(ctx context.Context, userID uint64, challenge string) (model.OneTimeTokenModel, error)
+	Consume(ctx context.Context, challenge model.OneTimeTokenModel) error
+}
+
+// LoginChallengeService binds the second step of a login to the user who passed the first one,
+// the code of the second step is only accepted along with the challenge issued to that user.
+type LoginChallengeService struct {
+	oneTimeTokenRepository repository.OneTimeTokenRepositoryI
+	hashService            HashServiceI
+	logger                 logger.Logger
+}
+
+var _ LoginChallengeServiceI = (*LoginChallengeService)(nil)
+
+func NewLoginChallengeService(
+	oneTimeTokenRepository repository.OneTimeTokenRepositoryI,
+	hashService HashServiceI,
+	logger logger.Logger,
+) *LoginChallengeService {
+	return &LoginChallengeService{
+		oneTimeTokenRepository: oneTimeTokenRepository,
+		hashService:            hashService,
+		logger:                 logger,
+	}
+}
+
+// Issue returns a new challenge of the user, the challenges issued before stop working.
+func (s *LoginChallengeService) Issue(ctx context.Context, userID uint64) (string, error) {
+	ctx, span := trace.Span(ctx, "LoginChallengeService.Issue")
+	defer span.End()
+
+	loginChallengeType, _ := enum.NewTokenTypeEnum(enum.TokenTypeLoginChallenge)
+	err := s.oneTimeTokenRepository.Delete(ctx, userID, loginChallengeType)
+	if err != nil && !errors.Is(err, shared_errs.ErrRecordNotFound) {
+		s.logger.Error().Msgf("error deleting the login challenges of user ID %d: %v", userID, err)
+		return "", err
+	}
+
+	challenge, err := s.hashService.GenerateRandomBytes()
+	if err != nil {
+		s.logger.Error().Msgf("error generating random bytes: %v", err)
+		return "", err
+	}
+
+	// only the hash is stored, a leaked table does not allow skipping the first step
+	challengeHash := sha256.Sum256(challenge)
+	oneTimeToken := model.OneTimeTokenModel{
+		UserID:    userID,
+		TokenHash: challengeHash[:],
+		TokenType: loginChallengeType.String(),
+		ExpiresAt: time.Now().UTC().Add(loginChallengeTTL),
+		CreatedAt: time.Now().UTC(),
+	}
+	if _, err = s.oneTimeTokenRepository.Create(ctx, oneTimeToken); err != nil {
+		s.logger.Error().Msgf("error creating the login challenge of user ID %d: %v", userID, err)
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(challenge), nil
+}
+
+// Verify returns the stored challenge when challenge is the current one of the user. It stays
+// usable until Consume, so a mistyped code does not restart the login.
+func (s *LoginChallengeService) Verify(
+	ctx context.Context,
+	userID uint64,
+	challenge string,
+) (model.OneTimeTokenModel, error) {
+	ctx, span := trace.Span(ctx, "LoginChallengeService.Verify")
+	defer span.End()
+
+	rawChallenge, err := base64.StdEncoding.DecodeString(challenge)
+	if err != nil {
+		return model.OneTimeTokenModel{}, errs.ErrInvalidLoginChallenge
+	}
+
+	// expired challenges are not found
+	loginChallengeType, _ := enum.NewTokenTypeEnum(enum.TokenTypeLoginChallenge)
+	oneTimeToken, err := s.oneTimeTokenRepository.Find(ctx, userID, loginChallengeType)
+	if err != nil {
+		if errors.Is(err, shared_errs.ErrRecordNotFound) {
+			return model.OneTimeTokenModel{}, errs.ErrInvalidLoginChallenge
+		}
+		s.logger.Error().Msgf("error finding the login challenge of user ID %d: %v", userID, err)
+		return model.OneTimeTokenModel{}, err
+	}
+
+	challengeHash := sha256.Sum256(rawChallenge)
+	if subtle.ConstantTimeCompare(oneTimeToken.TokenHash, challengeHash[:]) != 1 {
+		return model.OneTimeTokenModel{}, errs.ErrInvalidLoginChallenge
+	}
+
+	return oneTimeToken, nil
+}
+
+// Consume deletes the challenge once the login is complete, only one of two concurrent logins
+// with the same challenge gets through.
+func (s *LoginChallengeService) Consume(ctx context.Context, challenge model.OneTimeTokenModel) error {
+	ctx, span := trace.Span(ctx, "LoginChallengeService.Consume")
+	defer span.End()
+
+	err := s.oneTimeTokenRepository.DeleteByID(ctx, challenge.UserID, challenge.ID)
+	if err != nil {
+		if errors.Is(err, shared_errs.ErrRecordNotFound) {
+			return errs.ErrInvalidLoginChallenge
+		}
+		s.logger.Error().Msgf("error deleting the login challenge of user ID %d: %v", challenge.UserID, err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package service_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/repository/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+	service_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/service/mocks"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type LoginChallengeServiceTestSuite struct {
+	suite.Suite
+	sut                    *service.LoginChallengeService
+	oneTimeTokenRepository *repository_mocks.MockOneTimeTokenRepositoryI
+	hashService            *service_mocks.MockHashServiceI
+	loginChallengeType     enum.TokenTypeEnum
+}
+
+func (s *LoginChallengeServiceTestSuite) SetupTest() {
+	s.oneTimeTokenRepository = repository_mocks.NewMockOneTimeTokenRepositoryI(s.T())
+	s.hashService = service_mocks.NewMockHashServiceI(s.T())
+	s.loginChallengeType, _ = enum.NewTokenTypeEnum(enum.TokenTypeLoginChallenge)
+
+	s.sut = service.NewLoginChallengeService(
+		s.oneTimeTokenRepository,
+		s.hashService,
+		logger.New(config.Config{Log: config.Log{LogLevel: "disabled"}}),
+	)
+}
+
+func TestLoginChallengeServiceSuite(t *testing.T) {
+	suite.Run(t, new(LoginChallengeServiceTestSuite))
+}
+
+func (s *LoginChallengeServiceTestSuite) storedChallenge(challenge []byte) model.OneTimeTokenModel {
+	challengeHash := sha256.Sum256(challenge)
+	return model.OneTimeTokenModel{
+		ID:        9,
+		UserID:    7,
+		TokenHash: challengeHash[:],
+		TokenType: enum.TokenTypeLoginChallenge,
+	}
+}
+
+func (s *LoginChallengeServiceTestSuite) TestIssue_ReplacesPreviousChallengeAndStoresItsHash() {
+	// Arrange
+	challenge := []byte{0xfb, 0xff, 0x01}
+	challengeHash := sha256.Sum256(challenge)
+
+	s.oneTimeTokenRepository.On("Delete", mock.Anything, uint64(7), s.loginChallengeType).
+		Return(shared_errs.ErrRecordNotFound)
+	s.hashService.On("GenerateRandomBytes").Return(challenge, nil)
+	s.oneTimeTokenRepository.On("Create", mock.Anything, mock.MatchedBy(func(t model.OneTimeTokenModel) bool {
+		return t.UserID == 7 &&
+			t.TokenType == enum.TokenTypeLoginChallenge &&
+			string(t.TokenHash) == string(challengeHash[:]) &&
+			t.ExpiresAt.After(t.CreatedAt)
+	})).Return(model.OneTimeTokenModel{ID: 9}, nil)
+
+	// Act
+	result, err := s.sut.Issue(context.Background(), 7)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(base64.StdEncoding.EncodeToString(challenge), result)
+}
+
+func (s *LoginChallengeServiceTestSuite) TestIssue_CreateError_ReturnsError() {
+	// Arrange
+	createErr := errors.New("database error")
+
+	s.oneTimeTokenRepository.On("Delete", mock.Anything, uint64(7), s.loginChallengeType).Return(nil)
+	s.hashService.On("GenerateRandomBytes").Return([]byte("challenge"), nil)
+	s.oneTimeTokenRepository.On("Create", mock.Anything, mock.Anything).Return(model.OneTimeTokenModel{}, createErr)
+
+	// Act
+	result, err := s.sut.Issue(context.Background(), 7)
+
+	// Assert
+	s.Require().ErrorIs(err, createErr)
+	s.Empty(result)
+}
+
+func (s *LoginChallengeServiceTestSuite) TestVerify_CurrentChallenge_ReturnsIt() {
+	// Arrange
+	challenge := []byte("challenge")
+	stored := s.storedChallenge(challenge)
+
+	s.oneTimeTokenRepository.On("Find", mock.Anything, uint64(7), s.loginChallengeType).Return(stored, nil)
+
+	// Act
+	result, err := s.sut.Verify(context.Background(), 7, base64.StdEncoding.EncodeToString(challenge))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(stored, result)
+}
+
+func (s *LoginChallengeServiceTestSuite) TestVerify_ChallengeOfAnotherLogin_ReturnsInvalidLoginChallenge() {
+	// Arrange
+	s.oneTimeTokenRepository.On("Find", mock.Anything, uint64(7), s.loginChallengeType).
+		Return(s.storedChallenge([]byte("challenge")), nil)
+
+	// Act
+	_, err := s.sut.Verify(context.Background(), 7, base64.StdEncoding.EncodeToString([]byte("another")))
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidLoginChallenge)
+}
+
+func (s *LoginChallengeServiceTestSuite) TestVerify_ExpiredChallenge_ReturnsInvalidLoginChallenge() {
+	// Arrange
+	s.oneTimeTokenRepository.On("Find", mock.Anything, uint64(7), s.loginChallengeType).
+		Return(model.OneTimeTokenModel{}, shared_errs.ErrRecordNotFound)
+
+	// Act
+	_, err := s.sut.Verify(context.Background(), 7, base64.StdEncoding.EncodeToString([]byte("challenge")))
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidLoginChallenge)
+}
+
+func (s *LoginChallengeServiceTestSuite) TestVerify_MalformedChallenge_ReturnsInvalidLoginChallenge() {
+	// Act
+	_, err := s.sut.Verify(context.Background(), 7, "not base64!")
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidLoginChallenge)
+	s.oneTimeTokenRepository.AssertNotCalled(s.T(), "Find", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *LoginChallengeServiceTestSuite) TestConsume_AlreadyConsumed_ReturnsInvalidLoginChallenge() {
+	// Arrange
+	stored := s.storedChallenge([]byte("challenge"))
+
+	s.oneTimeTokenRepository.On("DeleteByID", mock.Anything, stored.UserID, stored.ID).
+		Return(shared_errs.ErrRecordNotFound)
+
+	// Act
+	err := s.sut.Consume(context.Background(), stored)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidLoginChallenge)
+}
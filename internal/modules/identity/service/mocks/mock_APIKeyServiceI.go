@@ -0,0 +1,151 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	mock "github.com/stretchr/testify/mock"
+
+	service "github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+)
+
+// MockAPIKeyServiceI is an autogenerated mock type for the APIKeyServiceI type
+type MockAPIKeyServiceI struct {
+	mock.Mock
+}
+
+type MockAPIKeyServiceI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAPIKeyServiceI) EXPECT() *MockAPIKeyServiceI_Expecter {
+	return &MockAPIKeyServiceI_Expecter{mock: &_m.Mock}
+}
+
+// Authenticate provides a mock function with given fields: ctx, key
+func (_m *MockAPIKeyServiceI) Authenticate(ctx context.Context, key string) (model.APIKeyModel, error) {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authenticate")
+	}
+
+	var r0 model.APIKeyModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.APIKeyModel, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.APIKeyModel); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Get(0).(model.APIKeyModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPIKeyServiceI_Authenticate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authenticate'
+type MockAPIKeyServiceI_Authenticate_Call struct {
+	*mock.Call
+}
+
+// Authenticate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockAPIKeyServiceI_Expecter) Authenticate(ctx interface{}, key interface{}) *MockAPIKeyServiceI_Authenticate_Call {
+	return &MockAPIKeyServiceI_Authenticate_Call{Call: _e.mock.On("Authenticate", ctx, key)}
+}
+
+func (_c *MockAPIKeyServiceI_Authenticate_Call) Run(run func(ctx context.Context, key string)) *MockAPIKeyServiceI_Authenticate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyServiceI_Authenticate_Call) Return(_a0 model.APIKeyModel, _a1 error) *MockAPIKeyServiceI_Authenticate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPIKeyServiceI_Authenticate_Call) RunAndReturn(run func(context.Context, string) (model.APIKeyModel, error)) *MockAPIKeyServiceI_Authenticate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Generate provides a mock function with no fields
+func (_m *MockAPIKeyServiceI) Generate() (service.GeneratedAPIKey, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Generate")
+	}
+
+	var r0 service.GeneratedAPIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (service.GeneratedAPIKey, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() service.GeneratedAPIKey); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(service.GeneratedAPIKey)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPIKeyServiceI_Generate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Generate'
+type MockAPIKeyServiceI_Generate_Call struct {
+	*mock.Call
+}
+
+// Generate is a helper method to define mock.On call
+func (_e *MockAPIKeyServiceI_Expecter) Generate() *MockAPIKeyServiceI_Generate_Call {
+	return &MockAPIKeyServiceI_Generate_Call{Call: _e.mock.On("Generate")}
+}
+
+func (_c *MockAPIKeyServiceI_Generate_Call) Run(run func()) *MockAPIKeyServiceI_Generate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyServiceI_Generate_Call) Return(_a0 service.GeneratedAPIKey, _a1 error) *MockAPIKeyServiceI_Generate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPIKeyServiceI_Generate_Call) RunAndReturn(run func() (service.GeneratedAPIKey, error)) *MockAPIKeyServiceI_Generate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAPIKeyServiceI creates a new instance of MockAPIKeyServiceI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAPIKeyServiceI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAPIKeyServiceI {
+	mock := &MockAPIKeyServiceI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
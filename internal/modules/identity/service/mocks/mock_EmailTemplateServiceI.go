@@ -133,6 +133,118 @@ func (_c *MockEmailTemplateServiceI_CompileAuthVerificationCodeTemplate_Call) Ru
 	return _c
 }
 
+// CompileOneTimeLinkTemplate provides a mock function with given fields: input
+func (_m *MockEmailTemplateServiceI) CompileOneTimeLinkTemplate(input service.OneTimeLinkInput) (string, error) {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompileOneTimeLinkTemplate")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(service.OneTimeLinkInput) (string, error)); ok {
+		return rf(input)
+	}
+	if rf, ok := ret.Get(0).(func(service.OneTimeLinkInput) string); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(service.OneTimeLinkInput) error); ok {
+		r1 = rf(input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEmailTemplateServiceI_CompileOneTimeLinkTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompileOneTimeLinkTemplate'
+type MockEmailTemplateServiceI_CompileOneTimeLinkTemplate_Call struct {
+	*mock.Call
+}
+
+// CompileOneTimeLinkTemplate is a helper method to define mock.On call
+//   - input service.OneTimeLinkInput
+func (_e *MockEmailTemplateServiceI_Expecter) CompileOneTimeLinkTemplate(input interface{}) *MockEmailTemplateServiceI_CompileOneTimeLinkTemplate_Call {
+	return &MockEmailTemplateServiceI_CompileOneTimeLinkTemplate_Call{Call: _e.mock.On("CompileOneTimeLinkTemplate", input)}
+}
+
+func (_c *MockEmailTemplateServiceI_CompileOneTimeLinkTemplate_Call) Run(run func(input service.OneTimeLinkInput)) *MockEmailTemplateServiceI_CompileOneTimeLinkTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(service.OneTimeLinkInput))
+	})
+	return _c
+}
+
+func (_c *MockEmailTemplateServiceI_CompileOneTimeLinkTemplate_Call) Return(_a0 string, _a1 error) *MockEmailTemplateServiceI_CompileOneTimeLinkTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEmailTemplateServiceI_CompileOneTimeLinkTemplate_Call) RunAndReturn(run func(service.OneTimeLinkInput) (string, error)) *MockEmailTemplateServiceI_CompileOneTimeLinkTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompileOrganizationInvitationTemplate provides a mock function with given fields: input
+func (_m *MockEmailTemplateServiceI) CompileOrganizationInvitationTemplate(input service.OrganizationInvitationInput) (string, error) {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompileOrganizationInvitationTemplate")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(service.OrganizationInvitationInput) (string, error)); ok {
+		return rf(input)
+	}
+	if rf, ok := ret.Get(0).(func(service.OrganizationInvitationInput) string); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(service.OrganizationInvitationInput) error); ok {
+		r1 = rf(input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEmailTemplateServiceI_CompileOrganizationInvitationTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompileOrganizationInvitationTemplate'
+type MockEmailTemplateServiceI_CompileOrganizationInvitationTemplate_Call struct {
+	*mock.Call
+}
+
+// CompileOrganizationInvitationTemplate is a helper method to define mock.On call
+//   - input service.OrganizationInvitationInput
+func (_e *MockEmailTemplateServiceI_Expecter) CompileOrganizationInvitationTemplate(input interface{}) *MockEmailTemplateServiceI_CompileOrganizationInvitationTemplate_Call {
+	return &MockEmailTemplateServiceI_CompileOrganizationInvitationTemplate_Call{Call: _e.mock.On("CompileOrganizationInvitationTemplate", input)}
+}
+
+func (_c *MockEmailTemplateServiceI_CompileOrganizationInvitationTemplate_Call) Run(run func(input service.OrganizationInvitationInput)) *MockEmailTemplateServiceI_CompileOrganizationInvitationTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(service.OrganizationInvitationInput))
+	})
+	return _c
+}
+
+func (_c *MockEmailTemplateServiceI_CompileOrganizationInvitationTemplate_Call) Return(_a0 string, _a1 error) *MockEmailTemplateServiceI_CompileOrganizationInvitationTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEmailTemplateServiceI_CompileOrganizationInvitationTemplate_Call) RunAndReturn(run func(service.OrganizationInvitationInput) (string, error)) *MockEmailTemplateServiceI_CompileOrganizationInvitationTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockEmailTemplateServiceI creates a new instance of MockEmailTemplateServiceI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockEmailTemplateServiceI(t interface {
@@ -0,0 +1,199 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockLoginChallengeServiceI is an autogenerated mock type for the LoginChallengeServiceI type
+type MockLoginChallengeServiceI struct {
+	mock.Mock
+}
+
+type MockLoginChallengeServiceI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLoginChallengeServiceI) EXPECT() *MockLoginChallengeServiceI_Expecter {
+	return &MockLoginChallengeServiceI_Expecter{mock: &_m.Mock}
+}
+
+// Consume provides a mock function with given fields: ctx, challenge
+func (_m *MockLoginChallengeServiceI) Consume(ctx context.Context, challenge model.OneTimeTokenModel) error {
+	ret := _m.Called(ctx, challenge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Consume")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OneTimeTokenModel) error); ok {
+		r0 = rf(ctx, challenge)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLoginChallengeServiceI_Consume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Consume'
+type MockLoginChallengeServiceI_Consume_Call struct {
+	*mock.Call
+}
+
+// Consume is a helper method to define mock.On call
+//   - ctx context.Context
+//   - challenge model.OneTimeTokenModel
+func (_e *MockLoginChallengeServiceI_Expecter) Consume(ctx interface{}, challenge interface{}) *MockLoginChallengeServiceI_Consume_Call {
+	return &MockLoginChallengeServiceI_Consume_Call{Call: _e.mock.On("Consume", ctx, challenge)}
+}
+
+func (_c *MockLoginChallengeServiceI_Consume_Call) Run(run func(ctx context.Context, challenge model.OneTimeTokenModel)) *MockLoginChallengeServiceI_Consume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.OneTimeTokenModel))
+	})
+	return _c
+}
+
+func (_c *MockLoginChallengeServiceI_Consume_Call) Return(_a0 error) *MockLoginChallengeServiceI_Consume_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLoginChallengeServiceI_Consume_Call) RunAndReturn(run func(context.Context, model.OneTimeTokenModel) error) *MockLoginChallengeServiceI_Consume_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Issue provides a mock function with given fields: ctx, userID
+func (_m *MockLoginChallengeServiceI) Issue(ctx context.Context, userID uint64) (string, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Issue")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (string, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) string); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLoginChallengeServiceI_Issue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Issue'
+type MockLoginChallengeServiceI_Issue_Call struct {
+	*mock.Call
+}
+
+// Issue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockLoginChallengeServiceI_Expecter) Issue(ctx interface{}, userID interface{}) *MockLoginChallengeServiceI_Issue_Call {
+	return &MockLoginChallengeServiceI_Issue_Call{Call: _e.mock.On("Issue", ctx, userID)}
+}
+
+func (_c *MockLoginChallengeServiceI_Issue_Call) Run(run func(ctx context.Context, userID uint64)) *MockLoginChallengeServiceI_Issue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockLoginChallengeServiceI_Issue_Call) Return(_a0 string, _a1 error) *MockLoginChallengeServiceI_Issue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLoginChallengeServiceI_Issue_Call) RunAndReturn(run func(context.Context, uint64) (string, error)) *MockLoginChallengeServiceI_Issue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Verify provides a mock function with given fields: ctx, userID, challenge
+func (_m *MockLoginChallengeServiceI) Verify(ctx context.Context, userID uint64, challenge string) (model.OneTimeTokenModel, error) {
+	ret := _m.Called(ctx, userID, challenge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Verify")
+	}
+
+	var r0 model.OneTimeTokenModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) (model.OneTimeTokenModel, error)); ok {
+		return rf(ctx, userID, challenge)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) model.OneTimeTokenModel); ok {
+		r0 = rf(ctx, userID, challenge)
+	} else {
+		r0 = ret.Get(0).(model.OneTimeTokenModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string) error); ok {
+		r1 = rf(ctx, userID, challenge)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLoginChallengeServiceI_Verify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Verify'
+type MockLoginChallengeServiceI_Verify_Call struct {
+	*mock.Call
+}
+
+// Verify is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - challenge string
+func (_e *MockLoginChallengeServiceI_Expecter) Verify(ctx interface{}, userID interface{}, challenge interface{}) *MockLoginChallengeServiceI_Verify_Call {
+	return &MockLoginChallengeServiceI_Verify_Call{Call: _e.mock.On("Verify", ctx, userID, challenge)}
+}
+
+func (_c *MockLoginChallengeServiceI_Verify_Call) Run(run func(ctx context.Context, userID uint64, challenge string)) *MockLoginChallengeServiceI_Verify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockLoginChallengeServiceI_Verify_Call) Return(_a0 model.OneTimeTokenModel, _a1 error) *MockLoginChallengeServiceI_Verify_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLoginChallengeServiceI_Verify_Call) RunAndReturn(run func(context.Context, uint64, string) (model.OneTimeTokenModel, error)) *MockLoginChallengeServiceI_Verify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLoginChallengeServiceI creates a new instance of MockLoginChallengeServiceI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLoginChallengeServiceI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLoginChallengeServiceI {
+	mock := &MockLoginChallengeServiceI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,153 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	mock "github.com/stretchr/testify/mock"
+
+	service "github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+)
+
+// MockOneTimeLinkServiceI is an autogenerated mock type for the OneTimeLinkServiceI type
+type MockOneTimeLinkServiceI struct {
+	mock.Mock
+}
+
+type MockOneTimeLinkServiceI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOneTimeLinkServiceI) EXPECT() *MockOneTimeLinkServiceI_Expecter {
+	return &MockOneTimeLinkServiceI_Expecter{mock: &_m.Mock}
+}
+
+// Send provides a mock function with given fields: ctx, user, link
+func (_m *MockOneTimeLinkServiceI) Send(ctx context.Context, user model.UserModel, link service.OneTimeLink) error {
+	ret := _m.Called(ctx, user, link)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.UserModel, service.OneTimeLink) error); ok {
+		r0 = rf(ctx, user, link)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockOneTimeLinkServiceI_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type MockOneTimeLinkServiceI_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user model.UserModel
+//   - link service.OneTimeLink
+func (_e *MockOneTimeLinkServiceI_Expecter) Send(ctx interface{}, user interface{}, link interface{}) *MockOneTimeLinkServiceI_Send_Call {
+	return &MockOneTimeLinkServiceI_Send_Call{Call: _e.mock.On("Send", ctx, user, link)}
+}
+
+func (_c *MockOneTimeLinkServiceI_Send_Call) Run(run func(ctx context.Context, user model.UserModel, link service.OneTimeLink)) *MockOneTimeLinkServiceI_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.UserModel), args[2].(service.OneTimeLink))
+	})
+	return _c
+}
+
+func (_c *MockOneTimeLinkServiceI_Send_Call) Return(_a0 error) *MockOneTimeLinkServiceI_Send_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockOneTimeLinkServiceI_Send_Call) RunAndReturn(run func(context.Context, model.UserModel, service.OneTimeLink) error) *MockOneTimeLinkServiceI_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Verify provides a mock function with given fields: ctx, link, userID, token
+func (_m *MockOneTimeLinkServiceI) Verify(ctx context.Context, link service.OneTimeLink, userID uint64, token string) (model.UserModel, model.OneTimeTokenModel, error) {
+	ret := _m.Called(ctx, link, userID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Verify")
+	}
+
+	var r0 model.UserModel
+	var r1 model.OneTimeTokenModel
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, service.OneTimeLink, uint64, string) (model.UserModel, model.OneTimeTokenModel, error)); ok {
+		return rf(ctx, link, userID, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, service.OneTimeLink, uint64, string) model.UserModel); ok {
+		r0 = rf(ctx, link, userID, token)
+	} else {
+		r0 = ret.Get(0).(model.UserModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, service.OneTimeLink, uint64, string) model.OneTimeTokenModel); ok {
+		r1 = rf(ctx, link, userID, token)
+	} else {
+		r1 = ret.Get(1).(model.OneTimeTokenModel)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, service.OneTimeLink, uint64, string) error); ok {
+		r2 = rf(ctx, link, userID, token)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockOneTimeLinkServiceI_Verify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Verify'
+type MockOneTimeLinkServiceI_Verify_Call struct {
+	*mock.Call
+}
+
+// Verify is a helper method to define mock.On call
+//   - ctx context.Context
+//   - link service.OneTimeLink
+//   - userID uint64
+//   - token string
+func (_e *MockOneTimeLinkServiceI_Expecter) Verify(ctx interface{}, link interface{}, userID interface{}, token interface{}) *MockOneTimeLinkServiceI_Verify_Call {
+	return &MockOneTimeLinkServiceI_Verify_Call{Call: _e.mock.On("Verify", ctx, link, userID, token)}
+}
+
+func (_c *MockOneTimeLinkServiceI_Verify_Call) Run(run func(ctx context.Context, link service.OneTimeLink, userID uint64, token string)) *MockOneTimeLinkServiceI_Verify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(service.OneTimeLink), args[2].(uint64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockOneTimeLinkServiceI_Verify_Call) Return(_a0 model.UserModel, _a1 model.OneTimeTokenModel, _a2 error) *MockOneTimeLinkServiceI_Verify_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockOneTimeLinkServiceI_Verify_Call) RunAndReturn(run func(context.Context, service.OneTimeLink, uint64, string) (model.UserModel, model.OneTimeTokenModel, error)) *MockOneTimeLinkServiceI_Verify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockOneTimeLinkServiceI creates a new instance of MockOneTimeLinkServiceI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOneTimeLinkServiceI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOneTimeLinkServiceI {
+	mock := &MockOneTimeLinkServiceI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockOrganizationAuthorizationServiceI is an autogenerated mock type for the OrganizationAuthorizationServiceI type
+type MockOrganizationAuthorizationServiceI struct {
+	mock.Mock
+}
+
+type MockOrganizationAuthorizationServiceI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOrganizationAuthorizationServiceI) EXPECT() *MockOrganizationAuthorizationServiceI_Expecter {
+	return &MockOrganizationAuthorizationServiceI_Expecter{mock: &_m.Mock}
+}
+
+// Authorize provides a mock function with given fields: ctx, userID, organizationID, requiredRole
+func (_m *MockOrganizationAuthorizationServiceI) Authorize(ctx context.Context, userID uint64, organizationID uint64, requiredRole string) (model.OrganizationMemberModel, error) {
+	ret := _m.Called(ctx, userID, organizationID, requiredRole)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authorize")
+	}
+
+	var r0 model.OrganizationMemberModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, string) (model.OrganizationMemberModel, error)); ok {
+		return rf(ctx, userID, organizationID, requiredRole)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, string) model.OrganizationMemberModel); ok {
+		r0 = rf(ctx, userID, organizationID, requiredRole)
+	} else {
+		r0 = ret.Get(0).(model.OrganizationMemberModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, string) error); ok {
+		r1 = rf(ctx, userID, organizationID, requiredRole)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOrganizationAuthorizationServiceI_Authorize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authorize'
+type MockOrganizationAuthorizationServiceI_Authorize_Call struct {
+	*mock.Call
+}
+
+// Authorize is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - organizationID uint64
+//   - requiredRole string
+func (_e *MockOrganizationAuthorizationServiceI_Expecter) Authorize(ctx interface{}, userID interface{}, organizationID interface{}, requiredRole interface{}) *MockOrganizationAuthorizationServiceI_Authorize_Call {
+	return &MockOrganizationAuthorizationServiceI_Authorize_Call{Call: _e.mock.On("Authorize", ctx, userID, organizationID, requiredRole)}
+}
+
+func (_c *MockOrganizationAuthorizationServiceI_Authorize_Call) Run(run func(ctx context.Context, userID uint64, organizationID uint64, requiredRole string)) *MockOrganizationAuthorizationServiceI_Authorize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockOrganizationAuthorizationServiceI_Authorize_Call) Return(_a0 model.OrganizationMemberModel, _a1 error) *MockOrganizationAuthorizationServiceI_Authorize_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOrganizationAuthorizationServiceI_Authorize_Call) RunAndReturn(run func(context.Context, uint64, uint64, string) (model.OrganizationMemberModel, error)) *MockOrganizationAuthorizationServiceI_Authorize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockOrganizationAuthorizationServiceI creates a new instance of MockOrganizationAuthorizationServiceI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOrganizationAuthorizationServiceI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOrganizationAuthorizationServiceI {
+	mock := &MockOrganizationAuthorizationServiceI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
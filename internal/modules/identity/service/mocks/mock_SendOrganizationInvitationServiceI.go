@@ -0,0 +1,84 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	service "github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockSendOrganizationInvitationServiceI is an autogenerated mock type for the SendOrganizationInvitationServiceI type
+type MockSendOrganizationInvitationServiceI struct {
+	mock.Mock
+}
+
+type MockSendOrganizationInvitationServiceI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSendOrganizationInvitationServiceI) EXPECT() *MockSendOrganizationInvitationServiceI_Expecter {
+	return &MockSendOrganizationInvitationServiceI_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockSendOrganizationInvitationServiceI) Execute(ctx context.Context, input service.SendOrganizationInvitationInput) error {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, service.SendOrganizationInvitationInput) error); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSendOrganizationInvitationServiceI_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockSendOrganizationInvitationServiceI_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input service.SendOrganizationInvitationInput
+func (_e *MockSendOrganizationInvitationServiceI_Expecter) Execute(ctx interface{}, input interface{}) *MockSendOrganizationInvitationServiceI_Execute_Call {
+	return &MockSendOrganizationInvitationServiceI_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockSendOrganizationInvitationServiceI_Execute_Call) Run(run func(ctx context.Context, input service.SendOrganizationInvitationInput)) *MockSendOrganizationInvitationServiceI_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(service.SendOrganizationInvitationInput))
+	})
+	return _c
+}
+
+func (_c *MockSendOrganizationInvitationServiceI_Execute_Call) Return(_a0 error) *MockSendOrganizationInvitationServiceI_Execute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSendOrganizationInvitationServiceI_Execute_Call) RunAndReturn(run func(context.Context, service.SendOrganizationInvitationInput) error) *MockSendOrganizationInvitationServiceI_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSendOrganizationInvitationServiceI creates a new instance of MockSendOrganizationInvitationServiceI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSendOrganizationInvitationServiceI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSendOrganizationInvitationServiceI {
+	mock := &MockSendOrganizationInvitationServiceI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
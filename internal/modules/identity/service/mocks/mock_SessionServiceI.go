@@ -0,0 +1,306 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	mock "github.com/stretchr/testify/mock"
+
+	service "github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+)
+
+// MockSessionServiceI is an autogenerated mock type for the SessionServiceI type
+type MockSessionServiceI struct {
+	mock.Mock
+}
+
+type MockSessionServiceI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSessionServiceI) EXPECT() *MockSessionServiceI_Expecter {
+	return &MockSessionServiceI_Expecter{mock: &_m.Mock}
+}
+
+// IsActive provides a mock function with given fields: ctx, userID, sessionID
+func (_m *MockSessionServiceI) IsActive(ctx context.Context, userID uint64, sessionID uint64) (bool, error) {
+	ret := _m.Called(ctx, userID, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsActive")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) (bool, error)); ok {
+		return rf(ctx, userID, sessionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) bool); ok {
+		r0 = rf(ctx, userID, sessionID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, userID, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSessionServiceI_IsActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsActive'
+type MockSessionServiceI_IsActive_Call struct {
+	*mock.Call
+}
+
+// IsActive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - sessionID uint64
+func (_e *MockSessionServiceI_Expecter) IsActive(ctx interface{}, userID interface{}, sessionID interface{}) *MockSessionServiceI_IsActive_Call {
+	return &MockSessionServiceI_IsActive_Call{Call: _e.mock.On("IsActive", ctx, userID, sessionID)}
+}
+
+func (_c *MockSessionServiceI_IsActive_Call) Run(run func(ctx context.Context, userID uint64, sessionID uint64)) *MockSessionServiceI_IsActive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockSessionServiceI_IsActive_Call) Return(_a0 bool, _a1 error) *MockSessionServiceI_IsActive_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSessionServiceI_IsActive_Call) RunAndReturn(run func(context.Context, uint64, uint64) (bool, error)) *MockSessionServiceI_IsActive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refresh provides a mock function with given fields: ctx, refreshToken
+func (_m *MockSessionServiceI) Refresh(ctx context.Context, refreshToken string) (service.SessionTokens, error) {
+	ret := _m.Called(ctx, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 service.SessionTokens
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (service.SessionTokens, error)); ok {
+		return rf(ctx, refreshToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) service.SessionTokens); ok {
+		r0 = rf(ctx, refreshToken)
+	} else {
+		r0 = ret.Get(0).(service.SessionTokens)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, refreshToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSessionServiceI_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type MockSessionServiceI_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refreshToken string
+func (_e *MockSessionServiceI_Expecter) Refresh(ctx interface{}, refreshToken interface{}) *MockSessionServiceI_Refresh_Call {
+	return &MockSessionServiceI_Refresh_Call{Call: _e.mock.On("Refresh", ctx, refreshToken)}
+}
+
+func (_c *MockSessionServiceI_Refresh_Call) Run(run func(ctx context.Context, refreshToken string)) *MockSessionServiceI_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockSessionServiceI_Refresh_Call) Return(_a0 service.SessionTokens, _a1 error) *MockSessionServiceI_Refresh_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSessionServiceI_Refresh_Call) RunAndReturn(run func(context.Context, string) (service.SessionTokens, error)) *MockSessionServiceI_Refresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function with given fields: ctx, userID, sessionID
+func (_m *MockSessionServiceI) Revoke(ctx context.Context, userID uint64, sessionID uint64) error {
+	ret := _m.Called(ctx, userID, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) error); ok {
+		r0 = rf(ctx, userID, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSessionServiceI_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type MockSessionServiceI_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - sessionID uint64
+func (_e *MockSessionServiceI_Expecter) Revoke(ctx interface{}, userID interface{}, sessionID interface{}) *MockSessionServiceI_Revoke_Call {
+	return &MockSessionServiceI_Revoke_Call{Call: _e.mock.On("Revoke", ctx, userID, sessionID)}
+}
+
+func (_c *MockSessionServiceI_Revoke_Call) Run(run func(ctx context.Context, userID uint64, sessionID uint64)) *MockSessionServiceI_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockSessionServiceI_Revoke_Call) Return(_a0 error) *MockSessionServiceI_Revoke_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSessionServiceI_Revoke_Call) RunAndReturn(run func(context.Context, uint64, uint64) error) *MockSessionServiceI_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAll provides a mock function with given fields: ctx, userID
+func (_m *MockSessionServiceI) RevokeAll(ctx context.Context, userID uint64) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSessionServiceI_RevokeAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAll'
+type MockSessionServiceI_RevokeAll_Call struct {
+	*mock.Call
+}
+
+// RevokeAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockSessionServiceI_Expecter) RevokeAll(ctx interface{}, userID interface{}) *MockSessionServiceI_RevokeAll_Call {
+	return &MockSessionServiceI_RevokeAll_Call{Call: _e.mock.On("RevokeAll", ctx, userID)}
+}
+
+func (_c *MockSessionServiceI_RevokeAll_Call) Run(run func(ctx context.Context, userID uint64)) *MockSessionServiceI_RevokeAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockSessionServiceI_RevokeAll_Call) Return(_a0 error) *MockSessionServiceI_RevokeAll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSessionServiceI_RevokeAll_Call) RunAndReturn(run func(context.Context, uint64) error) *MockSessionServiceI_RevokeAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with given fields: ctx, user
+func (_m *MockSessionServiceI) Start(ctx context.Context, user model.UserModel) (service.SessionTokens, error) {
+	ret := _m.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 service.SessionTokens
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.UserModel) (service.SessionTokens, error)); ok {
+		return rf(ctx, user)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.UserModel) service.SessionTokens); ok {
+		r0 = rf(ctx, user)
+	} else {
+		r0 = ret.Get(0).(service.SessionTokens)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.UserModel) error); ok {
+		r1 = rf(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSessionServiceI_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type MockSessionServiceI_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user model.UserModel
+func (_e *MockSessionServiceI_Expecter) Start(ctx interface{}, user interface{}) *MockSessionServiceI_Start_Call {
+	return &MockSessionServiceI_Start_Call{Call: _e.mock.On("Start", ctx, user)}
+}
+
+func (_c *MockSessionServiceI_Start_Call) Run(run func(ctx context.Context, user model.UserModel)) *MockSessionServiceI_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.UserModel))
+	})
+	return _c
+}
+
+func (_c *MockSessionServiceI_Start_Call) Return(_a0 service.SessionTokens, _a1 error) *MockSessionServiceI_Start_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSessionServiceI_Start_Call) RunAndReturn(run func(context.Context, model.UserModel) (service.SessionTokens, error)) *MockSessionServiceI_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSessionServiceI creates a new instance of MockSessionServiceI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSessionServiceI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSessionServiceI {
+	mock := &MockSessionServiceI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
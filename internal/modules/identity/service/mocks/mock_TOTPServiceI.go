@@ -0,0 +1,465 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockTOTPServiceI is an autogenerated mock type for the TOTPServiceI type
+type MockTOTPServiceI struct {
+	mock.Mock
+}
+
+type MockTOTPServiceI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTOTPServiceI) EXPECT() *MockTOTPServiceI_Expecter {
+	return &MockTOTPServiceI_Expecter{mock: &_m.Mock}
+}
+
+// DecryptSecret provides a mock function with given fields: secretEncrypted
+func (_m *MockTOTPServiceI) DecryptSecret(secretEncrypted []byte) ([]byte, error) {
+	ret := _m.Called(secretEncrypted)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DecryptSecret")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]byte) ([]byte, error)); ok {
+		return rf(secretEncrypted)
+	}
+	if rf, ok := ret.Get(0).(func([]byte) []byte); ok {
+		r0 = rf(secretEncrypted)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(secretEncrypted)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTOTPServiceI_DecryptSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DecryptSecret'
+type MockTOTPServiceI_DecryptSecret_Call struct {
+	*mock.Call
+}
+
+// DecryptSecret is a helper method to define mock.On call
+//   - secretEncrypted []byte
+func (_e *MockTOTPServiceI_Expecter) DecryptSecret(secretEncrypted interface{}) *MockTOTPServiceI_DecryptSecret_Call {
+	return &MockTOTPServiceI_DecryptSecret_Call{Call: _e.mock.On("DecryptSecret", secretEncrypted)}
+}
+
+func (_c *MockTOTPServiceI_DecryptSecret_Call) Run(run func(secretEncrypted []byte)) *MockTOTPServiceI_DecryptSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte))
+	})
+	return _c
+}
+
+func (_c *MockTOTPServiceI_DecryptSecret_Call) Return(_a0 []byte, _a1 error) *MockTOTPServiceI_DecryptSecret_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTOTPServiceI_DecryptSecret_Call) RunAndReturn(run func([]byte) ([]byte, error)) *MockTOTPServiceI_DecryptSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EncodeSecret provides a mock function with given fields: secret
+func (_m *MockTOTPServiceI) EncodeSecret(secret []byte) string {
+	ret := _m.Called(secret)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EncodeSecret")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func([]byte) string); ok {
+		r0 = rf(secret)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockTOTPServiceI_EncodeSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EncodeSecret'
+type MockTOTPServiceI_EncodeSecret_Call struct {
+	*mock.Call
+}
+
+// EncodeSecret is a helper method to define mock.On call
+//   - secret []byte
+func (_e *MockTOTPServiceI_Expecter) EncodeSecret(secret interface{}) *MockTOTPServiceI_EncodeSecret_Call {
+	return &MockTOTPServiceI_EncodeSecret_Call{Call: _e.mock.On("EncodeSecret", secret)}
+}
+
+func (_c *MockTOTPServiceI_EncodeSecret_Call) Run(run func(secret []byte)) *MockTOTPServiceI_EncodeSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte))
+	})
+	return _c
+}
+
+func (_c *MockTOTPServiceI_EncodeSecret_Call) Return(_a0 string) *MockTOTPServiceI_EncodeSecret_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTOTPServiceI_EncodeSecret_Call) RunAndReturn(run func([]byte) string) *MockTOTPServiceI_EncodeSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EncryptSecret provides a mock function with given fields: secret
+func (_m *MockTOTPServiceI) EncryptSecret(secret []byte) ([]byte, error) {
+	ret := _m.Called(secret)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EncryptSecret")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]byte) ([]byte, error)); ok {
+		return rf(secret)
+	}
+	if rf, ok := ret.Get(0).(func([]byte) []byte); ok {
+		r0 = rf(secret)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(secret)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTOTPServiceI_EncryptSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EncryptSecret'
+type MockTOTPServiceI_EncryptSecret_Call struct {
+	*mock.Call
+}
+
+// EncryptSecret is a helper method to define mock.On call
+//   - secret []byte
+func (_e *MockTOTPServiceI_Expecter) EncryptSecret(secret interface{}) *MockTOTPServiceI_EncryptSecret_Call {
+	return &MockTOTPServiceI_EncryptSecret_Call{Call: _e.mock.On("EncryptSecret", secret)}
+}
+
+func (_c *MockTOTPServiceI_EncryptSecret_Call) Run(run func(secret []byte)) *MockTOTPServiceI_EncryptSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte))
+	})
+	return _c
+}
+
+func (_c *MockTOTPServiceI_EncryptSecret_Call) Return(_a0 []byte, _a1 error) *MockTOTPServiceI_EncryptSecret_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTOTPServiceI_EncryptSecret_Call) RunAndReturn(run func([]byte) ([]byte, error)) *MockTOTPServiceI_EncryptSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateRecoveryCodes provides a mock function with no fields
+func (_m *MockTOTPServiceI) GenerateRecoveryCodes() ([]string, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateRecoveryCodes")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTOTPServiceI_GenerateRecoveryCodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateRecoveryCodes'
+type MockTOTPServiceI_GenerateRecoveryCodes_Call struct {
+	*mock.Call
+}
+
+// GenerateRecoveryCodes is a helper method to define mock.On call
+func (_e *MockTOTPServiceI_Expecter) GenerateRecoveryCodes() *MockTOTPServiceI_GenerateRecoveryCodes_Call {
+	return &MockTOTPServiceI_GenerateRecoveryCodes_Call{Call: _e.mock.On("GenerateRecoveryCodes")}
+}
+
+func (_c *MockTOTPServiceI_GenerateRecoveryCodes_Call) Run(run func()) *MockTOTPServiceI_GenerateRecoveryCodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockTOTPServiceI_GenerateRecoveryCodes_Call) Return(_a0 []string, _a1 error) *MockTOTPServiceI_GenerateRecoveryCodes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTOTPServiceI_GenerateRecoveryCodes_Call) RunAndReturn(run func() ([]string, error)) *MockTOTPServiceI_GenerateRecoveryCodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateSecret provides a mock function with no fields
+func (_m *MockTOTPServiceI) GenerateSecret() ([]byte, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateSecret")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]byte, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []byte); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTOTPServiceI_GenerateSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateSecret'
+type MockTOTPServiceI_GenerateSecret_Call struct {
+	*mock.Call
+}
+
+// GenerateSecret is a helper method to define mock.On call
+func (_e *MockTOTPServiceI_Expecter) GenerateSecret() *MockTOTPServiceI_GenerateSecret_Call {
+	return &MockTOTPServiceI_GenerateSecret_Call{Call: _e.mock.On("GenerateSecret")}
+}
+
+func (_c *MockTOTPServiceI_GenerateSecret_Call) Run(run func()) *MockTOTPServiceI_GenerateSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockTOTPServiceI_GenerateSecret_Call) Return(_a0 []byte, _a1 error) *MockTOTPServiceI_GenerateSecret_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTOTPServiceI_GenerateSecret_Call) RunAndReturn(run func() ([]byte, error)) *MockTOTPServiceI_GenerateSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HashRecoveryCode provides a mock function with given fields: code
+func (_m *MockTOTPServiceI) HashRecoveryCode(code string) []byte {
+	ret := _m.Called(code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HashRecoveryCode")
+	}
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(string) []byte); ok {
+		r0 = rf(code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	return r0
+}
+
+// MockTOTPServiceI_HashRecoveryCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HashRecoveryCode'
+type MockTOTPServiceI_HashRecoveryCode_Call struct {
+	*mock.Call
+}
+
+// HashRecoveryCode is a helper method to define mock.On call
+//   - code string
+func (_e *MockTOTPServiceI_Expecter) HashRecoveryCode(code interface{}) *MockTOTPServiceI_HashRecoveryCode_Call {
+	return &MockTOTPServiceI_HashRecoveryCode_Call{Call: _e.mock.On("HashRecoveryCode", code)}
+}
+
+func (_c *MockTOTPServiceI_HashRecoveryCode_Call) Run(run func(code string)) *MockTOTPServiceI_HashRecoveryCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockTOTPServiceI_HashRecoveryCode_Call) Return(_a0 []byte) *MockTOTPServiceI_HashRecoveryCode_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTOTPServiceI_HashRecoveryCode_Call) RunAndReturn(run func(string) []byte) *MockTOTPServiceI_HashRecoveryCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProvisioningURI provides a mock function with given fields: secret, accountName
+func (_m *MockTOTPServiceI) ProvisioningURI(secret []byte, accountName string) string {
+	ret := _m.Called(secret, accountName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProvisioningURI")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func([]byte, string) string); ok {
+		r0 = rf(secret, accountName)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockTOTPServiceI_ProvisioningURI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProvisioningURI'
+type MockTOTPServiceI_ProvisioningURI_Call struct {
+	*mock.Call
+}
+
+// ProvisioningURI is a helper method to define mock.On call
+//   - secret []byte
+//   - accountName string
+func (_e *MockTOTPServiceI_Expecter) ProvisioningURI(secret interface{}, accountName interface{}) *MockTOTPServiceI_ProvisioningURI_Call {
+	return &MockTOTPServiceI_ProvisioningURI_Call{Call: _e.mock.On("ProvisioningURI", secret, accountName)}
+}
+
+func (_c *MockTOTPServiceI_ProvisioningURI_Call) Run(run func(secret []byte, accountName string)) *MockTOTPServiceI_ProvisioningURI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTOTPServiceI_ProvisioningURI_Call) Return(_a0 string) *MockTOTPServiceI_ProvisioningURI_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTOTPServiceI_ProvisioningURI_Call) RunAndReturn(run func([]byte, string) string) *MockTOTPServiceI_ProvisioningURI_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Validate provides a mock function with given fields: secret, code, at
+func (_m *MockTOTPServiceI) Validate(secret []byte, code string, at time.Time) (int64, bool) {
+	ret := _m.Called(secret, code, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Validate")
+	}
+
+	var r0 int64
+	var r1 bool
+	if rf, ok := ret.Get(0).(func([]byte, string, time.Time) (int64, bool)); ok {
+		return rf(secret, code, at)
+	}
+	if rf, ok := ret.Get(0).(func([]byte, string, time.Time) int64); ok {
+		r0 = rf(secret, code, at)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte, string, time.Time) bool); ok {
+		r1 = rf(secret, code, at)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// MockTOTPServiceI_Validate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Validate'
+type MockTOTPServiceI_Validate_Call struct {
+	*mock.Call
+}
+
+// Validate is a helper method to define mock.On call
+//   - secret []byte
+//   - code string
+//   - at time.Time
+func (_e *MockTOTPServiceI_Expecter) Validate(secret interface{}, code interface{}, at interface{}) *MockTOTPServiceI_Validate_Call {
+	return &MockTOTPServiceI_Validate_Call{Call: _e.mock.On("Validate", secret, code, at)}
+}
+
+func (_c *MockTOTPServiceI_Validate_Call) Run(run func(secret []byte, code string, at time.Time)) *MockTOTPServiceI_Validate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockTOTPServiceI_Validate_Call) Return(_a0 int64, _a1 bool) *MockTOTPServiceI_Validate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTOTPServiceI_Validate_Call) RunAndReturn(run func([]byte, string, time.Time) (int64, bool)) *MockTOTPServiceI_Validate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTOTPServiceI creates a new instance of MockTOTPServiceI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTOTPServiceI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTOTPServiceI {
+	mock := &MockTOTPServiceI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,85 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockTOTPVerificationServiceI is an autogenerated mock type for the TOTPVerificationServiceI type
+type MockTOTPVerificationServiceI struct {
+	mock.Mock
+}
+
+type MockTOTPVerificationServiceI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTOTPVerificationServiceI) EXPECT() *MockTOTPVerificationServiceI_Expecter {
+	return &MockTOTPVerificationServiceI_Expecter{mock: &_m.Mock}
+}
+
+// Verify provides a mock function with given fields: ctx, enrollment, code
+func (_m *MockTOTPVerificationServiceI) Verify(ctx context.Context, enrollment model.TOTPEnrollmentModel, code string) error {
+	ret := _m.Called(ctx, enrollment, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Verify")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.TOTPEnrollmentModel, string) error); ok {
+		r0 = rf(ctx, enrollment, code)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTOTPVerificationServiceI_Verify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Verify'
+type MockTOTPVerificationServiceI_Verify_Call struct {
+	*mock.Call
+}
+
+// Verify is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enrollment model.TOTPEnrollmentModel
+//   - code string
+func (_e *MockTOTPVerificationServiceI_Expecter) Verify(ctx interface{}, enrollment interface{}, code interface{}) *MockTOTPVerificationServiceI_Verify_Call {
+	return &MockTOTPVerificationServiceI_Verify_Call{Call: _e.mock.On("Verify", ctx, enrollment, code)}
+}
+
+func (_c *MockTOTPVerificationServiceI_Verify_Call) Run(run func(ctx context.Context, enrollment model.TOTPEnrollmentModel, code string)) *MockTOTPVerificationServiceI_Verify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.TOTPEnrollmentModel), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockTOTPVerificationServiceI_Verify_Call) Return(_a0 error) *MockTOTPVerificationServiceI_Verify_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTOTPVerificationServiceI_Verify_Call) RunAndReturn(run func(context.Context, model.TOTPEnrollmentModel, string) error) *MockTOTPVerificationServiceI_Verify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTOTPVerificationServiceI creates a new instance of MockTOTPVerificationServiceI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTOTPVerificationServiceI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTOTPVerificationServiceI {
+	mock := &MockTOTPVerificationServiceI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
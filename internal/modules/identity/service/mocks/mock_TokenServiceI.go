@@ -22,9 +22,9 @@ func (_m *MockTokenServiceI) EXPECT() *MockTokenServiceI_Expecter {
 	return &MockTokenServiceI_Expecter{mock: &_m.Mock}
 }
 
-// GenerateJWT provides a mock function with given fields: ctx, user
-func (_m *MockTokenServiceI) GenerateJWT(ctx context.Context, user model.UserModel) (string, error) {
-	ret := _m.Called(ctx, user)
+// GenerateJWT provides a mock function with given fields: ctx, user, sessionID
+func (_m *MockTokenServiceI) GenerateJWT(ctx context.Context, user model.UserModel, sessionID uint64) (string, error) {
+	ret := _m.Called(ctx, user, sessionID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GenerateJWT")
@@ -32,17 +32,17 @@ func (_m *MockTokenServiceI) GenerateJWT(ctx context.Context, user model.UserMod
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, model.UserModel) (string, error)); ok {
-		return rf(ctx, user)
+	if rf, ok := ret.Get(0).(func(context.Context, model.UserModel, uint64) (string, error)); ok {
+		return rf(ctx, user, sessionID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, model.UserModel) string); ok {
-		r0 = rf(ctx, user)
+	if rf, ok := ret.Get(0).(func(context.Context, model.UserModel, uint64) string); ok {
+		r0 = rf(ctx, user, sessionID)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, model.UserModel) error); ok {
-		r1 = rf(ctx, user)
+	if rf, ok := ret.Get(1).(func(context.Context, model.UserModel, uint64) error); ok {
+		r1 = rf(ctx, user, sessionID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -58,13 +58,14 @@ type MockTokenServiceI_GenerateJWT_Call struct {
 // GenerateJWT is a helper method to define mock.On call
 //   - ctx context.Context
 //   - user model.UserModel
-func (_e *MockTokenServiceI_Expecter) GenerateJWT(ctx interface{}, user interface{}) *MockTokenServiceI_GenerateJWT_Call {
-	return &MockTokenServiceI_GenerateJWT_Call{Call: _e.mock.On("GenerateJWT", ctx, user)}
+//   - sessionID uint64
+func (_e *MockTokenServiceI_Expecter) GenerateJWT(ctx interface{}, user interface{}, sessionID interface{}) *MockTokenServiceI_GenerateJWT_Call {
+	return &MockTokenServiceI_GenerateJWT_Call{Call: _e.mock.On("GenerateJWT", ctx, user, sessionID)}
 }
 
-func (_c *MockTokenServiceI_GenerateJWT_Call) Run(run func(ctx context.Context, user model.UserModel)) *MockTokenServiceI_GenerateJWT_Call {
+func (_c *MockTokenServiceI_GenerateJWT_Call) Run(run func(ctx context.Context, user model.UserModel, sessionID uint64)) *MockTokenServiceI_GenerateJWT_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(model.UserModel))
+		run(args[0].(context.Context), args[1].(model.UserModel), args[2].(uint64))
 	})
 	return _c
 }
@@ -74,7 +75,7 @@ func (_c *MockTokenServiceI_GenerateJWT_Call) Return(_a0 string, _a1 error) *Moc
 	return _c
 }
 
-func (_c *MockTokenServiceI_GenerateJWT_Call) RunAndReturn(run func(context.Context, model.UserModel) (string, error)) *MockTokenServiceI_GenerateJWT_Call {
+func (_c *MockTokenServiceI_GenerateJWT_Call) RunAndReturn(run func(context.Context, model.UserModel, uint64) (string, error)) *MockTokenServiceI_GenerateJWT_Call {
 	_c.Call.Return(run)
 	return _c
 }
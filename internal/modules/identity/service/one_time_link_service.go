@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/repository"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/mailer"
+)
+
+// OneTimeLink describes a link emailed to a user to act on their account once. Its token is stored
+// hashed as a one-time token of TokenType, a new link replaces the previous one.
+type OneTimeLink struct {
+	TokenType string
+	// Path is the page of the frontend the link opens, the user ID and the token are in its query.
+	Path       string
+	Subject    string
+	Template   string
+	Expiration time.Duration
+	// ErrInvalid is returned by Verify when the token is malformed, unknown or expired.
+	ErrInvalid error
+}
+
+var (
+	PasswordResetLink = OneTimeLink{
+		TokenType:  enum.TokenTypeResetPassword,
+		Path:       "/password/reset",
+		Subject:    "Password Reset",
+		Template:   "password_reset.gohtml",
+		Expiration: time.Hour,
+		ErrInvalid: errs.ErrInvalidPasswordResetToken,
+	}
+	MagicLink = OneTimeLink{
+		TokenType:  enum.TokenTypeMagicLink,
+		Path:       "/login/magic",
+		Subject:    "Sign In Link",
+		Template:   "magic_link.gohtml",
+		Expiration: 15 * time.Minute,
+		ErrInvalid: errs.ErrInvalidMagicLinkToken,
+	}
+)
+
+// OneTimeLinkByTokenType returns the link whose tokens are of tokenType.
+func OneTimeLinkByTokenType(tokenType string) (OneTimeLink, bool) {
+	for _, link := range []OneTimeLink{PasswordResetLink, MagicLink} {
+		if link.TokenType == tokenType {
+			return link, true
+		}
+	}
+	return OneTimeLink{}, false
+}
+
+type OneTimeLinkServiceI interface {
+	Send(ctx context.Context, user model.UserModel, link OneTimeLink) error
+	Verify(
+		ctx context.Context,
+		link OneTimeLink,
+		userID uint64,
+		token string,
+	) (model.UserModel, model.OneTimeTokenModel, error)
+}
+
+type OneTimeLinkService struct {
+	oneTimeTokenRepository repository.OneTimeTokenRepositoryI
+	userRepository         repository.UserRepositoryI
+	hashService            HashServiceI
+	emailTemplateService   EmailTemplateServiceI
+	mailerSMTP             mailer.SMTP
+	logger                 logger.Logger
+	cfg                    config.Config
+}
+
+var _ OneTimeLinkServiceI = (*OneTimeLinkService)(nil)
+
+func NewOneTimeLinkService(
+	oneTimeTokenRepository repository.OneTimeTokenRepositoryI,
+	userRepository repository.UserRepositoryI,
+	hashService HashServiceI,
+	emailTemplateService EmailTemplateServiceI,
+	mailerSMTP mailer.SMTP,
+	logger logger.Logger,
+	cfg config.Config,
+) *OneTimeLinkService {
+	return &OneTimeLinkService{
+		oneTimeTokenRepository: oneTimeTokenRepository,
+		userRepository:         userRepository,
+		hashService:            hashService,
+		emailTemplateService:   emailTemplateService,
+		mailerSMTP:             mailerSMTP,
+		logger:                 logger,
+		cfg:                    cfg,
+	}
+}
+
+// Send emails the link to the user with a new token, the links sent before stop working.
+func (s *OneTimeLinkService) Send(ctx context.Context, user model.UserModel, link OneTimeLink) error {
+	ctx, span := trace.Span(ctx, "OneTimeLinkService.Send")
+	defer span.End()
+
+	tokenType, err := enum.NewTokenTypeEnum(link.TokenType)
+	if err != nil {
+		return err
+	}
+
+	err = s.oneTimeTokenRepository.Delete(ctx, user.ID, tokenType)
+	if err != nil && !errors.Is(err, shared_errs.ErrRecordNotFound) {
+		s.logger.Error().Msgf("error deleting %s tokens for user ID %d: %v", tokenType, user.ID, err)
+		return err
+	}
+
+	token, err := s.hashService.GenerateRandomBytes()
+	if err != nil {
+		s.logger.Error().Msgf("error generating random bytes: %v", err)
+		return err
+	}
+
+	// only the hash is stored, a leaked table does not allow using the links
+	tokenHash := sha256.Sum256(token)
+	oneTimeToken := model.OneTimeTokenModel{
+		UserID:    user.ID,
+		TokenHash: tokenHash[:],
+		TokenType: tokenType.String(),
+		ExpiresAt: time.Now().UTC().Add(link.Expiration),
+		CreatedAt: time.Now().UTC(),
+	}
+	if _, err = s.oneTimeTokenRepository.Create(ctx, oneTimeToken); err != nil {
+		s.logger.Error().Msgf("error creating one-time token for user ID %d: %v", user.ID, err)
+		return err
+	}
+
+	name := fmt.Sprintf("%s %s", user.FirstName, user.LastName)
+	content, err := s.emailTemplateService.CompileOneTimeLinkTemplate(OneTimeLinkInput{
+		Template:          link.Template,
+		Title:             link.Subject,
+		Name:              name,
+		Link:              s.url(link, user.ID, token),
+		ExpirationMinutes: int(link.Expiration.Minutes()),
+	})
+	if err != nil {
+		s.logger.Error().Msgf("error compiling the %s template: %v", link.Template, err)
+		return err
+	}
+
+	md := mailer.MailData{
+		Sender:  s.cfg.MAIL.Sender,
+		ToName:  name,
+		ToEmail: user.Email,
+		Subject: link.Subject,
+		Content: content,
+	}
+	if err = s.mailerSMTP.Send(ctx, md); err != nil {
+		s.logger.Error().Msgf("error sending the %s email of user ID %d: %v", tokenType, user.ID, err)
+		return err
+	}
+
+	return nil
+}
+
+// Verify returns the active user and the one-time token the token of the link was issued for.
+// The token is left in place, the caller deletes it once the link was used.
+func (s *OneTimeLinkService) Verify(
+	ctx context.Context,
+	link OneTimeLink,
+	userID uint64,
+	token string,
+) (model.UserModel, model.OneTimeTokenModel, error) {
+	ctx, span := trace.Span(ctx, "OneTimeLinkService.Verify")
+	defer span.End()
+
+	tokenType, err := enum.NewTokenTypeEnum(link.TokenType)
+	if err != nil {
+		return model.UserModel{}, model.OneTimeTokenModel{}, err
+	}
+
+	rawToken, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return model.UserModel{}, model.OneTimeTokenModel{}, link.ErrInvalid
+	}
+
+	user, err := s.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, shared_errs.ErrRecordNotFound) {
+			return model.UserModel{}, model.OneTimeTokenModel{}, link.ErrInvalid
+		}
+		s.logger.Error().Msgf("error finding user by ID %d: %v", userID, err)
+		return model.UserModel{}, model.OneTimeTokenModel{}, err
+	}
+
+	// the user may have been blocked since the link was sent
+	if user.Status != enum.UserStatusActive {
+		return model.UserModel{}, model.OneTimeTokenModel{}, link.ErrInvalid
+	}
+
+	// expired tokens are not found
+	oneTimeToken, err := s.oneTimeTokenRepository.Find(ctx, user.ID, tokenType)
+	if err != nil {
+		if errors.Is(err, shared_errs.ErrRecordNotFound) {
+			return model.UserModel{}, model.OneTimeTokenModel{}, link.ErrInvalid
+		}
+		s.logger.Error().Msgf("error finding %s token for user ID %d: %v", tokenType, user.ID, err)
+		return model.UserModel{}, model.OneTimeTokenModel{}, err
+	}
+
+	tokenHash := sha256.Sum256(rawToken)
+	if subtle.ConstantTimeCompare(oneTimeToken.TokenHash, tokenHash[:]) != 1 {
+		return model.UserModel{}, model.OneTimeTokenModel{}, link.ErrInvalid
+	}
+
+	return user, oneTimeToken, nil
+}
+
+func (s *OneTimeLinkService) url(link OneTimeLink, userID uint64, token []byte) string {
+	return fmt.Sprintf(
+		"%s%s?id=%d&token=%s",
+		s.cfg.App.BaseURL,
+		link.Path,
+		userID,
+		url.QueryEscape(base64.StdEncoding.EncodeToString(token)),
+	)
+}
@@ -0,0 +1,224 @@
+package service_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/repository/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+	service_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/service/mocks"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/mailer"
+	mailer_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/mailer/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type OneTimeLinkServiceTestSuite struct {
+	suite.Suite
+	sut                    *service.OneTimeLinkService
+	oneTimeTokenRepository *repository_mocks.MockOneTimeTokenRepositoryI
+	userRepository         *repository_mocks.MockUserRepositoryI
+	hashService            *service_mocks.MockHashServiceI
+	emailTemplateService   *service_mocks.MockEmailTemplateServiceI
+	mailerSMTP             *mailer_mocks.MockSMTP
+	user                   model.UserModel
+}
+
+func (s *OneTimeLinkServiceTestSuite) SetupTest() {
+	s.oneTimeTokenRepository = repository_mocks.NewMockOneTimeTokenRepositoryI(s.T())
+	s.userRepository = repository_mocks.NewMockUserRepositoryI(s.T())
+	s.hashService = service_mocks.NewMockHashServiceI(s.T())
+	s.emailTemplateService = service_mocks.NewMockEmailTemplateServiceI(s.T())
+	s.mailerSMTP = mailer_mocks.NewMockSMTP(s.T())
+
+	cfg := config.Config{
+		MAIL: config.MAIL{Sender: "test@example.com"},
+		App:  config.App{BaseURL: "https://example.com"},
+		Log:  config.Log{LogLevel: "disabled"},
+	}
+	s.user = model.UserModel{
+		ID:        7,
+		FirstName: "Jane",
+		LastName:  "Smith",
+		Email:     "jane@example.com",
+		Status:    enum.UserStatusActive,
+	}
+
+	s.sut = service.NewOneTimeLinkService(
+		s.oneTimeTokenRepository,
+		s.userRepository,
+		s.hashService,
+		s.emailTemplateService,
+		s.mailerSMTP,
+		logger.New(cfg),
+		cfg,
+	)
+}
+
+func TestOneTimeLinkServiceSuite(t *testing.T) {
+	suite.Run(t, new(OneTimeLinkServiceTestSuite))
+}
+
+func (s *OneTimeLinkServiceTestSuite) storedToken(tokenType string, token []byte) model.OneTimeTokenModel {
+	tokenHash := sha256.Sum256(token)
+	return model.OneTimeTokenModel{ID: 3, UserID: s.user.ID, TokenHash: tokenHash[:], TokenType: tokenType}
+}
+
+func (s *OneTimeLinkServiceTestSuite) TestSend_EachLink_StoresHashedTokenAndEmailsItsLink() {
+	// Arrange
+	token := []byte{0xfb, 0xff, 0x01}
+	tokenHash := sha256.Sum256(token)
+	cases := []struct {
+		link              service.OneTimeLink
+		expectedLink      string
+		expirationMinutes int
+	}{
+		{
+			link:              service.PasswordResetLink,
+			expectedLink:      "https://example.com/password/reset?id=7&token=%2B%2F8B",
+			expirationMinutes: 60,
+		},
+		{
+			link:              service.MagicLink,
+			expectedLink:      "https://example.com/login/magic?id=7&token=%2B%2F8B",
+			expirationMinutes: 15,
+		},
+	}
+
+	s.hashService.On("GenerateRandomBytes").Return(token, nil)
+
+	for _, tc := range cases {
+		tokenType, err := enum.NewTokenTypeEnum(tc.link.TokenType)
+		s.Require().NoError(err)
+		s.oneTimeTokenRepository.On("Delete", mock.Anything, s.user.ID, tokenType).
+			Return(shared_errs.ErrRecordNotFound).Once()
+		s.oneTimeTokenRepository.On("Create", mock.Anything, mock.MatchedBy(func(t model.OneTimeTokenModel) bool {
+			return t.UserID == s.user.ID &&
+				t.TokenType == tc.link.TokenType &&
+				string(t.TokenHash) == string(tokenHash[:])
+		})).Return(model.OneTimeTokenModel{ID: 1}, nil).Once()
+		s.emailTemplateService.On("CompileOneTimeLinkTemplate", service.OneTimeLinkInput{
+			Template:          tc.link.Template,
+			Title:             tc.link.Subject,
+			Name:              "Jane Smith",
+			Link:              tc.expectedLink,
+			ExpirationMinutes: tc.expirationMinutes,
+		}).Return("<html>content</html>", nil).Once()
+		s.mailerSMTP.On("Send", mock.Anything, mailer.MailData{
+			Sender:  "test@example.com",
+			ToName:  "Jane Smith",
+			ToEmail: "jane@example.com",
+			Subject: tc.link.Subject,
+			Content: "<html>content</html>",
+		}).Return(nil).Once()
+
+		// Act
+		err = s.sut.Send(context.Background(), s.user, tc.link)
+
+		// Assert
+		s.Require().NoError(err, tc.link.TokenType)
+	}
+}
+
+func (s *OneTimeLinkServiceTestSuite) TestSend_MailerError_ReturnsError() {
+	// Arrange
+	sendErr := errors.New("smtp error")
+
+	s.oneTimeTokenRepository.On("Delete", mock.Anything, s.user.ID, mock.Anything).Return(nil)
+	s.hashService.On("GenerateRandomBytes").Return([]byte("random-token"), nil)
+	s.oneTimeTokenRepository.On("Create", mock.Anything, mock.Anything).Return(model.OneTimeTokenModel{ID: 1}, nil)
+	s.emailTemplateService.On("CompileOneTimeLinkTemplate", mock.Anything).Return("<html>content</html>", nil)
+	s.mailerSMTP.On("Send", mock.Anything, mock.Anything).Return(sendErr)
+
+	// Act
+	err := s.sut.Send(context.Background(), s.user, service.MagicLink)
+
+	// Assert
+	s.Require().ErrorIs(err, sendErr)
+}
+
+func (s *OneTimeLinkServiceTestSuite) TestVerify_ValidToken_ReturnsUserAndToken() {
+	// Arrange
+	token := []byte("random-token")
+	magicLinkType, _ := enum.NewTokenTypeEnum(enum.TokenTypeMagicLink)
+	stored := s.storedToken(enum.TokenTypeMagicLink, token)
+
+	s.userRepository.On("FindByID", mock.Anything, s.user.ID).Return(s.user, nil)
+	s.oneTimeTokenRepository.On("Find", mock.Anything, s.user.ID, magicLinkType).Return(stored, nil)
+
+	// Act
+	user, oneTimeToken, err := s.sut.Verify(
+		context.Background(),
+		service.MagicLink,
+		s.user.ID,
+		base64.StdEncoding.EncodeToString(token),
+	)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(s.user, user)
+	s.Equal(stored, oneTimeToken)
+}
+
+func (s *OneTimeLinkServiceTestSuite) TestVerify_WrongToken_ReturnsTheInvalidErrorOfTheLink() {
+	// Arrange
+	token := base64.StdEncoding.EncodeToString([]byte("wrong-token"))
+
+	s.userRepository.On("FindByID", mock.Anything, s.user.ID).Return(s.user, nil)
+	s.oneTimeTokenRepository.On("Find", mock.Anything, s.user.ID, mock.Anything).
+		Return(s.storedToken(enum.TokenTypeResetPassword, []byte("random-token")), nil)
+
+	// Act
+	_, _, err := s.sut.Verify(context.Background(), service.PasswordResetLink, s.user.ID, token)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidPasswordResetToken)
+}
+
+func (s *OneTimeLinkServiceTestSuite) TestVerify_ExpiredToken_ReturnsTheInvalidErrorOfTheLink() {
+	// Arrange
+	token := base64.StdEncoding.EncodeToString([]byte("random-token"))
+
+	s.userRepository.On("FindByID", mock.Anything, s.user.ID).Return(s.user, nil)
+	s.oneTimeTokenRepository.On("Find", mock.Anything, s.user.ID, mock.Anything).
+		Return(model.OneTimeTokenModel{}, shared_errs.ErrRecordNotFound)
+
+	// Act
+	_, _, err := s.sut.Verify(context.Background(), service.MagicLink, s.user.ID, token)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidMagicLinkToken)
+}
+
+func (s *OneTimeLinkServiceTestSuite) TestVerify_InactiveUser_ReturnsTheInvalidErrorOfTheLink() {
+	// Arrange
+	token := base64.StdEncoding.EncodeToString([]byte("random-token"))
+	s.user.Status = enum.UserStatusSuspended
+
+	s.userRepository.On("FindByID", mock.Anything, s.user.ID).Return(s.user, nil)
+
+	// Act
+	_, _, err := s.sut.Verify(context.Background(), service.MagicLink, s.user.ID, token)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidMagicLinkToken)
+	s.oneTimeTokenRepository.AssertNotCalled(s.T(), "Find", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *OneTimeLinkServiceTestSuite) TestVerify_MalformedToken_ReturnsTheInvalidErrorOfTheLink() {
+	// Act
+	_, _, err := s.sut.Verify(context.Background(), service.PasswordResetLink, s.user.ID, "not base64!")
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidPasswordResetToken)
+	s.userRepository.AssertNotCalled(s.T(), "FindByID", mock.Anything, mock.Anything)
+}
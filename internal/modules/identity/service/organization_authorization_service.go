@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/repository"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+)
+
+type OrganizationAuthorizationServiceI interface {
+	Authorize(
+		ctx context.Context,
+		userID, organizationID uint64,
+		requiredRole string,
+	) (model.OrganizationMemberModel, error)
+}
+
+type OrganizationAuthorizationService struct {
+	organizationMemberRepository repository.OrganizationMemberRepositoryI
+	logger                       logger.Logger
+}
+
+var _ OrganizationAuthorizationServiceI = (*OrganizationAuthorizationService)(nil)
+
+func NewOrganizationAuthorizationService(
+	organizationMemberRepository repository.OrganizationMemberRepositoryI,
+	logger logger.Logger,
+) *OrganizationAuthorizationService {
+	return &OrganizationAuthorizationService{
+		organizationMemberRepository: organizationMemberRepository,
+		logger:                       logger,
+	}
+}
+
+// Authorize returns the membership of the user when its role includes the required role.
+// Users that are not members of the organization are denied the same way as members with a lower role.
+func (s *OrganizationAuthorizationService) Authorize(
+	ctx context.Context,
+	userID, organizationID uint64,
+	requiredRole string,
+) (model.OrganizationMemberModel, error) {
+	ctx, span := trace.Span(ctx, "OrganizationAuthorizationService.Authorize")
+	defer span.End()
+
+	required, err := enum.NewOrganizationRoleEnum(requiredRole)
+	if err != nil {
+		return model.OrganizationMemberModel{}, err
+	}
+
+	member, err := s.organizationMemberRepository.Find(ctx, organizationID, userID)
+	if err != nil {
+		if errors.Is(err, shared_errs.ErrRecordNotFound) {
+			return model.OrganizationMemberModel{}, errs.ErrOrganizationAccessDenied
+		}
+		s.logger.Error().Msgf("error finding member %d of organization %d: %v", userID, organizationID, err)
+		return model.OrganizationMemberModel{}, err
+	}
+
+	role, err := enum.NewOrganizationRoleEnum(member.Role)
+	if err != nil {
+		return model.OrganizationMemberModel{}, err
+	}
+
+	if !role.Includes(required) {
+		return model.OrganizationMemberModel{}, errs.ErrOrganizationAccessDenied
+	}
+
+	return member, nil
+}
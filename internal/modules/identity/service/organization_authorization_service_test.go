@@ -0,0 +1,101 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/repository/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+)
+
+type OrganizationAuthorizationServiceTestSuite struct {
+	suite.Suite
+	sut                              *service.OrganizationAuthorizationService
+	organizationMemberRepositoryMock *repository_mocks.MockOrganizationMemberRepositoryI
+	logger                           logger.Logger
+}
+
+func (s *OrganizationAuthorizationServiceTestSuite) SetupTest() {
+	s.organizationMemberRepositoryMock = repository_mocks.NewMockOrganizationMemberRepositoryI(s.T())
+
+	cfg := config.Config{
+		Log: config.Log{
+			LogLevel: "disabled",
+		},
+	}
+	s.logger = logger.New(cfg)
+
+	s.sut = service.NewOrganizationAuthorizationService(s.organizationMemberRepositoryMock, s.logger)
+}
+
+func TestOrganizationAuthorizationServiceSuite(t *testing.T) {
+	suite.Run(t, new(OrganizationAuthorizationServiceTestSuite))
+}
+
+func (s *OrganizationAuthorizationServiceTestSuite) TestAuthorize_RoleIncludesRequiredRole_ReturnsMember() {
+	// Arrange
+	ctx := context.Background()
+	member := model.OrganizationMemberModel{OrganizationID: 2, UserID: 7, Role: enum.OrganizationRoleAdmin}
+
+	s.organizationMemberRepositoryMock.On("Find", mock.Anything, uint64(2), uint64(7)).Return(member, nil)
+
+	// Act
+	result, err := s.sut.Authorize(ctx, 7, 2, enum.OrganizationRoleEditor)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(member, result)
+}
+
+func (s *OrganizationAuthorizationServiceTestSuite) TestAuthorize_LowerRole_ReturnsAccessDenied() {
+	// Arrange
+	ctx := context.Background()
+	member := model.OrganizationMemberModel{OrganizationID: 2, UserID: 7, Role: enum.OrganizationRoleViewer}
+
+	s.organizationMemberRepositoryMock.On("Find", mock.Anything, uint64(2), uint64(7)).Return(member, nil)
+
+	// Act
+	_, err := s.sut.Authorize(ctx, 7, 2, enum.OrganizationRoleEditor)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrOrganizationAccessDenied)
+}
+
+func (s *OrganizationAuthorizationServiceTestSuite) TestAuthorize_NotMember_ReturnsAccessDenied() {
+	// Arrange
+	ctx := context.Background()
+
+	s.organizationMemberRepositoryMock.On("Find", mock.Anything, uint64(2), uint64(7)).
+		Return(model.OrganizationMemberModel{}, shared_errs.ErrRecordNotFound)
+
+	// Act
+	_, err := s.sut.Authorize(ctx, 7, 2, enum.OrganizationRoleViewer)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrOrganizationAccessDenied)
+}
+
+func (s *OrganizationAuthorizationServiceTestSuite) TestAuthorize_RepositoryFails_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	repositoryErr := errors.New("database error")
+
+	s.organizationMemberRepositoryMock.On("Find", mock.Anything, uint64(2), uint64(7)).
+		Return(model.OrganizationMemberModel{}, repositoryErr)
+
+	// Act
+	_, err := s.sut.Authorize(ctx, 7, 2, enum.OrganizationRoleViewer)
+
+	// Assert
+	s.Require().ErrorIs(err, repositoryErr)
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/mailer"
+)
+
+const sendOrganizationInvitationEmailSubject = "Organization Invitation"
+
+type SendOrganizationInvitationInput struct {
+	Invitee         model.UserModel
+	Inviter         model.UserModel
+	Organization    model.OrganizationModel
+	Invitation      model.OrganizationInvitationModel
+	InvitationToken []byte
+}
+
+type SendOrganizationInvitationServiceI interface {
+	Execute(ctx context.Context, input SendOrganizationInvitationInput) error
+}
+
+type SendOrganizationInvitationService struct {
+	emailTemplateService EmailTemplateServiceI
+	mailerSMTP           mailer.SMTP
+	logger               logger.Logger
+	cfg                  config.Config
+}
+
+var _ SendOrganizationInvitationServiceI = (*SendOrganizationInvitationService)(nil)
+
+func NewSendOrganizationInvitationService(
+	emailTemplateService EmailTemplateServiceI,
+	mailerSMTP mailer.SMTP,
+	logger logger.Logger,
+	cfg config.Config,
+) *SendOrganizationInvitationService {
+	return &SendOrganizationInvitationService{
+		emailTemplateService,
+		mailerSMTP,
+		logger,
+		cfg,
+	}
+}
+
+func (s *SendOrganizationInvitationService) Execute(ctx context.Context, input SendOrganizationInvitationInput) error {
+	ctx, span := trace.Span(ctx, "SendOrganizationInvitationService.Execute")
+	defer span.End()
+
+	invitationToken := base64.StdEncoding.EncodeToString(input.InvitationToken)
+
+	// generate the invitation acceptance link
+	invitationLink := fmt.Sprintf(
+		"%s/organizations/invitations/accept?id=%d&token=%s",
+		s.cfg.App.BaseURL,
+		input.Invitation.ID,
+		url.QueryEscape(invitationToken),
+	)
+
+	name := fmt.Sprintf("%s %s", input.Invitee.FirstName, input.Invitee.LastName)
+	emailTemplateInput := OrganizationInvitationInput{
+		Name:             name,
+		InviterName:      fmt.Sprintf("%s %s", input.Inviter.FirstName, input.Inviter.LastName),
+		OrganizationName: input.Organization.Name,
+		Role:             input.Invitation.Role,
+		InvitationLink:   invitationLink,
+	}
+	content, err := s.emailTemplateService.CompileOrganizationInvitationTemplate(emailTemplateInput)
+	if err != nil {
+		s.logger.Error().Msgf("error compiling organization invitation template: %v", err)
+		return err
+	}
+
+	md := mailer.MailData{
+		Sender:  s.cfg.MAIL.Sender,
+		ToName:  name,
+		ToEmail: input.Invitee.Email,
+		Subject: sendOrganizationInvitationEmailSubject,
+		Content: content,
+	}
+
+	err = s.mailerSMTP.Send(ctx, md)
+	if err != nil {
+		s.logger.Error().Msgf("error sending the email of invitation ID %d: %v", input.Invitation.ID, err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+	email_template_service_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/service/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/mailer"
+	mailer_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/mailer/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type SendOrganizationInvitationServiceTestSuite struct {
+	suite.Suite
+	sut                  *service.SendOrganizationInvitationService
+	emailTemplateService *email_template_service_mocks.MockEmailTemplateServiceI
+	mailerSMTP           *mailer_mocks.MockSMTP
+	logger               logger.Logger
+	cfg                  config.Config
+}
+
+func (s *SendOrganizationInvitationServiceTestSuite) SetupTest() {
+	s.emailTemplateService = email_template_service_mocks.NewMockEmailTemplateServiceI(s.T())
+	s.mailerSMTP = mailer_mocks.NewMockSMTP(s.T())
+
+	s.cfg = config.Config{
+		MAIL: config.MAIL{
+			Sender: "test@example.com",
+		},
+		App: config.App{
+			BaseURL: "https://example.com",
+		},
+		Log: config.Log{
+			LogLevel: "disabled",
+		},
+	}
+	s.logger = logger.New(s.cfg)
+
+	s.sut = service.NewSendOrganizationInvitationService(
+		s.emailTemplateService,
+		s.mailerSMTP,
+		s.logger,
+		s.cfg,
+	)
+}
+
+func TestSendOrganizationInvitationServiceSuite(t *testing.T) {
+	suite.Run(t, new(SendOrganizationInvitationServiceTestSuite))
+}
+
+func (s *SendOrganizationInvitationServiceTestSuite) newInput() service.SendOrganizationInvitationInput {
+	return service.SendOrganizationInvitationInput{
+		Invitee:         model.UserModel{ID: 7, FirstName: "Jane", LastName: "Smith", Email: "jane@example.com"},
+		Inviter:         model.UserModel{ID: 3, FirstName: "John", LastName: "Doe"},
+		Organization:    model.OrganizationModel{ID: 2, Name: "Acme"},
+		Invitation:      model.OrganizationInvitationModel{ID: 11, Role: "editor"},
+		InvitationToken: []byte{0xfb, 0xff, 0x01},
+	}
+}
+
+func (s *SendOrganizationInvitationServiceTestSuite) TestExecute_ValidInput_SendsInvitationEmail() {
+	// Arrange
+	input := s.newInput()
+	expectedTemplateInput := service.OrganizationInvitationInput{
+		Name:             "Jane Smith",
+		InviterName:      "John Doe",
+		OrganizationName: "Acme",
+		Role:             "editor",
+		InvitationLink:   "https://example.com/organizations/invitations/accept?id=11&token=%2B%2F8B",
+	}
+	expectedMailData := mailer.MailData{
+		Sender:  "test@example.com",
+		ToName:  "Jane Smith",
+		ToEmail: "jane@example.com",
+		Subject: "Organization Invitation",
+		Content: "<html>invitation</html>",
+	}
+
+	s.emailTemplateService.On("CompileOrganizationInvitationTemplate", expectedTemplateInput).
+		Return("<html>invitation</html>", nil)
+	s.mailerSMTP.On("Send", mock.Anything, expectedMailData).Return(nil)
+
+	// Act
+	err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().NoError(err)
+}
+
+func (s *SendOrganizationInvitationServiceTestSuite) TestExecute_EmailSendingFails_ReturnsError() {
+	// Arrange
+	input := s.newInput()
+	sendError := errors.New("failed to send email")
+
+	s.emailTemplateService.On("CompileOrganizationInvitationTemplate", mock.Anything).
+		Return("<html>invitation</html>", nil)
+	s.mailerSMTP.On("Send", mock.Anything, mock.Anything).Return(sendError)
+
+	// Act
+	err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().ErrorIs(err, sendError)
+}
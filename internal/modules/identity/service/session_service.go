@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/repository"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+)
+
+const (
+	refreshTokenBytes                  = 32
+	defaultRefreshTokenExpirationInSec = 30 * 24 * 60 * 60
+)
+
+// SessionTokens are the tokens issued to a session, the refresh token is only kept hashed.
+type SessionTokens struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+type SessionServiceI interface {
+	Start(ctx context.Context, user model.UserModel) (SessionTokens, error)
+	Refresh(ctx context.Context, refreshToken string) (SessionTokens, error)
+	IsActive(ctx context.Context, userID, sessionID uint64) (bool, error)
+	Revoke(ctx context.Context, userID, sessionID uint64) error
+	RevokeAll(ctx context.Context, userID uint64) error
+}
+
+type SessionService struct {
+	sessionRepository      repository.SessionRepositoryI
+	refreshTokenRepository repository.RefreshTokenRepositoryI
+	userRepository         repository.UserRepositoryI
+	tokenService           TokenServiceI
+	hashService            HashServiceI
+	txManager              database.TxManagerI
+	refreshTokenExpiration time.Duration
+	logger                 logger.Logger
+}
+
+var _ SessionServiceI = (*SessionService)(nil)
+
+func NewSessionService(
+	conf config.Config,
+	sessionRepository repository.SessionRepositoryI,
+	refreshTokenRepository repository.RefreshTokenRepositoryI,
+	userRepository repository.UserRepositoryI,
+	tokenService TokenServiceI,
+	hashService HashServiceI,
+	txManager database.TxManagerI,
+	logger logger.Logger,
+) *SessionService {
+	refreshTokenExpirationInSec := conf.JWT.RefreshTokenExpirationInSeconds
+	if refreshTokenExpirationInSec <= 0 {
+		refreshTokenExpirationInSec = defaultRefreshTokenExpirationInSec
+	}
+
+	return &SessionService{
+		sessionRepository:      sessionRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		userRepository:         userRepository,
+		tokenService:           tokenService,
+		hashService:            hashService,
+		txManager:              txManager,
+		refreshTokenExpiration: time.Duration(refreshTokenExpirationInSec) * time.Second,
+		logger:                 logger,
+	}
+}
+
+// Start opens a session for the user and issues its first tokens.
+func (s *SessionService) Start(ctx context.Context, user model.UserModel) (SessionTokens, error) {
+	ctx, span := trace.Span(ctx, "SessionService.Start")
+	defer span.End()
+
+	var tokens SessionTokens
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		session, err := s.sessionRepository.Create(ctx, model.SessionModel{UserID: user.ID})
+		if err != nil {
+			s.logger.Error().Msgf("error creating session of user ID %d: %v", user.ID, err)
+			return err
+		}
+
+		tokens, err = s.issueTokens(ctx, user, session.ID)
+		return err
+	})
+	if err != nil {
+		return SessionTokens{}, err
+	}
+
+	return tokens, nil
+}
+
+// Refresh exchanges a refresh token for new tokens of the same session, every refresh token
+// is accepted once. Presenting a used refresh token means it was stolen or replayed, the whole
+// session is revoked then, so neither the attacker nor the user can keep using it.
+func (s *SessionService) Refresh(ctx context.Context, refreshToken string) (SessionTokens, error) {
+	ctx, span := trace.Span(ctx, "SessionService.Refresh")
+	defer span.End()
+
+	storedToken, err := s.refreshTokenRepository.FindByTokenHash(ctx, hashRefreshToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, shared_errs.ErrRecordNotFound) {
+			return SessionTokens{}, errs.ErrInvalidRefreshToken
+		}
+		s.logger.Error().Msgf("error finding refresh token: %v", err)
+		return SessionTokens{}, err
+	}
+
+	session, err := s.sessionRepository.FindByID(ctx, storedToken.SessionID)
+	if err != nil {
+		s.logger.Error().Msgf("error finding session ID %d: %v", storedToken.SessionID, err)
+		return SessionTokens{}, err
+	}
+
+	if session.RevokedAt != nil {
+		return SessionTokens{}, errs.ErrInvalidRefreshToken
+	}
+
+	if storedToken.UsedAt != nil {
+		return SessionTokens{}, s.revokeReusedSession(ctx, session)
+	}
+
+	now := time.Now().UTC()
+	if !storedToken.ExpiresAt.After(now) {
+		return SessionTokens{}, errs.ErrInvalidRefreshToken
+	}
+
+	user, err := s.userRepository.FindByID(ctx, session.UserID)
+	if err != nil {
+		s.logger.Error().Msgf("error finding user by ID %d: %v", session.UserID, err)
+		return SessionTokens{}, err
+	}
+
+	if user.Status != enum.UserStatusActive {
+		return SessionTokens{}, errs.ErrUserIsNotActive
+	}
+
+	var tokens SessionTokens
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		err = s.refreshTokenRepository.MarkUsed(ctx, storedToken.ID, now)
+		if err != nil {
+			return err
+		}
+
+		tokens, err = s.issueTokens(ctx, user, session.ID)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, shared_errs.ErrRecordNotFound) {
+			// Another request used the token in the meantime
+			return SessionTokens{}, s.revokeReusedSession(ctx, session)
+		}
+		return SessionTokens{}, err
+	}
+
+	return tokens, nil
+}
+
+// IsActive reports whether the session belongs to the user and was not revoked.
+func (s *SessionService) IsActive(ctx context.Context, userID, sessionID uint64) (bool, error) {
+	ctx, span := trace.Span(ctx, "SessionService.IsActive")
+	defer span.End()
+
+	session, err := s.sessionRepository.FindByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, shared_errs.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return session.UserID == userID && session.RevokedAt == nil, nil
+}
+
+// Revoke ends a session of the user, its access and refresh tokens are rejected from then on.
+func (s *SessionService) Revoke(ctx context.Context, userID, sessionID uint64) error {
+	ctx, span := trace.Span(ctx, "SessionService.Revoke")
+	defer span.End()
+
+	err := s.sessionRepository.Revoke(ctx, userID, sessionID, time.Now().UTC())
+	if err != nil {
+		s.logger.Error().Msgf("error revoking session ID %d of user ID %d: %v", sessionID, userID, err)
+		return err
+	}
+
+	return nil
+}
+
+// RevokeAll ends every session of the user.
+func (s *SessionService) RevokeAll(ctx context.Context, userID uint64) error {
+	ctx, span := trace.Span(ctx, "SessionService.RevokeAll")
+	defer span.End()
+
+	err := s.sessionRepository.RevokeAllByUserID(ctx, userID, time.Now().UTC())
+	if err != nil {
+		s.logger.Error().Msgf("error revoking the sessions of user ID %d: %v", userID, err)
+		return err
+	}
+
+	return nil
+}
+
+func (s *SessionService) issueTokens(
+	ctx context.Context,
+	user model.UserModel,
+	sessionID uint64,
+) (SessionTokens, error) {
+	randomBytes, err := s.hashService.GenerateRandomBytes()
+	if err != nil {
+		s.logger.Error().Msgf("error generating random bytes: %v", err)
+		return SessionTokens{}, err
+	}
+
+	if len(randomBytes) < refreshTokenBytes {
+		return SessionTokens{}, errors.New("not enough random bytes to generate a refresh token")
+	}
+
+	refreshToken := hex.EncodeToString(randomBytes[:refreshTokenBytes])
+	_, err = s.refreshTokenRepository.Create(ctx, model.RefreshTokenModel{
+		SessionID: sessionID,
+		TokenHash: hashRefreshToken(refreshToken),
+		ExpiresAt: time.Now().UTC().Add(s.refreshTokenExpiration),
+	})
+	if err != nil {
+		s.logger.Error().Msgf("error creating refresh token of session ID %d: %v", sessionID, err)
+		return SessionTokens{}, err
+	}
+
+	accessToken, err := s.tokenService.GenerateJWT(ctx, user, sessionID)
+	if err != nil {
+		return SessionTokens{}, err
+	}
+
+	return SessionTokens{AccessToken: accessToken, RefreshToken: refreshToken}, nil
+}
+
+func (s *SessionService) revokeReusedSession(ctx context.Context, session model.SessionModel) error {
+	s.logger.Warn().Msgf("Refresh token reused in session ID %d of user ID %d, revoking it", session.ID, session.UserID)
+
+	err := s.sessionRepository.Revoke(ctx, session.UserID, session.ID, time.Now().UTC())
+	if err != nil {
+		s.logger.Error().Msgf("error revoking session ID %d: %v", session.ID, err)
+		return err
+	}
+
+	return errs.ErrInvalidRefreshToken
+}
+
+// hashRefreshToken hashes a refresh token for storage. Refresh tokens are random and long enough
+// that a fast hash is safe, and it lets them be looked up by their hash.
+func hashRefreshToken(refreshToken string) []byte {
+	hash := sha256.Sum256([]byte(refreshToken))
+	return hash[:]
+}
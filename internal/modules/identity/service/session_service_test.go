@@ -0,0 +1,234 @@
+package service_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/repository/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+	service_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/service/mocks"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	database_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/database/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+)
+
+type SessionServiceTestSuite struct {
+	suite.Suite
+	sut                        *service.SessionService
+	sessionRepositoryMock      *repository_mocks.MockSessionRepositoryI
+	refreshTokenRepositoryMock *repository_mocks.MockRefreshTokenRepositoryI
+	userRepositoryMock         *repository_mocks.MockUserRepositoryI
+	tokenServiceMock           *service_mocks.MockTokenServiceI
+	hashServiceMock            *service_mocks.MockHashServiceI
+	txManagerMock              *database_mocks.MockTxManagerI
+	logger                     logger.Logger
+}
+
+func (s *SessionServiceTestSuite) SetupTest() {
+	s.sessionRepositoryMock = repository_mocks.NewMockSessionRepositoryI(s.T())
+	s.refreshTokenRepositoryMock = repository_mocks.NewMockRefreshTokenRepositoryI(s.T())
+	s.userRepositoryMock = repository_mocks.NewMockUserRepositoryI(s.T())
+	s.tokenServiceMock = service_mocks.NewMockTokenServiceI(s.T())
+	s.hashServiceMock = service_mocks.NewMockHashServiceI(s.T())
+	s.txManagerMock = database_mocks.NewMockTxManagerI(s.T())
+	s.logger = logger.New(config.Config{Log: config.Log{LogLevel: "disabled"}})
+
+	s.txManagerMock.On("WithinTransaction", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) }).
+		Maybe()
+
+	s.sut = service.NewSessionService(
+		config.Config{},
+		s.sessionRepositoryMock,
+		s.refreshTokenRepositoryMock,
+		s.userRepositoryMock,
+		s.tokenServiceMock,
+		s.hashServiceMock,
+		s.txManagerMock,
+		s.logger,
+	)
+}
+
+func TestSessionServiceSuite(t *testing.T) {
+	suite.Run(t, new(SessionServiceTestSuite))
+}
+
+func (s *SessionServiceTestSuite) TestStart_ValidUser_IssuesTokensOfNewSession() {
+	// Arrange
+	ctx := context.Background()
+	user := model.UserModel{ID: 7, Status: enum.UserStatusActive}
+	session := model.SessionModel{ID: 3, UserID: user.ID}
+
+	s.sessionRepositoryMock.On("Create", mock.Anything, model.SessionModel{UserID: user.ID}).Return(session, nil)
+	s.hashServiceMock.On("GenerateRandomBytes").Return(make([]byte, 128), nil)
+	s.refreshTokenRepositoryMock.On("Create", mock.Anything, mock.MatchedBy(func(token model.RefreshTokenModel) bool {
+		return token.SessionID == session.ID && len(token.TokenHash) == sha256.Size && token.ExpiresAt.After(time.Now())
+	})).Return(model.RefreshTokenModel{ID: 1}, nil)
+	s.tokenServiceMock.On("GenerateJWT", mock.Anything, user, session.ID).Return("access-token", nil)
+
+	// Act
+	tokens, err := s.sut.Start(ctx, user)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal("access-token", tokens.AccessToken)
+	s.NotEmpty(tokens.RefreshToken)
+}
+
+func (s *SessionServiceTestSuite) TestRefresh_UnusedToken_RotatesRefreshToken() {
+	// Arrange
+	ctx := context.Background()
+	user := model.UserModel{ID: 7, Status: enum.UserStatusActive}
+	session := model.SessionModel{ID: 3, UserID: user.ID}
+	storedToken := model.RefreshTokenModel{ID: 11, SessionID: session.ID, ExpiresAt: time.Now().Add(time.Hour)}
+
+	s.refreshTokenRepositoryMock.On("FindByTokenHash", mock.Anything, hashOf("refresh-token")).Return(storedToken, nil)
+	s.sessionRepositoryMock.On("FindByID", mock.Anything, session.ID).Return(session, nil)
+	s.userRepositoryMock.On("FindByID", mock.Anything, user.ID).Return(user, nil)
+	s.refreshTokenRepositoryMock.On("MarkUsed", mock.Anything, storedToken.ID, mock.Anything).Return(nil)
+	s.hashServiceMock.On("GenerateRandomBytes").Return(make([]byte, 128), nil)
+	s.refreshTokenRepositoryMock.On("Create", mock.Anything, mock.Anything).Return(model.RefreshTokenModel{ID: 12}, nil)
+	s.tokenServiceMock.On("GenerateJWT", mock.Anything, user, session.ID).Return("access-token", nil)
+
+	// Act
+	tokens, err := s.sut.Refresh(ctx, "refresh-token")
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal("access-token", tokens.AccessToken)
+	s.NotEqual("refresh-token", tokens.RefreshToken)
+	s.sessionRepositoryMock.AssertNotCalled(s.T(), "Revoke", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *SessionServiceTestSuite) TestRefresh_UsedToken_RevokesTokenFamily() {
+	// Arrange
+	ctx := context.Background()
+	usedAt := time.Now().Add(-time.Minute)
+	session := model.SessionModel{ID: 3, UserID: 7}
+	storedToken := model.RefreshTokenModel{
+		ID:        11,
+		SessionID: session.ID,
+		ExpiresAt: time.Now().Add(time.Hour),
+		UsedAt:    &usedAt,
+	}
+
+	s.refreshTokenRepositoryMock.On("FindByTokenHash", mock.Anything, hashOf("refresh-token")).Return(storedToken, nil)
+	s.sessionRepositoryMock.On("FindByID", mock.Anything, session.ID).Return(session, nil)
+	s.sessionRepositoryMock.On("Revoke", mock.Anything, session.UserID, session.ID, mock.Anything).Return(nil)
+
+	// Act
+	_, err := s.sut.Refresh(ctx, "refresh-token")
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidRefreshToken)
+	s.tokenServiceMock.AssertNotCalled(s.T(), "GenerateJWT", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *SessionServiceTestSuite) TestRefresh_ConcurrentUse_RevokesTokenFamily() {
+	// Arrange
+	ctx := context.Background()
+	user := model.UserModel{ID: 7, Status: enum.UserStatusActive}
+	session := model.SessionModel{ID: 3, UserID: user.ID}
+	storedToken := model.RefreshTokenModel{ID: 11, SessionID: session.ID, ExpiresAt: time.Now().Add(time.Hour)}
+
+	s.refreshTokenRepositoryMock.On("FindByTokenHash", mock.Anything, hashOf("refresh-token")).Return(storedToken, nil)
+	s.sessionRepositoryMock.On("FindByID", mock.Anything, session.ID).Return(session, nil)
+	s.userRepositoryMock.On("FindByID", mock.Anything, user.ID).Return(user, nil)
+	s.refreshTokenRepositoryMock.On("MarkUsed", mock.Anything, storedToken.ID, mock.Anything).
+		Return(shared_errs.ErrRecordNotFound)
+	s.sessionRepositoryMock.On("Revoke", mock.Anything, session.UserID, session.ID, mock.Anything).Return(nil)
+
+	// Act
+	_, err := s.sut.Refresh(ctx, "refresh-token")
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidRefreshToken)
+}
+
+func (s *SessionServiceTestSuite) TestRefresh_RevokedSession_ReturnsInvalidRefreshToken() {
+	// Arrange
+	ctx := context.Background()
+	revokedAt := time.Now().Add(-time.Minute)
+	session := model.SessionModel{ID: 3, UserID: 7, RevokedAt: &revokedAt}
+	storedToken := model.RefreshTokenModel{ID: 11, SessionID: session.ID, ExpiresAt: time.Now().Add(time.Hour)}
+
+	s.refreshTokenRepositoryMock.On("FindByTokenHash", mock.Anything, hashOf("refresh-token")).Return(storedToken, nil)
+	s.sessionRepositoryMock.On("FindByID", mock.Anything, session.ID).Return(session, nil)
+
+	// Act
+	_, err := s.sut.Refresh(ctx, "refresh-token")
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidRefreshToken)
+	s.refreshTokenRepositoryMock.AssertNotCalled(s.T(), "MarkUsed", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *SessionServiceTestSuite) TestRefresh_ExpiredToken_ReturnsInvalidRefreshToken() {
+	// Arrange
+	ctx := context.Background()
+	session := model.SessionModel{ID: 3, UserID: 7}
+	storedToken := model.RefreshTokenModel{ID: 11, SessionID: session.ID, ExpiresAt: time.Now().Add(-time.Hour)}
+
+	s.refreshTokenRepositoryMock.On("FindByTokenHash", mock.Anything, hashOf("refresh-token")).Return(storedToken, nil)
+	s.sessionRepositoryMock.On("FindByID", mock.Anything, session.ID).Return(session, nil)
+
+	// Act
+	_, err := s.sut.Refresh(ctx, "refresh-token")
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidRefreshToken)
+}
+
+func (s *SessionServiceTestSuite) TestRefresh_UnknownToken_ReturnsInvalidRefreshToken() {
+	// Arrange
+	ctx := context.Background()
+
+	s.refreshTokenRepositoryMock.On("FindByTokenHash", mock.Anything, hashOf("unknown")).
+		Return(model.RefreshTokenModel{}, shared_errs.ErrRecordNotFound)
+
+	// Act
+	_, err := s.sut.Refresh(ctx, "unknown")
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidRefreshToken)
+}
+
+func (s *SessionServiceTestSuite) TestIsActive() {
+	// Arrange
+	ctx := context.Background()
+	revokedAt := time.Now()
+
+	s.sessionRepositoryMock.On("FindByID", mock.Anything, uint64(1)).Return(model.SessionModel{ID: 1, UserID: 7}, nil)
+	s.sessionRepositoryMock.On("FindByID", mock.Anything, uint64(2)).
+		Return(model.SessionModel{ID: 2, UserID: 7, RevokedAt: &revokedAt}, nil)
+	s.sessionRepositoryMock.On("FindByID", mock.Anything, uint64(3)).
+		Return(model.SessionModel{}, shared_errs.ErrRecordNotFound)
+
+	// Act
+	active, activeErr := s.sut.IsActive(ctx, 7, 1)
+	otherUser, otherUserErr := s.sut.IsActive(ctx, 8, 1)
+	revoked, revokedErr := s.sut.IsActive(ctx, 7, 2)
+	missing, missingErr := s.sut.IsActive(ctx, 7, 3)
+
+	// Assert
+	s.Require().NoError(errors.Join(activeErr, otherUserErr, revokedErr, missingErr))
+	s.True(active)
+	s.False(otherUser)
+	s.False(revoked)
+	s.False(missing)
+}
+
+func hashOf(token string) []byte {
+	hash := sha256.Sum256([]byte(token))
+	return hash[:]
+}
@@ -8,13 +8,14 @@ import (
 	"github.com/cristiano-pacheco/go-otel/trace"
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	internal_jwt "github.com/cristiano-pacheco/pingo/internal/shared/modules/jwt"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/registry"
 	"github.com/golang-jwt/jwt/v5"
 )
 
 type TokenServiceI interface {
-	GenerateJWT(ctx context.Context, user model.UserModel) (string, error)
+	GenerateJWT(ctx context.Context, user model.UserModel, sessionID uint64) (string, error)
 }
 
 type TokenService struct {
@@ -33,19 +34,23 @@ func NewTokenService(
 	return &TokenService{privateKeyRegistry, conf, logger}
 }
 
-func (s *TokenService) GenerateJWT(ctx context.Context, user model.UserModel) (string, error) {
+// GenerateJWT issues an access token of the user, it is only accepted while the session is active.
+func (s *TokenService) GenerateJWT(ctx context.Context, user model.UserModel, sessionID uint64) (string, error) {
 	_, span := trace.Span(ctx, "TokenService.GenerateJWT")
 	defer span.End()
 
 	now := time.Now()
 	duration := time.Duration(s.conf.JWT.ExpirationInSeconds) * time.Second
 	expires := now.Add(duration)
-	claims := jwt.RegisteredClaims{
-		ExpiresAt: jwt.NewNumericDate(expires),
-		IssuedAt:  jwt.NewNumericDate(now),
-		NotBefore: jwt.NewNumericDate(now),
-		Issuer:    s.conf.JWT.Issuer,
-		Subject:   strconv.FormatUint(user.ID, 10),
+	claims := internal_jwt.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expires),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			Issuer:    s.conf.JWT.Issuer,
+			Subject:   strconv.FormatUint(user.ID, 10),
+		},
+		SessionID: sessionID,
 	}
 
 	method := jwt.GetSigningMethod(jwt.SigningMethodRS256.Name)
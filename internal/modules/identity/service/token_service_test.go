@@ -74,7 +74,7 @@ func (s *TokenServiceTestSuite) TestGenerateJWT_ValidUser_ReturnsValidToken() {
 	s.privateKeyRegistryMock.On("Get").Return(s.privateKey)
 
 	// Act
-	token, err := s.sut.GenerateJWT(ctx, user)
+	token, err := s.sut.GenerateJWT(ctx, user, 77)
 
 	// Assert
 	s.Require().NoError(err)
@@ -109,7 +109,7 @@ func (s *TokenServiceTestSuite) TestGenerateJWT_UserWithZeroID_ReturnsValidToken
 	s.privateKeyRegistryMock.On("Get").Return(s.privateKey)
 
 	// Act
-	token, err := s.sut.GenerateJWT(ctx, user)
+	token, err := s.sut.GenerateJWT(ctx, user, 77)
 
 	// Assert
 	s.Require().NoError(err)
@@ -141,7 +141,7 @@ func (s *TokenServiceTestSuite) TestGenerateJWT_PrivateKeySigningFails_ReturnsEr
 	s.privateKeyRegistryMock.On("Get").Return(invalidKey)
 
 	// Act
-	token, err := s.sut.GenerateJWT(ctx, user)
+	token, err := s.sut.GenerateJWT(ctx, user, 77)
 
 	// Assert
 	s.Require().Error(err)
@@ -164,7 +164,7 @@ func (s *TokenServiceTestSuite) TestGenerateJWT_TokenExpirationIsSetCorrectly_Re
 	beforeGeneration := time.Now()
 
 	// Act
-	token, err := s.sut.GenerateJWT(ctx, user)
+	token, err := s.sut.GenerateJWT(ctx, user, 77)
 
 	// Assert
 	s.Require().NoError(err)
@@ -208,7 +208,7 @@ func (s *TokenServiceTestSuite) TestGenerateJWT_AllClaimsAreSetCorrectly_Returns
 	beforeGeneration := time.Now()
 
 	// Act
-	token, err := s.sut.GenerateJWT(ctx, user)
+	token, err := s.sut.GenerateJWT(ctx, user, 77)
 
 	// Assert
 	s.Require().NoError(err)
@@ -227,6 +227,7 @@ func (s *TokenServiceTestSuite) TestGenerateJWT_AllClaimsAreSetCorrectly_Returns
 
 	s.Equal(s.cfg.JWT.Issuer, claims["iss"])
 	s.Equal(strconv.FormatUint(user.ID, 10), claims["sub"])
+	s.InDelta(float64(77), claims["sid"], 0)
 
 	iatClaim, ok := claims["iat"].(float64)
 	s.True(ok)
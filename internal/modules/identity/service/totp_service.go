@@ -0,0 +1,211 @@
+package service
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // RFC 6238 authenticator apps use HMAC-SHA1
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+)
+
+const (
+	totpPeriodSeconds = 30
+	totpDigits        = 6
+	totpModulo        = 1000000
+	// totpSkewSteps is how many time steps a code is accepted before and after the current one,
+	// for the clock drift of the phones.
+	totpSkewSteps       = 1
+	totpSecretSize      = 20
+	totpDefaultIssuer   = "Pingo"
+	recoveryCodesTotal  = 10
+	recoveryCodeSize    = 10
+	recoveryCodeGroupOf = 4
+)
+
+var (
+	ErrInvalidTOTPEncryptionKey = errors.New("AUTH_TOTP_ENCRYPTION_KEY must be a base64 encoded 32 bytes key")
+	ErrInvalidTOTPSecret        = errors.New("invalid encrypted TOTP secret")
+)
+
+var totpSecretEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+type TOTPServiceI interface {
+	GenerateSecret() ([]byte, error)
+	EncodeSecret(secret []byte) string
+	ProvisioningURI(secret []byte, accountName string) string
+	Validate(secret []byte, code string, at time.Time) (int64, bool)
+	EncryptSecret(secret []byte) ([]byte, error)
+	DecryptSecret(secretEncrypted []byte) ([]byte, error)
+	GenerateRecoveryCodes() ([]string, error)
+	HashRecoveryCode(code string) []byte
+}
+
+// TOTPService implements the RFC 6238 time-based one-time passwords of the authenticator apps,
+// with 6 digits codes renewed every 30 seconds.
+type TOTPService struct {
+	issuer string
+	aead   cipher.AEAD
+}
+
+var _ TOTPServiceI = (*TOTPService)(nil)
+
+// NewTOTPService fails on an invalid AUTH_TOTP_ENCRYPTION_KEY, without a key the authenticator apps
+// can't be enrolled.
+func NewTOTPService(conf config.Config) (*TOTPService, error) {
+	issuer := conf.App.Name
+	if issuer == "" {
+		issuer = totpDefaultIssuer
+	}
+
+	s := TOTPService{issuer: issuer}
+	if conf.Auth.TOTPEncryptionKey == "" {
+		return &s, nil
+	}
+
+	key, err := base64.StdEncoding.DecodeString(conf.Auth.TOTPEncryptionKey)
+	if err != nil || len(key) != 32 {
+		return nil, ErrInvalidTOTPEncryptionKey
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	s.aead, err = cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+func (s *TOTPService) GenerateSecret() ([]byte, error) {
+	secret := make([]byte, totpSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// EncodeSecret returns the secret the way it is typed in an authenticator app.
+func (s *TOTPService) EncodeSecret(secret []byte) string {
+	return totpSecretEncoding.EncodeToString(secret)
+}
+
+// ProvisioningURI returns the otpauth URI the enrollment QR code is rendered from.
+func (s *TOTPService) ProvisioningURI(secret []byte, accountName string) string {
+	params := url.Values{}
+	params.Set("secret", s.EncodeSecret(secret))
+	params.Set("issuer", s.issuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", strconv.Itoa(totpDigits))
+	params.Set("period", strconv.Itoa(totpPeriodSeconds))
+
+	return fmt.Sprintf(
+		"otpauth://totp/%s:%s?%s",
+		url.PathEscape(s.issuer),
+		url.PathEscape(accountName),
+		params.Encode(),
+	)
+}
+
+// Validate checks the code against the time steps around at and returns the time step it matched,
+// the caller must reject steps that were already used.
+func (s *TOTPService) Validate(secret []byte, code string, at time.Time) (int64, bool) {
+	if len(code) != totpDigits {
+		return 0, false
+	}
+
+	currentStep := at.Unix() / totpPeriodSeconds
+	for step := currentStep - totpSkewSteps; step <= currentStep+totpSkewSteps; step++ {
+		if subtle.ConstantTimeCompare([]byte(s.generateCode(secret, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+func (s *TOTPService) EncryptSecret(secret []byte) ([]byte, error) {
+	if s.aead == nil {
+		return nil, errs.ErrTOTPNotConfigured
+	}
+
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return s.aead.Seal(nonce, nonce, secret, nil), nil
+}
+
+func (s *TOTPService) DecryptSecret(secretEncrypted []byte) ([]byte, error) {
+	if s.aead == nil {
+		return nil, errs.ErrTOTPNotConfigured
+	}
+
+	nonceSize := s.aead.NonceSize()
+	if len(secretEncrypted) < nonceSize {
+		return nil, ErrInvalidTOTPSecret
+	}
+
+	return s.aead.Open(nil, secretEncrypted[:nonceSize], secretEncrypted[nonceSize:], nil)
+}
+
+// GenerateRecoveryCodes returns the codes a user logs in with when the authenticator app is lost,
+// formatted in groups of 4 characters.
+func (s *TOTPService) GenerateRecoveryCodes() ([]string, error) {
+	codes := make([]string, 0, recoveryCodesTotal)
+	for range recoveryCodesTotal {
+		buffer := make([]byte, recoveryCodeSize)
+		if _, err := rand.Read(buffer); err != nil {
+			return nil, err
+		}
+
+		encoded := strings.ToLower(totpSecretEncoding.EncodeToString(buffer))
+		groups := make([]string, 0, len(encoded)/recoveryCodeGroupOf)
+		for i := 0; i < len(encoded); i += recoveryCodeGroupOf {
+			groups = append(groups, encoded[i:i+recoveryCodeGroupOf])
+		}
+		codes = append(codes, strings.Join(groups, "-"))
+	}
+	return codes, nil
+}
+
+// HashRecoveryCode returns the hash a recovery code is stored as, the dashes and the case
+// of the code are ignored.
+func (s *TOTPService) HashRecoveryCode(code string) []byte {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	hash := sha256.Sum256([]byte(normalized))
+	return hash[:]
+}
+
+// generateCode is the HOTP value of RFC 4226 for the time step.
+func (s *TOTPService) generateCode(secret []byte, step int64) string {
+	counter := make([]byte, 8)
+	binary.BigEndian.PutUint64(counter, uint64(step)) // #nosec G115 -- time steps are positive
+
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(counter)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", totpDigits, value%totpModulo)
+}
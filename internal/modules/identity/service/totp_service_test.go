@@ -0,0 +1,161 @@
+package service_test
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+)
+
+// rfc6238Secret is the SHA1 secret of the RFC 6238 test vectors.
+var rfc6238Secret = []byte("12345678901234567890")
+
+type TOTPServiceTestSuite struct {
+	suite.Suite
+	sut *service.TOTPService
+}
+
+func (s *TOTPServiceTestSuite) SetupTest() {
+	key := base64.StdEncoding.EncodeToString(make([]byte, 32))
+	sut, err := service.NewTOTPService(config.Config{
+		App:  config.App{Name: "Pingo"},
+		Auth: config.Auth{TOTPEncryptionKey: key},
+	})
+	s.Require().NoError(err)
+	s.sut = sut
+}
+
+func TestTOTPServiceSuite(t *testing.T) {
+	suite.Run(t, new(TOTPServiceTestSuite))
+}
+
+func (s *TOTPServiceTestSuite) TestValidate_RFC6238TestVectors_ReturnsMatchedStep() {
+	// Arrange
+	vectors := []struct {
+		unix int64
+		code string
+	}{
+		{unix: 59, code: "287082"},
+		{unix: 1111111109, code: "081804"},
+		{unix: 1111111111, code: "050471"},
+		{unix: 1234567890, code: "005924"},
+		{unix: 2000000000, code: "279037"},
+		{unix: 20000000000, code: "353130"},
+	}
+
+	for _, vector := range vectors {
+		// Act
+		step, ok := s.sut.Validate(rfc6238Secret, vector.code, time.Unix(vector.unix, 0))
+
+		// Assert
+		s.True(ok, "code %s at %d", vector.code, vector.unix)
+		s.Equal(vector.unix/30, step)
+	}
+}
+
+func (s *TOTPServiceTestSuite) TestValidate_CodeOfPreviousStep_IsAccepted() {
+	// Arrange
+	at := time.Unix(59+30, 0)
+
+	// Act
+	step, ok := s.sut.Validate(rfc6238Secret, "287082", at)
+
+	// Assert
+	s.True(ok)
+	s.Equal(int64(1), step)
+}
+
+func (s *TOTPServiceTestSuite) TestValidate_ExpiredCode_IsRejected() {
+	// Arrange
+	at := time.Unix(59+90, 0)
+
+	// Act
+	_, ok := s.sut.Validate(rfc6238Secret, "287082", at)
+
+	// Assert
+	s.False(ok)
+}
+
+func (s *TOTPServiceTestSuite) TestValidate_CodeWithWrongLength_IsRejected() {
+	// Act
+	_, ok := s.sut.Validate(rfc6238Secret, "94287082", time.Unix(59, 0))
+
+	// Assert
+	s.False(ok)
+}
+
+func (s *TOTPServiceTestSuite) TestProvisioningURI_ValidSecret_ReturnsOtpauthURI() {
+	// Act
+	uri := s.sut.ProvisioningURI(rfc6238Secret, "jane@example.com")
+
+	// Assert
+	s.Equal(
+		"otpauth://totp/Pingo:jane@example.com?algorithm=SHA1&digits=6&issuer=Pingo&period=30"+
+			"&secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ",
+		uri,
+	)
+}
+
+func (s *TOTPServiceTestSuite) TestEncryptSecret_EncryptedSecret_DecryptsToSecret() {
+	// Act
+	encrypted, err := s.sut.EncryptSecret(rfc6238Secret)
+	s.Require().NoError(err)
+	decrypted, err := s.sut.DecryptSecret(encrypted)
+
+	// Assert
+	s.Require().NoError(err)
+	s.NotContains(string(encrypted), string(rfc6238Secret))
+	s.Equal(rfc6238Secret, decrypted)
+}
+
+func (s *TOTPServiceTestSuite) TestEncryptSecret_NoEncryptionKey_ReturnsNotConfiguredError() {
+	// Arrange
+	sut, err := service.NewTOTPService(config.Config{})
+	s.Require().NoError(err)
+
+	// Act
+	_, err = sut.EncryptSecret(rfc6238Secret)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrTOTPNotConfigured)
+}
+
+func (s *TOTPServiceTestSuite) TestNewTOTPService_InvalidEncryptionKey_ReturnsError() {
+	// Arrange
+	conf := config.Config{Auth: config.Auth{TOTPEncryptionKey: base64.StdEncoding.EncodeToString([]byte("short"))}}
+
+	// Act
+	_, err := service.NewTOTPService(conf)
+
+	// Assert
+	s.Require().ErrorIs(err, service.ErrInvalidTOTPEncryptionKey)
+}
+
+func (s *TOTPServiceTestSuite) TestGenerateRecoveryCodes_ReturnsDistinctFormattedCodes() {
+	// Act
+	codes, err := s.sut.GenerateRecoveryCodes()
+
+	// Assert
+	s.Require().NoError(err)
+	s.Len(codes, 10)
+	seen := map[string]bool{}
+	for _, code := range codes {
+		s.Regexp(`^[a-z2-7]{4}-[a-z2-7]{4}-[a-z2-7]{4}-[a-z2-7]{4}$`, code)
+		s.False(seen[code])
+		seen[code] = true
+	}
+}
+
+func (s *TOTPServiceTestSuite) TestHashRecoveryCode_DashesAndCase_AreIgnored() {
+	// Act
+	hash := s.sut.HashRecoveryCode("abcd-efgh-ijkl-mnop")
+	normalizedHash := s.sut.HashRecoveryCode("ABCDEFGHIJKLMNOP")
+
+	// Assert
+	s.Equal(hash, normalizedHash)
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/repository"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+)
+
+type TOTPVerificationServiceI interface {
+	Verify(ctx context.Context, enrollment model.TOTPEnrollmentModel, code string) error
+}
+
+type TOTPVerificationService struct {
+	totpService                TOTPServiceI
+	totpEnrollmentRepository   repository.TOTPEnrollmentRepositoryI
+	totpRecoveryCodeRepository repository.TOTPRecoveryCodeRepositoryI
+	logger                     logger.Logger
+}
+
+var _ TOTPVerificationServiceI = (*TOTPVerificationService)(nil)
+
+func NewTOTPVerificationService(
+	totpService TOTPServiceI,
+	totpEnrollmentRepository repository.TOTPEnrollmentRepositoryI,
+	totpRecoveryCodeRepository repository.TOTPRecoveryCodeRepositoryI,
+	logger logger.Logger,
+) *TOTPVerificationService {
+	return &TOTPVerificationService{
+		totpService:                totpService,
+		totpEnrollmentRepository:   totpEnrollmentRepository,
+		totpRecoveryCodeRepository: totpRecoveryCodeRepository,
+		logger:                     logger,
+	}
+}
+
+const (
+	// maxFailedTOTPAttempts invalid codes in a row lock the codes of the user for totpLockout
+	maxFailedTOTPAttempts = 5
+	totpLockout           = 15 * time.Minute
+)
+
+// Verify accepts a code of the authenticator app or one of the recovery codes of the user,
+// every code can only be used once. Too many invalid codes in a row lock the codes for a while.
+func (s *TOTPVerificationService) Verify(
+	ctx context.Context,
+	enrollment model.TOTPEnrollmentModel,
+	code string,
+) error {
+	ctx, span := trace.Span(ctx, "TOTPVerificationService.Verify")
+	defer span.End()
+
+	now := time.Now().UTC()
+	if enrollment.LockedUntil != nil && now.Before(*enrollment.LockedUntil) {
+		return errs.ErrTOTPLocked
+	}
+
+	err := s.verifyCode(ctx, enrollment, code, now)
+	if errors.Is(err, errs.ErrInvalidTOTPCode) {
+		recordErr := s.totpEnrollmentRepository.RecordFailedAttempt(
+			ctx,
+			enrollment.UserID,
+			maxFailedTOTPAttempts,
+			now.Add(totpLockout),
+		)
+		if recordErr != nil {
+			s.logger.Error().Msgf("error recording an invalid code of user ID %d: %v", enrollment.UserID, recordErr)
+			return recordErr
+		}
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	if enrollment.FailedAttempts > 0 {
+		if err = s.totpEnrollmentRepository.ResetFailedAttempts(ctx, enrollment.UserID); err != nil {
+			s.logger.Error().Msgf("error resetting the invalid codes of user ID %d: %v", enrollment.UserID, err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *TOTPVerificationService) verifyCode(
+	ctx context.Context,
+	enrollment model.TOTPEnrollmentModel,
+	code string,
+	now time.Time,
+) error {
+	secret, err := s.totpService.DecryptSecret(enrollment.SecretEncrypted)
+	if err != nil {
+		s.logger.Error().Msgf("error decrypting the TOTP secret of user ID %d: %v", enrollment.UserID, err)
+		return err
+	}
+
+	if step, ok := s.totpService.Validate(secret, code, now); ok {
+		err = s.totpEnrollmentRepository.UpdateLastUsedStep(ctx, enrollment.UserID, step)
+		if err != nil {
+			if errors.Is(err, shared_errs.ErrRecordNotFound) {
+				return errs.ErrInvalidTOTPCode
+			}
+			s.logger.Error().Msgf("error updating the TOTP step of user ID %d: %v", enrollment.UserID, err)
+			return err
+		}
+		return nil
+	}
+
+	err = s.totpRecoveryCodeRepository.MarkUsed(ctx, enrollment.UserID, s.totpService.HashRecoveryCode(code), now)
+	if err != nil {
+		if errors.Is(err, shared_errs.ErrRecordNotFound) {
+			return errs.ErrInvalidTOTPCode
+		}
+		s.logger.Error().Msgf("error using a recovery code of user ID %d: %v", enrollment.UserID, err)
+		return err
+	}
+
+	s.logger.Info().Msgf("user ID %d used a recovery code", enrollment.UserID)
+	return nil
+}
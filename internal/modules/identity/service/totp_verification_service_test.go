@@ -0,0 +1,171 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/repository/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+	service_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/service/mocks"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+)
+
+type TOTPVerificationServiceTestSuite struct {
+	suite.Suite
+	sut                            *service.TOTPVerificationService
+	totpServiceMock                *service_mocks.MockTOTPServiceI
+	totpEnrollmentRepositoryMock   *repository_mocks.MockTOTPEnrollmentRepositoryI
+	totpRecoveryCodeRepositoryMock *repository_mocks.MockTOTPRecoveryCodeRepositoryI
+	enrollment                     model.TOTPEnrollmentModel
+}
+
+func (s *TOTPVerificationServiceTestSuite) SetupTest() {
+	s.totpServiceMock = service_mocks.NewMockTOTPServiceI(s.T())
+	s.totpEnrollmentRepositoryMock = repository_mocks.NewMockTOTPEnrollmentRepositoryI(s.T())
+	s.totpRecoveryCodeRepositoryMock = repository_mocks.NewMockTOTPRecoveryCodeRepositoryI(s.T())
+	s.enrollment = model.TOTPEnrollmentModel{ID: 1, UserID: 7, SecretEncrypted: []byte("encrypted")}
+
+	s.sut = service.NewTOTPVerificationService(
+		s.totpServiceMock,
+		s.totpEnrollmentRepositoryMock,
+		s.totpRecoveryCodeRepositoryMock,
+		logger.New(config.Config{Log: config.Log{LogLevel: "disabled"}}),
+	)
+
+	s.totpServiceMock.On("DecryptSecret", []byte("encrypted")).Return([]byte("secret"), nil).Maybe()
+}
+
+func TestTOTPVerificationServiceSuite(t *testing.T) {
+	suite.Run(t, new(TOTPVerificationServiceTestSuite))
+}
+
+func (s *TOTPVerificationServiceTestSuite) TestVerify_ValidTOTPCode_RecordsStep() {
+	// Arrange
+	s.totpServiceMock.On("Validate", []byte("secret"), "123456", mock.Anything).Return(int64(42), true)
+	s.totpEnrollmentRepositoryMock.On("UpdateLastUsedStep", mock.Anything, uint64(7), int64(42)).Return(nil)
+
+	// Act
+	err := s.sut.Verify(context.Background(), s.enrollment, "123456")
+
+	// Assert
+	s.Require().NoError(err)
+	s.totpRecoveryCodeRepositoryMock.AssertNotCalled(s.T(), "MarkUsed", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func (s *TOTPVerificationServiceTestSuite) TestVerify_ReusedTOTPCode_ReturnsInvalidCodeError() {
+	// Arrange
+	s.totpServiceMock.On("Validate", []byte("secret"), "123456", mock.Anything).Return(int64(42), true)
+	s.totpEnrollmentRepositoryMock.On("UpdateLastUsedStep", mock.Anything, uint64(7), int64(42)).
+		Return(shared_errs.ErrRecordNotFound)
+	s.totpEnrollmentRepositoryMock.On("RecordFailedAttempt", mock.Anything, uint64(7), 5, mock.Anything).Return(nil)
+
+	// Act
+	err := s.sut.Verify(context.Background(), s.enrollment, "123456")
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidTOTPCode)
+}
+
+func (s *TOTPVerificationServiceTestSuite) TestVerify_UnusedRecoveryCode_MarksItUsed() {
+	// Arrange
+	code := "abcd-efgh-ijkl-mnop"
+	s.totpServiceMock.On("Validate", []byte("secret"), code, mock.Anything).Return(int64(0), false)
+	s.totpServiceMock.On("HashRecoveryCode", code).Return([]byte("code-hash"))
+	s.totpRecoveryCodeRepositoryMock.On("MarkUsed", mock.Anything, uint64(7), []byte("code-hash"), mock.Anything).
+		Return(nil)
+
+	// Act
+	err := s.sut.Verify(context.Background(), s.enrollment, code)
+
+	// Assert
+	s.Require().NoError(err)
+}
+
+func (s *TOTPVerificationServiceTestSuite) TestVerify_UnknownCode_ReturnsInvalidCodeError() {
+	// Arrange
+	s.totpServiceMock.On("Validate", []byte("secret"), "000000", mock.Anything).Return(int64(0), false)
+	s.totpServiceMock.On("HashRecoveryCode", "000000").Return([]byte("code-hash"))
+	s.totpRecoveryCodeRepositoryMock.On("MarkUsed", mock.Anything, uint64(7), []byte("code-hash"), mock.Anything).
+		Return(shared_errs.ErrRecordNotFound)
+	lockedUntil := mock.MatchedBy(func(t time.Time) bool {
+		return t.After(time.Now().Add(14*time.Minute)) && t.Before(time.Now().Add(16*time.Minute))
+	})
+	s.totpEnrollmentRepositoryMock.On("RecordFailedAttempt", mock.Anything, uint64(7), 5, lockedUntil).Return(nil)
+
+	// Act
+	err := s.sut.Verify(context.Background(), s.enrollment, "000000")
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidTOTPCode)
+}
+
+func (s *TOTPVerificationServiceTestSuite) TestVerify_RecordingTheInvalidCodeFails_ReturnsError() {
+	// Arrange
+	recordErr := errors.New("database error")
+	s.totpServiceMock.On("Validate", []byte("secret"), "000000", mock.Anything).Return(int64(0), false)
+	s.totpServiceMock.On("HashRecoveryCode", "000000").Return([]byte("code-hash"))
+	s.totpRecoveryCodeRepositoryMock.On("MarkUsed", mock.Anything, uint64(7), []byte("code-hash"), mock.Anything).
+		Return(shared_errs.ErrRecordNotFound)
+	s.totpEnrollmentRepositoryMock.On("RecordFailedAttempt", mock.Anything, uint64(7), 5, mock.Anything).
+		Return(recordErr)
+
+	// Act
+	err := s.sut.Verify(context.Background(), s.enrollment, "000000")
+
+	// Assert
+	s.Require().ErrorIs(err, recordErr)
+}
+
+func (s *TOTPVerificationServiceTestSuite) TestVerify_LockedCodes_ReturnsLockedErrorWithoutCheckingTheCode() {
+	// Arrange
+	lockedUntil := time.Now().Add(10 * time.Minute)
+	s.enrollment.LockedUntil = &lockedUntil
+
+	// Act
+	err := s.sut.Verify(context.Background(), s.enrollment, "123456")
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrTOTPLocked)
+	s.totpServiceMock.AssertNotCalled(s.T(), "Validate", mock.Anything, mock.Anything, mock.Anything)
+	s.totpEnrollmentRepositoryMock.AssertNotCalled(s.T(), "RecordFailedAttempt", mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything)
+}
+
+func (s *TOTPVerificationServiceTestSuite) TestVerify_ExpiredLockAndValidCode_ResetsFailedAttempts() {
+	// Arrange
+	lockedUntil := time.Now().Add(-time.Minute)
+	s.enrollment.LockedUntil = &lockedUntil
+	s.enrollment.FailedAttempts = 2
+	s.totpServiceMock.On("Validate", []byte("secret"), "123456", mock.Anything).Return(int64(42), true)
+	s.totpEnrollmentRepositoryMock.On("UpdateLastUsedStep", mock.Anything, uint64(7), int64(42)).Return(nil)
+	s.totpEnrollmentRepositoryMock.On("ResetFailedAttempts", mock.Anything, uint64(7)).Return(nil)
+
+	// Act
+	err := s.sut.Verify(context.Background(), s.enrollment, "123456")
+
+	// Assert
+	s.Require().NoError(err)
+}
+
+func (s *TOTPVerificationServiceTestSuite) TestVerify_DecryptionFails_ReturnsError() {
+	// Arrange
+	decryptErr := errors.New("cipher: message authentication failed")
+	enrollment := model.TOTPEnrollmentModel{ID: 1, UserID: 7, SecretEncrypted: []byte("tampered")}
+	s.totpServiceMock.On("DecryptSecret", []byte("tampered")).Return(nil, decryptErr)
+
+	// Act
+	err := s.sut.Verify(context.Background(), enrollment, "123456")
+
+	// Assert
+	s.Require().ErrorIs(err, decryptErr)
+}
@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+)
+
+type APIKeyOutput struct {
+	APIKeyID   uint64
+	Name       string
+	Scope      string
+	KeyPrefix  string
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	CreatedAt  time.Time
+}
+
+func newAPIKeyOutput(apiKey model.APIKeyModel) APIKeyOutput {
+	return APIKeyOutput{
+		APIKeyID:   apiKey.ID,
+		Name:       apiKey.Name,
+		Scope:      apiKey.Scope,
+		KeyPrefix:  apiKey.KeyPrefix,
+		ExpiresAt:  apiKey.ExpiresAt,
+		LastUsedAt: apiKey.LastUsedAt,
+		CreatedAt:  apiKey.CreatedAt,
+	}
+}
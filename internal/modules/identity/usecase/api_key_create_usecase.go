@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/repository"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+)
+
+type APIKeyCreateInput struct {
+	UserID    uint64 `validate:"required"`
+	Name      string `validate:"required,min=3,max=255"`
+	Scope     string `validate:"required"`
+	ExpiresAt *time.Time
+}
+
+// APIKeyCreateOutput carries the key in clear, it is not possible to get it again afterwards.
+type APIKeyCreateOutput struct {
+	APIKeyOutput
+	Key string
+}
+
+type APIKeyCreateUseCase struct {
+	apiKeyService    service.APIKeyServiceI
+	apiKeyRepository repository.APIKeyRepositoryI
+	validate         validator.Validate
+	logger           logger.Logger
+}
+
+func NewAPIKeyCreateUseCase(
+	apiKeyService service.APIKeyServiceI,
+	apiKeyRepository repository.APIKeyRepositoryI,
+	validate validator.Validate,
+	logger logger.Logger,
+) *APIKeyCreateUseCase {
+	return &APIKeyCreateUseCase{
+		apiKeyService:    apiKeyService,
+		apiKeyRepository: apiKeyRepository,
+		validate:         validate,
+		logger:           logger,
+	}
+}
+
+// Execute creates an API key of the user. Keys without an expiration are valid until they are deleted.
+func (uc *APIKeyCreateUseCase) Execute(ctx context.Context, input APIKeyCreateInput) (APIKeyCreateOutput, error) {
+	ctx, span := trace.Span(ctx, "APIKeyCreateUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return APIKeyCreateOutput{}, err
+	}
+
+	scope, err := enum.NewAPIKeyScopeEnum(input.Scope)
+	if err != nil {
+		return APIKeyCreateOutput{}, err
+	}
+
+	var expiresAt *time.Time
+	if input.ExpiresAt != nil {
+		if !input.ExpiresAt.After(time.Now()) {
+			return APIKeyCreateOutput{}, errs.ErrInvalidAPIKeyExpiration
+		}
+		utcExpiresAt := input.ExpiresAt.UTC()
+		expiresAt = &utcExpiresAt
+	}
+
+	generatedAPIKey, err := uc.apiKeyService.Generate()
+	if err != nil {
+		uc.logger.Error().Msgf("error generating API key: %v", err)
+		return APIKeyCreateOutput{}, err
+	}
+
+	apiKey := model.APIKeyModel{
+		UserID:    input.UserID,
+		Name:      input.Name,
+		Scope:     scope.String(),
+		KeyPrefix: generatedAPIKey.KeyPrefix,
+		KeyHash:   generatedAPIKey.KeyHash,
+		ExpiresAt: expiresAt,
+	}
+	apiKey, err = uc.apiKeyRepository.Create(ctx, apiKey)
+	if err != nil {
+		uc.logger.Error().Msgf("error creating API key of user ID %d: %v", input.UserID, err)
+		return APIKeyCreateOutput{}, err
+	}
+
+	output := APIKeyCreateOutput{
+		APIKeyOutput: newAPIKeyOutput(apiKey),
+		Key:          generatedAPIKey.Key,
+	}
+
+	return output, nil
+}
@@ -0,0 +1,135 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/repository/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+	service_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/service/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/usecase"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	shared_validator_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/validator/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type APIKeyCreateUseCaseTestSuite struct {
+	suite.Suite
+	sut                  *usecase.APIKeyCreateUseCase
+	apiKeyServiceMock    *service_mocks.MockAPIKeyServiceI
+	apiKeyRepositoryMock *repository_mocks.MockAPIKeyRepositoryI
+	validatorMock        *shared_validator_mocks.MockValidate
+	logger               logger.Logger
+}
+
+func (s *APIKeyCreateUseCaseTestSuite) SetupTest() {
+	s.apiKeyServiceMock = service_mocks.NewMockAPIKeyServiceI(s.T())
+	s.apiKeyRepositoryMock = repository_mocks.NewMockAPIKeyRepositoryI(s.T())
+	s.validatorMock = shared_validator_mocks.NewMockValidate(s.T())
+	s.logger = logger.New(config.Config{Log: config.Log{LogLevel: "disabled"}})
+
+	s.sut = usecase.NewAPIKeyCreateUseCase(
+		s.apiKeyServiceMock,
+		s.apiKeyRepositoryMock,
+		s.validatorMock,
+		s.logger,
+	)
+}
+
+func TestAPIKeyCreateUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(APIKeyCreateUseCaseTestSuite))
+}
+
+func (s *APIKeyCreateUseCaseTestSuite) TestExecute_ValidInput_StoresHashAndReturnsKeyOnce() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.APIKeyCreateInput{UserID: 5, Name: "ci pipeline", Scope: enum.APIKeyScopeRead}
+	generatedAPIKey := service.GeneratedAPIKey{
+		Key:       "pingo_abc123_secret",
+		KeyPrefix: "pingo_abc123",
+		KeyHash:   []byte("hash"),
+	}
+	expectedAPIKey := model.APIKeyModel{
+		UserID:    input.UserID,
+		Name:      input.Name,
+		Scope:     enum.APIKeyScopeRead,
+		KeyPrefix: generatedAPIKey.KeyPrefix,
+		KeyHash:   generatedAPIKey.KeyHash,
+	}
+	createdAPIKey := expectedAPIKey
+	createdAPIKey.ID = 9
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.apiKeyServiceMock.On("Generate").Return(generatedAPIKey, nil)
+	s.apiKeyRepositoryMock.On("Create", mock.Anything, expectedAPIKey).Return(createdAPIKey, nil)
+
+	// Act
+	output, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(uint64(9), output.APIKeyID)
+	s.Equal(generatedAPIKey.Key, output.Key)
+	s.Equal(generatedAPIKey.KeyPrefix, output.KeyPrefix)
+	s.Nil(output.ExpiresAt)
+}
+
+func (s *APIKeyCreateUseCaseTestSuite) TestExecute_InvalidScope_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.APIKeyCreateInput{UserID: 5, Name: "ci pipeline", Scope: "admin"}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidAPIKeyScope)
+	s.apiKeyServiceMock.AssertNotCalled(s.T(), "Generate")
+}
+
+func (s *APIKeyCreateUseCaseTestSuite) TestExecute_ExpirationInThePast_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	expiresAt := time.Now().Add(-time.Hour)
+	input := usecase.APIKeyCreateInput{
+		UserID:    5,
+		Name:      "ci pipeline",
+		Scope:     enum.APIKeyScopeWrite,
+		ExpiresAt: &expiresAt,
+	}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidAPIKeyExpiration)
+	s.apiKeyServiceMock.AssertNotCalled(s.T(), "Generate")
+}
+
+func (s *APIKeyCreateUseCaseTestSuite) TestExecute_CreateFails_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.APIKeyCreateInput{UserID: 5, Name: "ci pipeline", Scope: enum.APIKeyScopeWrite}
+	createErr := errors.New("database error")
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.apiKeyServiceMock.On("Generate").Return(service.GeneratedAPIKey{KeyPrefix: "pingo_abc123"}, nil)
+	s.apiKeyRepositoryMock.On("Create", mock.Anything, mock.Anything).Return(model.APIKeyModel{}, createErr)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, createErr)
+}
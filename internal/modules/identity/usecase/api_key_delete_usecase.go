@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/repository"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+)
+
+type APIKeyDeleteInput struct {
+	UserID   uint64 `validate:"required"`
+	APIKeyID uint64 `validate:"required"`
+}
+
+type APIKeyDeleteUseCase struct {
+	apiKeyRepository repository.APIKeyRepositoryI
+	validate         validator.Validate
+	logger           logger.Logger
+}
+
+func NewAPIKeyDeleteUseCase(
+	apiKeyRepository repository.APIKeyRepositoryI,
+	validate validator.Validate,
+	logger logger.Logger,
+) *APIKeyDeleteUseCase {
+	return &APIKeyDeleteUseCase{
+		apiKeyRepository: apiKeyRepository,
+		validate:         validate,
+		logger:           logger,
+	}
+}
+
+// Execute deletes an API key of the user, the requests made with it are rejected from then on.
+func (uc *APIKeyDeleteUseCase) Execute(ctx context.Context, input APIKeyDeleteInput) error {
+	ctx, span := trace.Span(ctx, "APIKeyDeleteUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return err
+	}
+
+	err = uc.apiKeyRepository.Delete(ctx, input.UserID, input.APIKeyID)
+	if err != nil {
+		if !errors.Is(err, shared_errs.ErrRecordNotFound) {
+			uc.logger.Error().Msgf("error deleting API key ID %d: %v", input.APIKeyID, err)
+		}
+		return err
+	}
+
+	return nil
+}
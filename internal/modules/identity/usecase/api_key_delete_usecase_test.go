@@ -0,0 +1,82 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/repository/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/usecase"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	shared_validator_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/validator/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type APIKeyDeleteUseCaseTestSuite struct {
+	suite.Suite
+	sut                  *usecase.APIKeyDeleteUseCase
+	apiKeyRepositoryMock *repository_mocks.MockAPIKeyRepositoryI
+	validatorMock        *shared_validator_mocks.MockValidate
+	logger               logger.Logger
+}
+
+func (s *APIKeyDeleteUseCaseTestSuite) SetupTest() {
+	s.apiKeyRepositoryMock = repository_mocks.NewMockAPIKeyRepositoryI(s.T())
+	s.validatorMock = shared_validator_mocks.NewMockValidate(s.T())
+	s.logger = logger.New(config.Config{Log: config.Log{LogLevel: "disabled"}})
+
+	s.sut = usecase.NewAPIKeyDeleteUseCase(s.apiKeyRepositoryMock, s.validatorMock, s.logger)
+}
+
+func TestAPIKeyDeleteUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(APIKeyDeleteUseCaseTestSuite))
+}
+
+func (s *APIKeyDeleteUseCaseTestSuite) TestExecute_OwnKey_DeletesIt() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.APIKeyDeleteInput{UserID: 5, APIKeyID: 9}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.apiKeyRepositoryMock.On("Delete", mock.Anything, uint64(5), uint64(9)).Return(nil)
+
+	// Act
+	err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().NoError(err)
+}
+
+func (s *APIKeyDeleteUseCaseTestSuite) TestExecute_KeyOfAnotherUser_ReturnsNotFound() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.APIKeyDeleteInput{UserID: 5, APIKeyID: 9}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.apiKeyRepositoryMock.On("Delete", mock.Anything, uint64(5), uint64(9)).Return(shared_errs.ErrRecordNotFound)
+
+	// Act
+	err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, shared_errs.ErrRecordNotFound)
+}
+
+func (s *APIKeyDeleteUseCaseTestSuite) TestExecute_ValidationFails_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.APIKeyDeleteInput{UserID: 5}
+	validationErr := errors.New("validation error")
+
+	s.validatorMock.On("Struct", input).Return(validationErr)
+
+	// Act
+	err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, validationErr)
+	s.apiKeyRepositoryMock.AssertNotCalled(s.T(), "Delete", mock.Anything, mock.Anything, mock.Anything)
+}
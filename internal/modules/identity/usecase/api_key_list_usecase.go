@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/repository"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+)
+
+type APIKeyListInput struct {
+	UserID uint64 `validate:"required"`
+}
+
+type APIKeyListOutput struct {
+	APIKeys []APIKeyOutput
+}
+
+type APIKeyListUseCase struct {
+	apiKeyRepository repository.APIKeyRepositoryI
+	validate         validator.Validate
+	logger           logger.Logger
+}
+
+func NewAPIKeyListUseCase(
+	apiKeyRepository repository.APIKeyRepositoryI,
+	validate validator.Validate,
+	logger logger.Logger,
+) *APIKeyListUseCase {
+	return &APIKeyListUseCase{
+		apiKeyRepository: apiKeyRepository,
+		validate:         validate,
+		logger:           logger,
+	}
+}
+
+// Execute lists the API keys of the user, their secrets are never part of the output.
+func (uc *APIKeyListUseCase) Execute(ctx context.Context, input APIKeyListInput) (APIKeyListOutput, error) {
+	ctx, span := trace.Span(ctx, "APIKeyListUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return APIKeyListOutput{}, err
+	}
+
+	apiKeys, err := uc.apiKeyRepository.FindAllByUserID(ctx, input.UserID)
+	if err != nil {
+		uc.logger.Error().Msgf("error finding the API keys of user ID %d: %v", input.UserID, err)
+		return APIKeyListOutput{}, err
+	}
+
+	output := APIKeyListOutput{APIKeys: make([]APIKeyOutput, len(apiKeys))}
+	for i, apiKey := range apiKeys {
+		output.APIKeys[i] = newAPIKeyOutput(apiKey)
+	}
+
+	return output, nil
+}
@@ -15,41 +15,54 @@ import (
 )
 
 type AuthGenerateTokenUseCase struct {
-	oneTimeTokenRepository repository.OneTimeTokenRepositoryI
-	userRepository         repository.UserRepositoryI
-	tokenService           service.TokenServiceI
-	hashService            service.HashServiceI
-	validator              validator.Validate
-	logger                 logger.Logger
+	oneTimeTokenRepository   repository.OneTimeTokenRepositoryI
+	userRepository           repository.UserRepositoryI
+	totpEnrollmentRepository repository.TOTPEnrollmentRepositoryI
+	totpVerificationService  service.TOTPVerificationServiceI
+	loginChallengeService    service.LoginChallengeServiceI
+	sessionService           service.SessionServiceI
+	hashService              service.HashServiceI
+	validator                validator.Validate
+	logger                   logger.Logger
 }
 
 func NewAuthGenerateTokenUseCase(
 	oneTimeTokenRepository repository.OneTimeTokenRepositoryI,
 	userRepository repository.UserRepositoryI,
-	tokenService service.TokenServiceI,
+	totpEnrollmentRepository repository.TOTPEnrollmentRepositoryI,
+	totpVerificationService service.TOTPVerificationServiceI,
+	loginChallengeService service.LoginChallengeServiceI,
+	sessionService service.SessionServiceI,
 	hashService service.HashServiceI,
 	validator validator.Validate,
 	logger logger.Logger,
 ) *AuthGenerateTokenUseCase {
 	return &AuthGenerateTokenUseCase{
-		oneTimeTokenRepository: oneTimeTokenRepository,
-		userRepository:         userRepository,
-		tokenService:           tokenService,
-		hashService:            hashService,
-		validator:              validator,
-		logger:                 logger,
+		oneTimeTokenRepository:   oneTimeTokenRepository,
+		userRepository:           userRepository,
+		totpEnrollmentRepository: totpEnrollmentRepository,
+		totpVerificationService:  totpVerificationService,
+		loginChallengeService:    loginChallengeService,
+		sessionService:           sessionService,
+		hashService:              hashService,
+		validator:                validator,
+		logger:                   logger,
 	}
 }
 
 type GenerateTokenInput struct {
-	UserID uint64 `validate:"required"`
-	Code   string `validate:"required"`
+	UserID         uint64 `validate:"required"`
+	ChallengeToken string `validate:"required"`
+	Code           string `validate:"required"`
 }
 
 type GenerateTokenOutput struct {
-	Token string
+	Token        string
+	RefreshToken string
 }
 
+// Execute completes a login with the challenge of its first step and the emailed code, or the code
+// of the authenticator app when one is enrolled. The challenge can't be used again.
 func (uc *AuthGenerateTokenUseCase) Execute(
 	ctx context.Context,
 	input GenerateTokenInput,
@@ -64,6 +77,12 @@ func (uc *AuthGenerateTokenUseCase) Execute(
 		return output, err
 	}
 
+	// the code is only checked for the user who passed the first step of the login
+	challenge, err := uc.loginChallengeService.Verify(ctx, input.UserID, input.ChallengeToken)
+	if err != nil {
+		return output, err
+	}
+
 	user, err := uc.userRepository.FindByID(ctx, input.UserID)
 	if err != nil {
 		if errors.Is(err, shared_errs.ErrRecordNotFound) {
@@ -77,31 +96,58 @@ func (uc *AuthGenerateTokenUseCase) Execute(
 		return output, errs.ErrUserIsNotActive
 	}
 
+	enrollment, err := uc.totpEnrollmentRepository.FindByUserID(ctx, user.ID)
+	if err != nil && !errors.Is(err, shared_errs.ErrRecordNotFound) {
+		uc.logger.Error().Msgf("error finding the TOTP enrollment of the user %d: %v", input.UserID, err)
+		return output, err
+	}
+
+	// users with an authenticator app log in with its codes or a recovery code instead of an emailed code
+	if enrollment.ConfirmedAt != nil {
+		err = uc.totpVerificationService.Verify(ctx, enrollment, input.Code)
+		if errors.Is(err, errs.ErrInvalidTOTPCode) {
+			return output, errs.ErrInvalidCredentials
+		}
+	} else {
+		err = uc.verifyEmailedCode(ctx, input)
+	}
+	if err != nil {
+		return output, err
+	}
+
+	if err = uc.loginChallengeService.Consume(ctx, challenge); err != nil {
+		return output, err
+	}
+
+	tokens, err := uc.sessionService.Start(ctx, user)
+	if err != nil {
+		return output, err
+	}
+
+	return GenerateTokenOutput{Token: tokens.AccessToken, RefreshToken: tokens.RefreshToken}, nil
+}
+
+func (uc *AuthGenerateTokenUseCase) verifyEmailedCode(ctx context.Context, input GenerateTokenInput) error {
 	loginVerificationType, _ := enum.NewTokenTypeEnum(enum.TokenTypeLoginVerification)
 	oneTimeToken, err := uc.oneTimeTokenRepository.Find(ctx, input.UserID, loginVerificationType)
 	if err != nil {
 		if errors.Is(err, shared_errs.ErrRecordNotFound) {
-			return output, errs.ErrInvalidCredentials
+			return errs.ErrInvalidCredentials
 		}
 		uc.logger.Error().Msgf("error finding one-time token for the user %d: %v", input.UserID, err)
-		return output, err
+		return err
 	}
 
 	err = uc.hashService.CompareHashAndPassword(oneTimeToken.TokenHash, []byte(input.Code))
 	if err != nil {
-		return output, err
+		return err
 	}
 
 	err = uc.oneTimeTokenRepository.Delete(ctx, input.UserID, loginVerificationType)
 	if err != nil {
 		uc.logger.Error().Msgf("error deleting one-time token for the user %d: %v", input.UserID, err)
-		return output, err
-	}
-
-	token, err := uc.tokenService.GenerateJWT(ctx, user)
-	if err != nil {
-		return output, err
+		return err
 	}
 
-	return GenerateTokenOutput{Token: token}, nil
+	return nil
 }
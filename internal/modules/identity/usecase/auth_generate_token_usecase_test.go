@@ -13,6 +13,7 @@ import (
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
 	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/repository/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
 	service_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/service/mocks"
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/usecase"
 	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
@@ -23,14 +24,18 @@ import (
 
 type AuthGenerateTokenUseCaseTestSuite struct {
 	suite.Suite
-	sut                        *usecase.AuthGenerateTokenUseCase
-	oneTimeTokenRepositoryMock *repository_mocks.MockOneTimeTokenRepositoryI
-	userRepositoryMock         *repository_mocks.MockUserRepositoryI
-	validatorMock              *validator_mocks.MockValidate
-	tokenServiceMock           *service_mocks.MockTokenServiceI
-	hashServiceMock            *service_mocks.MockHashServiceI
-	logger                     logger.Logger
-	cfg                        config.Config
+	sut                          *usecase.AuthGenerateTokenUseCase
+	oneTimeTokenRepositoryMock   *repository_mocks.MockOneTimeTokenRepositoryI
+	userRepositoryMock           *repository_mocks.MockUserRepositoryI
+	validatorMock                *validator_mocks.MockValidate
+	sessionServiceMock           *service_mocks.MockSessionServiceI
+	hashServiceMock              *service_mocks.MockHashServiceI
+	totpEnrollmentRepositoryMock *repository_mocks.MockTOTPEnrollmentRepositoryI
+	totpVerificationServiceMock  *service_mocks.MockTOTPVerificationServiceI
+	loginChallengeServiceMock    *service_mocks.MockLoginChallengeServiceI
+	challenge                    model.OneTimeTokenModel
+	logger                       logger.Logger
+	cfg                          config.Config
 }
 
 func (s *AuthGenerateTokenUseCaseTestSuite) SetupTest() {
@@ -53,13 +58,23 @@ func (s *AuthGenerateTokenUseCaseTestSuite) SetupTest() {
 	s.oneTimeTokenRepositoryMock = repository_mocks.NewMockOneTimeTokenRepositoryI(s.T())
 	s.userRepositoryMock = repository_mocks.NewMockUserRepositoryI(s.T())
 	s.validatorMock = validator_mocks.NewMockValidate(s.T())
-	s.tokenServiceMock = service_mocks.NewMockTokenServiceI(s.T())
+	s.sessionServiceMock = service_mocks.NewMockSessionServiceI(s.T())
 	s.hashServiceMock = service_mocks.NewMockHashServiceI(s.T())
+	s.totpEnrollmentRepositoryMock = repository_mocks.NewMockTOTPEnrollmentRepositoryI(s.T())
+	s.totpVerificationServiceMock = service_mocks.NewMockTOTPVerificationServiceI(s.T())
+	s.loginChallengeServiceMock = service_mocks.NewMockLoginChallengeServiceI(s.T())
+
+	s.challenge = model.OneTimeTokenModel{ID: 9, UserID: 123, TokenType: enum.TokenTypeLoginChallenge}
+	s.loginChallengeServiceMock.On("Verify", mock.Anything, uint64(123), "challenge").
+		Return(s.challenge, nil).Maybe()
 
 	s.sut = usecase.NewAuthGenerateTokenUseCase(
 		s.oneTimeTokenRepositoryMock,
 		s.userRepositoryMock,
-		s.tokenServiceMock,
+		s.totpEnrollmentRepositoryMock,
+		s.totpVerificationServiceMock,
+		s.loginChallengeServiceMock,
+		s.sessionServiceMock,
 		s.hashServiceMock,
 		s.validatorMock,
 		s.logger,
@@ -79,8 +94,9 @@ func (s *AuthGenerateTokenUseCaseTestSuite) TestExecute_ValidInput_ReturnsToken(
 	hashedCode := []byte("hashed-code")
 
 	input := usecase.GenerateTokenInput{
-		UserID: userID,
-		Code:   code,
+		UserID:         userID,
+		ChallengeToken: "challenge",
+		Code:           code,
 	}
 
 	user := model.UserModel{
@@ -101,12 +117,16 @@ func (s *AuthGenerateTokenUseCaseTestSuite) TestExecute_ValidInput_ReturnsToken(
 
 	s.validatorMock.On("Struct", input).Return(nil)
 	s.userRepositoryMock.On("FindByID", mock.Anything, userID).Return(user, nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, userID).
+		Return(model.TOTPEnrollmentModel{}, shared_errs.ErrRecordNotFound)
 	s.oneTimeTokenRepositoryMock.On("Find", mock.Anything, userID, loginVerificationType).
 		Return(oneTimeToken, nil)
 	s.hashServiceMock.On("CompareHashAndPassword", hashedCode, []byte(code)).Return(nil)
 	s.oneTimeTokenRepositoryMock.On("Delete", mock.Anything, userID, loginVerificationType).
 		Return(nil)
-	s.tokenServiceMock.On("GenerateJWT", mock.Anything, user).Return(token, nil)
+	s.loginChallengeServiceMock.On("Consume", mock.Anything, s.challenge).Return(nil)
+	s.sessionServiceMock.On("Start", mock.Anything, user).
+		Return(service.SessionTokens{AccessToken: token, RefreshToken: "refresh-token"}, nil)
 
 	// Act
 	result, err := s.sut.Execute(ctx, input)
@@ -114,6 +134,7 @@ func (s *AuthGenerateTokenUseCaseTestSuite) TestExecute_ValidInput_ReturnsToken(
 	// Assert
 	s.Require().NoError(err)
 	s.Equal(token, result.Token)
+	s.Equal("refresh-token", result.RefreshToken)
 }
 
 func (s *AuthGenerateTokenUseCaseTestSuite) TestExecute_ValidationFails_ReturnsError() {
@@ -144,8 +165,9 @@ func (s *AuthGenerateTokenUseCaseTestSuite) TestExecute_UserNotFound_ReturnsInva
 	code := "123456"
 
 	input := usecase.GenerateTokenInput{
-		UserID: userID,
-		Code:   code,
+		UserID:         userID,
+		ChallengeToken: "challenge",
+		Code:           code,
 	}
 
 	s.validatorMock.On("Struct", input).Return(nil)
@@ -168,8 +190,9 @@ func (s *AuthGenerateTokenUseCaseTestSuite) TestExecute_UserRepositoryError_Retu
 	repositoryError := errors.New("database error")
 
 	input := usecase.GenerateTokenInput{
-		UserID: userID,
-		Code:   code,
+		UserID:         userID,
+		ChallengeToken: "challenge",
+		Code:           code,
 	}
 
 	s.validatorMock.On("Struct", input).Return(nil)
@@ -191,8 +214,9 @@ func (s *AuthGenerateTokenUseCaseTestSuite) TestExecute_UserNotActive_ReturnsUse
 	code := "123456"
 
 	input := usecase.GenerateTokenInput{
-		UserID: userID,
-		Code:   code,
+		UserID:         userID,
+		ChallengeToken: "challenge",
+		Code:           code,
 	}
 
 	user := model.UserModel{
@@ -219,8 +243,9 @@ func (s *AuthGenerateTokenUseCaseTestSuite) TestExecute_OneTimeTokenNotFound_Ret
 	code := "123456"
 
 	input := usecase.GenerateTokenInput{
-		UserID: userID,
-		Code:   code,
+		UserID:         userID,
+		ChallengeToken: "challenge",
+		Code:           code,
 	}
 
 	user := model.UserModel{
@@ -233,6 +258,8 @@ func (s *AuthGenerateTokenUseCaseTestSuite) TestExecute_OneTimeTokenNotFound_Ret
 
 	s.validatorMock.On("Struct", input).Return(nil)
 	s.userRepositoryMock.On("FindByID", mock.Anything, userID).Return(user, nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, userID).
+		Return(model.TOTPEnrollmentModel{}, shared_errs.ErrRecordNotFound)
 	s.oneTimeTokenRepositoryMock.On("Find", mock.Anything, userID, loginVerificationType).
 		Return(model.OneTimeTokenModel{}, shared_errs.ErrRecordNotFound)
 
@@ -252,8 +279,9 @@ func (s *AuthGenerateTokenUseCaseTestSuite) TestExecute_OneTimeTokenRepositoryEr
 	repositoryError := errors.New("database error")
 
 	input := usecase.GenerateTokenInput{
-		UserID: userID,
-		Code:   code,
+		UserID:         userID,
+		ChallengeToken: "challenge",
+		Code:           code,
 	}
 
 	user := model.UserModel{
@@ -266,6 +294,8 @@ func (s *AuthGenerateTokenUseCaseTestSuite) TestExecute_OneTimeTokenRepositoryEr
 
 	s.validatorMock.On("Struct", input).Return(nil)
 	s.userRepositoryMock.On("FindByID", mock.Anything, userID).Return(user, nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, userID).
+		Return(model.TOTPEnrollmentModel{}, shared_errs.ErrRecordNotFound)
 	s.oneTimeTokenRepositoryMock.On("Find", mock.Anything, userID, loginVerificationType).
 		Return(model.OneTimeTokenModel{}, repositoryError)
 
@@ -287,8 +317,9 @@ func (s *AuthGenerateTokenUseCaseTestSuite) TestExecute_HashComparisonFails_Retu
 	hashError := errors.New("hash comparison failed")
 
 	input := usecase.GenerateTokenInput{
-		UserID: userID,
-		Code:   code,
+		UserID:         userID,
+		ChallengeToken: "challenge",
+		Code:           code,
 	}
 
 	user := model.UserModel{
@@ -309,6 +340,8 @@ func (s *AuthGenerateTokenUseCaseTestSuite) TestExecute_HashComparisonFails_Retu
 
 	s.validatorMock.On("Struct", input).Return(nil)
 	s.userRepositoryMock.On("FindByID", mock.Anything, userID).Return(user, nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, userID).
+		Return(model.TOTPEnrollmentModel{}, shared_errs.ErrRecordNotFound)
 	s.oneTimeTokenRepositoryMock.On("Find", mock.Anything, userID, loginVerificationType).
 		Return(oneTimeToken, nil)
 	s.hashServiceMock.On("CompareHashAndPassword", hashedCode, []byte(code)).Return(hashError)
@@ -331,8 +364,9 @@ func (s *AuthGenerateTokenUseCaseTestSuite) TestExecute_TokenGenerationFails_Ret
 	tokenError := errors.New("token generation error")
 
 	input := usecase.GenerateTokenInput{
-		UserID: userID,
-		Code:   code,
+		UserID:         userID,
+		ChallengeToken: "challenge",
+		Code:           code,
 	}
 
 	user := model.UserModel{
@@ -353,12 +387,15 @@ func (s *AuthGenerateTokenUseCaseTestSuite) TestExecute_TokenGenerationFails_Ret
 
 	s.validatorMock.On("Struct", input).Return(nil)
 	s.userRepositoryMock.On("FindByID", mock.Anything, userID).Return(user, nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, userID).
+		Return(model.TOTPEnrollmentModel{}, shared_errs.ErrRecordNotFound)
 	s.oneTimeTokenRepositoryMock.On("Find", mock.Anything, userID, loginVerificationType).
 		Return(oneTimeToken, nil)
 	s.hashServiceMock.On("CompareHashAndPassword", hashedCode, []byte(code)).Return(nil)
 	s.oneTimeTokenRepositoryMock.On("Delete", mock.Anything, userID, loginVerificationType).
 		Return(nil)
-	s.tokenServiceMock.On("GenerateJWT", mock.Anything, user).Return("", tokenError)
+	s.loginChallengeServiceMock.On("Consume", mock.Anything, s.challenge).Return(nil)
+	s.sessionServiceMock.On("Start", mock.Anything, user).Return(service.SessionTokens{}, tokenError)
 
 	// Act
 	result, err := s.sut.Execute(ctx, input)
@@ -368,3 +405,198 @@ func (s *AuthGenerateTokenUseCaseTestSuite) TestExecute_TokenGenerationFails_Ret
 	s.Equal(tokenError, err)
 	s.Empty(result.Token)
 }
+
+func (s *AuthGenerateTokenUseCaseTestSuite) TestExecute_TOTPEnrolled_ValidCode_ReturnsToken() {
+	// Arrange
+	ctx := context.Background()
+	userID := uint64(123)
+	code := "287082"
+	confirmedAt := time.Now()
+
+	input := usecase.GenerateTokenInput{
+		UserID:         userID,
+		ChallengeToken: "challenge",
+		Code:           code,
+	}
+
+	user := model.UserModel{
+		ID:     userID,
+		Status: enum.UserStatusActive,
+		Email:  "test@example.com",
+	}
+
+	enrollment := model.TOTPEnrollmentModel{
+		ID:          1,
+		UserID:      userID,
+		ConfirmedAt: &confirmedAt,
+	}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.userRepositoryMock.On("FindByID", mock.Anything, userID).Return(user, nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, userID).Return(enrollment, nil)
+	s.totpVerificationServiceMock.On("Verify", mock.Anything, enrollment, code).Return(nil)
+	s.loginChallengeServiceMock.On("Consume", mock.Anything, s.challenge).Return(nil)
+	s.sessionServiceMock.On("Start", mock.Anything, user).
+		Return(service.SessionTokens{AccessToken: "jwt-token", RefreshToken: "refresh-token"}, nil)
+
+	// Act
+	result, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal("jwt-token", result.Token)
+	s.Equal("refresh-token", result.RefreshToken)
+	s.oneTimeTokenRepositoryMock.AssertNotCalled(s.T(), "Find", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *AuthGenerateTokenUseCaseTestSuite) TestExecute_TOTPEnrolled_InvalidCode_ReturnsInvalidCredentialsError() {
+	// Arrange
+	ctx := context.Background()
+	userID := uint64(123)
+	code := "000000"
+	confirmedAt := time.Now()
+
+	input := usecase.GenerateTokenInput{
+		UserID:         userID,
+		ChallengeToken: "challenge",
+		Code:           code,
+	}
+
+	user := model.UserModel{
+		ID:     userID,
+		Status: enum.UserStatusActive,
+		Email:  "test@example.com",
+	}
+
+	enrollment := model.TOTPEnrollmentModel{
+		ID:          1,
+		UserID:      userID,
+		ConfirmedAt: &confirmedAt,
+	}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.userRepositoryMock.On("FindByID", mock.Anything, userID).Return(user, nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, userID).Return(enrollment, nil)
+	s.totpVerificationServiceMock.On("Verify", mock.Anything, enrollment, code).Return(errs.ErrInvalidTOTPCode)
+
+	// Act
+	result, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidCredentials)
+	s.Empty(result.Token)
+	s.sessionServiceMock.AssertNotCalled(s.T(), "Start", mock.Anything, mock.Anything)
+}
+
+func (s *AuthGenerateTokenUseCaseTestSuite) TestExecute_TOTPLocked_ReturnsLockedErrorAndKeepsChallenge() {
+	// Arrange
+	ctx := context.Background()
+	userID := uint64(123)
+	confirmedAt := time.Now()
+	lockedUntil := time.Now().Add(10 * time.Minute)
+
+	input := usecase.GenerateTokenInput{
+		UserID:         userID,
+		ChallengeToken: "challenge",
+		Code:           "123456",
+	}
+	user := model.UserModel{ID: userID, Status: enum.UserStatusActive}
+	enrollment := model.TOTPEnrollmentModel{ID: 1, UserID: userID, ConfirmedAt: &confirmedAt, LockedUntil: &lockedUntil}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.userRepositoryMock.On("FindByID", mock.Anything, userID).Return(user, nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, userID).Return(enrollment, nil)
+	s.totpVerificationServiceMock.On("Verify", mock.Anything, enrollment, input.Code).Return(errs.ErrTOTPLocked)
+
+	// Act
+	result, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrTOTPLocked)
+	s.Empty(result.Token)
+	s.loginChallengeServiceMock.AssertNotCalled(s.T(), "Consume", mock.Anything, mock.Anything)
+}
+
+func (s *AuthGenerateTokenUseCaseTestSuite) TestExecute_TOTPEnrollmentRepositoryError_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	userID := uint64(123)
+	repositoryError := errors.New("database error")
+
+	input := usecase.GenerateTokenInput{
+		UserID:         userID,
+		ChallengeToken: "challenge",
+		Code:           "123456",
+	}
+
+	user := model.UserModel{
+		ID:     userID,
+		Status: enum.UserStatusActive,
+		Email:  "test@example.com",
+	}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.userRepositoryMock.On("FindByID", mock.Anything, userID).Return(user, nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, userID).
+		Return(model.TOTPEnrollmentModel{}, repositoryError)
+
+	// Act
+	result, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, repositoryError)
+	s.Empty(result.Token)
+}
+
+func (s *AuthGenerateTokenUseCaseTestSuite) TestExecute_InvalidChallenge_ReturnsInvalidLoginChallengeError() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.GenerateTokenInput{
+		UserID:         uint64(123),
+		ChallengeToken: "another-challenge",
+		Code:           "123456",
+	}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.loginChallengeServiceMock.On("Verify", mock.Anything, input.UserID, input.ChallengeToken).
+		Return(model.OneTimeTokenModel{}, errs.ErrInvalidLoginChallenge)
+
+	// Act
+	result, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidLoginChallenge)
+	s.Empty(result.Token)
+	s.userRepositoryMock.AssertNotCalled(s.T(), "FindByID", mock.Anything, mock.Anything)
+	s.totpVerificationServiceMock.AssertNotCalled(s.T(), "Verify", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *AuthGenerateTokenUseCaseTestSuite) TestExecute_ChallengeAlreadyUsed_ReturnsInvalidLoginChallengeError() {
+	// Arrange
+	ctx := context.Background()
+	userID := uint64(123)
+	code := "287082"
+	confirmedAt := time.Now()
+
+	input := usecase.GenerateTokenInput{
+		UserID:         userID,
+		ChallengeToken: "challenge",
+		Code:           code,
+	}
+	user := model.UserModel{ID: userID, Status: enum.UserStatusActive}
+	enrollment := model.TOTPEnrollmentModel{ID: 1, UserID: userID, ConfirmedAt: &confirmedAt}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.userRepositoryMock.On("FindByID", mock.Anything, userID).Return(user, nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, userID).Return(enrollment, nil)
+	s.totpVerificationServiceMock.On("Verify", mock.Anything, enrollment, code).Return(nil)
+	s.loginChallengeServiceMock.On("Consume", mock.Anything, s.challenge).Return(errs.ErrInvalidLoginChallenge)
+
+	// Act
+	result, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidLoginChallenge)
+	s.Empty(result.Token)
+	s.sessionServiceMock.AssertNotCalled(s.T(), "Start", mock.Anything, mock.Anything)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/repository"
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
 	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
 
@@ -24,26 +25,40 @@ type AuthLoginInput struct {
 
 type AuthLoginOutput struct {
 	UserID uint64
+	// TOTPRequired tells that the code of the next step comes from the authenticator app of the user,
+	// no code is emailed.
+	TOTPRequired bool
+	// ChallengeToken goes along with the code of the next step, it binds the code to this login.
+	ChallengeToken string
 }
 
 type AuthLoginUseCase struct {
+	cfg                       config.Config
 	userAuthenticatedProducer producer.UserAuthenticatedProducerI
 	userRepository            repository.UserRepositoryI
+	totpEnrollmentRepository  repository.TOTPEnrollmentRepositoryI
+	loginChallengeService     service.LoginChallengeServiceI
 	hashService               service.HashServiceI
 	validator                 validator.Validate
 	logger                    logger.Logger
 }
 
 func NewAuthLoginUseCase(
+	cfg config.Config,
 	userAuthenticatedProducer producer.UserAuthenticatedProducerI,
 	userRepository repository.UserRepositoryI,
+	totpEnrollmentRepository repository.TOTPEnrollmentRepositoryI,
+	loginChallengeService service.LoginChallengeServiceI,
 	validator validator.Validate,
 	hashService service.HashServiceI,
 	logger logger.Logger,
 ) *AuthLoginUseCase {
 	return &AuthLoginUseCase{
+		cfg:                       cfg,
 		userAuthenticatedProducer: userAuthenticatedProducer,
 		userRepository:            userRepository,
+		totpEnrollmentRepository:  totpEnrollmentRepository,
+		loginChallengeService:     loginChallengeService,
 		validator:                 validator,
 		hashService:               hashService,
 		logger:                    logger,
@@ -53,6 +68,11 @@ func NewAuthLoginUseCase(
 func (u *AuthLoginUseCase) Execute(ctx context.Context, input AuthLoginInput) (AuthLoginOutput, error) {
 	ctx, span := trace.Span(ctx, "AuthLoginUseCase.Execute")
 	defer span.End()
+
+	if !u.cfg.Auth.PasswordLoginEnabled() {
+		return AuthLoginOutput{}, errs.ErrPasswordLoginDisabled
+	}
+
 	if err := u.validator.Struct(input); err != nil {
 		return AuthLoginOutput{}, err
 	}
@@ -75,6 +95,21 @@ func (u *AuthLoginUseCase) Execute(ctx context.Context, input AuthLoginInput) (A
 		return AuthLoginOutput{}, errs.ErrInvalidCredentials
 	}
 
+	enrollment, err := u.totpEnrollmentRepository.FindByUserID(ctx, user.ID)
+	if err != nil && !errors.Is(err, shared_errs.ErrRecordNotFound) {
+		u.logger.Error().Msgf("error finding the TOTP enrollment of user id: %v, error: %v", user.ID, err)
+		return AuthLoginOutput{}, err
+	}
+
+	challengeToken, err := u.loginChallengeService.Issue(ctx, user.ID)
+	if err != nil {
+		return AuthLoginOutput{}, err
+	}
+
+	if enrollment.ConfirmedAt != nil {
+		return AuthLoginOutput{UserID: user.ID, TOTPRequired: true, ChallengeToken: challengeToken}, nil
+	}
+
 	message := event.UserAuthenticatedMessage{UserID: user.ID}
 	err = u.userAuthenticatedProducer.Produce(ctx, message)
 	if err != nil {
@@ -82,5 +117,5 @@ func (u *AuthLoginUseCase) Execute(ctx context.Context, input AuthLoginInput) (A
 		return AuthLoginOutput{}, err
 	}
 
-	return AuthLoginOutput{UserID: user.ID}, nil
+	return AuthLoginOutput{UserID: user.ID, ChallengeToken: challengeToken}, nil
 }
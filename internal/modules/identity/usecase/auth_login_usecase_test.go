@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
@@ -28,6 +29,8 @@ type AuthLoginUseCaseTestSuite struct {
 	userRepositoryMock            *repository_mocks.MockUserRepositoryI
 	hashServiceMock               *service_mocks.MockHashServiceI
 	validatorMock                 *shared_validator_mocks.MockValidate
+	totpEnrollmentRepositoryMock  *repository_mocks.MockTOTPEnrollmentRepositoryI
+	loginChallengeServiceMock     *service_mocks.MockLoginChallengeServiceI
 	logger                        logger.Logger
 	cfg                           config.Config
 }
@@ -53,10 +56,15 @@ func (s *AuthLoginUseCaseTestSuite) SetupTest() {
 	s.userRepositoryMock = repository_mocks.NewMockUserRepositoryI(s.T())
 	s.hashServiceMock = service_mocks.NewMockHashServiceI(s.T())
 	s.validatorMock = shared_validator_mocks.NewMockValidate(s.T())
+	s.totpEnrollmentRepositoryMock = repository_mocks.NewMockTOTPEnrollmentRepositoryI(s.T())
+	s.loginChallengeServiceMock = service_mocks.NewMockLoginChallengeServiceI(s.T())
 
 	s.sut = usecase.NewAuthLoginUseCase(
+		s.cfg,
 		s.userAuthenticatedProducerMock,
 		s.userRepositoryMock,
+		s.totpEnrollmentRepositoryMock,
+		s.loginChallengeServiceMock,
 		s.validatorMock,
 		s.hashServiceMock,
 		s.logger,
@@ -87,6 +95,9 @@ func (s *AuthLoginUseCaseTestSuite) TestExecute_ValidCredentials_ReturnsSuccess(
 	s.validatorMock.On("Struct", input).Return(nil)
 	s.userRepositoryMock.On("FindByEmail", mock.Anything, input.Email).Return(user, nil)
 	s.hashServiceMock.On("CompareHashAndPassword", user.PasswordHash, []byte(input.Password)).Return(nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, user.ID).
+		Return(model.TOTPEnrollmentModel{}, shared_errs.ErrRecordNotFound)
+	s.loginChallengeServiceMock.On("Issue", mock.Anything, user.ID).Return("challenge", nil)
 	s.userAuthenticatedProducerMock.On("Produce", mock.Anything, message).Return(nil)
 
 	// Act
@@ -95,6 +106,8 @@ func (s *AuthLoginUseCaseTestSuite) TestExecute_ValidCredentials_ReturnsSuccess(
 	// Assert
 	s.Require().NoError(err)
 	s.Equal(user.ID, output.UserID)
+	s.Equal("challenge", output.ChallengeToken)
+	s.False(output.TOTPRequired)
 }
 
 func (s *AuthLoginUseCaseTestSuite) TestExecute_ValidationFails_ReturnsError() {
@@ -216,6 +229,8 @@ func (s *AuthLoginUseCaseTestSuite) TestExecute_InvalidPassword_ReturnsError() {
 	s.Require().Error(err)
 	s.Equal(errs.ErrInvalidCredentials, err)
 	s.Equal(uint64(0), output.UserID)
+	s.Empty(output.ChallengeToken)
+	s.loginChallengeServiceMock.AssertNotCalled(s.T(), "Issue", mock.Anything, mock.Anything)
 }
 
 func (s *AuthLoginUseCaseTestSuite) TestExecute_ProducerFails_ReturnsError() {
@@ -239,6 +254,9 @@ func (s *AuthLoginUseCaseTestSuite) TestExecute_ProducerFails_ReturnsError() {
 	s.validatorMock.On("Struct", input).Return(nil)
 	s.userRepositoryMock.On("FindByEmail", mock.Anything, input.Email).Return(user, nil)
 	s.hashServiceMock.On("CompareHashAndPassword", user.PasswordHash, []byte(input.Password)).Return(nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, user.ID).
+		Return(model.TOTPEnrollmentModel{}, shared_errs.ErrRecordNotFound)
+	s.loginChallengeServiceMock.On("Issue", mock.Anything, user.ID).Return("challenge", nil)
 	s.userAuthenticatedProducerMock.On("Produce", mock.Anything, message).Return(producerError)
 
 	// Act
@@ -249,3 +267,66 @@ func (s *AuthLoginUseCaseTestSuite) TestExecute_ProducerFails_ReturnsError() {
 	s.Equal(producerError, err)
 	s.Equal(uint64(0), output.UserID)
 }
+
+func (s *AuthLoginUseCaseTestSuite) TestExecute_PasswordLoginDisabled_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	s.cfg.Auth.LoginMethod = config.AuthLoginMethodMagicLink
+	sut := usecase.NewAuthLoginUseCase(
+		s.cfg,
+		s.userAuthenticatedProducerMock,
+		s.userRepositoryMock,
+		s.totpEnrollmentRepositoryMock,
+		s.loginChallengeServiceMock,
+		s.validatorMock,
+		s.hashServiceMock,
+		s.logger,
+	)
+	input := usecase.AuthLoginInput{
+		Email:    "test@example.com",
+		Password: "password123",
+	}
+
+	// Act
+	output, err := sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrPasswordLoginDisabled)
+	s.Equal(uint64(0), output.UserID)
+	s.userRepositoryMock.AssertNotCalled(s.T(), "FindByEmail", mock.Anything, mock.Anything)
+}
+
+func (s *AuthLoginUseCaseTestSuite) TestExecute_TOTPEnrolled_ReturnsTOTPRequired() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.AuthLoginInput{
+		Email:    "test@example.com",
+		Password: "password123",
+	}
+
+	user := model.UserModel{
+		ID:           uint64(123),
+		Email:        input.Email,
+		PasswordHash: []byte("hashed-password"),
+		Status:       enum.UserStatusActive,
+	}
+
+	confirmedAt := time.Now()
+	enrollment := model.TOTPEnrollmentModel{ID: 1, UserID: user.ID, ConfirmedAt: &confirmedAt}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.userRepositoryMock.On("FindByEmail", mock.Anything, input.Email).Return(user, nil)
+	s.hashServiceMock.On("CompareHashAndPassword", user.PasswordHash, []byte(input.Password)).Return(nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, user.ID).Return(enrollment, nil)
+	s.loginChallengeServiceMock.On("Issue", mock.Anything, user.ID).Return("challenge", nil)
+
+	// Act
+	output, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(user.ID, output.UserID)
+	s.True(output.TOTPRequired)
+	s.Equal("challenge", output.ChallengeToken)
+	s.userAuthenticatedProducerMock.AssertNotCalled(s.T(), "Produce", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+)
+
+type AuthLogoutInput struct {
+	UserID    uint64 `validate:"required"`
+	SessionID uint64 `validate:"required"`
+	// AllSessions logs the user out of every device instead of only the current session.
+	AllSessions bool
+}
+
+type AuthLogoutUseCase struct {
+	sessionService service.SessionServiceI
+	validator      validator.Validate
+	logger         logger.Logger
+}
+
+func NewAuthLogoutUseCase(
+	sessionService service.SessionServiceI,
+	validator validator.Validate,
+	logger logger.Logger,
+) *AuthLogoutUseCase {
+	return &AuthLogoutUseCase{
+		sessionService: sessionService,
+		validator:      validator,
+		logger:         logger,
+	}
+}
+
+// Execute revokes the session, the access and refresh tokens issued for it stop working immediately.
+// API keys are not sessions and are left untouched.
+func (uc *AuthLogoutUseCase) Execute(ctx context.Context, input AuthLogoutInput) error {
+	ctx, span := trace.Span(ctx, "AuthLogoutUseCase.Execute")
+	defer span.End()
+
+	err := uc.validator.Struct(input)
+	if err != nil {
+		return err
+	}
+
+	if input.AllSessions {
+		return uc.sessionService.RevokeAll(ctx, input.UserID)
+	}
+
+	return uc.sessionService.Revoke(ctx, input.UserID, input.SessionID)
+}
@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/repository"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+)
+
+type AuthMagicLinkLoginInput struct {
+	UserID uint64 `validate:"required"`
+	Token  string `validate:"required"`
+}
+
+// AuthMagicLinkLoginOutput has the tokens of the new session, unless the user has an authenticator
+// app: then TOTPRequired is set and the login goes on with ChallengeToken and a code of the app.
+type AuthMagicLinkLoginOutput struct {
+	Token          string
+	RefreshToken   string
+	UserID         uint64
+	TOTPRequired   bool
+	ChallengeToken string
+}
+
+type AuthMagicLinkLoginUseCase struct {
+	cfg                      config.Config
+	oneTimeLinkService       service.OneTimeLinkServiceI
+	oneTimeTokenRepository   repository.OneTimeTokenRepositoryI
+	totpEnrollmentRepository repository.TOTPEnrollmentRepositoryI
+	loginChallengeService    service.LoginChallengeServiceI
+	sessionService           service.SessionServiceI
+	validator                validator.Validate
+	logger                   logger.Logger
+}
+
+func NewAuthMagicLinkLoginUseCase(
+	cfg config.Config,
+	oneTimeLinkService service.OneTimeLinkServiceI,
+	oneTimeTokenRepository repository.OneTimeTokenRepositoryI,
+	totpEnrollmentRepository repository.TOTPEnrollmentRepositoryI,
+	loginChallengeService service.LoginChallengeServiceI,
+	sessionService service.SessionServiceI,
+	validator validator.Validate,
+	logger logger.Logger,
+) *AuthMagicLinkLoginUseCase {
+	return &AuthMagicLinkLoginUseCase{
+		cfg:                      cfg,
+		oneTimeLinkService:       oneTimeLinkService,
+		oneTimeTokenRepository:   oneTimeTokenRepository,
+		totpEnrollmentRepository: totpEnrollmentRepository,
+		loginChallengeService:    loginChallengeService,
+		sessionService:           sessionService,
+		validator:                validator,
+		logger:                   logger,
+	}
+}
+
+// Execute exchanges the token of a magic link for a new session, the link can't be used again.
+// Users with an authenticator app get a login challenge instead, the link only replaces the password.
+func (uc *AuthMagicLinkLoginUseCase) Execute(
+	ctx context.Context,
+	input AuthMagicLinkLoginInput,
+) (AuthMagicLinkLoginOutput, error) {
+	ctx, span := trace.Span(ctx, "AuthMagicLinkLoginUseCase.Execute")
+	defer span.End()
+
+	if !uc.cfg.Auth.MagicLinkLoginEnabled() {
+		return AuthMagicLinkLoginOutput{}, errs.ErrMagicLinkLoginDisabled
+	}
+
+	if err := uc.validator.Struct(input); err != nil {
+		return AuthMagicLinkLoginOutput{}, err
+	}
+
+	user, oneTimeToken, err := uc.oneTimeLinkService.Verify(ctx, service.MagicLink, input.UserID, input.Token)
+	if err != nil {
+		return AuthMagicLinkLoginOutput{}, err
+	}
+
+	// deleting by ID lets only one of two concurrent requests with the same link through
+	err = uc.oneTimeTokenRepository.DeleteByID(ctx, user.ID, oneTimeToken.ID)
+	if err != nil {
+		if errors.Is(err, shared_errs.ErrRecordNotFound) {
+			return AuthMagicLinkLoginOutput{}, errs.ErrInvalidMagicLinkToken
+		}
+		uc.logger.Error().Msgf("error deleting magic link token for user ID %d: %v", user.ID, err)
+		return AuthMagicLinkLoginOutput{}, err
+	}
+
+	enrollment, err := uc.totpEnrollmentRepository.FindByUserID(ctx, user.ID)
+	if err != nil && !errors.Is(err, shared_errs.ErrRecordNotFound) {
+		uc.logger.Error().Msgf("error finding the TOTP enrollment of user ID %d: %v", user.ID, err)
+		return AuthMagicLinkLoginOutput{}, err
+	}
+
+	if enrollment.ConfirmedAt != nil {
+		challengeToken, challengeErr := uc.loginChallengeService.Issue(ctx, user.ID)
+		if challengeErr != nil {
+			return AuthMagicLinkLoginOutput{}, challengeErr
+		}
+		return AuthMagicLinkLoginOutput{UserID: user.ID, TOTPRequired: true, ChallengeToken: challengeToken}, nil
+	}
+
+	tokens, err := uc.sessionService.Start(ctx, user)
+	if err != nil {
+		return AuthMagicLinkLoginOutput{}, err
+	}
+
+	return AuthMagicLinkLoginOutput{
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		UserID:       user.ID,
+	}, nil
+}
@@ -0,0 +1,176 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/repository/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+	service_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/service/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/usecase"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	shared_validator_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/validator/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AuthMagicLinkLoginUseCaseTestSuite struct {
+	suite.Suite
+	sut                          *usecase.AuthMagicLinkLoginUseCase
+	oneTimeLinkServiceMock       *service_mocks.MockOneTimeLinkServiceI
+	oneTimeTokenRepositoryMock   *repository_mocks.MockOneTimeTokenRepositoryI
+	totpEnrollmentRepositoryMock *repository_mocks.MockTOTPEnrollmentRepositoryI
+	loginChallengeServiceMock    *service_mocks.MockLoginChallengeServiceI
+	sessionServiceMock           *service_mocks.MockSessionServiceI
+	validatorMock                *shared_validator_mocks.MockValidate
+	cfg                          config.Config
+}
+
+func (s *AuthMagicLinkLoginUseCaseTestSuite) SetupTest() {
+	s.oneTimeLinkServiceMock = service_mocks.NewMockOneTimeLinkServiceI(s.T())
+	s.oneTimeTokenRepositoryMock = repository_mocks.NewMockOneTimeTokenRepositoryI(s.T())
+	s.totpEnrollmentRepositoryMock = repository_mocks.NewMockTOTPEnrollmentRepositoryI(s.T())
+	s.loginChallengeServiceMock = service_mocks.NewMockLoginChallengeServiceI(s.T())
+	s.sessionServiceMock = service_mocks.NewMockSessionServiceI(s.T())
+	s.validatorMock = shared_validator_mocks.NewMockValidate(s.T())
+
+	s.cfg = config.Config{
+		Auth: config.Auth{LoginMethod: config.AuthLoginMethodMagicLink},
+		Log:  config.Log{LogLevel: "disabled"},
+	}
+
+	s.sut = s.newSut(s.cfg)
+}
+
+func TestAuthMagicLinkLoginUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(AuthMagicLinkLoginUseCaseTestSuite))
+}
+
+func (s *AuthMagicLinkLoginUseCaseTestSuite) newSut(cfg config.Config) *usecase.AuthMagicLinkLoginUseCase {
+	return usecase.NewAuthMagicLinkLoginUseCase(
+		cfg,
+		s.oneTimeLinkServiceMock,
+		s.oneTimeTokenRepositoryMock,
+		s.totpEnrollmentRepositoryMock,
+		s.loginChallengeServiceMock,
+		s.sessionServiceMock,
+		s.validatorMock,
+		logger.New(cfg),
+	)
+}
+
+func (s *AuthMagicLinkLoginUseCaseTestSuite) magicLinkToken() model.OneTimeTokenModel {
+	return model.OneTimeTokenModel{ID: 3, UserID: 7, TokenType: enum.TokenTypeMagicLink}
+}
+
+func (s *AuthMagicLinkLoginUseCaseTestSuite) TestExecute_ValidToken_StartsSession() {
+	// Arrange
+	token := []byte("random-token")
+	input := usecase.AuthMagicLinkLoginInput{UserID: 7, Token: base64.StdEncoding.EncodeToString(token)}
+	user := model.UserModel{ID: 7, Status: enum.UserStatusActive}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.oneTimeLinkServiceMock.On("Verify", mock.Anything, service.MagicLink, input.UserID, input.Token).
+		Return(user, s.magicLinkToken(), nil)
+	s.oneTimeTokenRepositoryMock.On("DeleteByID", mock.Anything, user.ID, uint64(3)).Return(nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, user.ID).
+		Return(model.TOTPEnrollmentModel{}, shared_errs.ErrRecordNotFound)
+	s.sessionServiceMock.On("Start", mock.Anything, user).
+		Return(service.SessionTokens{AccessToken: "access", RefreshToken: "refresh"}, nil)
+
+	// Act
+	output, err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal("access", output.Token)
+	s.Equal("refresh", output.RefreshToken)
+	s.False(output.TOTPRequired)
+}
+
+func (s *AuthMagicLinkLoginUseCaseTestSuite) TestExecute_TOTPEnrolled_ReturnsChallengeWithoutSession() {
+	// Arrange
+	token := []byte("random-token")
+	input := usecase.AuthMagicLinkLoginInput{UserID: 7, Token: base64.StdEncoding.EncodeToString(token)}
+	user := model.UserModel{ID: 7, Status: enum.UserStatusActive}
+	confirmedAt := time.Now()
+	enrollment := model.TOTPEnrollmentModel{ID: 1, UserID: user.ID, ConfirmedAt: &confirmedAt}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.oneTimeLinkServiceMock.On("Verify", mock.Anything, service.MagicLink, input.UserID, input.Token).
+		Return(user, s.magicLinkToken(), nil)
+	s.oneTimeTokenRepositoryMock.On("DeleteByID", mock.Anything, user.ID, uint64(3)).Return(nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, user.ID).Return(enrollment, nil)
+	s.loginChallengeServiceMock.On("Issue", mock.Anything, user.ID).Return("challenge", nil)
+
+	// Act
+	output, err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.True(output.TOTPRequired)
+	s.Equal(user.ID, output.UserID)
+	s.Equal("challenge", output.ChallengeToken)
+	s.Empty(output.Token)
+	s.Empty(output.RefreshToken)
+	s.sessionServiceMock.AssertNotCalled(s.T(), "Start", mock.Anything, mock.Anything)
+}
+
+func (s *AuthMagicLinkLoginUseCaseTestSuite) TestExecute_InvalidToken_ReturnsInvalidTokenError() {
+	// Arrange
+	input := usecase.AuthMagicLinkLoginInput{UserID: 7, Token: base64.StdEncoding.EncodeToString([]byte("wrong"))}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.oneTimeLinkServiceMock.On("Verify", mock.Anything, service.MagicLink, input.UserID, input.Token).
+		Return(model.UserModel{}, model.OneTimeTokenModel{}, errs.ErrInvalidMagicLinkToken)
+
+	// Act
+	_, err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidMagicLinkToken)
+	s.sessionServiceMock.AssertNotCalled(s.T(), "Start", mock.Anything, mock.Anything)
+}
+
+func (s *AuthMagicLinkLoginUseCaseTestSuite) TestExecute_TokenAlreadyUsed_ReturnsInvalidTokenError() {
+	// Arrange
+	token := []byte("random-token")
+	input := usecase.AuthMagicLinkLoginInput{UserID: 7, Token: base64.StdEncoding.EncodeToString(token)}
+	user := model.UserModel{ID: 7, Status: enum.UserStatusActive}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.oneTimeLinkServiceMock.On("Verify", mock.Anything, service.MagicLink, input.UserID, input.Token).
+		Return(user, s.magicLinkToken(), nil)
+	s.oneTimeTokenRepositoryMock.On("DeleteByID", mock.Anything, user.ID, uint64(3)).
+		Return(shared_errs.ErrRecordNotFound)
+
+	// Act
+	_, err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidMagicLinkToken)
+	s.sessionServiceMock.AssertNotCalled(s.T(), "Start", mock.Anything, mock.Anything)
+}
+
+func (s *AuthMagicLinkLoginUseCaseTestSuite) TestExecute_MagicLinkLoginDisabled_ReturnsError() {
+	// Arrange
+	s.cfg.Auth.LoginMethod = config.AuthLoginMethodPassword
+	sut := s.newSut(s.cfg)
+	input := usecase.AuthMagicLinkLoginInput{UserID: 7, Token: "token"}
+
+	// Act
+	_, err := sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrMagicLinkLoginDisabled)
+	s.oneTimeLinkServiceMock.AssertNotCalled(
+		s.T(), "Verify", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	)
+}
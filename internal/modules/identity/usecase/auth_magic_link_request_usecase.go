@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/event"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/event/producer"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/repository"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+)
+
+type AuthMagicLinkRequestInput struct {
+	Email string `validate:"required,email,max=255"`
+}
+
+type AuthMagicLinkRequestUseCase struct {
+	cfg                          config.Config
+	oneTimeLinkRequestedProducer producer.OneTimeLinkRequestedProducerI
+	userRepository               repository.UserRepositoryI
+	validator                    validator.Validate
+	logger                       logger.Logger
+}
+
+func NewAuthMagicLinkRequestUseCase(
+	cfg config.Config,
+	oneTimeLinkRequestedProducer producer.OneTimeLinkRequestedProducerI,
+	userRepository repository.UserRepositoryI,
+	validator validator.Validate,
+	logger logger.Logger,
+) *AuthMagicLinkRequestUseCase {
+	return &AuthMagicLinkRequestUseCase{
+		cfg:                          cfg,
+		oneTimeLinkRequestedProducer: oneTimeLinkRequestedProducer,
+		userRepository:               userRepository,
+		validator:                    validator,
+		logger:                       logger,
+	}
+}
+
+// Execute requests the magic link email of the user. Unknown and inactive users are ignored
+// without an error, so the response does not tell which emails have an account.
+func (uc *AuthMagicLinkRequestUseCase) Execute(ctx context.Context, input AuthMagicLinkRequestInput) error {
+	ctx, span := trace.Span(ctx, "AuthMagicLinkRequestUseCase.Execute")
+	defer span.End()
+
+	if !uc.cfg.Auth.MagicLinkLoginEnabled() {
+		return errs.ErrMagicLinkLoginDisabled
+	}
+
+	if err := uc.validator.Struct(input); err != nil {
+		return err
+	}
+
+	user, err := uc.userRepository.FindByEmail(ctx, input.Email)
+	if err != nil {
+		if errors.Is(err, shared_errs.ErrRecordNotFound) {
+			return nil
+		}
+		uc.logger.Error().Msgf("error finding user by email: %v", err)
+		return err
+	}
+
+	if user.Status != enum.UserStatusActive {
+		return nil
+	}
+
+	message := event.OneTimeLinkRequestedMessage{UserID: user.ID, TokenType: enum.TokenTypeMagicLink}
+	if err = uc.oneTimeLinkRequestedProducer.Produce(ctx, message); err != nil {
+		uc.logger.Error().Msgf("error producing magic link requested event for user ID %d: %v", user.ID, err)
+		return err
+	}
+
+	return nil
+}
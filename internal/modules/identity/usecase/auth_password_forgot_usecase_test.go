@@ -0,0 +1,109 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/event"
+	producer_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/event/producer/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/repository/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/usecase"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	shared_validator_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/validator/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AuthPasswordForgotUseCaseTestSuite struct {
+	suite.Suite
+	sut                              *usecase.AuthPasswordForgotUseCase
+	oneTimeLinkRequestedProducerMock *producer_mocks.MockOneTimeLinkRequestedProducerI
+	userRepositoryMock               *repository_mocks.MockUserRepositoryI
+	validatorMock                    *shared_validator_mocks.MockValidate
+}
+
+func (s *AuthPasswordForgotUseCaseTestSuite) SetupTest() {
+	s.oneTimeLinkRequestedProducerMock = producer_mocks.NewMockOneTimeLinkRequestedProducerI(s.T())
+	s.userRepositoryMock = repository_mocks.NewMockUserRepositoryI(s.T())
+	s.validatorMock = shared_validator_mocks.NewMockValidate(s.T())
+
+	s.sut = usecase.NewAuthPasswordForgotUseCase(
+		s.oneTimeLinkRequestedProducerMock,
+		s.userRepositoryMock,
+		s.validatorMock,
+		logger.New(config.Config{Log: config.Log{LogLevel: "disabled"}}),
+	)
+}
+
+func TestAuthPasswordForgotUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(AuthPasswordForgotUseCaseTestSuite))
+}
+
+func (s *AuthPasswordForgotUseCaseTestSuite) TestExecute_ActiveUser_ProducesEvent() {
+	// Arrange
+	input := usecase.AuthPasswordForgotInput{Email: "jane@example.com"}
+	user := model.UserModel{ID: 7, Email: input.Email, Status: enum.UserStatusActive}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.userRepositoryMock.On("FindByEmail", mock.Anything, input.Email).Return(user, nil)
+	message := event.OneTimeLinkRequestedMessage{UserID: 7, TokenType: enum.TokenTypeResetPassword}
+	s.oneTimeLinkRequestedProducerMock.On("Produce", mock.Anything, message).Return(nil)
+
+	// Act
+	err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().NoError(err)
+}
+
+func (s *AuthPasswordForgotUseCaseTestSuite) TestExecute_UnknownEmail_ReturnsNil() {
+	// Arrange
+	input := usecase.AuthPasswordForgotInput{Email: "nobody@example.com"}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.userRepositoryMock.On("FindByEmail", mock.Anything, input.Email).
+		Return(model.UserModel{}, shared_errs.ErrRecordNotFound)
+
+	// Act
+	err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.oneTimeLinkRequestedProducerMock.AssertNotCalled(s.T(), "Produce", mock.Anything, mock.Anything)
+}
+
+func (s *AuthPasswordForgotUseCaseTestSuite) TestExecute_InactiveUser_ReturnsNil() {
+	// Arrange
+	input := usecase.AuthPasswordForgotInput{Email: "jane@example.com"}
+	user := model.UserModel{ID: 7, Email: input.Email, Status: enum.UserStatusPending}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.userRepositoryMock.On("FindByEmail", mock.Anything, input.Email).Return(user, nil)
+
+	// Act
+	err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.oneTimeLinkRequestedProducerMock.AssertNotCalled(s.T(), "Produce", mock.Anything, mock.Anything)
+}
+
+func (s *AuthPasswordForgotUseCaseTestSuite) TestExecute_RepositoryError_ReturnsError() {
+	// Arrange
+	input := usecase.AuthPasswordForgotInput{Email: "jane@example.com"}
+	repositoryErr := errors.New("database error")
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.userRepositoryMock.On("FindByEmail", mock.Anything, input.Email).Return(model.UserModel{}, repositoryErr)
+
+	// Act
+	err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().ErrorIs(err, repositoryErr)
+}
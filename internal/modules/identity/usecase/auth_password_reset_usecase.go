@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/repository"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+	identity_validator "github.com/cristiano-pacheco/pingo/internal/modules/identity/validator"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+)
+
+type AuthPasswordResetInput struct {
+	UserID   uint64 `validate:"required"`
+	Token    string `validate:"required"`
+	Password string `validate:"required,min=8"`
+}
+
+type AuthPasswordResetUseCase struct {
+	passwordValidator      identity_validator.PasswordValidatorI
+	oneTimeLinkService     service.OneTimeLinkServiceI
+	oneTimeTokenRepository repository.OneTimeTokenRepositoryI
+	userRepository         repository.UserRepositoryI
+	apiKeyRepository       repository.APIKeyRepositoryI
+	sessionService         service.SessionServiceI
+	hashService            service.HashServiceI
+	txManager              database.TxManagerI
+	validator              validator.Validate
+	logger                 logger.Logger
+}
+
+func NewAuthPasswordResetUseCase(
+	passwordValidator identity_validator.PasswordValidatorI,
+	oneTimeLinkService service.OneTimeLinkServiceI,
+	oneTimeTokenRepository repository.OneTimeTokenRepositoryI,
+	userRepository repository.UserRepositoryI,
+	apiKeyRepository repository.APIKeyRepositoryI,
+	sessionService service.SessionServiceI,
+	hashService service.HashServiceI,
+	txManager database.TxManagerI,
+	validator validator.Validate,
+	logger logger.Logger,
+) *AuthPasswordResetUseCase {
+	return &AuthPasswordResetUseCase{
+		passwordValidator:      passwordValidator,
+		oneTimeLinkService:     oneTimeLinkService,
+		oneTimeTokenRepository: oneTimeTokenRepository,
+		userRepository:         userRepository,
+		apiKeyRepository:       apiKeyRepository,
+		sessionService:         sessionService,
+		hashService:            hashService,
+		txManager:              txManager,
+		validator:              validator,
+		logger:                 logger,
+	}
+}
+
+// Execute sets the new password of the user and revokes all of their sessions and API keys,
+// whoever got in with the old password is signed out and loses the keys they may have created.
+func (uc *AuthPasswordResetUseCase) Execute(ctx context.Context, input AuthPasswordResetInput) error {
+	ctx, span := trace.Span(ctx, "AuthPasswordResetUseCase.Execute")
+	defer span.End()
+
+	if err := uc.validator.Struct(input); err != nil {
+		return err
+	}
+
+	if err := uc.passwordValidator.Validate(input.Password); err != nil {
+		return err
+	}
+
+	user, _, err := uc.oneTimeLinkService.Verify(ctx, service.PasswordResetLink, input.UserID, input.Token)
+	if err != nil {
+		return err
+	}
+
+	passwordHash, err := uc.hashService.GenerateFromPassword([]byte(input.Password))
+	if err != nil {
+		uc.logger.Error().Msgf("error generating password hash: %v", err)
+		return err
+	}
+
+	resetPasswordType, _ := enum.NewTokenTypeEnum(enum.TokenTypeResetPassword)
+	return uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		user.PasswordHash = passwordHash
+		user.UpdatedAt = time.Now().UTC()
+		if err = uc.userRepository.Update(ctx, user); err != nil {
+			uc.logger.Error().Msgf("error updating the password of user ID %d: %v", user.ID, err)
+			return err
+		}
+
+		// the token is single use
+		if err = uc.oneTimeTokenRepository.Delete(ctx, user.ID, resetPasswordType); err != nil {
+			uc.logger.Error().Msgf("error deleting password reset tokens for user ID %d: %v", user.ID, err)
+			return err
+		}
+
+		if err = uc.apiKeyRepository.DeleteAllByUserID(ctx, user.ID); err != nil {
+			uc.logger.Error().Msgf("error deleting the API keys of user ID %d: %v", user.ID, err)
+			return err
+		}
+
+		return uc.sessionService.RevokeAll(ctx, user.ID)
+	})
+}
@@ -0,0 +1,163 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/repository/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+	service_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/service/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/usecase"
+	validator_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/validator/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	database_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/database/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	shared_validator_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/validator/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AuthPasswordResetUseCaseTestSuite struct {
+	suite.Suite
+	sut                        *usecase.AuthPasswordResetUseCase
+	passwordValidatorMock      *validator_mocks.MockPasswordValidatorI
+	oneTimeLinkServiceMock     *service_mocks.MockOneTimeLinkServiceI
+	oneTimeTokenRepositoryMock *repository_mocks.MockOneTimeTokenRepositoryI
+	userRepositoryMock         *repository_mocks.MockUserRepositoryI
+	apiKeyRepositoryMock       *repository_mocks.MockAPIKeyRepositoryI
+	sessionServiceMock         *service_mocks.MockSessionServiceI
+	hashServiceMock            *service_mocks.MockHashServiceI
+	txManagerMock              *database_mocks.MockTxManagerI
+	validatorMock              *shared_validator_mocks.MockValidate
+}
+
+func (s *AuthPasswordResetUseCaseTestSuite) SetupTest() {
+	s.passwordValidatorMock = validator_mocks.NewMockPasswordValidatorI(s.T())
+	s.oneTimeLinkServiceMock = service_mocks.NewMockOneTimeLinkServiceI(s.T())
+	s.oneTimeTokenRepositoryMock = repository_mocks.NewMockOneTimeTokenRepositoryI(s.T())
+	s.userRepositoryMock = repository_mocks.NewMockUserRepositoryI(s.T())
+	s.apiKeyRepositoryMock = repository_mocks.NewMockAPIKeyRepositoryI(s.T())
+	s.sessionServiceMock = service_mocks.NewMockSessionServiceI(s.T())
+	s.hashServiceMock = service_mocks.NewMockHashServiceI(s.T())
+	s.txManagerMock = database_mocks.NewMockTxManagerI(s.T())
+	s.validatorMock = shared_validator_mocks.NewMockValidate(s.T())
+
+	s.txManagerMock.On("WithinTransaction", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) },
+	).Maybe()
+
+	s.sut = usecase.NewAuthPasswordResetUseCase(
+		s.passwordValidatorMock,
+		s.oneTimeLinkServiceMock,
+		s.oneTimeTokenRepositoryMock,
+		s.userRepositoryMock,
+		s.apiKeyRepositoryMock,
+		s.sessionServiceMock,
+		s.hashServiceMock,
+		s.txManagerMock,
+		s.validatorMock,
+		logger.New(config.Config{Log: config.Log{LogLevel: "disabled"}}),
+	)
+}
+
+func TestAuthPasswordResetUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(AuthPasswordResetUseCaseTestSuite))
+}
+
+func (s *AuthPasswordResetUseCaseTestSuite) newInput(token []byte) usecase.AuthPasswordResetInput {
+	return usecase.AuthPasswordResetInput{
+		UserID:   7,
+		Token:    base64.StdEncoding.EncodeToString(token),
+		Password: "N3w@Password",
+	}
+}
+
+func (s *AuthPasswordResetUseCaseTestSuite) resetToken() model.OneTimeTokenModel {
+	return model.OneTimeTokenModel{ID: 1, UserID: 7, TokenType: enum.TokenTypeResetPassword}
+}
+
+func (s *AuthPasswordResetUseCaseTestSuite) TestExecute_ValidToken_UpdatesPasswordAndRevokesSessionsAndAPIKeys() {
+	// Arrange
+	token := []byte("random-token")
+	input := s.newInput(token)
+	user := model.UserModel{ID: 7, Status: enum.UserStatusActive, PasswordHash: []byte("old-hash")}
+	resetPasswordType, _ := enum.NewTokenTypeEnum(enum.TokenTypeResetPassword)
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.passwordValidatorMock.On("Validate", input.Password).Return(nil)
+	s.oneTimeLinkServiceMock.On("Verify", mock.Anything, service.PasswordResetLink, input.UserID, input.Token).
+		Return(user, s.resetToken(), nil)
+	s.hashServiceMock.On("GenerateFromPassword", []byte(input.Password)).Return([]byte("new-hash"), nil)
+	s.userRepositoryMock.On("Update", mock.Anything, mock.MatchedBy(func(u model.UserModel) bool {
+		return u.ID == user.ID && string(u.PasswordHash) == "new-hash"
+	})).Return(nil)
+	s.oneTimeTokenRepositoryMock.On("Delete", mock.Anything, user.ID, resetPasswordType).Return(nil)
+	s.apiKeyRepositoryMock.On("DeleteAllByUserID", mock.Anything, user.ID).Return(nil)
+	s.sessionServiceMock.On("RevokeAll", mock.Anything, user.ID).Return(nil)
+
+	// Act
+	err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().NoError(err)
+}
+
+func (s *AuthPasswordResetUseCaseTestSuite) TestExecute_DeletingAPIKeysFails_ReturnsErrorWithoutRevokingSessions() {
+	// Arrange
+	token := []byte("random-token")
+	input := s.newInput(token)
+	user := model.UserModel{ID: 7, Status: enum.UserStatusActive, PasswordHash: []byte("old-hash")}
+	deleteErr := errors.New("database unavailable")
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.passwordValidatorMock.On("Validate", input.Password).Return(nil)
+	s.oneTimeLinkServiceMock.On("Verify", mock.Anything, service.PasswordResetLink, input.UserID, input.Token).
+		Return(user, s.resetToken(), nil)
+	s.hashServiceMock.On("GenerateFromPassword", []byte(input.Password)).Return([]byte("new-hash"), nil)
+	s.userRepositoryMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+	s.oneTimeTokenRepositoryMock.On("Delete", mock.Anything, user.ID, mock.Anything).Return(nil)
+	s.apiKeyRepositoryMock.On("DeleteAllByUserID", mock.Anything, user.ID).Return(deleteErr)
+
+	// Act
+	err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().ErrorIs(err, deleteErr)
+	s.sessionServiceMock.AssertNotCalled(s.T(), "RevokeAll", mock.Anything, mock.Anything)
+}
+
+func (s *AuthPasswordResetUseCaseTestSuite) TestExecute_InvalidToken_ReturnsInvalidTokenError() {
+	// Arrange
+	input := s.newInput([]byte("wrong-token"))
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.passwordValidatorMock.On("Validate", input.Password).Return(nil)
+	s.oneTimeLinkServiceMock.On("Verify", mock.Anything, service.PasswordResetLink, input.UserID, input.Token).
+		Return(model.UserModel{}, model.OneTimeTokenModel{}, errs.ErrInvalidPasswordResetToken)
+
+	// Act
+	err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidPasswordResetToken)
+	s.userRepositoryMock.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (s *AuthPasswordResetUseCaseTestSuite) TestExecute_WeakPassword_ReturnsError() {
+	// Arrange
+	input := s.newInput([]byte("random-token"))
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.passwordValidatorMock.On("Validate", input.Password).Return(errs.ErrPasswordTooShort)
+
+	// Act
+	err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrPasswordTooShort)
+}
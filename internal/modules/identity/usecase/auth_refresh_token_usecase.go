@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+)
+
+type AuthRefreshTokenInput struct {
+	RefreshToken string `validate:"required"`
+}
+
+type AuthRefreshTokenOutput struct {
+	Token        string
+	RefreshToken string
+}
+
+type AuthRefreshTokenUseCase struct {
+	sessionService service.SessionServiceI
+	validator      validator.Validate
+	logger         logger.Logger
+}
+
+func NewAuthRefreshTokenUseCase(
+	sessionService service.SessionServiceI,
+	validator validator.Validate,
+	logger logger.Logger,
+) *AuthRefreshTokenUseCase {
+	return &AuthRefreshTokenUseCase{
+		sessionService: sessionService,
+		validator:      validator,
+		logger:         logger,
+	}
+}
+
+// Execute issues a new access token and rotates the refresh token, the given one can't be used again.
+func (uc *AuthRefreshTokenUseCase) Execute(
+	ctx context.Context,
+	input AuthRefreshTokenInput,
+) (AuthRefreshTokenOutput, error) {
+	ctx, span := trace.Span(ctx, "AuthRefreshTokenUseCase.Execute")
+	defer span.End()
+
+	err := uc.validator.Struct(input)
+	if err != nil {
+		return AuthRefreshTokenOutput{}, err
+	}
+
+	tokens, err := uc.sessionService.Refresh(ctx, input.RefreshToken)
+	if err != nil {
+		return AuthRefreshTokenOutput{}, err
+	}
+
+	return AuthRefreshTokenOutput{Token: tokens.AccessToken, RefreshToken: tokens.RefreshToken}, nil
+}
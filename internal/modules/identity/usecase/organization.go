@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/repository"
+)
+
+type OrganizationOutput struct {
+	OrganizationID uint64
+	Name           string
+	Role           string
+}
+
+type OrganizationMemberOutput struct {
+	UserID    uint64
+	Role      string
+	CreatedAt time.Time
+}
+
+func newOrganizationMemberOutput(member model.OrganizationMemberModel) OrganizationMemberOutput {
+	return OrganizationMemberOutput{
+		UserID:    member.UserID,
+		Role:      member.Role,
+		CreatedAt: member.CreatedAt,
+	}
+}
+
+// checkRoleIncludes fails when the actor role does not include the role it is granting or taking away,
+// so an admin cannot make or unmake an owner.
+func checkRoleIncludes(actorRole, role string) error {
+	actor, err := enum.NewOrganizationRoleEnum(actorRole)
+	if err != nil {
+		return err
+	}
+
+	other, err := enum.NewOrganizationRoleEnum(role)
+	if err != nil {
+		return err
+	}
+
+	if !actor.Includes(other) {
+		return errs.ErrOrganizationAccessDenied
+	}
+
+	return nil
+}
+
+// checkOtherOwnerExists fails when the member losing the owner role is the last owner of the organization.
+// It locks the owners, so it must run in the transaction that changes the member, two concurrent changes
+// cannot both see the other owner.
+func checkOtherOwnerExists(
+	ctx context.Context,
+	organizationMemberRepository repository.OrganizationMemberRepositoryI,
+	member model.OrganizationMemberModel,
+) error {
+	if member.Role != enum.OrganizationRoleOwner {
+		return nil
+	}
+
+	owners, err := organizationMemberRepository.LockByRole(ctx, member.OrganizationID, enum.OrganizationRoleOwner)
+	if err != nil {
+		return err
+	}
+
+	if len(owners) <= 1 {
+		return errs.ErrLastOrganizationOwner
+	}
+
+	return nil
+}
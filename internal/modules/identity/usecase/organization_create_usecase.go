@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/repository"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+)
+
+type OrganizationCreateInput struct {
+	UserID uint64 `validate:"required"`
+	Name   string `validate:"required,min=3,max=255"`
+}
+
+type OrganizationCreateUseCase struct {
+	organizationRepository       repository.OrganizationRepositoryI
+	organizationMemberRepository repository.OrganizationMemberRepositoryI
+	txManager                    database.TxManagerI
+	validate                     validator.Validate
+	logger                       logger.Logger
+}
+
+func NewOrganizationCreateUseCase(
+	organizationRepository repository.OrganizationRepositoryI,
+	organizationMemberRepository repository.OrganizationMemberRepositoryI,
+	txManager database.TxManagerI,
+	validate validator.Validate,
+	logger logger.Logger,
+) *OrganizationCreateUseCase {
+	return &OrganizationCreateUseCase{
+		organizationRepository:       organizationRepository,
+		organizationMemberRepository: organizationMemberRepository,
+		txManager:                    txManager,
+		validate:                     validate,
+		logger:                       logger,
+	}
+}
+
+// Execute creates the organization with the user as its owner.
+func (uc *OrganizationCreateUseCase) Execute(
+	ctx context.Context,
+	input OrganizationCreateInput,
+) (OrganizationOutput, error) {
+	ctx, span := trace.Span(ctx, "OrganizationCreateUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return OrganizationOutput{}, err
+	}
+
+	var organization model.OrganizationModel
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		organization, err = uc.organizationRepository.Create(ctx, model.OrganizationModel{Name: input.Name})
+		if err != nil {
+			uc.logger.Error().Msgf("error creating organization: %v", err)
+			return err
+		}
+
+		owner := model.OrganizationMemberModel{
+			OrganizationID: organization.ID,
+			UserID:         input.UserID,
+			Role:           enum.OrganizationRoleOwner,
+		}
+		err = uc.organizationMemberRepository.Create(ctx, owner)
+		if err != nil {
+			uc.logger.Error().Msgf("error adding the owner of organization ID %d: %v", organization.ID, err)
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return OrganizationOutput{}, err
+	}
+
+	output := OrganizationOutput{
+		OrganizationID: organization.ID,
+		Name:           organization.Name,
+		Role:           enum.OrganizationRoleOwner,
+	}
+
+	return output, nil
+}
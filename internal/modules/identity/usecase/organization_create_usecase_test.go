@@ -0,0 +1,112 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/repository/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/usecase"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	database_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/database/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	shared_validator_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/validator/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type OrganizationCreateUseCaseTestSuite struct {
+	suite.Suite
+	sut                              *usecase.OrganizationCreateUseCase
+	organizationRepositoryMock       *repository_mocks.MockOrganizationRepositoryI
+	organizationMemberRepositoryMock *repository_mocks.MockOrganizationMemberRepositoryI
+	txManagerMock                    *database_mocks.MockTxManagerI
+	validatorMock                    *shared_validator_mocks.MockValidate
+	logger                           logger.Logger
+}
+
+func (s *OrganizationCreateUseCaseTestSuite) SetupTest() {
+	s.organizationRepositoryMock = repository_mocks.NewMockOrganizationRepositoryI(s.T())
+	s.organizationMemberRepositoryMock = repository_mocks.NewMockOrganizationMemberRepositoryI(s.T())
+	s.txManagerMock = database_mocks.NewMockTxManagerI(s.T())
+	s.validatorMock = shared_validator_mocks.NewMockValidate(s.T())
+	s.logger = logger.New(config.Config{Log: config.Log{LogLevel: "disabled"}})
+
+	s.txManagerMock.On("WithinTransaction", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) }).
+		Maybe()
+
+	s.sut = usecase.NewOrganizationCreateUseCase(
+		s.organizationRepositoryMock,
+		s.organizationMemberRepositoryMock,
+		s.txManagerMock,
+		s.validatorMock,
+		s.logger,
+	)
+}
+
+func TestOrganizationCreateUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(OrganizationCreateUseCaseTestSuite))
+}
+
+func (s *OrganizationCreateUseCaseTestSuite) TestExecute_ValidInput_CreatesOrganizationOwnedByUser() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.OrganizationCreateInput{UserID: 5, Name: "Platform"}
+	organization := model.OrganizationModel{ID: 3, Name: input.Name}
+	expectedOwner := model.OrganizationMemberModel{
+		OrganizationID: organization.ID,
+		UserID:         input.UserID,
+		Role:           enum.OrganizationRoleOwner,
+	}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.organizationRepositoryMock.On("Create", mock.Anything, model.OrganizationModel{Name: input.Name}).
+		Return(organization, nil)
+	s.organizationMemberRepositoryMock.On("Create", mock.Anything, expectedOwner).Return(nil)
+
+	// Act
+	output, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(organization.ID, output.OrganizationID)
+	s.Equal(input.Name, output.Name)
+	s.Equal(enum.OrganizationRoleOwner, output.Role)
+}
+
+func (s *OrganizationCreateUseCaseTestSuite) TestExecute_ValidationFails_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.OrganizationCreateInput{UserID: 5, Name: "P"}
+	validationErr := errors.New("validation error")
+
+	s.validatorMock.On("Struct", input).Return(validationErr)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, validationErr)
+	s.organizationRepositoryMock.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *OrganizationCreateUseCaseTestSuite) TestExecute_AddOwnerFails_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.OrganizationCreateInput{UserID: 5, Name: "Platform"}
+	createErr := errors.New("database error")
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.organizationRepositoryMock.On("Create", mock.Anything, mock.Anything).
+		Return(model.OrganizationModel{ID: 3, Name: input.Name}, nil)
+	s.organizationMemberRepositoryMock.On("Create", mock.Anything, mock.Anything).Return(createErr)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, createErr)
+}
@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/repository"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+)
+
+type OrganizationInvitationAcceptInput struct {
+	UserID       uint64 `validate:"required"`
+	InvitationID uint64 `validate:"required"`
+	Token        string `validate:"required"`
+}
+
+type OrganizationInvitationAcceptUseCase struct {
+	organizationMemberRepository     repository.OrganizationMemberRepositoryI
+	organizationInvitationRepository repository.OrganizationInvitationRepositoryI
+	oneTimeTokenRepository           repository.OneTimeTokenRepositoryI
+	txManager                        database.TxManagerI
+	validate                         validator.Validate
+	logger                           logger.Logger
+}
+
+func NewOrganizationInvitationAcceptUseCase(
+	organizationMemberRepository repository.OrganizationMemberRepositoryI,
+	organizationInvitationRepository repository.OrganizationInvitationRepositoryI,
+	oneTimeTokenRepository repository.OneTimeTokenRepositoryI,
+	txManager database.TxManagerI,
+	validate validator.Validate,
+	logger logger.Logger,
+) *OrganizationInvitationAcceptUseCase {
+	return &OrganizationInvitationAcceptUseCase{
+		organizationMemberRepository:     organizationMemberRepository,
+		organizationInvitationRepository: organizationInvitationRepository,
+		oneTimeTokenRepository:           oneTimeTokenRepository,
+		txManager:                        txManager,
+		validate:                         validate,
+		logger:                           logger,
+	}
+}
+
+// Execute adds the user to the organization it was invited to. The invitation and its token
+// can only be used once, by the invited user.
+func (uc *OrganizationInvitationAcceptUseCase) Execute(
+	ctx context.Context,
+	input OrganizationInvitationAcceptInput,
+) (OrganizationMemberOutput, error) {
+	ctx, span := trace.Span(ctx, "OrganizationInvitationAcceptUseCase.Execute")
+	defer span.End()
+
+	output := OrganizationMemberOutput{}
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return output, err
+	}
+
+	invitation, err := uc.validateInvitationAndToken(ctx, input)
+	if err != nil {
+		return output, err
+	}
+
+	member := model.OrganizationMemberModel{
+		OrganizationID: invitation.OrganizationID,
+		UserID:         input.UserID,
+		Role:           invitation.Role,
+	}
+
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		_, err = uc.organizationMemberRepository.Find(ctx, invitation.OrganizationID, input.UserID)
+		if err == nil {
+			return errs.ErrAlreadyOrganizationMember
+		}
+		if !errors.Is(err, shared_errs.ErrRecordNotFound) {
+			uc.logger.Error().Msgf("error finding member of organization %d: %v", invitation.OrganizationID, err)
+			return err
+		}
+
+		err = uc.organizationMemberRepository.Create(ctx, member)
+		if err != nil {
+			uc.logger.Error().Msgf("error adding member to organization ID %d: %v", invitation.OrganizationID, err)
+			return err
+		}
+
+		err = uc.organizationInvitationRepository.Delete(ctx, input.UserID, invitation.ID)
+		if err != nil {
+			uc.logger.Error().Msgf("error deleting invitation ID %d: %v", invitation.ID, err)
+			return err
+		}
+
+		err = uc.oneTimeTokenRepository.DeleteByID(ctx, input.UserID, invitation.OneTimeTokenID)
+		if err != nil {
+			uc.logger.Error().Msgf("error deleting one-time token of invitation ID %d: %v", invitation.ID, err)
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return output, err
+	}
+
+	return newOrganizationMemberOutput(member), nil
+}
+
+// validateInvitationAndToken reports an invitation of another user, an expired token and a wrong token
+// the same way, so the response does not tell which invitations exist.
+func (uc *OrganizationInvitationAcceptUseCase) validateInvitationAndToken(
+	ctx context.Context,
+	input OrganizationInvitationAcceptInput,
+) (model.OrganizationInvitationModel, error) {
+	token, err := base64.StdEncoding.DecodeString(input.Token)
+	if err != nil {
+		return model.OrganizationInvitationModel{}, errs.ErrInvalidOrganizationInvitationToken
+	}
+
+	invitation, err := uc.organizationInvitationRepository.FindByID(ctx, input.UserID, input.InvitationID)
+	if err != nil {
+		if errors.Is(err, shared_errs.ErrRecordNotFound) {
+			return model.OrganizationInvitationModel{}, errs.ErrInvalidOrganizationInvitationToken
+		}
+		uc.logger.Error().Msgf("error finding invitation by ID %d: %v", input.InvitationID, err)
+		return model.OrganizationInvitationModel{}, err
+	}
+
+	invitationTokenType, err := enum.NewTokenTypeEnum(enum.TokenTypeOrganizationInvitation)
+	if err != nil {
+		return model.OrganizationInvitationModel{}, err
+	}
+
+	oneTimeToken, err := uc.oneTimeTokenRepository.FindByID(
+		ctx,
+		input.UserID,
+		invitation.OneTimeTokenID,
+		invitationTokenType,
+	)
+	if err != nil {
+		if errors.Is(err, shared_errs.ErrRecordNotFound) {
+			return model.OrganizationInvitationModel{}, errs.ErrInvalidOrganizationInvitationToken
+		}
+		uc.logger.Error().Msgf("error finding one-time token of invitation ID %d: %v", invitation.ID, err)
+		return model.OrganizationInvitationModel{}, err
+	}
+
+	tokenHash := sha256.Sum256(token)
+	if subtle.ConstantTimeCompare(oneTimeToken.TokenHash, tokenHash[:]) != 1 {
+		return model.OrganizationInvitationModel{}, errs.ErrInvalidOrganizationInvitationToken
+	}
+
+	return invitation, nil
+}
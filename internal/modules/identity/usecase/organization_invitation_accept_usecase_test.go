@@ -0,0 +1,198 @@
+package usecase_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/repository/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/usecase"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	database_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/database/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	shared_validator_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/validator/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type OrganizationInvitationAcceptUseCaseTestSuite struct {
+	suite.Suite
+	sut                                  *usecase.OrganizationInvitationAcceptUseCase
+	organizationMemberRepositoryMock     *repository_mocks.MockOrganizationMemberRepositoryI
+	organizationInvitationRepositoryMock *repository_mocks.MockOrganizationInvitationRepositoryI
+	oneTimeTokenRepositoryMock           *repository_mocks.MockOneTimeTokenRepositoryI
+	txManagerMock                        *database_mocks.MockTxManagerI
+	validatorMock                        *shared_validator_mocks.MockValidate
+	logger                               logger.Logger
+}
+
+func (s *OrganizationInvitationAcceptUseCaseTestSuite) SetupTest() {
+	s.organizationMemberRepositoryMock = repository_mocks.NewMockOrganizationMemberRepositoryI(s.T())
+	s.organizationInvitationRepositoryMock = repository_mocks.NewMockOrganizationInvitationRepositoryI(s.T())
+	s.oneTimeTokenRepositoryMock = repository_mocks.NewMockOneTimeTokenRepositoryI(s.T())
+	s.txManagerMock = database_mocks.NewMockTxManagerI(s.T())
+	s.validatorMock = shared_validator_mocks.NewMockValidate(s.T())
+	s.logger = logger.New(config.Config{Log: config.Log{LogLevel: "disabled"}})
+
+	s.txManagerMock.On("WithinTransaction", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) }).
+		Maybe()
+
+	s.sut = usecase.NewOrganizationInvitationAcceptUseCase(
+		s.organizationMemberRepositoryMock,
+		s.organizationInvitationRepositoryMock,
+		s.oneTimeTokenRepositoryMock,
+		s.txManagerMock,
+		s.validatorMock,
+		s.logger,
+	)
+}
+
+func TestOrganizationInvitationAcceptUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(OrganizationInvitationAcceptUseCaseTestSuite))
+}
+
+func (s *OrganizationInvitationAcceptUseCaseTestSuite) newInvitation() model.OrganizationInvitationModel {
+	return model.OrganizationInvitationModel{
+		ID:             20,
+		OrganizationID: 3,
+		UserID:         9,
+		OneTimeTokenID: 12,
+		Role:           enum.OrganizationRoleEditor,
+	}
+}
+
+func (s *OrganizationInvitationAcceptUseCaseTestSuite) expectToken(invitation model.OrganizationInvitationModel) {
+	tokenType, err := enum.NewTokenTypeEnum(enum.TokenTypeOrganizationInvitation)
+	s.Require().NoError(err)
+
+	tokenHash := sha256.Sum256([]byte("invitation-token"))
+	s.oneTimeTokenRepositoryMock.
+		On("FindByID", mock.Anything, invitation.UserID, invitation.OneTimeTokenID, tokenType).
+		Return(model.OneTimeTokenModel{ID: invitation.OneTimeTokenID, TokenHash: tokenHash[:]}, nil)
+}
+
+func (s *OrganizationInvitationAcceptUseCaseTestSuite) TestExecute_ValidToken_AddsMember() {
+	// Arrange
+	ctx := context.Background()
+	invitation := s.newInvitation()
+	input := usecase.OrganizationInvitationAcceptInput{
+		UserID:       invitation.UserID,
+		InvitationID: invitation.ID,
+		Token:        base64.StdEncoding.EncodeToString([]byte("invitation-token")),
+	}
+	expectedMember := model.OrganizationMemberModel{
+		OrganizationID: invitation.OrganizationID,
+		UserID:         invitation.UserID,
+		Role:           invitation.Role,
+	}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.organizationInvitationRepositoryMock.On("FindByID", mock.Anything, input.UserID, input.InvitationID).
+		Return(invitation, nil)
+	s.expectToken(invitation)
+	s.organizationMemberRepositoryMock.On("Find", mock.Anything, invitation.OrganizationID, input.UserID).
+		Return(model.OrganizationMemberModel{}, shared_errs.ErrRecordNotFound)
+	s.organizationMemberRepositoryMock.On("Create", mock.Anything, expectedMember).Return(nil)
+	s.organizationInvitationRepositoryMock.On("Delete", mock.Anything, input.UserID, invitation.ID).Return(nil)
+	s.oneTimeTokenRepositoryMock.On("DeleteByID", mock.Anything, input.UserID, invitation.OneTimeTokenID).
+		Return(nil)
+
+	// Act
+	output, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(input.UserID, output.UserID)
+	s.Equal(invitation.Role, output.Role)
+}
+
+func (s *OrganizationInvitationAcceptUseCaseTestSuite) TestExecute_WrongToken_ReturnsInvalidToken() {
+	// Arrange
+	ctx := context.Background()
+	invitation := s.newInvitation()
+	input := usecase.OrganizationInvitationAcceptInput{
+		UserID:       invitation.UserID,
+		InvitationID: invitation.ID,
+		Token:        base64.StdEncoding.EncodeToString([]byte("another-token")),
+	}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.organizationInvitationRepositoryMock.On("FindByID", mock.Anything, input.UserID, input.InvitationID).
+		Return(invitation, nil)
+	s.expectToken(invitation)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidOrganizationInvitationToken)
+	s.organizationMemberRepositoryMock.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *OrganizationInvitationAcceptUseCaseTestSuite) TestExecute_InvitationOfAnotherUser_ReturnsInvalidToken() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.OrganizationInvitationAcceptInput{
+		UserID:       7,
+		InvitationID: 20,
+		Token:        base64.StdEncoding.EncodeToString([]byte("invitation-token")),
+	}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.organizationInvitationRepositoryMock.On("FindByID", mock.Anything, input.UserID, input.InvitationID).
+		Return(model.OrganizationInvitationModel{}, shared_errs.ErrRecordNotFound)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidOrganizationInvitationToken)
+}
+
+func (s *OrganizationInvitationAcceptUseCaseTestSuite) TestExecute_MalformedToken_ReturnsInvalidToken() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.OrganizationInvitationAcceptInput{UserID: 9, InvitationID: 20, Token: "not base64!"}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidOrganizationInvitationToken)
+	s.organizationInvitationRepositoryMock.AssertNotCalled(
+		s.T(), "FindByID", mock.Anything, mock.Anything, mock.Anything,
+	)
+}
+
+func (s *OrganizationInvitationAcceptUseCaseTestSuite) TestExecute_AlreadyMember_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	invitation := s.newInvitation()
+	input := usecase.OrganizationInvitationAcceptInput{
+		UserID:       invitation.UserID,
+		InvitationID: invitation.ID,
+		Token:        base64.StdEncoding.EncodeToString([]byte("invitation-token")),
+	}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.organizationInvitationRepositoryMock.On("FindByID", mock.Anything, input.UserID, input.InvitationID).
+		Return(invitation, nil)
+	s.expectToken(invitation)
+	s.organizationMemberRepositoryMock.On("Find", mock.Anything, invitation.OrganizationID, input.UserID).
+		Return(model.OrganizationMemberModel{UserID: input.UserID}, nil)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrAlreadyOrganizationMember)
+	s.organizationMemberRepositoryMock.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
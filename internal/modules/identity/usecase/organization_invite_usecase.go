@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/repository"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+)
+
+const organizationInvitationTokenExpiration = 7 * 24 * time.Hour
+
+type OrganizationInviteInput struct {
+	UserID         uint64 `validate:"required"`
+	OrganizationID uint64 `validate:"required"`
+	Email          string `validate:"required,email,max=255"`
+	Role           string `validate:"required"`
+}
+
+type OrganizationInviteUseCase struct {
+	organizationAuthorizationService  service.OrganizationAuthorizationServiceI
+	sendOrganizationInvitationService service.SendOrganizationInvitationServiceI
+	hashService                       service.HashServiceI
+	organizationRepository            repository.OrganizationRepositoryI
+	organizationMemberRepository      repository.OrganizationMemberRepositoryI
+	organizationInvitationRepository  repository.OrganizationInvitationRepositoryI
+	oneTimeTokenRepository            repository.OneTimeTokenRepositoryI
+	userRepository                    repository.UserRepositoryI
+	txManager                         database.TxManagerI
+	validate                          validator.Validate
+	logger                            logger.Logger
+}
+
+func NewOrganizationInviteUseCase(
+	organizationAuthorizationService service.OrganizationAuthorizationServiceI,
+	sendOrganizationInvitationService service.SendOrganizationInvitationServiceI,
+	hashService service.HashServiceI,
+	organizationRepository repository.OrganizationRepositoryI,
+	organizationMemberRepository repository.OrganizationMemberRepositoryI,
+	organizationInvitationRepository repository.OrganizationInvitationRepositoryI,
+	oneTimeTokenRepository repository.OneTimeTokenRepositoryI,
+	userRepository repository.UserRepositoryI,
+	txManager database.TxManagerI,
+	validate validator.Validate,
+	logger logger.Logger,
+) *OrganizationInviteUseCase {
+	return &OrganizationInviteUseCase{
+		organizationAuthorizationService:  organizationAuthorizationService,
+		sendOrganizationInvitationService: sendOrganizationInvitationService,
+		hashService:                       hashService,
+		organizationRepository:            organizationRepository,
+		organizationMemberRepository:      organizationMemberRepository,
+		organizationInvitationRepository:  organizationInvitationRepository,
+		oneTimeTokenRepository:            oneTimeTokenRepository,
+		userRepository:                    userRepository,
+		txManager:                         txManager,
+		validate:                          validate,
+		logger:                            logger,
+	}
+}
+
+// Execute invites a registered user to the organization by email. The invitee joins with the given role
+// once it accepts the invitation with the one-time token sent to it. An email without an account is not
+// reported, so the invitation does not reveal which emails are registered.
+func (uc *OrganizationInviteUseCase) Execute(ctx context.Context, input OrganizationInviteInput) error {
+	ctx, span := trace.Span(ctx, "OrganizationInviteUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return err
+	}
+
+	inviterMember, err := uc.organizationAuthorizationService.Authorize(
+		ctx,
+		input.UserID,
+		input.OrganizationID,
+		enum.OrganizationRoleAdmin,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = checkRoleIncludes(inviterMember.Role, input.Role)
+	if err != nil {
+		return err
+	}
+
+	invitee, err := uc.userRepository.FindByEmail(ctx, input.Email)
+	if err != nil {
+		if errors.Is(err, shared_errs.ErrRecordNotFound) {
+			return nil
+		}
+		uc.logger.Error().Msgf("error finding user by email: %v", err)
+		return err
+	}
+
+	_, err = uc.organizationMemberRepository.Find(ctx, input.OrganizationID, invitee.ID)
+	if err == nil {
+		return errs.ErrAlreadyOrganizationMember
+	}
+	if !errors.Is(err, shared_errs.ErrRecordNotFound) {
+		uc.logger.Error().Msgf("error finding member %d of organization %d: %v", invitee.ID, input.OrganizationID, err)
+		return err
+	}
+
+	organization, err := uc.organizationRepository.FindByID(ctx, input.OrganizationID)
+	if err != nil {
+		uc.logger.Error().Msgf("error finding organization by ID %d: %v", input.OrganizationID, err)
+		return err
+	}
+
+	inviter, err := uc.userRepository.FindByID(ctx, input.UserID)
+	if err != nil {
+		uc.logger.Error().Msgf("error finding user by ID %d: %v", input.UserID, err)
+		return err
+	}
+
+	token, err := uc.hashService.GenerateRandomBytes()
+	if err != nil {
+		uc.logger.Error().Msgf("error generating random bytes: %v", err)
+		return err
+	}
+
+	tokenHash := sha256.Sum256(token)
+	var invitation model.OrganizationInvitationModel
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		oneTimeToken := model.OneTimeTokenModel{
+			UserID:    invitee.ID,
+			ExpiresAt: time.Now().UTC().Add(organizationInvitationTokenExpiration),
+			TokenType: enum.TokenTypeOrganizationInvitation,
+			TokenHash: tokenHash[:],
+		}
+		oneTimeToken, err = uc.oneTimeTokenRepository.Create(ctx, oneTimeToken)
+		if err != nil {
+			uc.logger.Error().Msgf("error creating one-time token: %v", err)
+			return err
+		}
+
+		invitation, err = uc.organizationInvitationRepository.Create(ctx, model.OrganizationInvitationModel{
+			OrganizationID:  organization.ID,
+			UserID:          invitee.ID,
+			OneTimeTokenID:  oneTimeToken.ID,
+			Role:            input.Role,
+			InvitedByUserID: inviter.ID,
+		})
+		if err != nil {
+			uc.logger.Error().Msgf("error creating invitation to organization ID %d: %v", organization.ID, err)
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	sendInput := service.SendOrganizationInvitationInput{
+		Invitee:         invitee,
+		Inviter:         inviter,
+		Organization:    organization,
+		Invitation:      invitation,
+		InvitationToken: token,
+	}
+	err = uc.sendOrganizationInvitationService.Execute(ctx, sendInput)
+	if err != nil {
+		uc.logger.Error().Msgf("error sending organization invitation email: %v", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,233 @@
+package usecase_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/repository/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+	service_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/service/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/usecase"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	database_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/database/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	shared_validator_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/validator/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type OrganizationInviteUseCaseTestSuite struct {
+	suite.Suite
+	sut                                  *usecase.OrganizationInviteUseCase
+	organizationAuthorizationServiceMock *service_mocks.MockOrganizationAuthorizationServiceI
+	sendOrganizationInvitationMock       *service_mocks.MockSendOrganizationInvitationServiceI
+	hashServiceMock                      *service_mocks.MockHashServiceI
+	organizationRepositoryMock           *repository_mocks.MockOrganizationRepositoryI
+	organizationMemberRepositoryMock     *repository_mocks.MockOrganizationMemberRepositoryI
+	organizationInvitationRepositoryMock *repository_mocks.MockOrganizationInvitationRepositoryI
+	oneTimeTokenRepositoryMock           *repository_mocks.MockOneTimeTokenRepositoryI
+	userRepositoryMock                   *repository_mocks.MockUserRepositoryI
+	txManagerMock                        *database_mocks.MockTxManagerI
+	validatorMock                        *shared_validator_mocks.MockValidate
+	logger                               logger.Logger
+}
+
+func (s *OrganizationInviteUseCaseTestSuite) SetupTest() {
+	s.organizationAuthorizationServiceMock = service_mocks.NewMockOrganizationAuthorizationServiceI(s.T())
+	s.sendOrganizationInvitationMock = service_mocks.NewMockSendOrganizationInvitationServiceI(s.T())
+	s.hashServiceMock = service_mocks.NewMockHashServiceI(s.T())
+	s.organizationRepositoryMock = repository_mocks.NewMockOrganizationRepositoryI(s.T())
+	s.organizationMemberRepositoryMock = repository_mocks.NewMockOrganizationMemberRepositoryI(s.T())
+	s.organizationInvitationRepositoryMock = repository_mocks.NewMockOrganizationInvitationRepositoryI(s.T())
+	s.oneTimeTokenRepositoryMock = repository_mocks.NewMockOneTimeTokenRepositoryI(s.T())
+	s.userRepositoryMock = repository_mocks.NewMockUserRepositoryI(s.T())
+	s.txManagerMock = database_mocks.NewMockTxManagerI(s.T())
+	s.validatorMock = shared_validator_mocks.NewMockValidate(s.T())
+	s.logger = logger.New(config.Config{Log: config.Log{LogLevel: "disabled"}})
+
+	s.txManagerMock.On("WithinTransaction", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) }).
+		Maybe()
+
+	s.sut = usecase.NewOrganizationInviteUseCase(
+		s.organizationAuthorizationServiceMock,
+		s.sendOrganizationInvitationMock,
+		s.hashServiceMock,
+		s.organizationRepositoryMock,
+		s.organizationMemberRepositoryMock,
+		s.organizationInvitationRepositoryMock,
+		s.oneTimeTokenRepositoryMock,
+		s.userRepositoryMock,
+		s.txManagerMock,
+		s.validatorMock,
+		s.logger,
+	)
+}
+
+func TestOrganizationInviteUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(OrganizationInviteUseCaseTestSuite))
+}
+
+func (s *OrganizationInviteUseCaseTestSuite) newInput() usecase.OrganizationInviteInput {
+	return usecase.OrganizationInviteInput{
+		UserID:         5,
+		OrganizationID: 3,
+		Email:          "jane@example.com",
+		Role:           enum.OrganizationRoleEditor,
+	}
+}
+
+func (s *OrganizationInviteUseCaseTestSuite) expectInviterAuthorized(
+	input usecase.OrganizationInviteInput,
+	role string,
+) {
+	inviter := model.OrganizationMemberModel{OrganizationID: input.OrganizationID, UserID: input.UserID, Role: role}
+	s.organizationAuthorizationServiceMock.
+		On("Authorize", mock.Anything, input.UserID, input.OrganizationID, enum.OrganizationRoleAdmin).
+		Return(inviter, nil)
+}
+
+func (s *OrganizationInviteUseCaseTestSuite) TestExecute_ValidInput_CreatesInvitationAndSendsEmail() {
+	// Arrange
+	ctx := context.Background()
+	input := s.newInput()
+	invitee := model.UserModel{ID: 9, Email: input.Email}
+	inviter := model.UserModel{ID: input.UserID}
+	organization := model.OrganizationModel{ID: input.OrganizationID, Name: "Platform"}
+	token := []byte("invitation-token")
+	tokenHash := sha256.Sum256(token)
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.expectInviterAuthorized(input, enum.OrganizationRoleAdmin)
+	s.userRepositoryMock.On("FindByEmail", mock.Anything, input.Email).Return(invitee, nil)
+	s.organizationMemberRepositoryMock.On("Find", mock.Anything, input.OrganizationID, invitee.ID).
+		Return(model.OrganizationMemberModel{}, shared_errs.ErrRecordNotFound)
+	s.organizationRepositoryMock.On("FindByID", mock.Anything, input.OrganizationID).Return(organization, nil)
+	s.userRepositoryMock.On("FindByID", mock.Anything, input.UserID).Return(inviter, nil)
+	s.hashServiceMock.On("GenerateRandomBytes").Return(token, nil)
+	expectedToken := mock.MatchedBy(func(oneTimeToken model.OneTimeTokenModel) bool {
+		return oneTimeToken.UserID == invitee.ID &&
+			oneTimeToken.TokenType == enum.TokenTypeOrganizationInvitation &&
+			string(oneTimeToken.TokenHash) == string(tokenHash[:])
+	})
+	s.oneTimeTokenRepositoryMock.On("Create", mock.Anything, expectedToken).
+		Return(model.OneTimeTokenModel{ID: 12}, nil)
+	expectedInvitation := model.OrganizationInvitationModel{
+		OrganizationID:  organization.ID,
+		UserID:          invitee.ID,
+		OneTimeTokenID:  12,
+		Role:            input.Role,
+		InvitedByUserID: inviter.ID,
+	}
+	createdInvitation := expectedInvitation
+	createdInvitation.ID = 20
+	s.organizationInvitationRepositoryMock.On("Create", mock.Anything, expectedInvitation).
+		Return(createdInvitation, nil)
+	s.sendOrganizationInvitationMock.On("Execute", mock.Anything, service.SendOrganizationInvitationInput{
+		Invitee:         invitee,
+		Inviter:         inviter,
+		Organization:    organization,
+		Invitation:      createdInvitation,
+		InvitationToken: token,
+	}).Return(nil)
+
+	// Act
+	err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().NoError(err)
+}
+
+func (s *OrganizationInviteUseCaseTestSuite) TestExecute_NotAnAdmin_ReturnsAccessDenied() {
+	// Arrange
+	ctx := context.Background()
+	input := s.newInput()
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.organizationAuthorizationServiceMock.
+		On("Authorize", mock.Anything, input.UserID, input.OrganizationID, enum.OrganizationRoleAdmin).
+		Return(model.OrganizationMemberModel{}, errs.ErrOrganizationAccessDenied)
+
+	// Act
+	err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrOrganizationAccessDenied)
+	s.userRepositoryMock.AssertNotCalled(s.T(), "FindByEmail", mock.Anything, mock.Anything)
+}
+
+func (s *OrganizationInviteUseCaseTestSuite) TestExecute_AdminInvitesOwner_ReturnsAccessDenied() {
+	// Arrange
+	ctx := context.Background()
+	input := s.newInput()
+	input.Role = enum.OrganizationRoleOwner
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.expectInviterAuthorized(input, enum.OrganizationRoleAdmin)
+
+	// Act
+	err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrOrganizationAccessDenied)
+}
+
+func (s *OrganizationInviteUseCaseTestSuite) TestExecute_InvalidRole_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := s.newInput()
+	input.Role = "superuser"
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.expectInviterAuthorized(input, enum.OrganizationRoleOwner)
+
+	// Act
+	err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidOrganizationRole)
+}
+
+func (s *OrganizationInviteUseCaseTestSuite) TestExecute_UnknownEmail_ReturnsNilWithoutInviting() {
+	// Arrange
+	ctx := context.Background()
+	input := s.newInput()
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.expectInviterAuthorized(input, enum.OrganizationRoleAdmin)
+	s.userRepositoryMock.On("FindByEmail", mock.Anything, input.Email).
+		Return(model.UserModel{}, shared_errs.ErrRecordNotFound)
+
+	// Act
+	err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.oneTimeTokenRepositoryMock.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+	s.sendOrganizationInvitationMock.AssertNotCalled(s.T(), "Execute", mock.Anything, mock.Anything)
+}
+
+func (s *OrganizationInviteUseCaseTestSuite) TestExecute_AlreadyMember_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := s.newInput()
+	invitee := model.UserModel{ID: 9, Email: input.Email}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.expectInviterAuthorized(input, enum.OrganizationRoleAdmin)
+	s.userRepositoryMock.On("FindByEmail", mock.Anything, input.Email).Return(invitee, nil)
+	s.organizationMemberRepositoryMock.On("Find", mock.Anything, input.OrganizationID, invitee.ID).
+		Return(model.OrganizationMemberModel{UserID: invitee.ID}, nil)
+
+	// Act
+	err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrAlreadyOrganizationMember)
+	s.oneTimeTokenRepositoryMock.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
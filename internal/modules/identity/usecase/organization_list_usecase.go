@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/repository"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+)
+
+type OrganizationListInput struct {
+	UserID uint64 `validate:"required"`
+}
+
+type OrganizationListOutput struct {
+	Organizations []OrganizationOutput
+}
+
+type OrganizationListUseCase struct {
+	organizationRepository repository.OrganizationRepositoryI
+	validate               validator.Validate
+	logger                 logger.Logger
+}
+
+func NewOrganizationListUseCase(
+	organizationRepository repository.OrganizationRepositoryI,
+	validate validator.Validate,
+	logger logger.Logger,
+) *OrganizationListUseCase {
+	return &OrganizationListUseCase{
+		organizationRepository: organizationRepository,
+		validate:               validate,
+		logger:                 logger,
+	}
+}
+
+// Execute lists the organizations the user is a member of, with the role it has in each of them.
+func (uc *OrganizationListUseCase) Execute(
+	ctx context.Context,
+	input OrganizationListInput,
+) (OrganizationListOutput, error) {
+	ctx, span := trace.Span(ctx, "OrganizationListUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return OrganizationListOutput{}, err
+	}
+
+	organizations, err := uc.organizationRepository.FindAllByUserID(ctx, input.UserID)
+	if err != nil {
+		uc.logger.Error().Msgf("error finding the organizations of user ID %d: %v", input.UserID, err)
+		return OrganizationListOutput{}, err
+	}
+
+	output := OrganizationListOutput{Organizations: make([]OrganizationOutput, len(organizations))}
+	for i, organization := range organizations {
+		output.Organizations[i] = OrganizationOutput{
+			OrganizationID: organization.OrganizationID,
+			Name:           organization.Name,
+			Role:           organization.Role,
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/repository"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+)
+
+type OrganizationMemberListInput struct {
+	UserID         uint64 `validate:"required"`
+	OrganizationID uint64 `validate:"required"`
+}
+
+type OrganizationMemberListOutput struct {
+	Members []OrganizationMemberOutput
+}
+
+type OrganizationMemberListUseCase struct {
+	organizationAuthorizationService service.OrganizationAuthorizationServiceI
+	organizationMemberRepository     repository.OrganizationMemberRepositoryI
+	validate                         validator.Validate
+	logger                           logger.Logger
+}
+
+func NewOrganizationMemberListUseCase(
+	organizationAuthorizationService service.OrganizationAuthorizationServiceI,
+	organizationMemberRepository repository.OrganizationMemberRepositoryI,
+	validate validator.Validate,
+	logger logger.Logger,
+) *OrganizationMemberListUseCase {
+	return &OrganizationMemberListUseCase{
+		organizationAuthorizationService: organizationAuthorizationService,
+		organizationMemberRepository:     organizationMemberRepository,
+		validate:                         validate,
+		logger:                           logger,
+	}
+}
+
+func (uc *OrganizationMemberListUseCase) Execute(
+	ctx context.Context,
+	input OrganizationMemberListInput,
+) (OrganizationMemberListOutput, error) {
+	ctx, span := trace.Span(ctx, "OrganizationMemberListUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return OrganizationMemberListOutput{}, err
+	}
+
+	_, err = uc.organizationAuthorizationService.Authorize(
+		ctx,
+		input.UserID,
+		input.OrganizationID,
+		enum.OrganizationRoleViewer,
+	)
+	if err != nil {
+		return OrganizationMemberListOutput{}, err
+	}
+
+	members, err := uc.organizationMemberRepository.FindAll(ctx, input.OrganizationID)
+	if err != nil {
+		uc.logger.Error().Msgf("error finding the members of organization ID %d: %v", input.OrganizationID, err)
+		return OrganizationMemberListOutput{}, err
+	}
+
+	output := OrganizationMemberListOutput{Members: make([]OrganizationMemberOutput, len(members))}
+	for i, member := range members {
+		output.Members[i] = newOrganizationMemberOutput(member)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/repository"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+)
+
+type OrganizationMemberRemoveInput struct {
+	UserID         uint64 `validate:"required"`
+	OrganizationID uint64 `validate:"required"`
+	MemberUserID   uint64 `validate:"required"`
+}
+
+type OrganizationMemberRemoveUseCase struct {
+	organizationAuthorizationService service.OrganizationAuthorizationServiceI
+	organizationMemberRepository     repository.OrganizationMemberRepositoryI
+	txManager                        database.TxManagerI
+	validate                         validator.Validate
+	logger                           logger.Logger
+}
+
+func NewOrganizationMemberRemoveUseCase(
+	organizationAuthorizationService service.OrganizationAuthorizationServiceI,
+	organizationMemberRepository repository.OrganizationMemberRepositoryI,
+	txManager database.TxManagerI,
+	validate validator.Validate,
+	logger logger.Logger,
+) *OrganizationMemberRemoveUseCase {
+	return &OrganizationMemberRemoveUseCase{
+		organizationAuthorizationService: organizationAuthorizationService,
+		organizationMemberRepository:     organizationMemberRepository,
+		txManager:                        txManager,
+		validate:                         validate,
+		logger:                           logger,
+	}
+}
+
+// Execute removes a member from the organization. Every member can leave it, admins remove
+// the members up to their own role, and the last owner cannot leave.
+func (uc *OrganizationMemberRemoveUseCase) Execute(ctx context.Context, input OrganizationMemberRemoveInput) error {
+	ctx, span := trace.Span(ctx, "OrganizationMemberRemoveUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return err
+	}
+
+	requiredRole := enum.OrganizationRoleAdmin
+	if input.MemberUserID == input.UserID {
+		requiredRole = enum.OrganizationRoleViewer
+	}
+
+	actor, err := uc.organizationAuthorizationService.Authorize(ctx, input.UserID, input.OrganizationID, requiredRole)
+	if err != nil {
+		return err
+	}
+
+	return uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		member, findErr := uc.organizationMemberRepository.Find(ctx, input.OrganizationID, input.MemberUserID)
+		if findErr != nil {
+			if !errors.Is(findErr, shared_errs.ErrRecordNotFound) {
+				uc.logger.Error().Msgf("error finding member %d: %v", input.MemberUserID, findErr)
+			}
+			return findErr
+		}
+
+		if err = checkRoleIncludes(actor.Role, member.Role); err != nil {
+			return err
+		}
+
+		if err = checkOtherOwnerExists(ctx, uc.organizationMemberRepository, member); err != nil {
+			return err
+		}
+
+		err = uc.organizationMemberRepository.Delete(ctx, input.OrganizationID, input.MemberUserID)
+		if err != nil {
+			uc.logger.Error().Msgf("error removing member %d: %v", input.MemberUserID, err)
+			return err
+		}
+
+		return nil
+	})
+}
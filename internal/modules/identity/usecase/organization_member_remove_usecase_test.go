@@ -0,0 +1,150 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/repository/mocks"
+	service_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/service/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/usecase"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	database_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/database/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	shared_validator_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/validator/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type OrganizationMemberRemoveUseCaseTestSuite struct {
+	suite.Suite
+	sut                                  *usecase.OrganizationMemberRemoveUseCase
+	organizationAuthorizationServiceMock *service_mocks.MockOrganizationAuthorizationServiceI
+	organizationMemberRepositoryMock     *repository_mocks.MockOrganizationMemberRepositoryI
+	txManagerMock                        *database_mocks.MockTxManagerI
+	validatorMock                        *shared_validator_mocks.MockValidate
+	logger                               logger.Logger
+}
+
+func (s *OrganizationMemberRemoveUseCaseTestSuite) SetupTest() {
+	s.organizationAuthorizationServiceMock = service_mocks.NewMockOrganizationAuthorizationServiceI(s.T())
+	s.organizationMemberRepositoryMock = repository_mocks.NewMockOrganizationMemberRepositoryI(s.T())
+	s.txManagerMock = database_mocks.NewMockTxManagerI(s.T())
+	s.validatorMock = shared_validator_mocks.NewMockValidate(s.T())
+	s.logger = logger.New(config.Config{Log: config.Log{LogLevel: "disabled"}})
+
+	s.txManagerMock.On("WithinTransaction", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) }).
+		Maybe()
+
+	s.sut = usecase.NewOrganizationMemberRemoveUseCase(
+		s.organizationAuthorizationServiceMock,
+		s.organizationMemberRepositoryMock,
+		s.txManagerMock,
+		s.validatorMock,
+		s.logger,
+	)
+}
+
+func TestOrganizationMemberRemoveUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(OrganizationMemberRemoveUseCaseTestSuite))
+}
+
+func (s *OrganizationMemberRemoveUseCaseTestSuite) expectActor(
+	input usecase.OrganizationMemberRemoveInput,
+	requiredRole, role string,
+) {
+	actor := model.OrganizationMemberModel{OrganizationID: input.OrganizationID, UserID: input.UserID, Role: role}
+	s.organizationAuthorizationServiceMock.
+		On("Authorize", mock.Anything, input.UserID, input.OrganizationID, requiredRole).
+		Return(actor, nil)
+}
+
+func (s *OrganizationMemberRemoveUseCaseTestSuite) expectMember(
+	input usecase.OrganizationMemberRemoveInput,
+	role string,
+) {
+	member := model.OrganizationMemberModel{
+		OrganizationID: input.OrganizationID,
+		UserID:         input.MemberUserID,
+		Role:           role,
+	}
+	s.organizationMemberRepositoryMock.On("Find", mock.Anything, input.OrganizationID, input.MemberUserID).
+		Return(member, nil)
+}
+
+func (s *OrganizationMemberRemoveUseCaseTestSuite) TestExecute_AdminRemovesEditor_DeletesMember() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.OrganizationMemberRemoveInput{UserID: 5, OrganizationID: 3, MemberUserID: 9}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.expectActor(input, enum.OrganizationRoleAdmin, enum.OrganizationRoleAdmin)
+	s.expectMember(input, enum.OrganizationRoleEditor)
+	s.organizationMemberRepositoryMock.On("Delete", mock.Anything, input.OrganizationID, input.MemberUserID).
+		Return(nil)
+
+	// Act
+	err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().NoError(err)
+}
+
+func (s *OrganizationMemberRemoveUseCaseTestSuite) TestExecute_ViewerLeaves_DeletesMember() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.OrganizationMemberRemoveInput{UserID: 9, OrganizationID: 3, MemberUserID: 9}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.expectActor(input, enum.OrganizationRoleViewer, enum.OrganizationRoleViewer)
+	s.expectMember(input, enum.OrganizationRoleViewer)
+	s.organizationMemberRepositoryMock.On("Delete", mock.Anything, input.OrganizationID, input.MemberUserID).
+		Return(nil)
+
+	// Act
+	err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().NoError(err)
+}
+
+func (s *OrganizationMemberRemoveUseCaseTestSuite) TestExecute_EditorRemovesAnotherMember_ReturnsAccessDenied() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.OrganizationMemberRemoveInput{UserID: 5, OrganizationID: 3, MemberUserID: 9}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.organizationAuthorizationServiceMock.
+		On("Authorize", mock.Anything, input.UserID, input.OrganizationID, enum.OrganizationRoleAdmin).
+		Return(model.OrganizationMemberModel{}, errs.ErrOrganizationAccessDenied)
+
+	// Act
+	err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrOrganizationAccessDenied)
+	s.organizationMemberRepositoryMock.AssertNotCalled(s.T(), "Delete", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *OrganizationMemberRemoveUseCaseTestSuite) TestExecute_LastOwnerLeaves_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.OrganizationMemberRemoveInput{UserID: 5, OrganizationID: 3, MemberUserID: 5}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.expectActor(input, enum.OrganizationRoleViewer, enum.OrganizationRoleOwner)
+	s.expectMember(input, enum.OrganizationRoleOwner)
+	s.organizationMemberRepositoryMock.
+		On("LockByRole", mock.Anything, input.OrganizationID, enum.OrganizationRoleOwner).
+		Return([]model.OrganizationMemberModel{{UserID: input.MemberUserID, Role: enum.OrganizationRoleOwner}}, nil)
+
+	// Act
+	err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrLastOrganizationOwner)
+	s.organizationMemberRepositoryMock.AssertNotCalled(s.T(), "Delete", mock.Anything, mock.Anything, mock.Anything)
+}
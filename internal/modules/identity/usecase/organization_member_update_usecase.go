@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/repository"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+)
+
+type OrganizationMemberUpdateInput struct {
+	UserID         uint64 `validate:"required"`
+	OrganizationID uint64 `validate:"required"`
+	MemberUserID   uint64 `validate:"required"`
+	Role           string `validate:"required"`
+}
+
+type OrganizationMemberUpdateUseCase struct {
+	organizationAuthorizationService service.OrganizationAuthorizationServiceI
+	organizationMemberRepository     repository.OrganizationMemberRepositoryI
+	txManager                        database.TxManagerI
+	validate                         validator.Validate
+	logger                           logger.Logger
+}
+
+func NewOrganizationMemberUpdateUseCase(
+	organizationAuthorizationService service.OrganizationAuthorizationServiceI,
+	organizationMemberRepository repository.OrganizationMemberRepositoryI,
+	txManager database.TxManagerI,
+	validate validator.Validate,
+	logger logger.Logger,
+) *OrganizationMemberUpdateUseCase {
+	return &OrganizationMemberUpdateUseCase{
+		organizationAuthorizationService: organizationAuthorizationService,
+		organizationMemberRepository:     organizationMemberRepository,
+		txManager:                        txManager,
+		validate:                         validate,
+		logger:                           logger,
+	}
+}
+
+// Execute changes the role of a member. Admins manage the members up to their own role,
+// and the last owner of an organization keeps its role.
+func (uc *OrganizationMemberUpdateUseCase) Execute(
+	ctx context.Context,
+	input OrganizationMemberUpdateInput,
+) (OrganizationMemberOutput, error) {
+	ctx, span := trace.Span(ctx, "OrganizationMemberUpdateUseCase.Execute")
+	defer span.End()
+
+	output := OrganizationMemberOutput{}
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return output, err
+	}
+
+	actor, err := uc.organizationAuthorizationService.Authorize(
+		ctx,
+		input.UserID,
+		input.OrganizationID,
+		enum.OrganizationRoleAdmin,
+	)
+	if err != nil {
+		return output, err
+	}
+
+	err = checkRoleIncludes(actor.Role, input.Role)
+	if err != nil {
+		return output, err
+	}
+
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		member, findErr := uc.organizationMemberRepository.Find(ctx, input.OrganizationID, input.MemberUserID)
+		if findErr != nil {
+			if !errors.Is(findErr, shared_errs.ErrRecordNotFound) {
+				uc.logger.Error().Msgf("error finding member %d: %v", input.MemberUserID, findErr)
+			}
+			return findErr
+		}
+
+		if err = checkRoleIncludes(actor.Role, member.Role); err != nil {
+			return err
+		}
+
+		if input.Role != enum.OrganizationRoleOwner {
+			if err = checkOtherOwnerExists(ctx, uc.organizationMemberRepository, member); err != nil {
+				return err
+			}
+		}
+
+		err = uc.organizationMemberRepository.UpdateRole(ctx, input.OrganizationID, input.MemberUserID, input.Role)
+		if err != nil {
+			uc.logger.Error().Msgf("error updating the role of member %d: %v", input.MemberUserID, err)
+			return err
+		}
+
+		member.Role = input.Role
+		output = newOrganizationMemberOutput(member)
+		return nil
+	})
+	if err != nil {
+		return OrganizationMemberOutput{}, err
+	}
+
+	return output, nil
+}
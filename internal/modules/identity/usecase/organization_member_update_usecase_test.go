@@ -0,0 +1,225 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/repository/mocks"
+	service_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/service/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/usecase"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	database_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/database/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	shared_validator_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/validator/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type OrganizationMemberUpdateUseCaseTestSuite struct {
+	suite.Suite
+	sut                                  *usecase.OrganizationMemberUpdateUseCase
+	organizationAuthorizationServiceMock *service_mocks.MockOrganizationAuthorizationServiceI
+	organizationMemberRepositoryMock     *repository_mocks.MockOrganizationMemberRepositoryI
+	txManagerMock                        *database_mocks.MockTxManagerI
+	validatorMock                        *shared_validator_mocks.MockValidate
+	logger                               logger.Logger
+}
+
+func (s *OrganizationMemberUpdateUseCaseTestSuite) SetupTest() {
+	s.organizationAuthorizationServiceMock = service_mocks.NewMockOrganizationAuthorizationServiceI(s.T())
+	s.organizationMemberRepositoryMock = repository_mocks.NewMockOrganizationMemberRepositoryI(s.T())
+	s.txManagerMock = database_mocks.NewMockTxManagerI(s.T())
+	s.validatorMock = shared_validator_mocks.NewMockValidate(s.T())
+	s.logger = logger.New(config.Config{Log: config.Log{LogLevel: "disabled"}})
+
+	s.txManagerMock.On("WithinTransaction", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) }).
+		Maybe()
+
+	s.sut = usecase.NewOrganizationMemberUpdateUseCase(
+		s.organizationAuthorizationServiceMock,
+		s.organizationMemberRepositoryMock,
+		s.txManagerMock,
+		s.validatorMock,
+		s.logger,
+	)
+}
+
+func TestOrganizationMemberUpdateUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(OrganizationMemberUpdateUseCaseTestSuite))
+}
+
+func (s *OrganizationMemberUpdateUseCaseTestSuite) expectActor(
+	input usecase.OrganizationMemberUpdateInput,
+	role string,
+) {
+	actor := model.OrganizationMemberModel{OrganizationID: input.OrganizationID, UserID: input.UserID, Role: role}
+	s.organizationAuthorizationServiceMock.
+		On("Authorize", mock.Anything, input.UserID, input.OrganizationID, enum.OrganizationRoleAdmin).
+		Return(actor, nil)
+}
+
+func (s *OrganizationMemberUpdateUseCaseTestSuite) expectMember(
+	input usecase.OrganizationMemberUpdateInput,
+	role string,
+) {
+	member := model.OrganizationMemberModel{
+		OrganizationID: input.OrganizationID,
+		UserID:         input.MemberUserID,
+		Role:           role,
+	}
+	s.organizationMemberRepositoryMock.On("Find", mock.Anything, input.OrganizationID, input.MemberUserID).
+		Return(member, nil)
+}
+
+func (s *OrganizationMemberUpdateUseCaseTestSuite) TestExecute_AdminPromotesViewer_UpdatesRole() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.OrganizationMemberUpdateInput{
+		UserID:         5,
+		OrganizationID: 3,
+		MemberUserID:   9,
+		Role:           enum.OrganizationRoleEditor,
+	}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.expectActor(input, enum.OrganizationRoleAdmin)
+	s.expectMember(input, enum.OrganizationRoleViewer)
+	s.organizationMemberRepositoryMock.
+		On("UpdateRole", mock.Anything, input.OrganizationID, input.MemberUserID, input.Role).
+		Return(nil)
+
+	// Act
+	output, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(input.MemberUserID, output.UserID)
+	s.Equal(enum.OrganizationRoleEditor, output.Role)
+}
+
+func (s *OrganizationMemberUpdateUseCaseTestSuite) TestExecute_AdminPromotesToOwner_ReturnsAccessDenied() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.OrganizationMemberUpdateInput{
+		UserID:         5,
+		OrganizationID: 3,
+		MemberUserID:   9,
+		Role:           enum.OrganizationRoleOwner,
+	}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.expectActor(input, enum.OrganizationRoleAdmin)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrOrganizationAccessDenied)
+	s.organizationMemberRepositoryMock.AssertNotCalled(
+		s.T(), "UpdateRole", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	)
+}
+
+func (s *OrganizationMemberUpdateUseCaseTestSuite) TestExecute_AdminDemotesOwner_ReturnsAccessDenied() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.OrganizationMemberUpdateInput{
+		UserID:         5,
+		OrganizationID: 3,
+		MemberUserID:   9,
+		Role:           enum.OrganizationRoleViewer,
+	}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.expectActor(input, enum.OrganizationRoleAdmin)
+	s.expectMember(input, enum.OrganizationRoleOwner)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrOrganizationAccessDenied)
+}
+
+func (s *OrganizationMemberUpdateUseCaseTestSuite) TestExecute_LastOwnerDemotesItself_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.OrganizationMemberUpdateInput{
+		UserID:         5,
+		OrganizationID: 3,
+		MemberUserID:   5,
+		Role:           enum.OrganizationRoleAdmin,
+	}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.expectActor(input, enum.OrganizationRoleOwner)
+	s.expectMember(input, enum.OrganizationRoleOwner)
+	s.organizationMemberRepositoryMock.
+		On("LockByRole", mock.Anything, input.OrganizationID, enum.OrganizationRoleOwner).
+		Return([]model.OrganizationMemberModel{{UserID: input.MemberUserID, Role: enum.OrganizationRoleOwner}}, nil)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrLastOrganizationOwner)
+}
+
+func (s *OrganizationMemberUpdateUseCaseTestSuite) TestExecute_OwnerDemotedWithAnotherOwner_UpdatesRole() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.OrganizationMemberUpdateInput{
+		UserID:         5,
+		OrganizationID: 3,
+		MemberUserID:   9,
+		Role:           enum.OrganizationRoleAdmin,
+	}
+	owners := []model.OrganizationMemberModel{
+		{UserID: input.UserID, Role: enum.OrganizationRoleOwner},
+		{UserID: input.MemberUserID, Role: enum.OrganizationRoleOwner},
+	}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.expectActor(input, enum.OrganizationRoleOwner)
+	s.expectMember(input, enum.OrganizationRoleOwner)
+	s.organizationMemberRepositoryMock.
+		On("LockByRole", mock.Anything, input.OrganizationID, enum.OrganizationRoleOwner).
+		Return(owners, nil)
+	s.organizationMemberRepositoryMock.
+		On("UpdateRole", mock.Anything, input.OrganizationID, input.MemberUserID, input.Role).
+		Return(nil)
+
+	// Act
+	output, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(enum.OrganizationRoleAdmin, output.Role)
+}
+
+func (s *OrganizationMemberUpdateUseCaseTestSuite) TestExecute_MemberNotFound_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.OrganizationMemberUpdateInput{
+		UserID:         5,
+		OrganizationID: 3,
+		MemberUserID:   9,
+		Role:           enum.OrganizationRoleEditor,
+	}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.expectActor(input, enum.OrganizationRoleOwner)
+	s.organizationMemberRepositoryMock.On("Find", mock.Anything, input.OrganizationID, input.MemberUserID).
+		Return(model.OrganizationMemberModel{}, shared_errs.ErrRecordNotFound)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, shared_errs.ErrRecordNotFound)
+}
@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/repository"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+)
+
+type TOTPConfirmInput struct {
+	UserID uint64 `validate:"required"`
+	Code   string `validate:"required"`
+}
+
+type TOTPConfirmOutput struct {
+	RecoveryCodes []string
+}
+
+type TOTPConfirmUseCase struct {
+	totpService                service.TOTPServiceI
+	totpEnrollmentRepository   repository.TOTPEnrollmentRepositoryI
+	totpRecoveryCodeRepository repository.TOTPRecoveryCodeRepositoryI
+	txManager                  database.TxManagerI
+	validator                  validator.Validate
+	logger                     logger.Logger
+}
+
+func NewTOTPConfirmUseCase(
+	totpService service.TOTPServiceI,
+	totpEnrollmentRepository repository.TOTPEnrollmentRepositoryI,
+	totpRecoveryCodeRepository repository.TOTPRecoveryCodeRepositoryI,
+	txManager database.TxManagerI,
+	validator validator.Validate,
+	logger logger.Logger,
+) *TOTPConfirmUseCase {
+	return &TOTPConfirmUseCase{
+		totpService:                totpService,
+		totpEnrollmentRepository:   totpEnrollmentRepository,
+		totpRecoveryCodeRepository: totpRecoveryCodeRepository,
+		txManager:                  txManager,
+		validator:                  validator,
+		logger:                     logger,
+	}
+}
+
+// Execute confirms the enrollment with a code of the authenticator app, proving it was set up,
+// and returns the recovery codes. The recovery codes are only returned here.
+func (uc *TOTPConfirmUseCase) Execute(ctx context.Context, input TOTPConfirmInput) (TOTPConfirmOutput, error) {
+	ctx, span := trace.Span(ctx, "TOTPConfirmUseCase.Execute")
+	defer span.End()
+
+	if err := uc.validator.Struct(input); err != nil {
+		return TOTPConfirmOutput{}, err
+	}
+
+	enrollment, err := uc.totpEnrollmentRepository.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		if errors.Is(err, shared_errs.ErrRecordNotFound) {
+			return TOTPConfirmOutput{}, errs.ErrTOTPNotEnrolled
+		}
+		uc.logger.Error().Msgf("error finding the TOTP enrollment of user ID %d: %v", input.UserID, err)
+		return TOTPConfirmOutput{}, err
+	}
+
+	if enrollment.ConfirmedAt != nil {
+		return TOTPConfirmOutput{}, errs.ErrTOTPAlreadyEnrolled
+	}
+
+	secret, err := uc.totpService.DecryptSecret(enrollment.SecretEncrypted)
+	if err != nil {
+		uc.logger.Error().Msgf("error decrypting the TOTP secret of user ID %d: %v", input.UserID, err)
+		return TOTPConfirmOutput{}, err
+	}
+
+	now := time.Now().UTC()
+	step, ok := uc.totpService.Validate(secret, input.Code, now)
+	if !ok {
+		return TOTPConfirmOutput{}, errs.ErrInvalidTOTPCode
+	}
+
+	recoveryCodes, err := uc.totpService.GenerateRecoveryCodes()
+	if err != nil {
+		uc.logger.Error().Msgf("error generating recovery codes: %v", err)
+		return TOTPConfirmOutput{}, err
+	}
+
+	recoveryCodeModels := make([]model.TOTPRecoveryCodeModel, 0, len(recoveryCodes))
+	for _, recoveryCode := range recoveryCodes {
+		recoveryCodeModels = append(recoveryCodeModels, model.TOTPRecoveryCodeModel{
+			UserID:   input.UserID,
+			CodeHash: uc.totpService.HashRecoveryCode(recoveryCode),
+		})
+	}
+
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err = uc.totpEnrollmentRepository.Confirm(ctx, input.UserID, now, step); err != nil {
+			if errors.Is(err, shared_errs.ErrRecordNotFound) {
+				return errs.ErrTOTPAlreadyEnrolled
+			}
+			uc.logger.Error().Msgf("error confirming the TOTP enrollment of user ID %d: %v", input.UserID, err)
+			return err
+		}
+
+		if err = uc.totpRecoveryCodeRepository.DeleteByUserID(ctx, input.UserID); err != nil {
+			uc.logger.Error().Msgf("error deleting the recovery codes of user ID %d: %v", input.UserID, err)
+			return err
+		}
+
+		if err = uc.totpRecoveryCodeRepository.CreateMany(ctx, recoveryCodeModels); err != nil {
+			uc.logger.Error().Msgf("error creating the recovery codes of user ID %d: %v", input.UserID, err)
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return TOTPConfirmOutput{}, err
+	}
+
+	return TOTPConfirmOutput{RecoveryCodes: recoveryCodes}, nil
+}
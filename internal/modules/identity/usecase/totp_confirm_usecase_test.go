@@ -0,0 +1,164 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/repository/mocks"
+	service_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/service/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/usecase"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	database_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/database/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	shared_validator_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/validator/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type TOTPConfirmUseCaseTestSuite struct {
+	suite.Suite
+	sut                            *usecase.TOTPConfirmUseCase
+	totpServiceMock                *service_mocks.MockTOTPServiceI
+	totpEnrollmentRepositoryMock   *repository_mocks.MockTOTPEnrollmentRepositoryI
+	totpRecoveryCodeRepositoryMock *repository_mocks.MockTOTPRecoveryCodeRepositoryI
+	txManagerMock                  *database_mocks.MockTxManagerI
+	validatorMock                  *shared_validator_mocks.MockValidate
+}
+
+func (s *TOTPConfirmUseCaseTestSuite) SetupTest() {
+	s.totpServiceMock = service_mocks.NewMockTOTPServiceI(s.T())
+	s.totpEnrollmentRepositoryMock = repository_mocks.NewMockTOTPEnrollmentRepositoryI(s.T())
+	s.totpRecoveryCodeRepositoryMock = repository_mocks.NewMockTOTPRecoveryCodeRepositoryI(s.T())
+	s.txManagerMock = database_mocks.NewMockTxManagerI(s.T())
+	s.validatorMock = shared_validator_mocks.NewMockValidate(s.T())
+
+	s.txManagerMock.On("WithinTransaction", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) },
+	).Maybe()
+
+	s.sut = usecase.NewTOTPConfirmUseCase(
+		s.totpServiceMock,
+		s.totpEnrollmentRepositoryMock,
+		s.totpRecoveryCodeRepositoryMock,
+		s.txManagerMock,
+		s.validatorMock,
+		logger.New(config.Config{Log: config.Log{LogLevel: "disabled"}}),
+	)
+}
+
+func TestTOTPConfirmUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(TOTPConfirmUseCaseTestSuite))
+}
+
+func (s *TOTPConfirmUseCaseTestSuite) TestExecute_ValidCode_ConfirmsAndReturnsRecoveryCodes() {
+	// Arrange
+	input := usecase.TOTPConfirmInput{UserID: 7, Code: "287082"}
+	secret := []byte("12345678901234567890")
+	enrollment := model.TOTPEnrollmentModel{ID: 1, UserID: 7, SecretEncrypted: []byte("encrypted-secret")}
+	recoveryCodes := []string{"aaaa-bbbb-cccc-dddd", "eeee-ffff-gggg-hhhh"}
+	step := int64(1234)
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, input.UserID).Return(enrollment, nil)
+	s.totpServiceMock.On("DecryptSecret", enrollment.SecretEncrypted).Return(secret, nil)
+	s.totpServiceMock.On("Validate", secret, input.Code, mock.Anything).Return(step, true)
+	s.totpServiceMock.On("GenerateRecoveryCodes").Return(recoveryCodes, nil)
+	s.totpServiceMock.On("HashRecoveryCode", recoveryCodes[0]).Return([]byte("hash-1"))
+	s.totpServiceMock.On("HashRecoveryCode", recoveryCodes[1]).Return([]byte("hash-2"))
+	s.totpEnrollmentRepositoryMock.On("Confirm", mock.Anything, input.UserID, mock.Anything, step).Return(nil)
+	s.totpRecoveryCodeRepositoryMock.On("DeleteByUserID", mock.Anything, input.UserID).Return(nil)
+	s.totpRecoveryCodeRepositoryMock.On("CreateMany", mock.Anything, []model.TOTPRecoveryCodeModel{
+		{UserID: 7, CodeHash: []byte("hash-1")},
+		{UserID: 7, CodeHash: []byte("hash-2")},
+	}).Return(nil)
+
+	// Act
+	output, err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(recoveryCodes, output.RecoveryCodes)
+}
+
+func (s *TOTPConfirmUseCaseTestSuite) TestExecute_InvalidCode_ReturnsError() {
+	// Arrange
+	input := usecase.TOTPConfirmInput{UserID: 7, Code: "000000"}
+	secret := []byte("12345678901234567890")
+	enrollment := model.TOTPEnrollmentModel{ID: 1, UserID: 7, SecretEncrypted: []byte("encrypted-secret")}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, input.UserID).Return(enrollment, nil)
+	s.totpServiceMock.On("DecryptSecret", enrollment.SecretEncrypted).Return(secret, nil)
+	s.totpServiceMock.On("Validate", secret, input.Code, mock.Anything).Return(int64(0), false)
+
+	// Act
+	output, err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidTOTPCode)
+	s.Empty(output.RecoveryCodes)
+	s.totpEnrollmentRepositoryMock.AssertNotCalled(
+		s.T(), "Confirm", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	)
+}
+
+func (s *TOTPConfirmUseCaseTestSuite) TestExecute_NotEnrolled_ReturnsError() {
+	// Arrange
+	input := usecase.TOTPConfirmInput{UserID: 7, Code: "287082"}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, input.UserID).
+		Return(model.TOTPEnrollmentModel{}, shared_errs.ErrRecordNotFound)
+
+	// Act
+	_, err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrTOTPNotEnrolled)
+}
+
+func (s *TOTPConfirmUseCaseTestSuite) TestExecute_AlreadyConfirmed_ReturnsError() {
+	// Arrange
+	input := usecase.TOTPConfirmInput{UserID: 7, Code: "287082"}
+	confirmedAt := time.Now()
+	enrollment := model.TOTPEnrollmentModel{ID: 1, UserID: 7, ConfirmedAt: &confirmedAt}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, input.UserID).Return(enrollment, nil)
+
+	// Act
+	_, err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrTOTPAlreadyEnrolled)
+	s.totpServiceMock.AssertNotCalled(s.T(), "DecryptSecret", mock.Anything)
+}
+
+func (s *TOTPConfirmUseCaseTestSuite) TestExecute_ConfirmedConcurrently_ReturnsError() {
+	// Arrange
+	input := usecase.TOTPConfirmInput{UserID: 7, Code: "287082"}
+	secret := []byte("12345678901234567890")
+	enrollment := model.TOTPEnrollmentModel{ID: 1, UserID: 7, SecretEncrypted: []byte("encrypted-secret")}
+	recoveryCodes := []string{"aaaa-bbbb-cccc-dddd"}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, input.UserID).Return(enrollment, nil)
+	s.totpServiceMock.On("DecryptSecret", enrollment.SecretEncrypted).Return(secret, nil)
+	s.totpServiceMock.On("Validate", secret, input.Code, mock.Anything).Return(int64(1234), true)
+	s.totpServiceMock.On("GenerateRecoveryCodes").Return(recoveryCodes, nil)
+	s.totpServiceMock.On("HashRecoveryCode", recoveryCodes[0]).Return([]byte("hash-1"))
+	s.totpEnrollmentRepositoryMock.On("Confirm", mock.Anything, input.UserID, mock.Anything, int64(1234)).
+		Return(shared_errs.ErrRecordNotFound)
+
+	// Act
+	output, err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrTOTPAlreadyEnrolled)
+	s.Empty(output.RecoveryCodes)
+	s.totpRecoveryCodeRepositoryMock.AssertNotCalled(s.T(), "CreateMany", mock.Anything, mock.Anything)
+}
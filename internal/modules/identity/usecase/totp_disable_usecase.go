@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/repository"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+)
+
+type TOTPDisableInput struct {
+	UserID uint64 `validate:"required"`
+	Code   string `validate:"required"`
+}
+
+type TOTPDisableUseCase struct {
+	totpVerificationService    service.TOTPVerificationServiceI
+	totpEnrollmentRepository   repository.TOTPEnrollmentRepositoryI
+	totpRecoveryCodeRepository repository.TOTPRecoveryCodeRepositoryI
+	txManager                  database.TxManagerI
+	validator                  validator.Validate
+	logger                     logger.Logger
+}
+
+func NewTOTPDisableUseCase(
+	totpVerificationService service.TOTPVerificationServiceI,
+	totpEnrollmentRepository repository.TOTPEnrollmentRepositoryI,
+	totpRecoveryCodeRepository repository.TOTPRecoveryCodeRepositoryI,
+	txManager database.TxManagerI,
+	validator validator.Validate,
+	logger logger.Logger,
+) *TOTPDisableUseCase {
+	return &TOTPDisableUseCase{
+		totpVerificationService:    totpVerificationService,
+		totpEnrollmentRepository:   totpEnrollmentRepository,
+		totpRecoveryCodeRepository: totpRecoveryCodeRepository,
+		txManager:                  txManager,
+		validator:                  validator,
+		logger:                     logger,
+	}
+}
+
+// Execute removes the authenticator app of the user, the login goes back to the emailed codes.
+// It takes a code of the app or a recovery code, so a stolen session can't turn it off.
+func (uc *TOTPDisableUseCase) Execute(ctx context.Context, input TOTPDisableInput) error {
+	ctx, span := trace.Span(ctx, "TOTPDisableUseCase.Execute")
+	defer span.End()
+
+	if err := uc.validator.Struct(input); err != nil {
+		return err
+	}
+
+	enrollment, err := uc.totpEnrollmentRepository.FindByUserID(ctx, input.UserID)
+	if err != nil && !errors.Is(err, shared_errs.ErrRecordNotFound) {
+		uc.logger.Error().Msgf("error finding the TOTP enrollment of user ID %d: %v", input.UserID, err)
+		return err
+	}
+
+	if enrollment.ConfirmedAt == nil {
+		return errs.ErrTOTPNotEnrolled
+	}
+
+	if err = uc.totpVerificationService.Verify(ctx, enrollment, input.Code); err != nil {
+		return err
+	}
+
+	return uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err = uc.totpEnrollmentRepository.DeleteByUserID(ctx, input.UserID); err != nil {
+			uc.logger.Error().Msgf("error deleting the TOTP enrollment of user ID %d: %v", input.UserID, err)
+			return err
+		}
+
+		if err = uc.totpRecoveryCodeRepository.DeleteByUserID(ctx, input.UserID); err != nil {
+			uc.logger.Error().Msgf("error deleting the recovery codes of user ID %d: %v", input.UserID, err)
+			return err
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,142 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/repository/mocks"
+	service_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/service/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/usecase"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	database_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/database/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	shared_validator_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/validator/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type TOTPDisableUseCaseTestSuite struct {
+	suite.Suite
+	sut                            *usecase.TOTPDisableUseCase
+	totpVerificationServiceMock    *service_mocks.MockTOTPVerificationServiceI
+	totpEnrollmentRepositoryMock   *repository_mocks.MockTOTPEnrollmentRepositoryI
+	totpRecoveryCodeRepositoryMock *repository_mocks.MockTOTPRecoveryCodeRepositoryI
+	txManagerMock                  *database_mocks.MockTxManagerI
+	validatorMock                  *shared_validator_mocks.MockValidate
+}
+
+func (s *TOTPDisableUseCaseTestSuite) SetupTest() {
+	s.totpVerificationServiceMock = service_mocks.NewMockTOTPVerificationServiceI(s.T())
+	s.totpEnrollmentRepositoryMock = repository_mocks.NewMockTOTPEnrollmentRepositoryI(s.T())
+	s.totpRecoveryCodeRepositoryMock = repository_mocks.NewMockTOTPRecoveryCodeRepositoryI(s.T())
+	s.txManagerMock = database_mocks.NewMockTxManagerI(s.T())
+	s.validatorMock = shared_validator_mocks.NewMockValidate(s.T())
+
+	s.txManagerMock.On("WithinTransaction", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) },
+	).Maybe()
+
+	s.sut = usecase.NewTOTPDisableUseCase(
+		s.totpVerificationServiceMock,
+		s.totpEnrollmentRepositoryMock,
+		s.totpRecoveryCodeRepositoryMock,
+		s.txManagerMock,
+		s.validatorMock,
+		logger.New(config.Config{Log: config.Log{LogLevel: "disabled"}}),
+	)
+}
+
+func TestTOTPDisableUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(TOTPDisableUseCaseTestSuite))
+}
+
+func (s *TOTPDisableUseCaseTestSuite) TestExecute_ValidCode_RemovesEnrollmentAndRecoveryCodes() {
+	// Arrange
+	input := usecase.TOTPDisableInput{UserID: 7, Code: "287082"}
+	confirmedAt := time.Now()
+	enrollment := model.TOTPEnrollmentModel{ID: 1, UserID: 7, ConfirmedAt: &confirmedAt}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, input.UserID).Return(enrollment, nil)
+	s.totpVerificationServiceMock.On("Verify", mock.Anything, enrollment, input.Code).Return(nil)
+	s.totpEnrollmentRepositoryMock.On("DeleteByUserID", mock.Anything, input.UserID).Return(nil)
+	s.totpRecoveryCodeRepositoryMock.On("DeleteByUserID", mock.Anything, input.UserID).Return(nil)
+
+	// Act
+	err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().NoError(err)
+}
+
+func (s *TOTPDisableUseCaseTestSuite) TestExecute_InvalidCode_ReturnsError() {
+	// Arrange
+	input := usecase.TOTPDisableInput{UserID: 7, Code: "000000"}
+	confirmedAt := time.Now()
+	enrollment := model.TOTPEnrollmentModel{ID: 1, UserID: 7, ConfirmedAt: &confirmedAt}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, input.UserID).Return(enrollment, nil)
+	s.totpVerificationServiceMock.On("Verify", mock.Anything, enrollment, input.Code).Return(errs.ErrInvalidTOTPCode)
+
+	// Act
+	err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidTOTPCode)
+	s.totpEnrollmentRepositoryMock.AssertNotCalled(s.T(), "DeleteByUserID", mock.Anything, mock.Anything)
+}
+
+func (s *TOTPDisableUseCaseTestSuite) TestExecute_TooManyInvalidCodes_ReturnsLockedError() {
+	// Arrange
+	input := usecase.TOTPDisableInput{UserID: 7, Code: "123456"}
+	confirmedAt := time.Now()
+	lockedUntil := time.Now().Add(10 * time.Minute)
+	enrollment := model.TOTPEnrollmentModel{ID: 1, UserID: 7, ConfirmedAt: &confirmedAt, LockedUntil: &lockedUntil}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, input.UserID).Return(enrollment, nil)
+	s.totpVerificationServiceMock.On("Verify", mock.Anything, enrollment, input.Code).Return(errs.ErrTOTPLocked)
+
+	// Act
+	err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrTOTPLocked)
+	s.totpEnrollmentRepositoryMock.AssertNotCalled(s.T(), "DeleteByUserID", mock.Anything, mock.Anything)
+}
+
+func (s *TOTPDisableUseCaseTestSuite) TestExecute_NotEnrolled_ReturnsError() {
+	// Arrange
+	input := usecase.TOTPDisableInput{UserID: 7, Code: "287082"}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, input.UserID).
+		Return(model.TOTPEnrollmentModel{}, shared_errs.ErrRecordNotFound)
+
+	// Act
+	err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrTOTPNotEnrolled)
+	s.totpVerificationServiceMock.AssertNotCalled(s.T(), "Verify", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *TOTPDisableUseCaseTestSuite) TestExecute_UnconfirmedEnrollment_ReturnsError() {
+	// Arrange
+	input := usecase.TOTPDisableInput{UserID: 7, Code: "287082"}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, input.UserID).
+		Return(model.TOTPEnrollmentModel{ID: 1, UserID: 7}, nil)
+
+	// Act
+	err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrTOTPNotEnrolled)
+}
@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/repository"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+)
+
+type TOTPEnrollInput struct {
+	UserID uint64 `validate:"required"`
+}
+
+type TOTPEnrollOutput struct {
+	Secret          string
+	ProvisioningURI string
+}
+
+type TOTPEnrollUseCase struct {
+	totpService              service.TOTPServiceI
+	totpEnrollmentRepository repository.TOTPEnrollmentRepositoryI
+	userRepository           repository.UserRepositoryI
+	txManager                database.TxManagerI
+	validator                validator.Validate
+	logger                   logger.Logger
+}
+
+func NewTOTPEnrollUseCase(
+	totpService service.TOTPServiceI,
+	totpEnrollmentRepository repository.TOTPEnrollmentRepositoryI,
+	userRepository repository.UserRepositoryI,
+	txManager database.TxManagerI,
+	validator validator.Validate,
+	logger logger.Logger,
+) *TOTPEnrollUseCase {
+	return &TOTPEnrollUseCase{
+		totpService:              totpService,
+		totpEnrollmentRepository: totpEnrollmentRepository,
+		userRepository:           userRepository,
+		txManager:                txManager,
+		validator:                validator,
+		logger:                   logger,
+	}
+}
+
+// Execute starts the enrollment of an authenticator app, it is only used for logging in once confirmed.
+// Starting over replaces an enrollment that was not confirmed.
+func (uc *TOTPEnrollUseCase) Execute(ctx context.Context, input TOTPEnrollInput) (TOTPEnrollOutput, error) {
+	ctx, span := trace.Span(ctx, "TOTPEnrollUseCase.Execute")
+	defer span.End()
+
+	if err := uc.validator.Struct(input); err != nil {
+		return TOTPEnrollOutput{}, err
+	}
+
+	user, err := uc.userRepository.FindByID(ctx, input.UserID)
+	if err != nil {
+		uc.logger.Error().Msgf("error finding user by ID %d: %v", input.UserID, err)
+		return TOTPEnrollOutput{}, err
+	}
+
+	enrollment, err := uc.totpEnrollmentRepository.FindByUserID(ctx, user.ID)
+	if err != nil && !errors.Is(err, shared_errs.ErrRecordNotFound) {
+		uc.logger.Error().Msgf("error finding the TOTP enrollment of user ID %d: %v", user.ID, err)
+		return TOTPEnrollOutput{}, err
+	}
+
+	if enrollment.ConfirmedAt != nil {
+		return TOTPEnrollOutput{}, errs.ErrTOTPAlreadyEnrolled
+	}
+
+	secret, err := uc.totpService.GenerateSecret()
+	if err != nil {
+		uc.logger.Error().Msgf("error generating TOTP secret: %v", err)
+		return TOTPEnrollOutput{}, err
+	}
+
+	secretEncrypted, err := uc.totpService.EncryptSecret(secret)
+	if err != nil {
+		return TOTPEnrollOutput{}, err
+	}
+
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if enrollment.ID != 0 {
+			if err = uc.totpEnrollmentRepository.DeleteByUserID(ctx, user.ID); err != nil &&
+				!errors.Is(err, shared_errs.ErrRecordNotFound) {
+				uc.logger.Error().Msgf("error deleting the TOTP enrollment of user ID %d: %v", user.ID, err)
+				return err
+			}
+		}
+
+		newEnrollment := model.TOTPEnrollmentModel{
+			UserID:          user.ID,
+			SecretEncrypted: secretEncrypted,
+		}
+		if _, err = uc.totpEnrollmentRepository.Create(ctx, newEnrollment); err != nil {
+			uc.logger.Error().Msgf("error creating the TOTP enrollment of user ID %d: %v", user.ID, err)
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return TOTPEnrollOutput{}, err
+	}
+
+	output := TOTPEnrollOutput{
+		Secret:          uc.totpService.EncodeSecret(secret),
+		ProvisioningURI: uc.totpService.ProvisioningURI(secret, user.Email),
+	}
+
+	return output, nil
+}
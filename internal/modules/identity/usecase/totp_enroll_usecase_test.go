@@ -0,0 +1,177 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/model"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/repository/mocks"
+	service_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/service/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/usecase"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	database_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/database/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	shared_validator_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/validator/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type TOTPEnrollUseCaseTestSuite struct {
+	suite.Suite
+	sut                          *usecase.TOTPEnrollUseCase
+	totpServiceMock              *service_mocks.MockTOTPServiceI
+	totpEnrollmentRepositoryMock *repository_mocks.MockTOTPEnrollmentRepositoryI
+	userRepositoryMock           *repository_mocks.MockUserRepositoryI
+	txManagerMock                *database_mocks.MockTxManagerI
+	validatorMock                *shared_validator_mocks.MockValidate
+}
+
+func (s *TOTPEnrollUseCaseTestSuite) SetupTest() {
+	s.totpServiceMock = service_mocks.NewMockTOTPServiceI(s.T())
+	s.totpEnrollmentRepositoryMock = repository_mocks.NewMockTOTPEnrollmentRepositoryI(s.T())
+	s.userRepositoryMock = repository_mocks.NewMockUserRepositoryI(s.T())
+	s.txManagerMock = database_mocks.NewMockTxManagerI(s.T())
+	s.validatorMock = shared_validator_mocks.NewMockValidate(s.T())
+
+	s.txManagerMock.On("WithinTransaction", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) },
+	).Maybe()
+
+	s.sut = usecase.NewTOTPEnrollUseCase(
+		s.totpServiceMock,
+		s.totpEnrollmentRepositoryMock,
+		s.userRepositoryMock,
+		s.txManagerMock,
+		s.validatorMock,
+		logger.New(config.Config{Log: config.Log{LogLevel: "disabled"}}),
+	)
+}
+
+func TestTOTPEnrollUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(TOTPEnrollUseCaseTestSuite))
+}
+
+func (s *TOTPEnrollUseCaseTestSuite) TestExecute_NotEnrolled_StoresEncryptedSecretAndReturnsURI() {
+	// Arrange
+	input := usecase.TOTPEnrollInput{UserID: 7}
+	user := model.UserModel{ID: 7, Email: "user@example.com"}
+	secret := []byte("12345678901234567890")
+	secretEncrypted := []byte("encrypted-secret")
+	uri := "otpauth://totp/Pingo:user@example.com?secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.userRepositoryMock.On("FindByID", mock.Anything, input.UserID).Return(user, nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, user.ID).
+		Return(model.TOTPEnrollmentModel{}, shared_errs.ErrRecordNotFound)
+	s.totpServiceMock.On("GenerateSecret").Return(secret, nil)
+	s.totpServiceMock.On("EncryptSecret", secret).Return(secretEncrypted, nil)
+	s.totpEnrollmentRepositoryMock.On("Create", mock.Anything, model.TOTPEnrollmentModel{
+		UserID:          user.ID,
+		SecretEncrypted: secretEncrypted,
+	}).Return(model.TOTPEnrollmentModel{ID: 1}, nil)
+	s.totpServiceMock.On("EncodeSecret", secret).Return("GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ")
+	s.totpServiceMock.On("ProvisioningURI", secret, user.Email).Return(uri)
+
+	// Act
+	output, err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal("GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ", output.Secret)
+	s.Equal(uri, output.ProvisioningURI)
+	s.totpEnrollmentRepositoryMock.AssertNotCalled(s.T(), "DeleteByUserID", mock.Anything, mock.Anything)
+}
+
+func (s *TOTPEnrollUseCaseTestSuite) TestExecute_UnconfirmedEnrollment_ReplacesIt() {
+	// Arrange
+	input := usecase.TOTPEnrollInput{UserID: 7}
+	user := model.UserModel{ID: 7, Email: "user@example.com"}
+	secret := []byte("12345678901234567890")
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.userRepositoryMock.On("FindByID", mock.Anything, input.UserID).Return(user, nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, user.ID).
+		Return(model.TOTPEnrollmentModel{ID: 3, UserID: user.ID}, nil)
+	s.totpServiceMock.On("GenerateSecret").Return(secret, nil)
+	s.totpServiceMock.On("EncryptSecret", secret).Return([]byte("encrypted-secret"), nil)
+	s.totpEnrollmentRepositoryMock.On("DeleteByUserID", mock.Anything, user.ID).Return(nil)
+	s.totpEnrollmentRepositoryMock.On("Create", mock.Anything, mock.Anything).
+		Return(model.TOTPEnrollmentModel{ID: 4}, nil)
+	s.totpServiceMock.On("EncodeSecret", secret).Return("GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ")
+	s.totpServiceMock.On("ProvisioningURI", secret, user.Email).Return("otpauth://totp/Pingo:user@example.com")
+
+	// Act
+	_, err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.totpEnrollmentRepositoryMock.AssertCalled(s.T(), "DeleteByUserID", mock.Anything, user.ID)
+}
+
+func (s *TOTPEnrollUseCaseTestSuite) TestExecute_AlreadyEnrolled_ReturnsError() {
+	// Arrange
+	input := usecase.TOTPEnrollInput{UserID: 7}
+	user := model.UserModel{ID: 7, Email: "user@example.com"}
+	confirmedAt := time.Now()
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.userRepositoryMock.On("FindByID", mock.Anything, input.UserID).Return(user, nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, user.ID).
+		Return(model.TOTPEnrollmentModel{ID: 3, UserID: user.ID, ConfirmedAt: &confirmedAt}, nil)
+
+	// Act
+	_, err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrTOTPAlreadyEnrolled)
+	s.totpServiceMock.AssertNotCalled(s.T(), "GenerateSecret")
+}
+
+func (s *TOTPEnrollUseCaseTestSuite) TestExecute_EncryptionNotConfigured_ReturnsError() {
+	// Arrange
+	input := usecase.TOTPEnrollInput{UserID: 7}
+	user := model.UserModel{ID: 7, Email: "user@example.com"}
+	secret := []byte("12345678901234567890")
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.userRepositoryMock.On("FindByID", mock.Anything, input.UserID).Return(user, nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, user.ID).
+		Return(model.TOTPEnrollmentModel{}, shared_errs.ErrRecordNotFound)
+	s.totpServiceMock.On("GenerateSecret").Return(secret, nil)
+	s.totpServiceMock.On("EncryptSecret", secret).Return(nil, errs.ErrTOTPNotConfigured)
+
+	// Act
+	_, err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrTOTPNotConfigured)
+	s.totpEnrollmentRepositoryMock.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *TOTPEnrollUseCaseTestSuite) TestExecute_CreateFails_ReturnsError() {
+	// Arrange
+	input := usecase.TOTPEnrollInput{UserID: 7}
+	user := model.UserModel{ID: 7, Email: "user@example.com"}
+	secret := []byte("12345678901234567890")
+	createErr := errors.New("database error")
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.userRepositoryMock.On("FindByID", mock.Anything, input.UserID).Return(user, nil)
+	s.totpEnrollmentRepositoryMock.On("FindByUserID", mock.Anything, user.ID).
+		Return(model.TOTPEnrollmentModel{}, shared_errs.ErrRecordNotFound)
+	s.totpServiceMock.On("GenerateSecret").Return(secret, nil)
+	s.totpServiceMock.On("EncryptSecret", secret).Return([]byte("encrypted-secret"), nil)
+	s.totpEnrollmentRepositoryMock.On("Create", mock.Anything, mock.Anything).
+		Return(model.TOTPEnrollmentModel{}, createErr)
+
+	// Act
+	output, err := s.sut.Execute(context.Background(), input)
+
+	// Assert
+	s.Require().ErrorIs(err, createErr)
+	s.Empty(output.ProvisioningURI)
+}
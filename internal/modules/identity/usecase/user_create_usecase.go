@@ -13,6 +13,7 @@ import (
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/service"
 	identity_validator "github.com/cristiano-pacheco/pingo/internal/modules/identity/validator"
 	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
 
@@ -38,6 +39,7 @@ type UserCreateUseCase struct {
 	userCreatedProducer producer.UserCreatedProducerI
 	userRepository      repository.UserRepositoryI
 	hashService         service.HashServiceI
+	txManager           database.TxManagerI
 	validate            validator.Validate
 	logger              logger.Logger
 }
@@ -47,6 +49,7 @@ func NewUserCreateUseCase(
 	hashService service.HashServiceI,
 	userRepository repository.UserRepositoryI,
 	userCreatedProducer producer.UserCreatedProducerI,
+	txManager database.TxManagerI,
 	validate validator.Validate,
 	logger logger.Logger,
 ) *UserCreateUseCase {
@@ -55,6 +58,7 @@ func NewUserCreateUseCase(
 		passwordValidator:   passwordValidator,
 		userRepository:      userRepository,
 		hashService:         hashService,
+		txManager:           txManager,
 		validate:            validate,
 		logger:              logger,
 	}
@@ -100,16 +104,25 @@ func (uc *UserCreateUseCase) Execute(ctx context.Context, input UserCreateInput)
 		Status:       pendingUserStatus,
 	}
 
-	createdUser, err := uc.userRepository.Create(ctx, userModel)
+	// the event is stored with the user, so it is neither lost nor sent for a rolled back user
+	var createdUser model.UserModel
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		createdUser, err = uc.userRepository.Create(ctx, userModel)
+		if err != nil {
+			uc.logger.Error().Msgf("error creating user: %v", err)
+			return err
+		}
+
+		message := event.UserCreatedMessage{UserID: createdUser.ID}
+		err = uc.userCreatedProducer.Produce(ctx, message)
+		if err != nil {
+			uc.logger.Error().Msgf("error producing user created event: %v", err)
+			return err
+		}
+
+		return nil
+	})
 	if err != nil {
-		uc.logger.Error().Msgf("error creating user: %v", err)
-		return output, err
-	}
-
-	message := event.UserCreatedMessage{UserID: createdUser.ID}
-	err = uc.userCreatedProducer.Produce(ctx, message)
-	if err != nil {
-		uc.logger.Error().Msgf("error producing user created event: %v", err)
 		return output, err
 	}
 
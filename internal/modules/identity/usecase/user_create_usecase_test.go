@@ -16,6 +16,7 @@ import (
 	validator_mocks "github.com/cristiano-pacheco/pingo/internal/modules/identity/validator/mocks"
 	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	database_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/database/mocks"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
 	shared_validator_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/validator/mocks"
 	"github.com/stretchr/testify/mock"
@@ -29,6 +30,7 @@ type UserCreateUseCaseTestSuite struct {
 	passwordValidatorMock   *validator_mocks.MockPasswordValidatorI
 	userRepositoryMock      *repository_mocks.MockUserRepositoryI
 	hashServiceMock         *service_mocks.MockHashServiceI
+	txManagerMock           *database_mocks.MockTxManagerI
 	validatorMock           *shared_validator_mocks.MockValidate
 	logger                  logger.Logger
 	cfg                     config.Config
@@ -39,6 +41,7 @@ func (s *UserCreateUseCaseTestSuite) SetupTest() {
 	s.passwordValidatorMock = validator_mocks.NewMockPasswordValidatorI(s.T())
 	s.userRepositoryMock = repository_mocks.NewMockUserRepositoryI(s.T())
 	s.hashServiceMock = service_mocks.NewMockHashServiceI(s.T())
+	s.txManagerMock = database_mocks.NewMockTxManagerI(s.T())
 	s.validatorMock = shared_validator_mocks.NewMockValidate(s.T())
 
 	s.cfg = config.Config{
@@ -65,6 +68,7 @@ func (s *UserCreateUseCaseTestSuite) SetupTest() {
 		s.hashServiceMock,
 		s.userRepositoryMock,
 		s.userCreatedProducerMock,
+		s.txManagerMock,
 		s.validatorMock,
 		s.logger,
 	)
@@ -74,6 +78,11 @@ func TestUserCreateUseCaseSuite(t *testing.T) {
 	suite.Run(t, new(UserCreateUseCaseTestSuite))
 }
 
+func (s *UserCreateUseCaseTestSuite) expectTransaction() {
+	s.txManagerMock.On("WithinTransaction", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) })
+}
+
 func (s *UserCreateUseCaseTestSuite) TestExecute_ValidInput_CreatesUserSuccessfully() {
 	// Arrange
 	ctx := context.Background()
@@ -103,6 +112,7 @@ func (s *UserCreateUseCaseTestSuite) TestExecute_ValidInput_CreatesUserSuccessfu
 	s.userRepositoryMock.On("FindByEmail", mock.Anything, input.Email).
 		Return(existingUser, shared_errs.ErrRecordNotFound)
 	s.hashServiceMock.On("GenerateFromPassword", []byte(input.Password)).Return(passwordHash, nil)
+	s.expectTransaction()
 	s.userRepositoryMock.On("Create", mock.Anything, mock.AnythingOfType("model.UserModel")).Return(createdUser, nil)
 	s.userCreatedProducerMock.On("Produce", mock.Anything, expectedEventMessage).Return(nil)
 
@@ -257,6 +267,7 @@ func (s *UserCreateUseCaseTestSuite) TestExecute_UserRepositoryCreateFails_Retur
 	s.userRepositoryMock.On("FindByEmail", mock.Anything, input.Email).
 		Return(existingUser, shared_errs.ErrRecordNotFound)
 	s.hashServiceMock.On("GenerateFromPassword", []byte(input.Password)).Return(passwordHash, nil)
+	s.expectTransaction()
 	s.userRepositoryMock.On("Create", mock.Anything, mock.AnythingOfType("model.UserModel")).
 		Return(model.UserModel{}, createError)
 
@@ -298,6 +309,7 @@ func (s *UserCreateUseCaseTestSuite) TestExecute_UserCreatedProducerFails_Return
 	s.userRepositoryMock.On("FindByEmail", mock.Anything, input.Email).
 		Return(existingUser, shared_errs.ErrRecordNotFound)
 	s.hashServiceMock.On("GenerateFromPassword", []byte(input.Password)).Return(passwordHash, nil)
+	s.expectTransaction()
 	s.userRepositoryMock.On("Create", mock.Anything, mock.AnythingOfType("model.UserModel")).Return(createdUser, nil)
 	s.userCreatedProducerMock.On("Produce", mock.Anything, expectedEventMessage).Return(eventError)
 
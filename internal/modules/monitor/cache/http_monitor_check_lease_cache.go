@@ -0,0 +1,92 @@
+package cache
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/pkg/redis"
+	redisClient "github.com/redis/go-redis/v9"
+)
+
+const leaseKeyPrefix = "http_monitor_check_lease:"
+
+// acquireLeaseScript sets the lease when it is free or already owned by the caller,
+// so the owner keeps the monitor for as long as it keeps renewing the lease.
+var acquireLeaseScript = redisClient.NewScript(`
+local owner = redis.call("GET", KEYS[1])
+if owner == false or owner == ARGV[1] then
+	redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+	return 1
+end
+return 0
+`)
+
+type HTTPMonitorCheckLeaseCacheI interface {
+	Acquire(ctx context.Context, monitorID uint64, ttl time.Duration) (bool, error)
+}
+
+// HTTPMonitorCheckLeaseCache coordinates the replicas so each monitor is checked by a single instance.
+// A replica that stops renewing its leases hands the monitors over to the others once they expire.
+type HTTPMonitorCheckLeaseCache struct {
+	redisClient redis.Redis
+	owner       string
+}
+
+var _ HTTPMonitorCheckLeaseCacheI = (*HTTPMonitorCheckLeaseCache)(nil)
+
+func NewHTTPMonitorCheckLeaseCache(redisClient redis.Redis) (*HTTPMonitorCheckLeaseCache, error) {
+	owner, err := newLeaseOwner()
+	if err != nil {
+		return nil, err
+	}
+
+	return &HTTPMonitorCheckLeaseCache{
+		redisClient: redisClient,
+		owner:       owner,
+	}, nil
+}
+
+// Acquire reports whether this instance holds the check lease of the monitor for the given ttl.
+func (c *HTTPMonitorCheckLeaseCache) Acquire(ctx context.Context, monitorID uint64, ttl time.Duration) (bool, error) {
+	ctx, span := trace.Span(ctx, "HTTPMonitorCheckLeaseCache.Acquire")
+	defer span.End()
+
+	client := c.redisClient.Client()
+	if client == nil {
+		return false, errors.New("redis client is nil")
+	}
+
+	key := c.buildKey(monitorID)
+	acquired, err := acquireLeaseScript.Run(ctx, client, []string{key}, c.owner, ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+
+	return acquired == 1, nil
+}
+
+func (c *HTTPMonitorCheckLeaseCache) buildKey(monitorID uint64) string {
+	return fmt.Sprintf("%s%s", leaseKeyPrefix, strconv.FormatUint(monitorID, 10))
+}
+
+// newLeaseOwner identifies this instance, the hostname only helps when inspecting the leases.
+func newLeaseOwner() (string, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+
+	b := make([]byte, 8)
+	if _, err = rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s:%s", hostname, hex.EncodeToString(b)), nil
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockHTTPMonitorCheckLeaseCacheI is an autogenerated mock type for the HTTPMonitorCheckLeaseCacheI type
+type MockHTTPMonitorCheckLeaseCacheI struct {
+	mock.Mock
+}
+
+type MockHTTPMonitorCheckLeaseCacheI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockHTTPMonitorCheckLeaseCacheI) EXPECT() *MockHTTPMonitorCheckLeaseCacheI_Expecter {
+	return &MockHTTPMonitorCheckLeaseCacheI_Expecter{mock: &_m.Mock}
+}
+
+// Acquire provides a mock function with given fields: ctx, monitorID, ttl
+func (_m *MockHTTPMonitorCheckLeaseCacheI) Acquire(ctx context.Context, monitorID uint64, ttl time.Duration) (bool, error) {
+	ret := _m.Called(ctx, monitorID, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Acquire")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Duration) (bool, error)); ok {
+		return rf(ctx, monitorID, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Duration) bool); ok {
+		r0 = rf(ctx, monitorID, ttl)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, time.Duration) error); ok {
+		r1 = rf(ctx, monitorID, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockHTTPMonitorCheckLeaseCacheI_Acquire_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Acquire'
+type MockHTTPMonitorCheckLeaseCacheI_Acquire_Call struct {
+	*mock.Call
+}
+
+// Acquire is a helper method to define mock.On call
+//   - ctx context.Context
+//   - monitorID uint64
+//   - ttl time.Duration
+func (_e *MockHTTPMonitorCheckLeaseCacheI_Expecter) Acquire(ctx interface{}, monitorID interface{}, ttl interface{}) *MockHTTPMonitorCheckLeaseCacheI_Acquire_Call {
+	return &MockHTTPMonitorCheckLeaseCacheI_Acquire_Call{Call: _e.mock.On("Acquire", ctx, monitorID, ttl)}
+}
+
+func (_c *MockHTTPMonitorCheckLeaseCacheI_Acquire_Call) Run(run func(ctx context.Context, monitorID uint64, ttl time.Duration)) *MockHTTPMonitorCheckLeaseCacheI_Acquire_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockHTTPMonitorCheckLeaseCacheI_Acquire_Call) Return(_a0 bool, _a1 error) *MockHTTPMonitorCheckLeaseCacheI_Acquire_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockHTTPMonitorCheckLeaseCacheI_Acquire_Call) RunAndReturn(run func(context.Context, uint64, time.Duration) (bool, error)) *MockHTTPMonitorCheckLeaseCacheI_Acquire_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockHTTPMonitorCheckLeaseCacheI creates a new instance of MockHTTPMonitorCheckLeaseCacheI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockHTTPMonitorCheckLeaseCacheI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockHTTPMonitorCheckLeaseCacheI {
+	mock := &MockHTTPMonitorCheckLeaseCacheI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
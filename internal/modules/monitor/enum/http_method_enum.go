@@ -0,0 +1,34 @@
+package enum
+
+import (
+	"net/http"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+)
+
+const (
+	HTTPMethodGet    = http.MethodGet
+	HTTPMethodPost   = http.MethodPost
+	HTTPMethodPut    = http.MethodPut
+	HTTPMethodDelete = http.MethodDelete
+	HTTPMethodHead   = http.MethodHead
+)
+
+type HTTPMethodEnum struct {
+	value string
+}
+
+func NewHTTPMethodEnum(value string) (HTTPMethodEnum, error) {
+	if value != HTTPMethodGet &&
+		value != HTTPMethodPost &&
+		value != HTTPMethodPut &&
+		value != HTTPMethodDelete &&
+		value != HTTPMethodHead {
+		return HTTPMethodEnum{}, errs.ErrInvalidHTTPMethod
+	}
+	return HTTPMethodEnum{value: value}, nil
+}
+
+func (e HTTPMethodEnum) String() string {
+	return e.value
+}
@@ -0,0 +1,52 @@
+package enum_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+)
+
+func TestNewHTTPMethodEnum_ValidMethods_ReturnsEnum(t *testing.T) {
+	methods := []string{
+		enum.HTTPMethodGet,
+		enum.HTTPMethodPost,
+		enum.HTTPMethodPut,
+		enum.HTTPMethodDelete,
+		enum.HTTPMethodHead,
+	}
+
+	for _, method := range methods {
+		t.Run(method, func(t *testing.T) {
+			// Arrange
+			val := method
+			// Act
+			e, err := enum.NewHTTPMethodEnum(val)
+			// Assert
+			require.NoError(t, err)
+			require.Equal(t, val, e.String())
+		})
+	}
+}
+
+func TestNewHTTPMethodEnum_InvalidMethod_ReturnsError(t *testing.T) {
+	t.Run("unsupported method", func(t *testing.T) {
+		// Arrange
+		val := "PATCH"
+		// Act
+		_, err := enum.NewHTTPMethodEnum(val)
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidHTTPMethod)
+	})
+
+	t.Run("lowercase method", func(t *testing.T) {
+		// Arrange
+		val := "get"
+		// Act
+		_, err := enum.NewHTTPMethodEnum(val)
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidHTTPMethod)
+	})
+}
@@ -0,0 +1,26 @@
+package enum
+
+import "github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+
+const (
+	IncidentCauseTimeout          = "timeout"
+	IncidentCauseRequestError     = "request_error"
+	IncidentCauseUnexpectedStatus = "unexpected_status"
+)
+
+type IncidentCauseEnum struct {
+	value string
+}
+
+func NewIncidentCauseEnum(value string) (IncidentCauseEnum, error) {
+	if value != IncidentCauseTimeout &&
+		value != IncidentCauseRequestError &&
+		value != IncidentCauseUnexpectedStatus {
+		return IncidentCauseEnum{}, errs.ErrInvalidIncidentCause
+	}
+	return IncidentCauseEnum{value: value}, nil
+}
+
+func (e IncidentCauseEnum) String() string {
+	return e.value
+}
@@ -0,0 +1,24 @@
+package enum
+
+import "github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+
+const (
+	IncidentStatusOpen     = "open"
+	IncidentStatusResolved = "resolved"
+)
+
+type IncidentStatusEnum struct {
+	value string
+}
+
+func NewIncidentStatusEnum(value string) (IncidentStatusEnum, error) {
+	if value != IncidentStatusOpen &&
+		value != IncidentStatusResolved {
+		return IncidentStatusEnum{}, errs.ErrInvalidIncidentStatus
+	}
+	return IncidentStatusEnum{value: value}, nil
+}
+
+func (e IncidentStatusEnum) String() string {
+	return e.value
+}
@@ -0,0 +1,40 @@
+package enum_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+)
+
+func TestNewIncidentStatusEnum_ValidStatuses_ReturnsEnum(t *testing.T) {
+	statuses := []string{
+		enum.IncidentStatusOpen,
+		enum.IncidentStatusResolved,
+	}
+
+	for _, status := range statuses {
+		t.Run(status, func(t *testing.T) {
+			// Arrange
+			val := status
+			// Act
+			e, err := enum.NewIncidentStatusEnum(val)
+			// Assert
+			require.NoError(t, err)
+			require.Equal(t, val, e.String())
+		})
+	}
+}
+
+func TestNewIncidentStatusEnum_InvalidStatus_ReturnsError(t *testing.T) {
+	t.Run("unsupported status", func(t *testing.T) {
+		// Arrange
+		val := "closed"
+		// Act
+		_, err := enum.NewIncidentStatusEnum(val)
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidIncidentStatus)
+	})
+}
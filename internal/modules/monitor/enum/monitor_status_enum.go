@@ -0,0 +1,24 @@
+package enum
+
+import "github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+
+const (
+	MonitorStatusUp   = "up"
+	MonitorStatusDown = "down"
+)
+
+type MonitorStatusEnum struct {
+	value string
+}
+
+func NewMonitorStatusEnum(value string) (MonitorStatusEnum, error) {
+	if value != MonitorStatusUp &&
+		value != MonitorStatusDown {
+		return MonitorStatusEnum{}, errs.ErrInvalidMonitorStatus
+	}
+	return MonitorStatusEnum{value: value}, nil
+}
+
+func (e MonitorStatusEnum) String() string {
+	return e.value
+}
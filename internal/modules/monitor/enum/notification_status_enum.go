@@ -0,0 +1,26 @@
+package enum
+
+import "github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+
+const (
+	NotificationStatusPending = "pending"
+	NotificationStatusSent    = "sent"
+	NotificationStatusFailed  = "failed"
+)
+
+type NotificationStatusEnum struct {
+	value string
+}
+
+func NewNotificationStatusEnum(value string) (NotificationStatusEnum, error) {
+	if value != NotificationStatusPending &&
+		value != NotificationStatusSent &&
+		value != NotificationStatusFailed {
+		return NotificationStatusEnum{}, errs.ErrInvalidNotificationStatus
+	}
+	return NotificationStatusEnum{value: value}, nil
+}
+
+func (e NotificationStatusEnum) String() string {
+	return e.value
+}
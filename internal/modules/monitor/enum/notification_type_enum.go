@@ -0,0 +1,26 @@
+package enum
+
+import "github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+
+const (
+	NotificationTypeFailure     = "failure"
+	NotificationTypeRecovery    = "recovery"
+	NotificationTypeMaintenance = "maintenance"
+)
+
+type NotificationTypeEnum struct {
+	value string
+}
+
+func NewNotificationTypeEnum(value string) (NotificationTypeEnum, error) {
+	if value != NotificationTypeFailure &&
+		value != NotificationTypeRecovery &&
+		value != NotificationTypeMaintenance {
+		return NotificationTypeEnum{}, errs.ErrInvalidNotificationType
+	}
+	return NotificationTypeEnum{value: value}, nil
+}
+
+func (e NotificationTypeEnum) String() string {
+	return e.value
+}
@@ -0,0 +1,45 @@
+package enum
+
+import (
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+)
+
+const (
+	StatsWindow24Hours = "24h"
+	StatsWindow7Days   = "7d"
+	StatsWindow30Days  = "30d"
+)
+
+// MaxStatsWindowDuration is how far back the largest window reaches, custom ranges are capped at it.
+const MaxStatsWindowDuration = 30 * 24 * time.Hour
+
+type StatsWindowEnum struct {
+	value string
+}
+
+func NewStatsWindowEnum(value string) (StatsWindowEnum, error) {
+	if value != StatsWindow24Hours &&
+		value != StatsWindow7Days &&
+		value != StatsWindow30Days {
+		return StatsWindowEnum{}, errs.ErrInvalidStatsWindow
+	}
+	return StatsWindowEnum{value: value}, nil
+}
+
+func (e StatsWindowEnum) String() string {
+	return e.value
+}
+
+// Duration returns how far back from now the window reaches.
+func (e StatsWindowEnum) Duration() time.Duration {
+	switch e.value {
+	case StatsWindow7Days:
+		return 7 * 24 * time.Hour
+	case StatsWindow30Days:
+		return MaxStatsWindowDuration
+	default:
+		return 24 * time.Hour
+	}
+}
@@ -0,0 +1,43 @@
+package enum_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+)
+
+func TestNewStatsWindowEnum_ValidWindows_ReturnsEnum(t *testing.T) {
+	windows := map[string]time.Duration{
+		enum.StatsWindow24Hours: 24 * time.Hour,
+		enum.StatsWindow7Days:   7 * 24 * time.Hour,
+		enum.StatsWindow30Days:  30 * 24 * time.Hour,
+	}
+
+	for window, duration := range windows {
+		t.Run(window, func(t *testing.T) {
+			// Arrange
+			val := window
+			// Act
+			e, err := enum.NewStatsWindowEnum(val)
+			// Assert
+			require.NoError(t, err)
+			require.Equal(t, val, e.String())
+			require.Equal(t, duration, e.Duration())
+		})
+	}
+}
+
+func TestNewStatsWindowEnum_InvalidWindow_ReturnsError(t *testing.T) {
+	t.Run("unsupported window", func(t *testing.T) {
+		// Arrange
+		val := "1y"
+		// Act
+		_, err := enum.NewStatsWindowEnum(val)
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidStatsWindow)
+	})
+}
@@ -11,4 +11,24 @@ var (
 	ErrContactNameAlreadyInUse = errs.New("MONITOR_02", "Contact name already in use", http.StatusConflict, nil)
 	ErrInvalidContactEmail     = errs.New("MONITOR_03", "Invalid email address for contact", http.StatusBadRequest, nil)
 	ErrInvalidContactWebhook   = errs.New("MONITOR_04", "Invalid webhook URL for contact", http.StatusBadRequest, nil)
+	ErrInvalidMonitorStatus    = errs.New("MONITOR_05", "Invalid monitor status", http.StatusBadRequest, nil)
+	ErrInvalidMonitorURL       = errs.New("MONITOR_06", "Invalid URL for monitor", http.StatusBadRequest, nil)
+	ErrInvalidHTTPMethod       = errs.New("MONITOR_07", "Invalid HTTP method", http.StatusBadRequest, nil)
+	ErrInvalidCheckTimeout     = errs.New("MONITOR_08", "Invalid check timeout", http.StatusBadRequest, nil)
+	ErrInvalidFailThreshold    = errs.New("MONITOR_09", "Invalid fail threshold", http.StatusBadRequest, nil)
+	ErrInvalidCheckInterval    = errs.New("MONITOR_10", "Invalid check interval", http.StatusBadRequest, nil)
+	ErrInvalidRequestHeaders   = errs.New("MONITOR_11", "Invalid request headers", http.StatusBadRequest, nil)
+	ErrInvalidResponseStatuses = errs.New("MONITOR_12", "Invalid valid response statuses", http.StatusBadRequest, nil)
+	ErrContactNotFound         = errs.New("MONITOR_13", "Contact not found", http.StatusNotFound, nil)
+	ErrInvalidTimeRange        = errs.New("MONITOR_14", "Invalid time range", http.StatusBadRequest, nil)
+	ErrInvalidStatsWindow      = errs.New("MONITOR_15", "Invalid statistics window", http.StatusBadRequest, nil)
+	ErrInvalidIncidentCause    = errs.New("MONITOR_16", "Invalid incident cause", http.StatusBadRequest, nil)
+	ErrInvalidIncidentStatus   = errs.New("MONITOR_17", "Invalid incident status", http.StatusBadRequest, nil)
+
+	ErrInvalidNotificationType   = errs.New("MONITOR_18", "Invalid notification type", http.StatusBadRequest, nil)
+	ErrInvalidNotificationStatus = errs.New("MONITOR_19", "Invalid notification status", http.StatusBadRequest, nil)
+	ErrUnsupportedContactType    = errs.New("MONITOR_20", "Unsupported contact type", http.StatusInternalServerError, nil)
+	ErrContactNotWebhook         = errs.New("MONITOR_21", "Contact is not a webhook", http.StatusBadRequest, nil)
+	ErrNotificationNotRetryable  = errs.New("MONITOR_22", "Notification is not retryable", http.StatusConflict, nil)
+	ErrMissingSigningSecret      = errs.New("MONITOR_23", "Webhook has no signing secret", http.StatusConflict, nil)
 )
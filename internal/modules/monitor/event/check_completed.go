@@ -0,0 +1,19 @@
+package event
+
+import "time"
+
+const (
+	MonitorCheckCompletedTopic = "monitor.check.completed"
+)
+
+// CheckCompletedMessage is published for every check, successful or not. The status code and
+// response time are null when the request didn't get a response.
+type CheckCompletedMessage struct {
+	MonitorID      uint64    `json:"monitor_id"`
+	CheckID        uint64    `json:"check_id"`
+	CheckedAt      time.Time `json:"checked_at"`
+	Success        bool      `json:"success"`
+	StatusCode     *int32    `json:"status_code"`
+	ResponseTimeMs *int32    `json:"response_time_ms"`
+	ErrorMessage   *string   `json:"error_message"`
+}
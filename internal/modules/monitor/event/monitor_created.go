@@ -0,0 +1,18 @@
+package event
+
+import "time"
+
+const (
+	MonitorCreatedTopic = "monitor.monitor.created"
+)
+
+type MonitorCreatedMessage struct {
+	MonitorID            uint64    `json:"monitor_id"`
+	Name                 string    `json:"name"`
+	HTTPURL              string    `json:"http_url"`
+	HTTPMethod           string    `json:"http_method"`
+	CheckIntervalSeconds int       `json:"check_interval_seconds"`
+	IsEnabled            bool      `json:"is_enabled"`
+	ContactIDs           []uint64  `json:"contact_ids"`
+	OccurredAt           time.Time `json:"occurred_at"`
+}
@@ -0,0 +1,12 @@
+package event
+
+import "time"
+
+const (
+	MonitorDeletedTopic = "monitor.monitor.deleted"
+)
+
+type MonitorDeletedMessage struct {
+	MonitorID  uint64    `json:"monitor_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
@@ -0,0 +1,19 @@
+package event
+
+import "time"
+
+const (
+	MonitorDownTopic = "monitor.monitor.down"
+)
+
+// MonitorDownMessage is published when an incident is opened, once the failed checks of the
+// monitor reach its threshold. The status code and error are the ones of the first failed check.
+type MonitorDownMessage struct {
+	MonitorID    uint64    `json:"monitor_id"`
+	MonitorName  string    `json:"monitor_name"`
+	IncidentID   uint64    `json:"incident_id"`
+	Cause        string    `json:"cause"`
+	StatusCode   *int32    `json:"status_code"`
+	ErrorMessage *string   `json:"error_message"`
+	StartedAt    time.Time `json:"started_at"`
+}
@@ -0,0 +1,17 @@
+package event
+
+import "time"
+
+const (
+	MonitorRecoveredTopic = "monitor.monitor.recovered"
+)
+
+// MonitorRecoveredMessage is published when the open incident of a monitor is resolved.
+type MonitorRecoveredMessage struct {
+	MonitorID       uint64    `json:"monitor_id"`
+	MonitorName     string    `json:"monitor_name"`
+	IncidentID      uint64    `json:"incident_id"`
+	StartedAt       time.Time `json:"started_at"`
+	ResolvedAt      time.Time `json:"resolved_at"`
+	DurationSeconds int64     `json:"duration_seconds"`
+}
@@ -0,0 +1,19 @@
+package event
+
+import "time"
+
+const (
+	MonitorUpdatedTopic = "monitor.monitor.updated"
+)
+
+// MonitorUpdatedMessage carries the monitor as it is after the update.
+type MonitorUpdatedMessage struct {
+	MonitorID            uint64    `json:"monitor_id"`
+	Name                 string    `json:"name"`
+	HTTPURL              string    `json:"http_url"`
+	HTTPMethod           string    `json:"http_method"`
+	CheckIntervalSeconds int       `json:"check_interval_seconds"`
+	IsEnabled            bool      `json:"is_enabled"`
+	ContactIDs           []uint64  `json:"contact_ids"`
+	OccurredAt           time.Time `json:"occurred_at"`
+}
@@ -0,0 +1,19 @@
+package event
+
+import "time"
+
+const (
+	MonitorNotificationSentTopic = "monitor.notification.sent"
+)
+
+// NotificationSentMessage is published when a notification is delivered to a contact,
+// retries included.
+type NotificationSentMessage struct {
+	NotificationID   uint64    `json:"notification_id"`
+	MonitorID        uint64    `json:"monitor_id"`
+	ContactID        uint64    `json:"contact_id"`
+	IncidentID       *uint64   `json:"incident_id"`
+	NotificationType string    `json:"notification_type"`
+	AttemptCount     int       `json:"attempt_count"`
+	SentAt           time.Time `json:"sent_at"`
+}
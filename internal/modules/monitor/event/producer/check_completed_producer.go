@@ -0,0 +1,41 @@
+package producer
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/outbox"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+)
+
+type CheckCompletedProducerI interface {
+	Produce(ctx context.Context, message event.CheckCompletedMessage) error
+}
+
+type CheckCompletedProducer struct {
+	outboxPublisher outbox.PublisherI
+}
+
+var _ CheckCompletedProducerI = (*CheckCompletedProducer)(nil)
+
+func NewCheckCompletedProducer(outboxPublisher outbox.PublisherI) *CheckCompletedProducer {
+	return &CheckCompletedProducer{
+		outboxPublisher: outboxPublisher,
+	}
+}
+
+// Produce publishes the message through the outbox, it reaches the broker once the transaction
+// carried by ctx, if any, commits.
+func (p *CheckCompletedProducer) Produce(ctx context.Context, message event.CheckCompletedMessage) error {
+	ctx, span := trace.Span(ctx, "CheckCompletedProducer.Produce")
+	defer span.End()
+
+	msg, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	m := broker.Message{Topic: event.MonitorCheckCompletedTopic, Key: monitorKey(message.MonitorID), Value: msg}
+	return p.outboxPublisher.Publish(ctx, m)
+}
@@ -0,0 +1,83 @@
+package producer_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event/producer"
+	outbox_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/outbox/mocks"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CheckCompletedProducerTestSuite struct {
+	suite.Suite
+	sut                 *producer.CheckCompletedProducer
+	outboxPublisherMock *outbox_mocks.MockPublisherI
+}
+
+func (s *CheckCompletedProducerTestSuite) SetupTest() {
+	s.outboxPublisherMock = outbox_mocks.NewMockPublisherI(s.T())
+
+	s.sut = producer.NewCheckCompletedProducer(s.outboxPublisherMock)
+}
+
+func TestCheckCompletedProducerSuite(t *testing.T) {
+	suite.Run(t, new(CheckCompletedProducerTestSuite))
+}
+
+func (s *CheckCompletedProducerTestSuite) newMessage() event.CheckCompletedMessage {
+	statusCode := int32(200)
+	responseTimeMs := int32(120)
+	return event.CheckCompletedMessage{
+		MonitorID:      1,
+		CheckID:        42,
+		CheckedAt:      time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+		Success:        true,
+		StatusCode:     &statusCode,
+		ResponseTimeMs: &responseTimeMs,
+	}
+}
+
+func (s *CheckCompletedProducerTestSuite) TestProduce_ValidMessage_PublishesKeyedByMonitor() {
+	// Arrange
+	ctx := context.Background()
+	message := s.newMessage()
+
+	expectedMessageBytes, err := json.Marshal(message)
+	s.Require().NoError(err)
+
+	expectedKafkaMessage := broker.Message{
+		Topic: event.MonitorCheckCompletedTopic,
+		Key:   []byte("1"),
+		Value: expectedMessageBytes,
+	}
+
+	s.outboxPublisherMock.On("Publish", mock.Anything, expectedKafkaMessage).Return(nil)
+
+	// Act
+	err = s.sut.Produce(ctx, message)
+
+	// Assert
+	s.Require().NoError(err)
+}
+
+func (s *CheckCompletedProducerTestSuite) TestProduce_PublishFails_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	message := s.newMessage()
+
+	publishErr := errors.New("database error")
+	s.outboxPublisherMock.On("Publish", mock.Anything, mock.AnythingOfType("broker.Message")).Return(publishErr)
+
+	// Act
+	err := s.sut.Produce(ctx, message)
+
+	// Assert
+	s.Require().ErrorIs(err, publishErr)
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	event "github.com/cristiano-pacheco/pingo/internal/modules/monitor/event"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockMonitorCreatedProducerI is an autogenerated mock type for the MonitorCreatedProducerI type
+type MockMonitorCreatedProducerI struct {
+	mock.Mock
+}
+
+type MockMonitorCreatedProducerI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMonitorCreatedProducerI) EXPECT() *MockMonitorCreatedProducerI_Expecter {
+	return &MockMonitorCreatedProducerI_Expecter{mock: &_m.Mock}
+}
+
+// Produce provides a mock function with given fields: ctx, message
+func (_m *MockMonitorCreatedProducerI) Produce(ctx context.Context, message event.MonitorCreatedMessage) error {
+	ret := _m.Called(ctx, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Produce")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, event.MonitorCreatedMessage) error); ok {
+		r0 = rf(ctx, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMonitorCreatedProducerI_Produce_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Produce'
+type MockMonitorCreatedProducerI_Produce_Call struct {
+	*mock.Call
+}
+
+// Produce is a helper method to define mock.On call
+//   - ctx context.Context
+//   - message event.MonitorCreatedMessage
+func (_e *MockMonitorCreatedProducerI_Expecter) Produce(ctx interface{}, message interface{}) *MockMonitorCreatedProducerI_Produce_Call {
+	return &MockMonitorCreatedProducerI_Produce_Call{Call: _e.mock.On("Produce", ctx, message)}
+}
+
+func (_c *MockMonitorCreatedProducerI_Produce_Call) Run(run func(ctx context.Context, message event.MonitorCreatedMessage)) *MockMonitorCreatedProducerI_Produce_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(event.MonitorCreatedMessage))
+	})
+	return _c
+}
+
+func (_c *MockMonitorCreatedProducerI_Produce_Call) Return(_a0 error) *MockMonitorCreatedProducerI_Produce_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMonitorCreatedProducerI_Produce_Call) RunAndReturn(run func(context.Context, event.MonitorCreatedMessage) error) *MockMonitorCreatedProducerI_Produce_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMonitorCreatedProducerI creates a new instance of MockMonitorCreatedProducerI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMonitorCreatedProducerI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMonitorCreatedProducerI {
+	mock := &MockMonitorCreatedProducerI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	event "github.com/cristiano-pacheco/pingo/internal/modules/monitor/event"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockMonitorDeletedProducerI is an autogenerated mock type for the MonitorDeletedProducerI type
+type MockMonitorDeletedProducerI struct {
+	mock.Mock
+}
+
+type MockMonitorDeletedProducerI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMonitorDeletedProducerI) EXPECT() *MockMonitorDeletedProducerI_Expecter {
+	return &MockMonitorDeletedProducerI_Expecter{mock: &_m.Mock}
+}
+
+// Produce provides a mock function with given fields: ctx, message
+func (_m *MockMonitorDeletedProducerI) Produce(ctx context.Context, message event.MonitorDeletedMessage) error {
+	ret := _m.Called(ctx, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Produce")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, event.MonitorDeletedMessage) error); ok {
+		r0 = rf(ctx, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMonitorDeletedProducerI_Produce_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Produce'
+type MockMonitorDeletedProducerI_Produce_Call struct {
+	*mock.Call
+}
+
+// Produce is a helper method to define mock.On call
+//   - ctx context.Context
+//   - message event.MonitorDeletedMessage
+func (_e *MockMonitorDeletedProducerI_Expecter) Produce(ctx interface{}, message interface{}) *MockMonitorDeletedProducerI_Produce_Call {
+	return &MockMonitorDeletedProducerI_Produce_Call{Call: _e.mock.On("Produce", ctx, message)}
+}
+
+func (_c *MockMonitorDeletedProducerI_Produce_Call) Run(run func(ctx context.Context, message event.MonitorDeletedMessage)) *MockMonitorDeletedProducerI_Produce_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(event.MonitorDeletedMessage))
+	})
+	return _c
+}
+
+func (_c *MockMonitorDeletedProducerI_Produce_Call) Return(_a0 error) *MockMonitorDeletedProducerI_Produce_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMonitorDeletedProducerI_Produce_Call) RunAndReturn(run func(context.Context, event.MonitorDeletedMessage) error) *MockMonitorDeletedProducerI_Produce_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMonitorDeletedProducerI creates a new instance of MockMonitorDeletedProducerI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMonitorDeletedProducerI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMonitorDeletedProducerI {
+	mock := &MockMonitorDeletedProducerI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
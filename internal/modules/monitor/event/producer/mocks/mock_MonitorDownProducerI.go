@@ -0,0 +1,84 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	event "github.com/cristiano-pacheco/pingo/internal/modules/monitor/event"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockMonitorDownProducerI is an autogenerated mock type for the MonitorDownProducerI type
+type MockMonitorDownProducerI struct {
+	mock.Mock
+}
+
+type MockMonitorDownProducerI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMonitorDownProducerI) EXPECT() *MockMonitorDownProducerI_Expecter {
+	return &MockMonitorDownProducerI_Expecter{mock: &_m.Mock}
+}
+
+// Produce provides a mock function with given fields: ctx, message
+func (_m *MockMonitorDownProducerI) Produce(ctx context.Context, message event.MonitorDownMessage) error {
+	ret := _m.Called(ctx, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Produce")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, event.MonitorDownMessage) error); ok {
+		r0 = rf(ctx, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMonitorDownProducerI_Produce_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Produce'
+type MockMonitorDownProducerI_Produce_Call struct {
+	*mock.Call
+}
+
+// Produce is a helper method to define mock.On call
+//   - ctx context.Context
+//   - message event.MonitorDownMessage
+func (_e *MockMonitorDownProducerI_Expecter) Produce(ctx interface{}, message interface{}) *MockMonitorDownProducerI_Produce_Call {
+	return &MockMonitorDownProducerI_Produce_Call{Call: _e.mock.On("Produce", ctx, message)}
+}
+
+func (_c *MockMonitorDownProducerI_Produce_Call) Run(run func(ctx context.Context, message event.MonitorDownMessage)) *MockMonitorDownProducerI_Produce_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(event.MonitorDownMessage))
+	})
+	return _c
+}
+
+func (_c *MockMonitorDownProducerI_Produce_Call) Return(_a0 error) *MockMonitorDownProducerI_Produce_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMonitorDownProducerI_Produce_Call) RunAndReturn(run func(context.Context, event.MonitorDownMessage) error) *MockMonitorDownProducerI_Produce_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMonitorDownProducerI creates a new instance of MockMonitorDownProducerI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMonitorDownProducerI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMonitorDownProducerI {
+	mock := &MockMonitorDownProducerI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
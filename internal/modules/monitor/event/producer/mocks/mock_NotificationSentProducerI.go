@@ -0,0 +1,84 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	event "github.com/cristiano-pacheco/pingo/internal/modules/monitor/event"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockNotificationSentProducerI is an autogenerated mock type for the NotificationSentProducerI type
+type MockNotificationSentProducerI struct {
+	mock.Mock
+}
+
+type MockNotificationSentProducerI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotificationSentProducerI) EXPECT() *MockNotificationSentProducerI_Expecter {
+	return &MockNotificationSentProducerI_Expecter{mock: &_m.Mock}
+}
+
+// Produce provides a mock function with given fields: ctx, message
+func (_m *MockNotificationSentProducerI) Produce(ctx context.Context, message event.NotificationSentMessage) error {
+	ret := _m.Called(ctx, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Produce")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, event.NotificationSentMessage) error); ok {
+		r0 = rf(ctx, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotificationSentProducerI_Produce_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Produce'
+type MockNotificationSentProducerI_Produce_Call struct {
+	*mock.Call
+}
+
+// Produce is a helper method to define mock.On call
+//   - ctx context.Context
+//   - message event.NotificationSentMessage
+func (_e *MockNotificationSentProducerI_Expecter) Produce(ctx interface{}, message interface{}) *MockNotificationSentProducerI_Produce_Call {
+	return &MockNotificationSentProducerI_Produce_Call{Call: _e.mock.On("Produce", ctx, message)}
+}
+
+func (_c *MockNotificationSentProducerI_Produce_Call) Run(run func(ctx context.Context, message event.NotificationSentMessage)) *MockNotificationSentProducerI_Produce_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(event.NotificationSentMessage))
+	})
+	return _c
+}
+
+func (_c *MockNotificationSentProducerI_Produce_Call) Return(_a0 error) *MockNotificationSentProducerI_Produce_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationSentProducerI_Produce_Call) RunAndReturn(run func(context.Context, event.NotificationSentMessage) error) *MockNotificationSentProducerI_Produce_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNotificationSentProducerI creates a new instance of MockNotificationSentProducerI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotificationSentProducerI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotificationSentProducerI {
+	mock := &MockNotificationSentProducerI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,41 @@
+package producer
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/outbox"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+)
+
+type MonitorCreatedProducerI interface {
+	Produce(ctx context.Context, message event.MonitorCreatedMessage) error
+}
+
+type MonitorCreatedProducer struct {
+	outboxPublisher outbox.PublisherI
+}
+
+var _ MonitorCreatedProducerI = (*MonitorCreatedProducer)(nil)
+
+func NewMonitorCreatedProducer(outboxPublisher outbox.PublisherI) *MonitorCreatedProducer {
+	return &MonitorCreatedProducer{
+		outboxPublisher: outboxPublisher,
+	}
+}
+
+// Produce publishes the message through the outbox, it reaches the broker once the transaction
+// carried by ctx, if any, commits.
+func (p *MonitorCreatedProducer) Produce(ctx context.Context, message event.MonitorCreatedMessage) error {
+	ctx, span := trace.Span(ctx, "MonitorCreatedProducer.Produce")
+	defer span.End()
+
+	msg, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	m := broker.Message{Topic: event.MonitorCreatedTopic, Key: monitorKey(message.MonitorID), Value: msg}
+	return p.outboxPublisher.Publish(ctx, m)
+}
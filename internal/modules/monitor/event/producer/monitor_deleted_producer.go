@@ -0,0 +1,41 @@
+package producer
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/outbox"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+)
+
+type MonitorDeletedProducerI interface {
+	Produce(ctx context.Context, message event.MonitorDeletedMessage) error
+}
+
+type MonitorDeletedProducer struct {
+	outboxPublisher outbox.PublisherI
+}
+
+var _ MonitorDeletedProducerI = (*MonitorDeletedProducer)(nil)
+
+func NewMonitorDeletedProducer(outboxPublisher outbox.PublisherI) *MonitorDeletedProducer {
+	return &MonitorDeletedProducer{
+		outboxPublisher: outboxPublisher,
+	}
+}
+
+// Produce publishes the message through the outbox, it reaches the broker once the transaction
+// carried by ctx, if any, commits.
+func (p *MonitorDeletedProducer) Produce(ctx context.Context, message event.MonitorDeletedMessage) error {
+	ctx, span := trace.Span(ctx, "MonitorDeletedProducer.Produce")
+	defer span.End()
+
+	msg, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	m := broker.Message{Topic: event.MonitorDeletedTopic, Key: monitorKey(message.MonitorID), Value: msg}
+	return p.outboxPublisher.Publish(ctx, m)
+}
@@ -0,0 +1,41 @@
+package producer
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/outbox"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+)
+
+type MonitorDownProducerI interface {
+	Produce(ctx context.Context, message event.MonitorDownMessage) error
+}
+
+type MonitorDownProducer struct {
+	outboxPublisher outbox.PublisherI
+}
+
+var _ MonitorDownProducerI = (*MonitorDownProducer)(nil)
+
+func NewMonitorDownProducer(outboxPublisher outbox.PublisherI) *MonitorDownProducer {
+	return &MonitorDownProducer{
+		outboxPublisher: outboxPublisher,
+	}
+}
+
+// Produce publishes the message through the outbox, it reaches the broker once the transaction
+// carried by ctx, if any, commits.
+func (p *MonitorDownProducer) Produce(ctx context.Context, message event.MonitorDownMessage) error {
+	ctx, span := trace.Span(ctx, "MonitorDownProducer.Produce")
+	defer span.End()
+
+	msg, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	m := broker.Message{Topic: event.MonitorDownTopic, Key: monitorKey(message.MonitorID), Value: msg}
+	return p.outboxPublisher.Publish(ctx, m)
+}
@@ -0,0 +1,82 @@
+package producer_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event/producer"
+	outbox_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/outbox/mocks"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MonitorDownProducerTestSuite struct {
+	suite.Suite
+	sut                 *producer.MonitorDownProducer
+	outboxPublisherMock *outbox_mocks.MockPublisherI
+}
+
+func (s *MonitorDownProducerTestSuite) SetupTest() {
+	s.outboxPublisherMock = outbox_mocks.NewMockPublisherI(s.T())
+
+	s.sut = producer.NewMonitorDownProducer(s.outboxPublisherMock)
+}
+
+func TestMonitorDownProducerSuite(t *testing.T) {
+	suite.Run(t, new(MonitorDownProducerTestSuite))
+}
+
+func (s *MonitorDownProducerTestSuite) newMessage() event.MonitorDownMessage {
+	statusCode := int32(500)
+	return event.MonitorDownMessage{
+		MonitorID:   1,
+		MonitorName: "API",
+		IncidentID:  7,
+		Cause:       "unexpected status code 500",
+		StatusCode:  &statusCode,
+		StartedAt:   time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func (s *MonitorDownProducerTestSuite) TestProduce_ValidMessage_PublishesKeyedByMonitor() {
+	// Arrange
+	ctx := context.Background()
+	message := s.newMessage()
+
+	expectedMessageBytes, err := json.Marshal(message)
+	s.Require().NoError(err)
+
+	expectedKafkaMessage := broker.Message{
+		Topic: event.MonitorDownTopic,
+		Key:   []byte("1"),
+		Value: expectedMessageBytes,
+	}
+
+	s.outboxPublisherMock.On("Publish", mock.Anything, expectedKafkaMessage).Return(nil)
+
+	// Act
+	err = s.sut.Produce(ctx, message)
+
+	// Assert
+	s.Require().NoError(err)
+}
+
+func (s *MonitorDownProducerTestSuite) TestProduce_PublishFails_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	message := s.newMessage()
+
+	publishErr := errors.New("database error")
+	s.outboxPublisherMock.On("Publish", mock.Anything, mock.AnythingOfType("broker.Message")).Return(publishErr)
+
+	// Act
+	err := s.sut.Produce(ctx, message)
+
+	// Assert
+	s.Require().ErrorIs(err, publishErr)
+}
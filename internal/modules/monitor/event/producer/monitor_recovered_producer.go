@@ -0,0 +1,41 @@
+package producer
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/outbox"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+)
+
+type MonitorRecoveredProducerI interface {
+	Produce(ctx context.Context, message event.MonitorRecoveredMessage) error
+}
+
+type MonitorRecoveredProducer struct {
+	outboxPublisher outbox.PublisherI
+}
+
+var _ MonitorRecoveredProducerI = (*MonitorRecoveredProducer)(nil)
+
+func NewMonitorRecoveredProducer(outboxPublisher outbox.PublisherI) *MonitorRecoveredProducer {
+	return &MonitorRecoveredProducer{
+		outboxPublisher: outboxPublisher,
+	}
+}
+
+// Produce publishes the message through the outbox, it reaches the broker once the transaction
+// carried by ctx, if any, commits.
+func (p *MonitorRecoveredProducer) Produce(ctx context.Context, message event.MonitorRecoveredMessage) error {
+	ctx, span := trace.Span(ctx, "MonitorRecoveredProducer.Produce")
+	defer span.End()
+
+	msg, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	m := broker.Message{Topic: event.MonitorRecoveredTopic, Key: monitorKey(message.MonitorID), Value: msg}
+	return p.outboxPublisher.Publish(ctx, m)
+}
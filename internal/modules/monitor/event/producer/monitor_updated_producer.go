@@ -0,0 +1,41 @@
+package producer
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/outbox"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+)
+
+type MonitorUpdatedProducerI interface {
+	Produce(ctx context.Context, message event.MonitorUpdatedMessage) error
+}
+
+type MonitorUpdatedProducer struct {
+	outboxPublisher outbox.PublisherI
+}
+
+var _ MonitorUpdatedProducerI = (*MonitorUpdatedProducer)(nil)
+
+func NewMonitorUpdatedProducer(outboxPublisher outbox.PublisherI) *MonitorUpdatedProducer {
+	return &MonitorUpdatedProducer{
+		outboxPublisher: outboxPublisher,
+	}
+}
+
+// Produce publishes the message through the outbox, it reaches the broker once the transaction
+// carried by ctx, if any, commits.
+func (p *MonitorUpdatedProducer) Produce(ctx context.Context, message event.MonitorUpdatedMessage) error {
+	ctx, span := trace.Span(ctx, "MonitorUpdatedProducer.Produce")
+	defer span.End()
+
+	msg, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	m := broker.Message{Topic: event.MonitorUpdatedTopic, Key: monitorKey(message.MonitorID), Value: msg}
+	return p.outboxPublisher.Publish(ctx, m)
+}
@@ -0,0 +1,83 @@
+package producer_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event/producer"
+	outbox_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/outbox/mocks"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MonitorUpdatedProducerTestSuite struct {
+	suite.Suite
+	sut                 *producer.MonitorUpdatedProducer
+	outboxPublisherMock *outbox_mocks.MockPublisherI
+}
+
+func (s *MonitorUpdatedProducerTestSuite) SetupTest() {
+	s.outboxPublisherMock = outbox_mocks.NewMockPublisherI(s.T())
+
+	s.sut = producer.NewMonitorUpdatedProducer(s.outboxPublisherMock)
+}
+
+func TestMonitorUpdatedProducerSuite(t *testing.T) {
+	suite.Run(t, new(MonitorUpdatedProducerTestSuite))
+}
+
+func (s *MonitorUpdatedProducerTestSuite) newMessage() event.MonitorUpdatedMessage {
+	return event.MonitorUpdatedMessage{
+		MonitorID:            1,
+		Name:                 "API",
+		HTTPURL:              "https://example.com/health",
+		HTTPMethod:           "HEAD",
+		CheckIntervalSeconds: 300,
+		IsEnabled:            false,
+		ContactIDs:           []uint64{},
+		OccurredAt:           time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func (s *MonitorUpdatedProducerTestSuite) TestProduce_ValidMessage_PublishesKeyedByMonitor() {
+	// Arrange
+	ctx := context.Background()
+	message := s.newMessage()
+
+	expectedMessageBytes, err := json.Marshal(message)
+	s.Require().NoError(err)
+
+	expectedKafkaMessage := broker.Message{
+		Topic: event.MonitorUpdatedTopic,
+		Key:   []byte("1"),
+		Value: expectedMessageBytes,
+	}
+
+	s.outboxPublisherMock.On("Publish", mock.Anything, expectedKafkaMessage).Return(nil)
+
+	// Act
+	err = s.sut.Produce(ctx, message)
+
+	// Assert
+	s.Require().NoError(err)
+}
+
+func (s *MonitorUpdatedProducerTestSuite) TestProduce_PublishFails_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	message := s.newMessage()
+
+	publishErr := errors.New("database error")
+	s.outboxPublisherMock.On("Publish", mock.Anything, mock.AnythingOfType("broker.Message")).Return(publishErr)
+
+	// Act
+	err := s.sut.Produce(ctx, message)
+
+	// Assert
+	s.Require().ErrorIs(err, publishErr)
+}
@@ -0,0 +1,41 @@
+package producer
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/outbox"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+)
+
+type NotificationSentProducerI interface {
+	Produce(ctx context.Context, message event.NotificationSentMessage) error
+}
+
+type NotificationSentProducer struct {
+	outboxPublisher outbox.PublisherI
+}
+
+var _ NotificationSentProducerI = (*NotificationSentProducer)(nil)
+
+func NewNotificationSentProducer(outboxPublisher outbox.PublisherI) *NotificationSentProducer {
+	return &NotificationSentProducer{
+		outboxPublisher: outboxPublisher,
+	}
+}
+
+// Produce publishes the message through the outbox, it reaches the broker once the transaction
+// carried by ctx, if any, commits.
+func (p *NotificationSentProducer) Produce(ctx context.Context, message event.NotificationSentMessage) error {
+	ctx, span := trace.Span(ctx, "NotificationSentProducer.Produce")
+	defer span.End()
+
+	msg, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	m := broker.Message{Topic: event.MonitorNotificationSentTopic, Key: monitorKey(message.MonitorID), Value: msg}
+	return p.outboxPublisher.Publish(ctx, m)
+}
@@ -0,0 +1,83 @@
+package producer_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event/producer"
+	outbox_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/outbox/mocks"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type NotificationSentProducerTestSuite struct {
+	suite.Suite
+	sut                 *producer.NotificationSentProducer
+	outboxPublisherMock *outbox_mocks.MockPublisherI
+}
+
+func (s *NotificationSentProducerTestSuite) SetupTest() {
+	s.outboxPublisherMock = outbox_mocks.NewMockPublisherI(s.T())
+
+	s.sut = producer.NewNotificationSentProducer(s.outboxPublisherMock)
+}
+
+func TestNotificationSentProducerSuite(t *testing.T) {
+	suite.Run(t, new(NotificationSentProducerTestSuite))
+}
+
+func (s *NotificationSentProducerTestSuite) newMessage() event.NotificationSentMessage {
+	incidentID := uint64(7)
+	return event.NotificationSentMessage{
+		NotificationID:   3,
+		MonitorID:        1,
+		ContactID:        2,
+		IncidentID:       &incidentID,
+		NotificationType: "down",
+		AttemptCount:     1,
+		SentAt:           time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func (s *NotificationSentProducerTestSuite) TestProduce_ValidMessage_PublishesKeyedByMonitor() {
+	// Arrange
+	ctx := context.Background()
+	message := s.newMessage()
+
+	expectedMessageBytes, err := json.Marshal(message)
+	s.Require().NoError(err)
+
+	expectedKafkaMessage := broker.Message{
+		Topic: event.MonitorNotificationSentTopic,
+		Key:   []byte("1"),
+		Value: expectedMessageBytes,
+	}
+
+	s.outboxPublisherMock.On("Publish", mock.Anything, expectedKafkaMessage).Return(nil)
+
+	// Act
+	err = s.sut.Produce(ctx, message)
+
+	// Assert
+	s.Require().NoError(err)
+}
+
+func (s *NotificationSentProducerTestSuite) TestProduce_PublishFails_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	message := s.newMessage()
+
+	publishErr := errors.New("database error")
+	s.outboxPublisherMock.On("Publish", mock.Anything, mock.AnythingOfType("broker.Message")).Return(publishErr)
+
+	// Act
+	err := s.sut.Produce(ctx, message)
+
+	// Assert
+	s.Require().ErrorIs(err, publishErr)
+}
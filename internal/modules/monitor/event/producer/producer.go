@@ -0,0 +1,9 @@
+package producer
+
+import "strconv"
+
+// monitorKey keys the messages by monitor, so the events of a monitor land on the same partition
+// and are consumed in order.
+func monitorKey(monitorID uint64) []byte {
+	return []byte(strconv.FormatUint(monitorID, 10))
+}
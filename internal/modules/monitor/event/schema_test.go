@@ -0,0 +1,68 @@
+package event_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event"
+	"github.com/stretchr/testify/suite"
+)
+
+const schemaDir = "../../../../docs/events"
+
+type SchemaTestSuite struct {
+	suite.Suite
+}
+
+func TestSchemaSuite(t *testing.T) {
+	suite.Run(t, new(SchemaTestSuite))
+}
+
+func (s *SchemaTestSuite) TestMessages_MatchDocumentedSchemas() {
+	messages := map[string]any{
+		event.MonitorCreatedTopic:          event.MonitorCreatedMessage{},
+		event.MonitorUpdatedTopic:          event.MonitorUpdatedMessage{},
+		event.MonitorDeletedTopic:          event.MonitorDeletedMessage{},
+		event.MonitorCheckCompletedTopic:   event.CheckCompletedMessage{},
+		event.MonitorDownTopic:             event.MonitorDownMessage{},
+		event.MonitorRecoveredTopic:        event.MonitorRecoveredMessage{},
+		event.MonitorNotificationSentTopic: event.NotificationSentMessage{},
+	}
+
+	for topic, message := range messages {
+		s.Run(topic, func() {
+			// Arrange
+			content, err := os.ReadFile(filepath.Join(schemaDir, topic+".schema.json"))
+			s.Require().NoError(err)
+
+			var schema struct {
+				Properties map[string]json.RawMessage `json:"properties"`
+				Required   []string                   `json:"required"`
+			}
+			s.Require().NoError(json.Unmarshal(content, &schema))
+
+			// Act
+			encoded, err := json.Marshal(message)
+			s.Require().NoError(err)
+
+			var fields map[string]json.RawMessage
+			s.Require().NoError(json.Unmarshal(encoded, &fields))
+
+			// Assert
+			s.ElementsMatch(keys(fields), keys(schema.Properties))
+			s.ElementsMatch(keys(fields), schema.Required)
+		})
+	}
+}
+
+func keys(m map[string]json.RawMessage) []string {
+	result := make([]string, 0, len(m))
+	for key := range m {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -7,10 +7,11 @@ type CreateContactRequest struct {
 }
 
 type CreateContactResponse struct {
-	ContactID   uint64 `json:"contact_id"`
-	Name        string `json:"name"`
-	ContactType string `json:"contact_type"`
-	ContactData string `json:"contact_data"`
+	ContactID     uint64 `json:"contact_id"`
+	Name          string `json:"name"`
+	ContactType   string `json:"contact_type"`
+	ContactData   string `json:"contact_data"`
+	SigningSecret string `json:"signing_secret,omitempty"`
 }
 
 type UpdateContactRequest struct {
@@ -20,6 +21,11 @@ type UpdateContactRequest struct {
 	IsEnabled   bool   `json:"is_enabled"`
 }
 
+type UpdateContactResponse struct {
+	ContactID     uint64 `json:"contact_id"`
+	SigningSecret string `json:"signing_secret"`
+}
+
 type ContactResponse struct {
 	ContactID   uint64 `json:"contact_id"`
 	Name        string `json:"name"`
@@ -27,3 +33,8 @@ type ContactResponse struct {
 	ContactData string `json:"contact_data"`
 	IsEnabled   bool   `json:"is_enabled"`
 }
+
+type RotateContactSecretResponse struct {
+	ContactID     uint64 `json:"contact_id"`
+	SigningSecret string `json:"signing_secret"`
+}
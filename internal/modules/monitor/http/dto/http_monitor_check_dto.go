@@ -0,0 +1,13 @@
+package dto
+
+import "time"
+
+type HTTPMonitorCheckResponse struct {
+	CheckID        uint64    `json:"check_id"`
+	MonitorID      uint64    `json:"monitor_id"`
+	CheckedAt      time.Time `json:"checked_at"`
+	ResponseTimeMs *int32    `json:"response_time_ms"`
+	StatusCode     *int32    `json:"status_code"`
+	Success        bool      `json:"success"`
+	ErrorMessage   *string   `json:"error_message"`
+}
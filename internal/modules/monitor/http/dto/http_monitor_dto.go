@@ -0,0 +1,47 @@
+package dto
+
+import "time"
+
+type CreateHTTPMonitorRequest struct {
+	Name                  string            `json:"name"`
+	HTTPURL               string            `json:"http_url"`
+	HTTPMethod            string            `json:"http_method"`
+	CheckTimeout          int               `json:"check_timeout"`
+	FailThreshold         int16             `json:"fail_threshold"`
+	CheckIntervalSeconds  int               `json:"check_interval_seconds"`
+	RequestHeaders        map[string]string `json:"request_headers"`
+	ValidResponseStatuses []int32           `json:"valid_response_statuses"`
+	ContactIDs            []uint64          `json:"contact_ids"`
+}
+
+type UpdateHTTPMonitorRequest struct {
+	Name                  string            `json:"name"`
+	HTTPURL               string            `json:"http_url"`
+	HTTPMethod            string            `json:"http_method"`
+	CheckTimeout          int               `json:"check_timeout"`
+	FailThreshold         int16             `json:"fail_threshold"`
+	CheckIntervalSeconds  int               `json:"check_interval_seconds"`
+	RequestHeaders        map[string]string `json:"request_headers"`
+	ValidResponseStatuses []int32           `json:"valid_response_statuses"`
+	ContactIDs            []uint64          `json:"contact_ids"`
+	IsEnabled             bool              `json:"is_enabled"`
+}
+
+type HTTPMonitorResponse struct {
+	MonitorID             uint64            `json:"monitor_id"`
+	Name                  string            `json:"name"`
+	HTTPURL               string            `json:"http_url"`
+	HTTPMethod            string            `json:"http_method"`
+	CheckTimeout          int               `json:"check_timeout"`
+	FailThreshold         int16             `json:"fail_threshold"`
+	CheckIntervalSeconds  int               `json:"check_interval_seconds"`
+	RequestHeaders        map[string]string `json:"request_headers"`
+	ValidResponseStatuses []int32           `json:"valid_response_statuses"`
+	IsEnabled             bool              `json:"is_enabled"`
+	LastCheckedAt         *time.Time        `json:"last_checked_at"`
+	LastStatus            *string           `json:"last_status"`
+	ConsecutiveFailures   int               `json:"consecutive_failures"`
+	ContactIDs            []uint64          `json:"contact_ids"`
+	CreatedAt             time.Time         `json:"created_at"`
+	UpdatedAt             time.Time         `json:"updated_at"`
+}
@@ -0,0 +1,50 @@
+package dto
+
+import "time"
+
+type HTTPMonitorCheckStatsResponse struct {
+	TotalChecks       int64    `json:"total_checks"`
+	SuccessfulChecks  int64    `json:"successful_checks"`
+	FailedChecks      int64    `json:"failed_checks"`
+	UptimePercentage  *float64 `json:"uptime_percentage"`
+	SuccessRate       *float64 `json:"success_rate"`
+	AvgResponseTimeMs *float64 `json:"avg_response_time_ms"`
+	P50ResponseTimeMs *float64 `json:"p50_response_time_ms"`
+	P95ResponseTimeMs *float64 `json:"p95_response_time_ms"`
+	P99ResponseTimeMs *float64 `json:"p99_response_time_ms"`
+}
+
+type HTTPMonitorErrorCountResponse struct {
+	StatusCode   *int32 `json:"status_code"`
+	ErrorMessage string `json:"error_message"`
+	Count        int64  `json:"count"`
+}
+
+type HTTPMonitorStatsResponse struct {
+	HTTPMonitorCheckStatsResponse
+	MonitorID uint64                          `json:"monitor_id"`
+	From      time.Time                       `json:"from"`
+	To        time.Time                       `json:"to"`
+	Errors    []HTTPMonitorErrorCountResponse `json:"errors"`
+}
+
+type HTTPMonitorStatsSummaryItemResponse struct {
+	HTTPMonitorCheckStatsResponse
+	MonitorID  uint64  `json:"monitor_id"`
+	Name       string  `json:"name"`
+	IsEnabled  bool    `json:"is_enabled"`
+	LastStatus *string `json:"last_status"`
+}
+
+type HTTPMonitorStatsSummaryResponse struct {
+	From             time.Time                             `json:"from"`
+	To               time.Time                             `json:"to"`
+	TotalMonitors    int                                   `json:"total_monitors"`
+	EnabledMonitors  int                                   `json:"enabled_monitors"`
+	TotalChecks      int64                                 `json:"total_checks"`
+	SuccessfulChecks int64                                 `json:"successful_checks"`
+	FailedChecks     int64                                 `json:"failed_checks"`
+	SuccessRate      *float64                              `json:"success_rate"`
+	UptimePercentage *float64                              `json:"uptime_percentage"`
+	Monitors         []HTTPMonitorStatsSummaryItemResponse `json:"monitors"`
+}
@@ -0,0 +1,29 @@
+package dto
+
+import "time"
+
+type IncidentResponse struct {
+	IncidentID         uint64     `json:"incident_id"`
+	MonitorID          uint64     `json:"monitor_id"`
+	Status             string     `json:"status"`
+	Cause              string     `json:"cause"`
+	StatusCode         *int32     `json:"status_code"`
+	ErrorMessage       *string    `json:"error_message"`
+	FirstFailedCheckID *uint64    `json:"first_failed_check_id"`
+	RecoveryCheckID    *uint64    `json:"recovery_check_id"`
+	StartedAt          time.Time  `json:"started_at"`
+	ResolvedAt         *time.Time `json:"resolved_at"`
+	DurationSeconds    *int64     `json:"duration_seconds"`
+}
+
+type IncidentMetricsResponse struct {
+	TotalIncidents int64    `json:"total_incidents"`
+	OpenIncidents  int64    `json:"open_incidents"`
+	MTTRSeconds    *float64 `json:"mttr_seconds"`
+	MTBFSeconds    *float64 `json:"mtbf_seconds"`
+}
+
+type IncidentHistoryResponse struct {
+	Incidents []IncidentResponse      `json:"incidents"`
+	Metrics   IncidentMetricsResponse `json:"metrics"`
+}
@@ -0,0 +1,20 @@
+package dto
+
+import "time"
+
+type NotificationResponse struct {
+	NotificationID   uint64     `json:"notification_id"`
+	MonitorID        uint64     `json:"monitor_id"`
+	ContactID        uint64     `json:"contact_id"`
+	IncidentID       *uint64    `json:"incident_id"`
+	CheckID          *uint64    `json:"check_id"`
+	NotificationType string     `json:"notification_type"`
+	Message          string     `json:"message"`
+	Status           string     `json:"status"`
+	ErrorMessage     *string    `json:"error_message"`
+	AttemptCount     int        `json:"attempt_count"`
+	SentAt           *time.Time `json:"sent_at"`
+	NextAttemptAt    *time.Time `json:"next_attempt_at"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
@@ -12,11 +12,12 @@ import (
 )
 
 type ContactHandler struct {
-	contactCreateUseCase *usecase.ContactCreateUseCase
-	contactListUseCase   *usecase.ContactListUseCase
-	contactUpdateUseCase *usecase.ContactUpdateUseCase
-	contactDeleteUseCase *usecase.ContactDeleteUseCase
-	logger               logger.Logger
+	contactCreateUseCase       *usecase.ContactCreateUseCase
+	contactListUseCase         *usecase.ContactListUseCase
+	contactUpdateUseCase       *usecase.ContactUpdateUseCase
+	contactDeleteUseCase       *usecase.ContactDeleteUseCase
+	contactRotateSecretUseCase *usecase.ContactRotateSecretUseCase
+	logger                     logger.Logger
 }
 
 func NewContactHandler(
@@ -24,14 +25,16 @@ func NewContactHandler(
 	contactListUseCase *usecase.ContactListUseCase,
 	contactUpdateUseCase *usecase.ContactUpdateUseCase,
 	contactDeleteUseCase *usecase.ContactDeleteUseCase,
+	contactRotateSecretUseCase *usecase.ContactRotateSecretUseCase,
 	logger logger.Logger,
 ) *ContactHandler {
 	return &ContactHandler{
-		contactCreateUseCase: contactCreateUseCase,
-		contactListUseCase:   contactListUseCase,
-		contactUpdateUseCase: contactUpdateUseCase,
-		contactDeleteUseCase: contactDeleteUseCase,
-		logger:               logger,
+		contactCreateUseCase:       contactCreateUseCase,
+		contactListUseCase:         contactListUseCase,
+		contactUpdateUseCase:       contactUpdateUseCase,
+		contactDeleteUseCase:       contactDeleteUseCase,
+		contactRotateSecretUseCase: contactRotateSecretUseCase,
+		logger:                     logger,
 	}
 }
 
@@ -41,6 +44,7 @@ func NewContactHandler(
 // @Accept		json
 // @Produce		json
 // @Security 	BearerAuth
+// @Param		X-Organization-ID	header	int	false	"Organization the request is made in, personal resources when omitted"
 // @Success		200	{object}	response.Envelope[[]dto.ContactResponse]	"Successfully retrieved contacts"
 // @Failure		401	{object}	errs.Error	"Invalid credentials"
 // @Failure		500	{object}	errs.Error	"Internal server error"
@@ -48,7 +52,15 @@ func NewContactHandler(
 func (h *ContactHandler) ListContacts(c *fiber.Ctx) error {
 	ctx := c.UserContext()
 
-	output, err := h.contactListUseCase.Execute(ctx)
+	userID, err := authenticatedUserID(c)
+	if err != nil {
+		return err
+	}
+
+	output, err := h.contactListUseCase.Execute(ctx, usecase.ContactListInput{
+		UserID:         userID,
+		OrganizationID: activeOrganizationID(c),
+	})
 	if err != nil {
 		h.logger.Error().Msgf("Failed to list contacts: %v", err)
 		return err
@@ -75,6 +87,7 @@ func (h *ContactHandler) ListContacts(c *fiber.Ctx) error {
 // @Accept		json
 // @Produce		json
 // @Security 	BearerAuth
+// @Param		X-Organization-ID	header	int	false	"Organization the request is made in, personal resources when omitted"
 // @Param		request	body	dto.CreateContactRequest	true	"Contact data"
 // @Success		201	{object}	response.Envelope[dto.CreateContactResponse]	"Successfully created contact"
 // @Failure		422	{object}	errs.Error	"Invalid request format or validation error"
@@ -83,6 +96,11 @@ func (h *ContactHandler) ListContacts(c *fiber.Ctx) error {
 // @Router		/api/v1/contacts [post]
 func (h *ContactHandler) CreateContact(c *fiber.Ctx) error {
 	ctx := c.UserContext()
+
+	userID, err := authenticatedUserID(c)
+	if err != nil {
+		return err
+	}
 	var createContactRequest dto.CreateContactRequest
 	if err := c.BodyParser(&createContactRequest); err != nil {
 		h.logger.Error().Msgf("Failed to parse request body: %v", err)
@@ -90,9 +108,11 @@ func (h *ContactHandler) CreateContact(c *fiber.Ctx) error {
 	}
 
 	input := usecase.ContactCreateInput{
-		Name:        createContactRequest.Name,
-		ContactType: createContactRequest.ContactType,
-		ContactData: createContactRequest.ContactData,
+		UserID:         userID,
+		OrganizationID: activeOrganizationID(c),
+		Name:           createContactRequest.Name,
+		ContactType:    createContactRequest.ContactType,
+		ContactData:    createContactRequest.ContactData,
 	}
 
 	output, err := h.contactCreateUseCase.Execute(ctx, input)
@@ -102,10 +122,11 @@ func (h *ContactHandler) CreateContact(c *fiber.Ctx) error {
 	}
 
 	createContactResponse := dto.CreateContactResponse{
-		ContactID:   output.ContactID,
-		Name:        output.Name,
-		ContactType: output.ContactType,
-		ContactData: output.ContactData,
+		ContactID:     output.ContactID,
+		Name:          output.Name,
+		ContactType:   output.ContactType,
+		ContactData:   output.ContactData,
+		SigningSecret: output.SigningSecret,
 	}
 
 	res := response.NewEnvelope(createContactResponse)
@@ -113,13 +134,16 @@ func (h *ContactHandler) CreateContact(c *fiber.Ctx) error {
 }
 
 // @Summary		Update contact
-// @Description	Updates an existing contact
+// @Description	Updates an existing contact. A contact changed to a webhook is given a signing secret,
+// @Description	it is returned only in this response.
 // @Tags		Contacts
 // @Accept		json
 // @Produce		json
 // @Security 	BearerAuth
+// @Param		X-Organization-ID	header	int	false	"Organization the request is made in, personal resources when omitted"
 // @Param		id		path	int	true	"Contact ID"
 // @Param		request	body	dto.UpdateContactRequest	true	"Contact data"
+// @Success		200	{object}	response.Envelope[dto.UpdateContactResponse]	"Updated, new secret"
 // @Success		204		"Successfully updated contact"
 // @Failure		422	{object}	errs.Error	"Invalid request format or validation error"
 // @Failure		401	{object}	errs.Error	"Invalid credentials"
@@ -128,6 +152,11 @@ func (h *ContactHandler) CreateContact(c *fiber.Ctx) error {
 // @Router		/api/v1/contacts/{id} [put]
 func (h *ContactHandler) UpdateContact(c *fiber.Ctx) error {
 	ctx := c.UserContext()
+
+	userID, err := authenticatedUserID(c)
+	if err != nil {
+		return err
+	}
 	var updateContactRequest dto.UpdateContactRequest
 	if err := c.BodyParser(&updateContactRequest); err != nil {
 		h.logger.Error().Msgf("Failed to parse request body: %v", err)
@@ -142,20 +171,32 @@ func (h *ContactHandler) UpdateContact(c *fiber.Ctx) error {
 	}
 
 	input := usecase.ContactUpdateInput{
-		ContactID:   contactID,
-		Name:        updateContactRequest.Name,
-		ContactType: updateContactRequest.ContactType,
-		ContactData: updateContactRequest.ContactData,
-		IsEnabled:   updateContactRequest.IsEnabled,
+		UserID:         userID,
+		OrganizationID: activeOrganizationID(c),
+		ContactID:      contactID,
+		Name:           updateContactRequest.Name,
+		ContactType:    updateContactRequest.ContactType,
+		ContactData:    updateContactRequest.ContactData,
+		IsEnabled:      updateContactRequest.IsEnabled,
 	}
 
-	err = h.contactUpdateUseCase.Execute(ctx, input)
+	output, err := h.contactUpdateUseCase.Execute(ctx, input)
 	if err != nil {
 		h.logger.Error().Msgf("Failed to update contact: %v", err)
 		return err
 	}
 
-	return c.SendStatus(http.StatusNoContent)
+	if output.SigningSecret == "" {
+		return c.SendStatus(http.StatusNoContent)
+	}
+
+	updateContactResponse := dto.UpdateContactResponse{
+		ContactID:     contactID,
+		SigningSecret: output.SigningSecret,
+	}
+
+	res := response.NewEnvelope(updateContactResponse)
+	return c.Status(http.StatusOK).JSON(res)
 }
 
 // @Summary		Delete contact
@@ -164,6 +205,7 @@ func (h *ContactHandler) UpdateContact(c *fiber.Ctx) error {
 // @Accept		json
 // @Produce		json
 // @Security 	BearerAuth
+// @Param		X-Organization-ID	header	int	false	"Organization the request is made in, personal resources when omitted"
 // @Param		id	path	int	true	"Contact ID"
 // @Success		204		"Successfully deleted contact"
 // @Failure		401	{object}	errs.Error	"Invalid credentials"
@@ -173,6 +215,11 @@ func (h *ContactHandler) UpdateContact(c *fiber.Ctx) error {
 func (h *ContactHandler) DeleteContact(c *fiber.Ctx) error {
 	ctx := c.UserContext()
 
+	userID, err := authenticatedUserID(c)
+	if err != nil {
+		return err
+	}
+
 	contactIDStr := c.Params("id")
 	contactID, err := strconv.ParseUint(contactIDStr, 10, 64)
 	if err != nil {
@@ -181,7 +228,9 @@ func (h *ContactHandler) DeleteContact(c *fiber.Ctx) error {
 	}
 
 	input := usecase.ContactDeleteInput{
-		ContactID: contactID,
+		UserID:         userID,
+		OrganizationID: activeOrganizationID(c),
+		ContactID:      contactID,
 	}
 
 	err = h.contactDeleteUseCase.Execute(ctx, input)
@@ -192,3 +241,53 @@ func (h *ContactHandler) DeleteContact(c *fiber.Ctx) error {
 
 	return c.SendStatus(http.StatusNoContent)
 }
+
+// @Summary		Rotate contact signing secret
+// @Description	Replaces the secret used to sign the webhook deliveries of a contact
+// @Tags		Contacts
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		X-Organization-ID	header	int	false	"Organization the request is made in, personal resources when omitted"
+// @Param		id	path	int	true	"Contact ID"
+// @Success		200	{object}	response.Envelope[dto.RotateContactSecretResponse]	"Successfully rotated signing secret"
+// @Failure		400	{object}	errs.Error	"Contact is not a webhook"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		404	{object}	errs.Error	"Contact not found"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/contacts/{id}/rotate-secret [post]
+func (h *ContactHandler) RotateContactSecret(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	userID, err := authenticatedUserID(c)
+	if err != nil {
+		return err
+	}
+
+	contactIDStr := c.Params("id")
+	contactID, err := strconv.ParseUint(contactIDStr, 10, 64)
+	if err != nil {
+		h.logger.Error().Msgf("Invalid contact ID: %v", err)
+		return fiber.NewError(http.StatusBadRequest, "Invalid contact ID")
+	}
+
+	input := usecase.ContactRotateSecretInput{
+		UserID:         userID,
+		OrganizationID: activeOrganizationID(c),
+		ContactID:      contactID,
+	}
+
+	output, err := h.contactRotateSecretUseCase.Execute(ctx, input)
+	if err != nil {
+		h.logger.Error().Msgf("Failed to rotate contact secret: %v", err)
+		return err
+	}
+
+	rotateContactSecretResponse := dto.RotateContactSecretResponse{
+		ContactID:     output.ContactID,
+		SigningSecret: output.SigningSecret,
+	}
+
+	res := response.NewEnvelope(rotateContactSecretResponse)
+	return c.Status(http.StatusOK).JSON(res)
+}
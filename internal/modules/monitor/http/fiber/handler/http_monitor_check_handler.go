@@ -0,0 +1,208 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/http/dto"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/usecase"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/sdk/http/response"
+	"github.com/gofiber/fiber/v2"
+)
+
+type HTTPMonitorCheckHandler struct {
+	httpMonitorCheckListUseCase *usecase.HTTPMonitorCheckListUseCase
+	httpMonitorCheckFindUseCase *usecase.HTTPMonitorCheckFindUseCase
+	logger                      logger.Logger
+}
+
+func NewHTTPMonitorCheckHandler(
+	httpMonitorCheckListUseCase *usecase.HTTPMonitorCheckListUseCase,
+	httpMonitorCheckFindUseCase *usecase.HTTPMonitorCheckFindUseCase,
+	logger logger.Logger,
+) *HTTPMonitorCheckHandler {
+	return &HTTPMonitorCheckHandler{
+		httpMonitorCheckListUseCase: httpMonitorCheckListUseCase,
+		httpMonitorCheckFindUseCase: httpMonitorCheckFindUseCase,
+		logger:                      logger,
+	}
+}
+
+// @Summary		List monitor checks
+// @Description	Retrieves a page of checks of an HTTP monitor, newest first
+// @Tags		Monitor Checks
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		X-Organization-ID	header	int	false	"Organization the request is made in, personal resources when omitted"
+// @Param		id			path	int		true	"Monitor ID"
+// @Param		from		query	string	false	"Checks at or after this RFC3339 time"
+// @Param		to			query	string	false	"Checks at or before this RFC3339 time"
+// @Param		success		query	bool	false	"Only successful or only failed checks"
+// @Param		status_code	query	string	false	"Comma-separated list of status codes"
+// @Param		page		query	int		false	"Page number"	default(1)
+// @Param		page_size	query	int		false	"Page size"		default(20)
+// @Success		200	{object}	response.Envelope[[]dto.HTTPMonitorCheckResponse]	"Successfully retrieved checks"
+// @Failure		400	{object}	errs.Error	"Invalid filter parameters"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		404	{object}	errs.Error	"Monitor not found"
+// @Failure		422	{object}	errs.Error	"Invalid pagination parameters"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/monitors/{id}/checks [get]
+func (h *HTTPMonitorCheckHandler) ListChecks(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	userID, err := authenticatedUserID(c)
+	if err != nil {
+		return err
+	}
+
+	monitorID, err := h.parseID(c, "id", "Invalid monitor ID")
+	if err != nil {
+		return err
+	}
+
+	input := usecase.HTTPMonitorCheckListInput{
+		UserID:         userID,
+		OrganizationID: activeOrganizationID(c),
+		MonitorID:      monitorID,
+		Page:           c.QueryInt("page", defaultPage),
+		PageSize:       c.QueryInt("page_size", defaultPageSize),
+	}
+
+	input.From, err = parseTimeQuery(c, "from")
+	if err != nil {
+		return err
+	}
+
+	input.To, err = parseTimeQuery(c, "to")
+	if err != nil {
+		return err
+	}
+
+	if c.Query("success") != "" {
+		success, parseErr := strconv.ParseBool(c.Query("success"))
+		if parseErr != nil {
+			return fiber.NewError(http.StatusBadRequest, "Invalid success parameter")
+		}
+		input.Success = &success
+	}
+
+	input.StatusCodes, err = h.parseStatusCodes(c)
+	if err != nil {
+		return err
+	}
+
+	output, err := h.httpMonitorCheckListUseCase.Execute(ctx, input)
+	if err != nil {
+		h.logger.Error().Msgf("Failed to list monitor checks: %v", err)
+		return err
+	}
+
+	checks := make([]dto.HTTPMonitorCheckResponse, len(output.Checks))
+	for i, check := range output.Checks {
+		checks[i] = h.toHTTPMonitorCheckResponse(check)
+	}
+
+	pagination := response.Pagination{
+		Page:     input.Page,
+		PageSize: input.PageSize,
+		Total:    output.Total,
+	}
+
+	res := response.NewPaginatedEnvelope(checks, pagination)
+	return c.Status(http.StatusOK).JSON(res)
+}
+
+// @Summary		Get monitor check
+// @Description	Retrieves a single check of an HTTP monitor
+// @Tags		Monitor Checks
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		X-Organization-ID	header	int	false	"Organization the request is made in, personal resources when omitted"
+// @Param		id		path	int	true	"Monitor ID"
+// @Param		checkId	path	int	true	"Check ID"
+// @Success		200	{object}	response.Envelope[dto.HTTPMonitorCheckResponse]	"Successfully retrieved check"
+// @Failure		400	{object}	errs.Error	"Invalid monitor or check ID"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		404	{object}	errs.Error	"Check not found"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/monitors/{id}/checks/{checkId} [get]
+func (h *HTTPMonitorCheckHandler) FindCheck(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	userID, err := authenticatedUserID(c)
+	if err != nil {
+		return err
+	}
+
+	monitorID, err := h.parseID(c, "id", "Invalid monitor ID")
+	if err != nil {
+		return err
+	}
+
+	checkID, err := h.parseID(c, "checkId", "Invalid check ID")
+	if err != nil {
+		return err
+	}
+
+	input := usecase.HTTPMonitorCheckFindInput{
+		UserID:         userID,
+		OrganizationID: activeOrganizationID(c),
+		MonitorID:      monitorID,
+		CheckID:        checkID,
+	}
+
+	output, err := h.httpMonitorCheckFindUseCase.Execute(ctx, input)
+	if err != nil {
+		h.logger.Error().Msgf("Failed to find monitor check: %v", err)
+		return err
+	}
+
+	res := response.NewEnvelope(h.toHTTPMonitorCheckResponse(output))
+	return c.Status(http.StatusOK).JSON(res)
+}
+
+func (h *HTTPMonitorCheckHandler) parseID(c *fiber.Ctx, param, message string) (uint64, error) {
+	id, err := strconv.ParseUint(c.Params(param), 10, 64)
+	if err != nil {
+		h.logger.Error().Msgf("%s: %v", message, err)
+		return 0, fiber.NewError(http.StatusBadRequest, message)
+	}
+	return id, nil
+}
+
+func (h *HTTPMonitorCheckHandler) parseStatusCodes(c *fiber.Ctx) ([]int32, error) {
+	value := c.Query("status_code")
+	if value == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(value, ",")
+	statusCodes := make([]int32, 0, len(parts))
+	for _, part := range parts {
+		statusCode, err := strconv.ParseInt(strings.TrimSpace(part), 10, 32)
+		if err != nil {
+			return nil, fiber.NewError(http.StatusBadRequest, "Invalid status_code parameter")
+		}
+		statusCodes = append(statusCodes, int32(statusCode))
+	}
+	return statusCodes, nil
+}
+
+func (h *HTTPMonitorCheckHandler) toHTTPMonitorCheckResponse(
+	check usecase.HTTPMonitorCheckOutput,
+) dto.HTTPMonitorCheckResponse {
+	return dto.HTTPMonitorCheckResponse{
+		CheckID:        check.CheckID,
+		MonitorID:      check.MonitorID,
+		CheckedAt:      check.CheckedAt,
+		ResponseTimeMs: check.ResponseTimeMs,
+		StatusCode:     check.StatusCode,
+		Success:        check.Success,
+		ErrorMessage:   check.ErrorMessage,
+	}
+}
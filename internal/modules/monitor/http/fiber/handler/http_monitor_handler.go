@@ -0,0 +1,385 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/http/dto"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/usecase"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/sdk/http/response"
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	defaultPage     = 1
+	defaultPageSize = 20
+)
+
+type HTTPMonitorHandler struct {
+	httpMonitorCreateUseCase     *usecase.HTTPMonitorCreateUseCase
+	httpMonitorListUseCase       *usecase.HTTPMonitorListUseCase
+	httpMonitorFindUseCase       *usecase.HTTPMonitorFindUseCase
+	httpMonitorUpdateUseCase     *usecase.HTTPMonitorUpdateUseCase
+	httpMonitorDeleteUseCase     *usecase.HTTPMonitorDeleteUseCase
+	httpMonitorSetEnabledUseCase *usecase.HTTPMonitorSetEnabledUseCase
+	logger                       logger.Logger
+}
+
+func NewHTTPMonitorHandler(
+	httpMonitorCreateUseCase *usecase.HTTPMonitorCreateUseCase,
+	httpMonitorListUseCase *usecase.HTTPMonitorListUseCase,
+	httpMonitorFindUseCase *usecase.HTTPMonitorFindUseCase,
+	httpMonitorUpdateUseCase *usecase.HTTPMonitorUpdateUseCase,
+	httpMonitorDeleteUseCase *usecase.HTTPMonitorDeleteUseCase,
+	httpMonitorSetEnabledUseCase *usecase.HTTPMonitorSetEnabledUseCase,
+	logger logger.Logger,
+) *HTTPMonitorHandler {
+	return &HTTPMonitorHandler{
+		httpMonitorCreateUseCase:     httpMonitorCreateUseCase,
+		httpMonitorListUseCase:       httpMonitorListUseCase,
+		httpMonitorFindUseCase:       httpMonitorFindUseCase,
+		httpMonitorUpdateUseCase:     httpMonitorUpdateUseCase,
+		httpMonitorDeleteUseCase:     httpMonitorDeleteUseCase,
+		httpMonitorSetEnabledUseCase: httpMonitorSetEnabledUseCase,
+		logger:                       logger,
+	}
+}
+
+// @Summary		List monitors
+// @Description	Retrieves a page of HTTP monitors
+// @Tags		Monitors
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		X-Organization-ID	header	int	false	"Organization the request is made in, personal resources when omitted"
+// @Param		page		query	int	false	"Page number"	default(1)
+// @Param		page_size	query	int	false	"Page size"		default(20)
+// @Success		200	{object}	response.Envelope[[]dto.HTTPMonitorResponse]	"Successfully retrieved monitors"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		422	{object}	errs.Error	"Invalid pagination parameters"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/monitors [get]
+func (h *HTTPMonitorHandler) ListMonitors(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	userID, err := authenticatedUserID(c)
+	if err != nil {
+		return err
+	}
+
+	input := usecase.HTTPMonitorListInput{
+		UserID:         userID,
+		OrganizationID: activeOrganizationID(c),
+		Page:           c.QueryInt("page", defaultPage),
+		PageSize:       c.QueryInt("page_size", defaultPageSize),
+	}
+
+	output, err := h.httpMonitorListUseCase.Execute(ctx, input)
+	if err != nil {
+		h.logger.Error().Msgf("Failed to list monitors: %v", err)
+		return err
+	}
+
+	monitors := make([]dto.HTTPMonitorResponse, len(output.Monitors))
+	for i, monitor := range output.Monitors {
+		monitors[i] = h.toHTTPMonitorResponse(monitor)
+	}
+
+	pagination := response.Pagination{
+		Page:     input.Page,
+		PageSize: input.PageSize,
+		Total:    output.Total,
+	}
+
+	res := response.NewPaginatedEnvelope(monitors, pagination)
+	return c.Status(http.StatusOK).JSON(res)
+}
+
+// @Summary		Get monitor
+// @Description	Retrieves an HTTP monitor by ID
+// @Tags		Monitors
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		X-Organization-ID	header	int	false	"Organization the request is made in, personal resources when omitted"
+// @Param		id	path	int	true	"Monitor ID"
+// @Success		200	{object}	response.Envelope[dto.HTTPMonitorResponse]	"Successfully retrieved monitor"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		404	{object}	errs.Error	"Monitor not found"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/monitors/{id} [get]
+func (h *HTTPMonitorHandler) FindMonitor(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	userID, err := authenticatedUserID(c)
+	if err != nil {
+		return err
+	}
+
+	monitorID, err := h.parseMonitorID(c)
+	if err != nil {
+		return err
+	}
+
+	input := usecase.HTTPMonitorFindInput{
+		UserID:         userID,
+		OrganizationID: activeOrganizationID(c),
+		MonitorID:      monitorID,
+	}
+
+	output, err := h.httpMonitorFindUseCase.Execute(ctx, input)
+	if err != nil {
+		h.logger.Error().Msgf("Failed to find monitor: %v", err)
+		return err
+	}
+
+	res := response.NewEnvelope(h.toHTTPMonitorResponse(output))
+	return c.Status(http.StatusOK).JSON(res)
+}
+
+// @Summary		Create monitor
+// @Description	Creates a new HTTP monitor and assigns its contacts
+// @Tags		Monitors
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		X-Organization-ID	header	int	false	"Organization the request is made in, personal resources when omitted"
+// @Param		request	body	dto.CreateHTTPMonitorRequest	true	"Monitor data"
+// @Success		201	{object}	response.Envelope[dto.HTTPMonitorResponse]	"Successfully created monitor"
+// @Failure		400	{object}	errs.Error	"Invalid monitor configuration"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		404	{object}	errs.Error	"Contact not found"
+// @Failure		422	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/monitors [post]
+func (h *HTTPMonitorHandler) CreateMonitor(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	userID, err := authenticatedUserID(c)
+	if err != nil {
+		return err
+	}
+	var createMonitorRequest dto.CreateHTTPMonitorRequest
+	if err := c.BodyParser(&createMonitorRequest); err != nil {
+		h.logger.Error().Msgf("Failed to parse request body: %v", err)
+		return err
+	}
+
+	input := usecase.HTTPMonitorCreateInput{
+		UserID:                userID,
+		OrganizationID:        activeOrganizationID(c),
+		Name:                  createMonitorRequest.Name,
+		HTTPURL:               createMonitorRequest.HTTPURL,
+		HTTPMethod:            createMonitorRequest.HTTPMethod,
+		CheckTimeout:          createMonitorRequest.CheckTimeout,
+		FailThreshold:         createMonitorRequest.FailThreshold,
+		CheckIntervalSeconds:  createMonitorRequest.CheckIntervalSeconds,
+		RequestHeaders:        createMonitorRequest.RequestHeaders,
+		ValidResponseStatuses: createMonitorRequest.ValidResponseStatuses,
+		ContactIDs:            createMonitorRequest.ContactIDs,
+	}
+
+	output, err := h.httpMonitorCreateUseCase.Execute(ctx, input)
+	if err != nil {
+		h.logger.Error().Msgf("Failed to create monitor: %v", err)
+		return err
+	}
+
+	res := response.NewEnvelope(h.toHTTPMonitorResponse(output))
+	return c.Status(http.StatusCreated).JSON(res)
+}
+
+// @Summary		Update monitor
+// @Description	Updates an existing HTTP monitor and replaces its contacts
+// @Tags		Monitors
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		X-Organization-ID	header	int	false	"Organization the request is made in, personal resources when omitted"
+// @Param		id		path	int	true	"Monitor ID"
+// @Param		request	body	dto.UpdateHTTPMonitorRequest	true	"Monitor data"
+// @Success		204		"Successfully updated monitor"
+// @Failure		400	{object}	errs.Error	"Invalid monitor configuration"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		404	{object}	errs.Error	"Monitor or contact not found"
+// @Failure		422	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/monitors/{id} [put]
+func (h *HTTPMonitorHandler) UpdateMonitor(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	userID, err := authenticatedUserID(c)
+	if err != nil {
+		return err
+	}
+	var updateMonitorRequest dto.UpdateHTTPMonitorRequest
+	if err := c.BodyParser(&updateMonitorRequest); err != nil {
+		h.logger.Error().Msgf("Failed to parse request body: %v", err)
+		return err
+	}
+
+	monitorID, err := h.parseMonitorID(c)
+	if err != nil {
+		return err
+	}
+
+	input := usecase.HTTPMonitorUpdateInput{
+		UserID:                userID,
+		OrganizationID:        activeOrganizationID(c),
+		MonitorID:             monitorID,
+		Name:                  updateMonitorRequest.Name,
+		HTTPURL:               updateMonitorRequest.HTTPURL,
+		HTTPMethod:            updateMonitorRequest.HTTPMethod,
+		CheckTimeout:          updateMonitorRequest.CheckTimeout,
+		FailThreshold:         updateMonitorRequest.FailThreshold,
+		CheckIntervalSeconds:  updateMonitorRequest.CheckIntervalSeconds,
+		RequestHeaders:        updateMonitorRequest.RequestHeaders,
+		ValidResponseStatuses: updateMonitorRequest.ValidResponseStatuses,
+		ContactIDs:            updateMonitorRequest.ContactIDs,
+		IsEnabled:             updateMonitorRequest.IsEnabled,
+	}
+
+	err = h.httpMonitorUpdateUseCase.Execute(ctx, input)
+	if err != nil {
+		h.logger.Error().Msgf("Failed to update monitor: %v", err)
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary		Delete monitor
+// @Description	Deletes an existing HTTP monitor with its checks
+// @Tags		Monitors
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		X-Organization-ID	header	int	false	"Organization the request is made in, personal resources when omitted"
+// @Param		id	path	int	true	"Monitor ID"
+// @Success		204		"Successfully deleted monitor"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		404	{object}	errs.Error	"Monitor not found"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/monitors/{id} [delete]
+func (h *HTTPMonitorHandler) DeleteMonitor(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	userID, err := authenticatedUserID(c)
+	if err != nil {
+		return err
+	}
+
+	monitorID, err := h.parseMonitorID(c)
+	if err != nil {
+		return err
+	}
+
+	input := usecase.HTTPMonitorDeleteInput{
+		UserID:         userID,
+		OrganizationID: activeOrganizationID(c),
+		MonitorID:      monitorID,
+	}
+
+	err = h.httpMonitorDeleteUseCase.Execute(ctx, input)
+	if err != nil {
+		h.logger.Error().Msgf("Failed to delete monitor: %v", err)
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary		Enable monitor
+// @Description	Enables an HTTP monitor so it is checked again
+// @Tags		Monitors
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		X-Organization-ID	header	int	false	"Organization the request is made in, personal resources when omitted"
+// @Param		id	path	int	true	"Monitor ID"
+// @Success		204		"Successfully enabled monitor"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		404	{object}	errs.Error	"Monitor not found"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/monitors/{id}/enable [post]
+func (h *HTTPMonitorHandler) EnableMonitor(c *fiber.Ctx) error {
+	return h.setEnabled(c, true)
+}
+
+// @Summary		Disable monitor
+// @Description	Disables an HTTP monitor so it is no longer checked
+// @Tags		Monitors
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		X-Organization-ID	header	int	false	"Organization the request is made in, personal resources when omitted"
+// @Param		id	path	int	true	"Monitor ID"
+// @Success		204		"Successfully disabled monitor"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		404	{object}	errs.Error	"Monitor not found"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/monitors/{id}/disable [post]
+func (h *HTTPMonitorHandler) DisableMonitor(c *fiber.Ctx) error {
+	return h.setEnabled(c, false)
+}
+
+func (h *HTTPMonitorHandler) setEnabled(c *fiber.Ctx, isEnabled bool) error {
+	ctx := c.UserContext()
+
+	userID, err := authenticatedUserID(c)
+	if err != nil {
+		return err
+	}
+
+	monitorID, err := h.parseMonitorID(c)
+	if err != nil {
+		return err
+	}
+
+	input := usecase.HTTPMonitorSetEnabledInput{
+		UserID:         userID,
+		OrganizationID: activeOrganizationID(c),
+		MonitorID:      monitorID,
+		IsEnabled:      isEnabled,
+	}
+
+	err = h.httpMonitorSetEnabledUseCase.Execute(ctx, input)
+	if err != nil {
+		h.logger.Error().Msgf("Failed to set monitor enabled flag: %v", err)
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+func (h *HTTPMonitorHandler) parseMonitorID(c *fiber.Ctx) (uint64, error) {
+	monitorIDStr := c.Params("id")
+	monitorID, err := strconv.ParseUint(monitorIDStr, 10, 64)
+	if err != nil {
+		h.logger.Error().Msgf("Invalid monitor ID: %v", err)
+		return 0, fiber.NewError(http.StatusBadRequest, "Invalid monitor ID")
+	}
+	return monitorID, nil
+}
+
+func (h *HTTPMonitorHandler) toHTTPMonitorResponse(monitor usecase.HTTPMonitorOutput) dto.HTTPMonitorResponse {
+	return dto.HTTPMonitorResponse{
+		MonitorID:             monitor.MonitorID,
+		Name:                  monitor.Name,
+		HTTPURL:               monitor.HTTPURL,
+		HTTPMethod:            monitor.HTTPMethod,
+		CheckTimeout:          monitor.CheckTimeout,
+		FailThreshold:         monitor.FailThreshold,
+		CheckIntervalSeconds:  monitor.CheckIntervalSeconds,
+		RequestHeaders:        monitor.RequestHeaders,
+		ValidResponseStatuses: monitor.ValidResponseStatuses,
+		IsEnabled:             monitor.IsEnabled,
+		LastCheckedAt:         monitor.LastCheckedAt,
+		LastStatus:            monitor.LastStatus,
+		ConsecutiveFailures:   monitor.ConsecutiveFailures,
+		ContactIDs:            monitor.ContactIDs,
+		CreatedAt:             monitor.CreatedAt,
+		UpdatedAt:             monitor.UpdatedAt,
+	}
+}
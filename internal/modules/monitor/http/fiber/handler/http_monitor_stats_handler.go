@@ -0,0 +1,192 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/http/dto"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/usecase"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/sdk/http/response"
+	"github.com/gofiber/fiber/v2"
+)
+
+type HTTPMonitorStatsHandler struct {
+	httpMonitorStatsUseCase        *usecase.HTTPMonitorStatsUseCase
+	httpMonitorStatsSummaryUseCase *usecase.HTTPMonitorStatsSummaryUseCase
+	logger                         logger.Logger
+}
+
+func NewHTTPMonitorStatsHandler(
+	httpMonitorStatsUseCase *usecase.HTTPMonitorStatsUseCase,
+	httpMonitorStatsSummaryUseCase *usecase.HTTPMonitorStatsSummaryUseCase,
+	logger logger.Logger,
+) *HTTPMonitorStatsHandler {
+	return &HTTPMonitorStatsHandler{
+		httpMonitorStatsUseCase:        httpMonitorStatsUseCase,
+		httpMonitorStatsSummaryUseCase: httpMonitorStatsSummaryUseCase,
+		logger:                         logger,
+	}
+}
+
+// @Summary		Get monitor statistics
+// @Description	Computes uptime, response time percentiles, success rate and error breakdown of a monitor.
+// @Description	Pass either a window or a custom from/to range of up to 30 days, the default is the last 24 hours.
+// @Tags		Monitor Stats
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		X-Organization-ID	header	int	false	"Organization the request is made in, personal resources when omitted"
+// @Param		id		path	int		true	"Monitor ID"
+// @Param		window	query	string	false	"Window ending now"	Enums(24h, 7d, 30d)
+// @Param		from	query	string	false	"Custom range start, RFC3339"
+// @Param		to		query	string	false	"Custom range end, RFC3339"
+// @Success		200	{object}	response.Envelope[dto.HTTPMonitorStatsResponse]	"Successfully computed statistics"
+// @Failure		400	{object}	errs.Error	"Invalid window or time range"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		404	{object}	errs.Error	"Monitor not found"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/monitors/{id}/stats [get]
+func (h *HTTPMonitorStatsHandler) GetMonitorStats(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	userID, err := authenticatedUserID(c)
+	if err != nil {
+		return err
+	}
+
+	monitorID, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		h.logger.Error().Msgf("Invalid monitor ID: %v", err)
+		return fiber.NewError(http.StatusBadRequest, "Invalid monitor ID")
+	}
+
+	input := usecase.HTTPMonitorStatsInput{
+		UserID:         userID,
+		OrganizationID: activeOrganizationID(c),
+		MonitorID:      monitorID,
+		Window:         c.Query("window"),
+	}
+
+	input.From, err = parseTimeQuery(c, "from")
+	if err != nil {
+		return err
+	}
+
+	input.To, err = parseTimeQuery(c, "to")
+	if err != nil {
+		return err
+	}
+
+	output, err := h.httpMonitorStatsUseCase.Execute(ctx, input)
+	if err != nil {
+		h.logger.Error().Msgf("Failed to compute monitor stats: %v", err)
+		return err
+	}
+
+	errorCounts := make([]dto.HTTPMonitorErrorCountResponse, len(output.Errors))
+	for i, errorCount := range output.Errors {
+		errorCounts[i] = dto.HTTPMonitorErrorCountResponse{
+			StatusCode:   errorCount.StatusCode,
+			ErrorMessage: errorCount.ErrorMessage,
+			Count:        errorCount.Count,
+		}
+	}
+
+	res := response.NewEnvelope(dto.HTTPMonitorStatsResponse{
+		HTTPMonitorCheckStatsResponse: h.toCheckStatsResponse(output.HTTPMonitorCheckStatsOutput),
+		MonitorID:                     output.MonitorID,
+		From:                          output.From,
+		To:                            output.To,
+		Errors:                        errorCounts,
+	})
+	return c.Status(http.StatusOK).JSON(res)
+}
+
+// @Summary		Get monitors statistics summary
+// @Description	Computes the statistics of every monitor and their totals for the overview page.
+// @Description	Pass either a window or a custom from/to range of up to 30 days, the default is the last 24 hours.
+// @Tags		Monitor Stats
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		X-Organization-ID	header	int	false	"Organization the request is made in, personal resources when omitted"
+// @Param		window	query	string	false	"Window ending now"	Enums(24h, 7d, 30d)
+// @Param		from	query	string	false	"Custom range start, RFC3339"
+// @Param		to		query	string	false	"Custom range end, RFC3339"
+// @Success		200	{object}	response.Envelope[dto.HTTPMonitorStatsSummaryResponse]	"Successfully computed summary"
+// @Failure		400	{object}	errs.Error	"Invalid window or time range"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/monitors/stats/summary [get]
+func (h *HTTPMonitorStatsHandler) GetStatsSummary(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	userID, err := authenticatedUserID(c)
+	if err != nil {
+		return err
+	}
+
+	input := usecase.HTTPMonitorStatsSummaryInput{
+		UserID:         userID,
+		OrganizationID: activeOrganizationID(c),
+		Window:         c.Query("window"),
+	}
+
+	input.From, err = parseTimeQuery(c, "from")
+	if err != nil {
+		return err
+	}
+
+	input.To, err = parseTimeQuery(c, "to")
+	if err != nil {
+		return err
+	}
+
+	output, err := h.httpMonitorStatsSummaryUseCase.Execute(ctx, input)
+	if err != nil {
+		h.logger.Error().Msgf("Failed to compute monitors stats summary: %v", err)
+		return err
+	}
+
+	monitors := make([]dto.HTTPMonitorStatsSummaryItemResponse, len(output.Monitors))
+	for i, monitor := range output.Monitors {
+		monitors[i] = dto.HTTPMonitorStatsSummaryItemResponse{
+			HTTPMonitorCheckStatsResponse: h.toCheckStatsResponse(monitor.HTTPMonitorCheckStatsOutput),
+			MonitorID:                     monitor.MonitorID,
+			Name:                          monitor.Name,
+			IsEnabled:                     monitor.IsEnabled,
+			LastStatus:                    monitor.LastStatus,
+		}
+	}
+
+	res := response.NewEnvelope(dto.HTTPMonitorStatsSummaryResponse{
+		From:             output.From,
+		To:               output.To,
+		TotalMonitors:    output.TotalMonitors,
+		EnabledMonitors:  output.EnabledMonitors,
+		TotalChecks:      output.TotalChecks,
+		SuccessfulChecks: output.SuccessfulChecks,
+		FailedChecks:     output.FailedChecks,
+		SuccessRate:      output.SuccessRate,
+		UptimePercentage: output.UptimePercentage,
+		Monitors:         monitors,
+	})
+	return c.Status(http.StatusOK).JSON(res)
+}
+
+func (h *HTTPMonitorStatsHandler) toCheckStatsResponse(
+	stats usecase.HTTPMonitorCheckStatsOutput,
+) dto.HTTPMonitorCheckStatsResponse {
+	return dto.HTTPMonitorCheckStatsResponse{
+		TotalChecks:       stats.TotalChecks,
+		SuccessfulChecks:  stats.SuccessfulChecks,
+		FailedChecks:      stats.FailedChecks,
+		UptimePercentage:  stats.UptimePercentage,
+		SuccessRate:       stats.SuccessRate,
+		AvgResponseTimeMs: stats.AvgResponseTimeMs,
+		P50ResponseTimeMs: stats.P50ResponseTimeMs,
+		P95ResponseTimeMs: stats.P95ResponseTimeMs,
+		P99ResponseTimeMs: stats.P99ResponseTimeMs,
+	}
+}
@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/http/dto"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/usecase"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/sdk/http/response"
+	"github.com/gofiber/fiber/v2"
+)
+
+type IncidentHandler struct {
+	incidentListUseCase *usecase.IncidentListUseCase
+	logger              logger.Logger
+}
+
+func NewIncidentHandler(
+	incidentListUseCase *usecase.IncidentListUseCase,
+	logger logger.Logger,
+) *IncidentHandler {
+	return &IncidentHandler{
+		incidentListUseCase: incidentListUseCase,
+		logger:              logger,
+	}
+}
+
+// @Summary		List incidents
+// @Description	Retrieves a page of incidents of every monitor, newest first, with MTTR/MTBF metrics
+// @Tags		Incidents
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		X-Organization-ID	header	int	false	"Organization the request is made in, personal resources when omitted"
+// @Param		status		query	string	false	"Incident status"	Enums(open, resolved)
+// @Param		from		query	string	false	"Incidents started at or after this RFC3339 time"
+// @Param		to			query	string	false	"Incidents started at or before this RFC3339 time"
+// @Param		page		query	int		false	"Page number"	default(1)
+// @Param		page_size	query	int		false	"Page size"		default(20)
+// @Success		200	{object}	response.Envelope[dto.IncidentHistoryResponse]	"Successfully retrieved incidents"
+// @Failure		400	{object}	errs.Error	"Invalid filter parameters"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		422	{object}	errs.Error	"Invalid pagination parameters"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/incidents [get]
+func (h *IncidentHandler) ListIncidents(c *fiber.Ctx) error {
+	return h.listIncidents(c, 0)
+}
+
+// @Summary		List monitor incidents
+// @Description	Retrieves a page of incidents of an HTTP monitor, newest first, with MTTR/MTBF metrics
+// @Tags		Incidents
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		X-Organization-ID	header	int	false	"Organization the request is made in, personal resources when omitted"
+// @Param		id			path	int		true	"Monitor ID"
+// @Param		status		query	string	false	"Incident status"	Enums(open, resolved)
+// @Param		from		query	string	false	"Incidents started at or after this RFC3339 time"
+// @Param		to			query	string	false	"Incidents started at or before this RFC3339 time"
+// @Param		page		query	int		false	"Page number"	default(1)
+// @Param		page_size	query	int		false	"Page size"		default(20)
+// @Success		200	{object}	response.Envelope[dto.IncidentHistoryResponse]	"Successfully retrieved incidents"
+// @Failure		400	{object}	errs.Error	"Invalid filter parameters"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		404	{object}	errs.Error	"Monitor not found"
+// @Failure		422	{object}	errs.Error	"Invalid pagination parameters"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/monitors/{id}/incidents [get]
+func (h *IncidentHandler) ListMonitorIncidents(c *fiber.Ctx) error {
+	monitorID, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		h.logger.Error().Msgf("Invalid monitor ID: %v", err)
+		return fiber.NewError(http.StatusBadRequest, "Invalid monitor ID")
+	}
+
+	return h.listIncidents(c, monitorID)
+}
+
+func (h *IncidentHandler) listIncidents(c *fiber.Ctx, monitorID uint64) error {
+	ctx := c.UserContext()
+
+	userID, err := authenticatedUserID(c)
+	if err != nil {
+		return err
+	}
+
+	input := usecase.IncidentListInput{
+		UserID:         userID,
+		OrganizationID: activeOrganizationID(c),
+		MonitorID:      monitorID,
+		Status:         c.Query("status"),
+		Page:           c.QueryInt("page", defaultPage),
+		PageSize:       c.QueryInt("page_size", defaultPageSize),
+	}
+
+	input.From, err = parseTimeQuery(c, "from")
+	if err != nil {
+		return err
+	}
+
+	input.To, err = parseTimeQuery(c, "to")
+	if err != nil {
+		return err
+	}
+
+	output, err := h.incidentListUseCase.Execute(ctx, input)
+	if err != nil {
+		h.logger.Error().Msgf("Failed to list incidents: %v", err)
+		return err
+	}
+
+	incidents := make([]dto.IncidentResponse, len(output.Incidents))
+	for i, incident := range output.Incidents {
+		incidents[i] = dto.IncidentResponse{
+			IncidentID:         incident.IncidentID,
+			MonitorID:          incident.MonitorID,
+			Status:             incident.Status,
+			Cause:              incident.Cause,
+			StatusCode:         incident.StatusCode,
+			ErrorMessage:       incident.ErrorMessage,
+			FirstFailedCheckID: incident.FirstFailedCheckID,
+			RecoveryCheckID:    incident.RecoveryCheckID,
+			StartedAt:          incident.StartedAt,
+			ResolvedAt:         incident.ResolvedAt,
+			DurationSeconds:    incident.DurationSeconds,
+		}
+	}
+
+	history := dto.IncidentHistoryResponse{
+		Incidents: incidents,
+		Metrics: dto.IncidentMetricsResponse{
+			TotalIncidents: output.Metrics.TotalIncidents,
+			OpenIncidents:  output.Metrics.OpenIncidents,
+			MTTRSeconds:    output.Metrics.MTTRSeconds,
+			MTBFSeconds:    output.Metrics.MTBFSeconds,
+		},
+	}
+
+	pagination := response.Pagination{
+		Page:     input.Page,
+		PageSize: input.PageSize,
+		Total:    output.Total,
+	}
+
+	res := response.NewPaginatedEnvelope(history, pagination)
+	return c.Status(http.StatusOK).JSON(res)
+}
@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/http/dto"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/usecase"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/sdk/http/response"
+	"github.com/gofiber/fiber/v2"
+)
+
+type NotificationHandler struct {
+	notificationFailedListUseCase *usecase.NotificationFailedListUseCase
+	notificationRetryUseCase      *usecase.NotificationRetryUseCase
+	logger                        logger.Logger
+}
+
+func NewNotificationHandler(
+	notificationFailedListUseCase *usecase.NotificationFailedListUseCase,
+	notificationRetryUseCase *usecase.NotificationRetryUseCase,
+	logger logger.Logger,
+) *NotificationHandler {
+	return &NotificationHandler{
+		notificationFailedListUseCase: notificationFailedListUseCase,
+		notificationRetryUseCase:      notificationRetryUseCase,
+		logger:                        logger,
+	}
+}
+
+// @Summary		List failed notifications
+// @Description	Retrieves a page of the notifications that ran out of delivery attempts, most recent first
+// @Tags		Notifications
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		X-Organization-ID	header	int	false	"Organization the request is made in, personal resources when omitted"
+// @Param		page		query	int		false	"Page number"	default(1)
+// @Param		page_size	query	int		false	"Page size"		default(20)
+// @Success		200	{object}	response.Envelope[[]dto.NotificationResponse]	"Successfully retrieved failed notifications"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		422	{object}	errs.Error	"Invalid pagination parameters"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/notifications/failed [get]
+func (h *NotificationHandler) ListFailedNotifications(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	userID, err := authenticatedUserID(c)
+	if err != nil {
+		return err
+	}
+
+	input := usecase.NotificationFailedListInput{
+		UserID:         userID,
+		OrganizationID: activeOrganizationID(c),
+		Page:           c.QueryInt("page", defaultPage),
+		PageSize:       c.QueryInt("page_size", defaultPageSize),
+	}
+
+	output, err := h.notificationFailedListUseCase.Execute(ctx, input)
+	if err != nil {
+		h.logger.Error().Msgf("Failed to list failed notifications: %v", err)
+		return err
+	}
+
+	notifications := make([]dto.NotificationResponse, len(output.Notifications))
+	for i, notification := range output.Notifications {
+		notifications[i] = h.toNotificationResponse(notification)
+	}
+
+	pagination := response.Pagination{
+		Page:     input.Page,
+		PageSize: input.PageSize,
+		Total:    output.Total,
+	}
+
+	res := response.NewPaginatedEnvelope(notifications, pagination)
+	return c.Status(http.StatusOK).JSON(res)
+}
+
+// @Summary		Retry notification
+// @Description	Makes one more delivery attempt of a notification that ran out of delivery attempts
+// @Tags		Notifications
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		X-Organization-ID	header	int	false	"Organization the request is made in, personal resources when omitted"
+// @Param		id	path	int	true	"Notification ID"
+// @Success		200	{object}	response.Envelope[dto.NotificationResponse]	"Outcome of the delivery attempt"
+// @Failure		400	{object}	errs.Error	"Invalid notification ID"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		404	{object}	errs.Error	"Notification not found"
+// @Failure		409	{object}	errs.Error	"Notification is not retryable"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/notifications/{id}/retry [post]
+func (h *NotificationHandler) RetryNotification(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	userID, err := authenticatedUserID(c)
+	if err != nil {
+		return err
+	}
+
+	notificationID, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		h.logger.Error().Msgf("Invalid notification ID: %v", err)
+		return fiber.NewError(http.StatusBadRequest, "Invalid notification ID")
+	}
+
+	input := usecase.NotificationRetryInput{
+		UserID:         userID,
+		OrganizationID: activeOrganizationID(c),
+		NotificationID: notificationID,
+	}
+
+	output, err := h.notificationRetryUseCase.Execute(ctx, input)
+	if err != nil {
+		h.logger.Error().Msgf("Failed to retry notification: %v", err)
+		return err
+	}
+
+	res := response.NewEnvelope(h.toNotificationResponse(output))
+	return c.Status(http.StatusOK).JSON(res)
+}
+
+func (h *NotificationHandler) toNotificationResponse(
+	notification usecase.NotificationOutput,
+) dto.NotificationResponse {
+	return dto.NotificationResponse{
+		NotificationID:   notification.NotificationID,
+		MonitorID:        notification.MonitorID,
+		ContactID:        notification.ContactID,
+		IncidentID:       notification.IncidentID,
+		CheckID:          notification.CheckID,
+		NotificationType: notification.NotificationType,
+		Message:          notification.Message,
+		Status:           notification.Status,
+		ErrorMessage:     notification.ErrorMessage,
+		AttemptCount:     notification.AttemptCount,
+		SentAt:           notification.SentAt,
+		NextAttemptAt:    notification.NextAttemptAt,
+		CreatedAt:        notification.CreatedAt,
+		UpdatedAt:        notification.UpdatedAt,
+	}
+}
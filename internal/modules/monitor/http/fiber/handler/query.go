@@ -0,0 +1,22 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// parseTimeQuery reads an optional RFC3339 query parameter, nil means it was not sent.
+func parseTimeQuery(c *fiber.Ctx, key string) (*time.Time, error) {
+	value := c.Query(key)
+	if value == "" {
+		return nil, nil //nolint:nilnil // an absent parameter is not an error
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fiber.NewError(http.StatusBadRequest, "Invalid "+key+" parameter, expected RFC3339")
+	}
+	return &t, nil
+}
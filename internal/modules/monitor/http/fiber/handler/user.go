@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/cristiano-pacheco/pingo/internal/shared/sdk/http/request"
+	"github.com/gofiber/fiber/v2"
+)
+
+// authenticatedUserID returns the ID of the user authenticated by the auth middleware,
+// the resources of the monitor module are scoped to it.
+func authenticatedUserID(c *fiber.Ctx) (uint64, error) {
+	userID, ok := request.UserIDFromContext(c.UserContext())
+	if !ok {
+		return 0, fiber.NewError(http.StatusUnauthorized, "UserID not found")
+	}
+	return userID, nil
+}
+
+// activeOrganizationID returns the organization selected by the organization middleware, zero when
+// the request is made on the personal resources of the user.
+func activeOrganizationID(c *fiber.Ctx) uint64 {
+	organizationID, _ := request.OrganizationIDFromContext(c.UserContext())
+	return organizationID
+}
@@ -1,6 +1,7 @@
 package router
 
 import (
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
 	"github.com/cristiano-pacheco/pingo/internal/modules/identity/http/fiber/middleware"
 	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/http/fiber/handler"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/http/router"
@@ -10,11 +11,20 @@ func SetupContactRoutes(
 	router *router.FiberRouter,
 	handler *handler.ContactHandler,
 	authMiddleware *middleware.AuthMiddleware,
+	organizationMiddleware *middleware.OrganizationMiddleware,
 ) {
 	r := router.Router()
+	requireViewer := organizationMiddleware.RequireRole(enum.OrganizationRoleViewer)
+	requireEditor := organizationMiddleware.RequireRole(enum.OrganizationRoleEditor)
 
-	r.Get("/api/v1/contacts", authMiddleware.Middleware(), handler.ListContacts)
-	r.Post("/api/v1/contacts", authMiddleware.Middleware(), handler.CreateContact)
-	r.Put("/api/v1/contacts/:id", authMiddleware.Middleware(), handler.UpdateContact)
-	r.Delete("/api/v1/contacts/:id", authMiddleware.Middleware(), handler.DeleteContact)
+	r.Get("/api/v1/contacts", authMiddleware.Middleware(), requireViewer, handler.ListContacts)
+	r.Post("/api/v1/contacts", authMiddleware.Middleware(), requireEditor, handler.CreateContact)
+	r.Put("/api/v1/contacts/:id", authMiddleware.Middleware(), requireEditor, handler.UpdateContact)
+	r.Delete("/api/v1/contacts/:id", authMiddleware.Middleware(), requireEditor, handler.DeleteContact)
+	r.Post(
+		"/api/v1/contacts/:id/rotate-secret",
+		authMiddleware.Middleware(),
+		requireEditor,
+		handler.RotateContactSecret,
+	)
 }
@@ -0,0 +1,21 @@
+package router
+
+import (
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/http/fiber/middleware"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/http/fiber/handler"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/http/router"
+)
+
+func SetupHTTPMonitorCheckRoutes(
+	router *router.FiberRouter,
+	handler *handler.HTTPMonitorCheckHandler,
+	authMiddleware *middleware.AuthMiddleware,
+	organizationMiddleware *middleware.OrganizationMiddleware,
+) {
+	r := router.Router()
+	requireViewer := organizationMiddleware.RequireRole(enum.OrganizationRoleViewer)
+
+	r.Get("/api/v1/monitors/:id/checks", authMiddleware.Middleware(), requireViewer, handler.ListChecks)
+	r.Get("/api/v1/monitors/:id/checks/:checkId", authMiddleware.Middleware(), requireViewer, handler.FindCheck)
+}
@@ -0,0 +1,27 @@
+package router
+
+import (
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/http/fiber/middleware"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/http/fiber/handler"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/http/router"
+)
+
+func SetupHTTPMonitorRoutes(
+	router *router.FiberRouter,
+	handler *handler.HTTPMonitorHandler,
+	authMiddleware *middleware.AuthMiddleware,
+	organizationMiddleware *middleware.OrganizationMiddleware,
+) {
+	r := router.Router()
+	requireViewer := organizationMiddleware.RequireRole(enum.OrganizationRoleViewer)
+	requireEditor := organizationMiddleware.RequireRole(enum.OrganizationRoleEditor)
+
+	r.Get("/api/v1/monitors", authMiddleware.Middleware(), requireViewer, handler.ListMonitors)
+	r.Get("/api/v1/monitors/:id", authMiddleware.Middleware(), requireViewer, handler.FindMonitor)
+	r.Post("/api/v1/monitors", authMiddleware.Middleware(), requireEditor, handler.CreateMonitor)
+	r.Put("/api/v1/monitors/:id", authMiddleware.Middleware(), requireEditor, handler.UpdateMonitor)
+	r.Delete("/api/v1/monitors/:id", authMiddleware.Middleware(), requireEditor, handler.DeleteMonitor)
+	r.Post("/api/v1/monitors/:id/enable", authMiddleware.Middleware(), requireEditor, handler.EnableMonitor)
+	r.Post("/api/v1/monitors/:id/disable", authMiddleware.Middleware(), requireEditor, handler.DisableMonitor)
+}
@@ -0,0 +1,21 @@
+package router
+
+import (
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/http/fiber/middleware"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/http/fiber/handler"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/http/router"
+)
+
+func SetupHTTPMonitorStatsRoutes(
+	router *router.FiberRouter,
+	handler *handler.HTTPMonitorStatsHandler,
+	authMiddleware *middleware.AuthMiddleware,
+	organizationMiddleware *middleware.OrganizationMiddleware,
+) {
+	r := router.Router()
+	requireViewer := organizationMiddleware.RequireRole(enum.OrganizationRoleViewer)
+
+	r.Get("/api/v1/monitors/stats/summary", authMiddleware.Middleware(), requireViewer, handler.GetStatsSummary)
+	r.Get("/api/v1/monitors/:id/stats", authMiddleware.Middleware(), requireViewer, handler.GetMonitorStats)
+}
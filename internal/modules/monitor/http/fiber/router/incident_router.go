@@ -0,0 +1,21 @@
+package router
+
+import (
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/http/fiber/middleware"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/http/fiber/handler"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/http/router"
+)
+
+func SetupIncidentRoutes(
+	router *router.FiberRouter,
+	handler *handler.IncidentHandler,
+	authMiddleware *middleware.AuthMiddleware,
+	organizationMiddleware *middleware.OrganizationMiddleware,
+) {
+	r := router.Router()
+	requireViewer := organizationMiddleware.RequireRole(enum.OrganizationRoleViewer)
+
+	r.Get("/api/v1/incidents", authMiddleware.Middleware(), requireViewer, handler.ListIncidents)
+	r.Get("/api/v1/monitors/:id/incidents", authMiddleware.Middleware(), requireViewer, handler.ListMonitorIncidents)
+}
@@ -0,0 +1,22 @@
+package router
+
+import (
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/identity/http/fiber/middleware"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/http/fiber/handler"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/http/router"
+)
+
+func SetupNotificationRoutes(
+	router *router.FiberRouter,
+	handler *handler.NotificationHandler,
+	authMiddleware *middleware.AuthMiddleware,
+	organizationMiddleware *middleware.OrganizationMiddleware,
+) {
+	r := router.Router()
+	requireViewer := organizationMiddleware.RequireRole(enum.OrganizationRoleViewer)
+	requireEditor := organizationMiddleware.RequireRole(enum.OrganizationRoleEditor)
+
+	r.Get("/api/v1/notifications/failed", authMiddleware.Middleware(), requireViewer, handler.ListFailedNotifications)
+	r.Post("/api/v1/notifications/:id/retry", authMiddleware.Middleware(), requireEditor, handler.RetryNotification)
+}
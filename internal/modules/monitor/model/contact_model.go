@@ -1,15 +1,21 @@
 package model
 
-import "time"
+import (
+	"database/sql"
+	"time"
+)
 
 type ContactModel struct {
-	ID          uint64 `gorm:"primarykey"`
-	Name        string
-	ContactType string
-	ContactData string
-	IsEnabled   bool
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID             uint64 `gorm:"primarykey"`
+	UserID         uint64
+	OrganizationID sql.NullInt64
+	Name           string
+	ContactType    string
+	ContactData    string
+	SigningSecret  sql.NullString
+	IsEnabled      bool
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
 }
 
 func (*ContactModel) TableName() string {
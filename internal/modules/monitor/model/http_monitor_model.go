@@ -9,6 +9,8 @@ import (
 
 type HTTPMonitorModel struct {
 	ID                    uint64         `gorm:"primarykey"`
+	UserID                uint64         `gorm:"column:user_id"`
+	OrganizationID        sql.NullInt64  `gorm:"column:organization_id"`
 	Name                  string         `gorm:"column:name"`
 	CheckTimeout          int            `gorm:"column:check_timeout"`
 	FailThreshold         int16          `gorm:"column:fail_threshold"`
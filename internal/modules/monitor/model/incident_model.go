@@ -0,0 +1,25 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+type IncidentModel struct {
+	ID                 uint64         `gorm:"primarykey"`
+	HTTPMonitorID      uint64         `gorm:"column:http_monitor_id"`
+	FirstFailedCheckID sql.NullInt64  `gorm:"column:first_failed_check_id"`
+	RecoveryCheckID    sql.NullInt64  `gorm:"column:recovery_check_id"`
+	Cause              string         `gorm:"column:cause"`
+	StatusCode         sql.NullInt32  `gorm:"column:status_code"`
+	ErrorMessage       sql.NullString `gorm:"column:error_message"`
+	StartedAt          time.Time      `gorm:"column:started_at"`
+	ResolvedAt         sql.NullTime   `gorm:"column:resolved_at"`
+	DurationSeconds    sql.NullInt64  `gorm:"column:duration_seconds"`
+	CreatedAt          time.Time      `gorm:"column:created_at"`
+	UpdatedAt          time.Time      `gorm:"column:updated_at"`
+}
+
+func (*IncidentModel) TableName() string {
+	return "incidents"
+}
@@ -6,16 +6,20 @@ import (
 )
 
 type NotificationModel struct {
-	ID               uint64         `gorm:"primarykey"`
-	HTTPMonitorID    uint64         `gorm:"column:http_monitor_id"`
-	ContactID        uint64         `gorm:"column:contact_id"`
-	NotificationType string         `gorm:"column:notification_type"`
-	Message          string         `gorm:"column:message"`
-	Status           string         `gorm:"column:status;default:'pending'"`
-	SentAt           sql.NullTime   `gorm:"column:sent_at"`
-	ErrorMessage     sql.NullString `gorm:"column:error_message"`
-	CreatedAt        time.Time      `gorm:"column:created_at"`
-	UpdatedAt        time.Time      `gorm:"column:updated_at"`
+	ID                 uint64         `gorm:"primarykey"`
+	HTTPMonitorID      uint64         `gorm:"column:http_monitor_id"`
+	ContactID          uint64         `gorm:"column:contact_id"`
+	IncidentID         sql.NullInt64  `gorm:"column:incident_id"`
+	HTTPMonitorCheckID sql.NullInt64  `gorm:"column:http_monitor_check_id"`
+	NotificationType   string         `gorm:"column:notification_type"`
+	Message            string         `gorm:"column:message"`
+	Status             string         `gorm:"column:status;default:'pending'"`
+	SentAt             sql.NullTime   `gorm:"column:sent_at"`
+	ErrorMessage       sql.NullString `gorm:"column:error_message"`
+	AttemptCount       int            `gorm:"column:attempt_count"`
+	NextAttemptAt      sql.NullTime   `gorm:"column:next_attempt_at"`
+	CreatedAt          time.Time      `gorm:"column:created_at"`
+	UpdatedAt          time.Time      `gorm:"column:updated_at"`
 }
 
 func (*NotificationModel) TableName() string {
@@ -1,9 +1,13 @@
 package monitor
 
 import (
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/cache"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event/producer"
 	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/http/fiber/handler"
 	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/http/fiber/router"
 	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/scheduler"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/service"
 	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/usecase"
 	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/validator"
 	"go.uber.org/fx"
@@ -13,6 +17,45 @@ var Module = fx.Module(
 	"monitor",
 	fx.Provide(
 		handler.NewContactHandler,
+		handler.NewHTTPMonitorHandler,
+		handler.NewHTTPMonitorCheckHandler,
+		handler.NewHTTPMonitorStatsHandler,
+		handler.NewIncidentHandler,
+		handler.NewNotificationHandler,
+
+		fx.Annotate(
+			cache.NewHTTPMonitorCheckLeaseCache,
+			fx.As(new(cache.HTTPMonitorCheckLeaseCacheI)),
+		),
+
+		fx.Annotate(
+			producer.NewMonitorCreatedProducer,
+			fx.As(new(producer.MonitorCreatedProducerI)),
+		),
+		fx.Annotate(
+			producer.NewMonitorUpdatedProducer,
+			fx.As(new(producer.MonitorUpdatedProducerI)),
+		),
+		fx.Annotate(
+			producer.NewMonitorDeletedProducer,
+			fx.As(new(producer.MonitorDeletedProducerI)),
+		),
+		fx.Annotate(
+			producer.NewCheckCompletedProducer,
+			fx.As(new(producer.CheckCompletedProducerI)),
+		),
+		fx.Annotate(
+			producer.NewMonitorDownProducer,
+			fx.As(new(producer.MonitorDownProducerI)),
+		),
+		fx.Annotate(
+			producer.NewMonitorRecoveredProducer,
+			fx.As(new(producer.MonitorRecoveredProducerI)),
+		),
+		fx.Annotate(
+			producer.NewNotificationSentProducer,
+			fx.As(new(producer.NotificationSentProducerI)),
+		),
 
 		fx.Annotate(
 			repository.NewContactRepository,
@@ -26,21 +69,75 @@ var Module = fx.Module(
 			repository.NewHTTPMonitorCheckRepository,
 			fx.As(new(repository.HTTPMonitorCheckRepositoryI)),
 		),
+		fx.Annotate(
+			repository.NewHTTPMonitorStatsRepository,
+			fx.As(new(repository.HTTPMonitorStatsRepositoryI)),
+		),
+		fx.Annotate(
+			repository.NewIncidentRepository,
+			fx.As(new(repository.IncidentRepositoryI)),
+		),
 		fx.Annotate(
 			repository.NewNotificationRepository,
 			fx.As(new(repository.NotificationRepositoryI)),
 		),
 
+		fx.Annotate(
+			service.NewHTTPMonitorCheckerService,
+			fx.As(new(service.HTTPMonitorCheckerServiceI)),
+		),
+		fx.Annotate(
+			service.NewHTTPMonitorIncidentService,
+			fx.As(new(service.HTTPMonitorIncidentServiceI)),
+		),
+		fx.Annotate(
+			service.NewNotificationDispatcherService,
+			fx.As(new(service.NotificationDispatcherServiceI)),
+		),
+		service.NewEmailNotificationSender,
+		service.NewWebhookNotificationSender,
+		service.NewNotificationSenders,
+
+		fx.Annotate(
+			scheduler.NewHTTPMonitorScheduler,
+			fx.As(new(scheduler.HTTPMonitorSchedulerI)),
+		),
+
 		fx.Annotate(
 			validator.NewContactValidator,
 			fx.As(new(validator.ContactValidatorI)),
 		),
+		fx.Annotate(
+			validator.NewHTTPMonitorValidator,
+			fx.As(new(validator.HTTPMonitorValidatorI)),
+		),
 
 		usecase.NewContactCreateUseCase,
 		usecase.NewContactListUseCase,
 		usecase.NewContactUpdateUseCase,
 		usecase.NewContactDeleteUseCase,
-
-		fx.Invoke(router.SetupContactRoutes),
+		usecase.NewContactRotateSecretUseCase,
+		usecase.NewHTTPMonitorCreateUseCase,
+		usecase.NewHTTPMonitorListUseCase,
+		usecase.NewHTTPMonitorFindUseCase,
+		usecase.NewHTTPMonitorUpdateUseCase,
+		usecase.NewHTTPMonitorDeleteUseCase,
+		usecase.NewHTTPMonitorSetEnabledUseCase,
+		usecase.NewHTTPMonitorCheckListUseCase,
+		usecase.NewHTTPMonitorCheckFindUseCase,
+		usecase.NewHTTPMonitorStatsUseCase,
+		usecase.NewHTTPMonitorStatsSummaryUseCase,
+		usecase.NewIncidentListUseCase,
+		usecase.NewNotificationFailedListUseCase,
+		usecase.NewNotificationRetryUseCase,
+	),
+	fx.Invoke(
+		router.SetupContactRoutes,
+		router.SetupHTTPMonitorRoutes,
+		router.SetupHTTPMonitorCheckRoutes,
+		router.SetupHTTPMonitorStatsRoutes,
+		router.SetupIncidentRoutes,
+		router.SetupNotificationRoutes,
+		scheduler.NewNotificationRetryScheduler,
 	),
 )
@@ -11,12 +11,17 @@ import (
 	"gorm.io/gorm"
 )
 
+// ContactRepositoryI only reaches the contacts of the given owner, the contacts of other owners
+// are reported as not found.
 type ContactRepositoryI interface {
-	FindAll(ctx context.Context) ([]model.ContactModel, error)
-	FindByName(ctx context.Context, name string) (model.ContactModel, error)
+	FindAll(ctx context.Context, owner Owner) ([]model.ContactModel, error)
+	FindByID(ctx context.Context, owner Owner, contactID uint64) (model.ContactModel, error)
+	FindByName(ctx context.Context, owner Owner, name string) (model.ContactModel, error)
+	FindByIDs(ctx context.Context, owner Owner, contactIDs []uint64) ([]model.ContactModel, error)
 	Create(ctx context.Context, contact model.ContactModel) (model.ContactModel, error)
-	Update(ctx context.Context, contact model.ContactModel) (model.ContactModel, error)
-	Delete(ctx context.Context, contactID uint64) error
+	Update(ctx context.Context, owner Owner, contact model.ContactModel) (model.ContactModel, error)
+	UpdateSigningSecret(ctx context.Context, owner Owner, contactID uint64, signingSecret string) error
+	Delete(ctx context.Context, owner Owner, contactID uint64) error
 }
 
 type ContactRepository struct {
@@ -29,23 +34,47 @@ func NewContactRepository(db *database.PingoDB) *ContactRepository {
 	return &ContactRepository{db}
 }
 
-func (r *ContactRepository) FindAll(ctx context.Context) ([]model.ContactModel, error) {
+func (r *ContactRepository) FindAll(ctx context.Context, owner Owner) ([]model.ContactModel, error) {
 	ctx, otelSpan := trace.Span(ctx, "ContactRepository.FindAll")
 	defer otelSpan.End()
 
-	contacts, err := gorm.G[model.ContactModel](r.DB).Find(ctx)
+	ownerCondition, ownerArgs := owner.condition()
+	contacts, err := gorm.G[model.ContactModel](r.DB).
+		Where(ownerCondition, ownerArgs...).
+		Order("id ASC").
+		Find(ctx)
 	if err != nil {
 		return nil, err
 	}
 	return contacts, nil
 }
 
-func (r *ContactRepository) FindByName(ctx context.Context, name string) (model.ContactModel, error) {
+func (r *ContactRepository) FindByID(ctx context.Context, owner Owner, contactID uint64) (model.ContactModel, error) {
+	ctx, otelSpan := trace.Span(ctx, "ContactRepository.FindByID")
+	defer otelSpan.End()
+
+	ownerCondition, ownerArgs := owner.condition()
+	contact, err := gorm.G[model.ContactModel](r.DB).
+		Where("id = ?", contactID).
+		Where(ownerCondition, ownerArgs...).
+		First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.ContactModel{}, errs.ErrRecordNotFound
+		}
+		return model.ContactModel{}, err
+	}
+	return contact, nil
+}
+
+func (r *ContactRepository) FindByName(ctx context.Context, owner Owner, name string) (model.ContactModel, error) {
 	ctx, otelSpan := trace.Span(ctx, "ContactRepository.FindByName")
 	defer otelSpan.End()
 
+	ownerCondition, ownerArgs := owner.condition()
 	contact, err := gorm.G[model.ContactModel](r.DB).
 		Where("name = ?", name).
+		Where(ownerCondition, ownerArgs...).
 		First(ctx)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -56,6 +85,29 @@ func (r *ContactRepository) FindByName(ctx context.Context, name string) (model.
 	return contact, nil
 }
 
+func (r *ContactRepository) FindByIDs(
+	ctx context.Context,
+	owner Owner,
+	contactIDs []uint64,
+) ([]model.ContactModel, error) {
+	ctx, otelSpan := trace.Span(ctx, "ContactRepository.FindByIDs")
+	defer otelSpan.End()
+
+	if len(contactIDs) == 0 {
+		return []model.ContactModel{}, nil
+	}
+
+	ownerCondition, ownerArgs := owner.condition()
+	contacts, err := gorm.G[model.ContactModel](r.DB).
+		Where("id IN ?", contactIDs).
+		Where(ownerCondition, ownerArgs...).
+		Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return contacts, nil
+}
+
 func (r *ContactRepository) Create(ctx context.Context, contact model.ContactModel) (model.ContactModel, error) {
 	ctx, otelSpan := trace.Span(ctx, "ContactRepository.Create")
 	defer otelSpan.End()
@@ -64,23 +116,60 @@ func (r *ContactRepository) Create(ctx context.Context, contact model.ContactMod
 	return contact, err
 }
 
-func (r *ContactRepository) Update(ctx context.Context, contact model.ContactModel) (model.ContactModel, error) {
+func (r *ContactRepository) Update(
+	ctx context.Context,
+	owner Owner,
+	contact model.ContactModel,
+) (model.ContactModel, error) {
 	ctx, otelSpan := trace.Span(ctx, "ContactRepository.Update")
 	defer otelSpan.End()
 
-	_, err := gorm.G[model.ContactModel](r.DB).Updates(ctx, contact)
+	ownerCondition, ownerArgs := owner.condition()
+	rowsAffected, err := gorm.G[model.ContactModel](r.DB).
+		Where("id = ?", contact.ID).
+		Where(ownerCondition, ownerArgs...).
+		Updates(ctx, contact)
 	if err != nil {
 		return model.ContactModel{}, err
 	}
+	if rowsAffected == 0 {
+		return model.ContactModel{}, errs.ErrRecordNotFound
+	}
 	return contact, nil
 }
 
-func (r *ContactRepository) Delete(ctx context.Context, contactID uint64) error {
+func (r *ContactRepository) UpdateSigningSecret(
+	ctx context.Context,
+	owner Owner,
+	contactID uint64,
+	signingSecret string,
+) error {
+	ctx, otelSpan := trace.Span(ctx, "ContactRepository.UpdateSigningSecret")
+	defer otelSpan.End()
+
+	ownerCondition, ownerArgs := owner.condition()
+	result := r.DB.WithContext(ctx).
+		Model(&model.ContactModel{}).
+		Where("id = ?", contactID).
+		Where(ownerCondition, ownerArgs...).
+		Update("signing_secret", signingSecret)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errs.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *ContactRepository) Delete(ctx context.Context, owner Owner, contactID uint64) error {
 	ctx, otelSpan := trace.Span(ctx, "ContactRepository.Delete")
 	defer otelSpan.End()
 
+	ownerCondition, ownerArgs := owner.condition()
 	rowsAffected, err := gorm.G[model.ContactModel](r.DB).
 		Where("id = ?", contactID).
+		Where(ownerCondition, ownerArgs...).
 		Delete(ctx)
 	if err != nil {
 		return err
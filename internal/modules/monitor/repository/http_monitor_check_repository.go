@@ -12,14 +12,23 @@ import (
 	"gorm.io/gorm"
 )
 
+// HTTPMonitorCheckFilter narrows down the checks of a monitor, nil and empty fields are ignored.
+type HTTPMonitorCheckFilter struct {
+	From        *time.Time
+	To          *time.Time
+	Success     *bool
+	StatusCodes []int32
+}
+
 type HTTPMonitorCheckRepositoryI interface {
 	FindByID(ctx context.Context, checkID uint64) (model.HTTPMonitorCheckModel, error)
 	FindAll(
 		ctx context.Context,
 		monitorID uint64,
-		from, to *time.Time,
+		filter HTTPMonitorCheckFilter,
 		page, pageSize int,
 	) ([]model.HTTPMonitorCheckModel, int64, error)
+	FindFirstFailedSinceLastSuccess(ctx context.Context, monitorID uint64) (model.HTTPMonitorCheckModel, error)
 	Create(ctx context.Context, check model.HTTPMonitorCheckModel) (model.HTTPMonitorCheckModel, error)
 }
 
@@ -57,7 +66,7 @@ func (r *HTTPMonitorCheckRepository) FindByID(
 func (r *HTTPMonitorCheckRepository) FindAll(
 	ctx context.Context,
 	monitorID uint64,
-	from, to *time.Time,
+	filter HTTPMonitorCheckFilter,
 	page, pageSize int,
 ) ([]model.HTTPMonitorCheckModel, int64, error) {
 	ctx, otelSpan := trace.Span(ctx, "HTTPMonitorCheckRepository.FindAll")
@@ -66,38 +75,14 @@ func (r *HTTPMonitorCheckRepository) FindAll(
 	// Calculate offset
 	offset := (page - 1) * pageSize
 
-	// Build base query
-	query := r.DB.Model(&model.HTTPMonitorCheckModel{}).
-		Where("http_monitor_id = ?", monitorID)
-
-	// Add optional date range filters
-	if from != nil {
-		query = query.Where("checked_at >= ?", *from)
-	}
-	if to != nil {
-		query = query.Where("checked_at <= ?", *to)
-	}
-
 	// Get total count
-	var total int64
-	if err := query.Count(&total).Error; err != nil {
+	total, err := r.filteredChecks(monitorID, filter).Count(ctx, "*")
+	if err != nil {
 		return nil, 0, err
 	}
 
-	// Build query for paginated results
-	checksQuery := gorm.G[model.HTTPMonitorCheckModel](r.DB).
-		Where("http_monitor_id = ?", monitorID)
-
-	// Add optional date range filters
-	if from != nil {
-		checksQuery = checksQuery.Where("checked_at >= ?", *from)
-	}
-	if to != nil {
-		checksQuery = checksQuery.Where("checked_at <= ?", *to)
-	}
-
 	// Get paginated results
-	checks, err := checksQuery.
+	checks, err := r.filteredChecks(monitorID, filter).
 		Order("checked_at DESC").
 		Limit(pageSize).
 		Offset(offset).
@@ -110,6 +95,57 @@ func (r *HTTPMonitorCheckRepository) FindAll(
 	return checks, total, nil
 }
 
+// FindFirstFailedSinceLastSuccess returns the check that started the current streak of failures.
+func (r *HTTPMonitorCheckRepository) FindFirstFailedSinceLastSuccess(
+	ctx context.Context,
+	monitorID uint64,
+) (model.HTTPMonitorCheckModel, error) {
+	ctx, otelSpan := trace.Span(ctx, "HTTPMonitorCheckRepository.FindFirstFailedSinceLastSuccess")
+	defer otelSpan.End()
+
+	lastSuccess := r.DB.Model(&model.HTTPMonitorCheckModel{}).
+		Select("COALESCE(MAX(checked_at), '-infinity')").
+		Where("http_monitor_id = ? AND success", monitorID)
+
+	check, err := gorm.G[model.HTTPMonitorCheckModel](r.DB).
+		Where("http_monitor_id = ? AND NOT success AND checked_at > (?)", monitorID, lastSuccess).
+		Order("checked_at").
+		Limit(1).
+		First(ctx)
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.HTTPMonitorCheckModel{}, errs.ErrRecordNotFound
+		}
+		return model.HTTPMonitorCheckModel{}, err
+	}
+	return check, nil
+}
+
+func (r *HTTPMonitorCheckRepository) filteredChecks(
+	monitorID uint64,
+	filter HTTPMonitorCheckFilter,
+) gorm.ChainInterface[model.HTTPMonitorCheckModel] {
+	query := gorm.G[model.HTTPMonitorCheckModel](r.DB).
+		Where("http_monitor_id = ?", monitorID)
+
+	// Add optional filters
+	if filter.From != nil {
+		query = query.Where("checked_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("checked_at <= ?", *filter.To)
+	}
+	if filter.Success != nil {
+		query = query.Where("success = ?", *filter.Success)
+	}
+	if len(filter.StatusCodes) > 0 {
+		query = query.Where("status_code IN ?", filter.StatusCodes)
+	}
+
+	return query
+}
+
 func (r *HTTPMonitorCheckRepository) Create(
 	ctx context.Context,
 	check model.HTTPMonitorCheckModel,
@@ -117,6 +153,6 @@ func (r *HTTPMonitorCheckRepository) Create(
 	ctx, otelSpan := trace.Span(ctx, "HTTPMonitorCheckRepository.Create")
 	defer otelSpan.End()
 
-	err := gorm.G[model.HTTPMonitorCheckModel](r.DB).Create(ctx, &check)
+	err := gorm.G[model.HTTPMonitorCheckModel](r.Conn(ctx)).Create(ctx, &check)
 	return check, err
 }
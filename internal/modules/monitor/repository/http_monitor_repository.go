@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/cristiano-pacheco/go-otel/trace"
 	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
@@ -11,13 +12,27 @@ import (
 	"gorm.io/gorm"
 )
 
+// HTTPMonitorRepositoryI reaches the monitors of the given owner, the monitors of other owners are
+// reported as not found. FindAllEnabled, FindByIDUnscoped and UpdateCheckResult serve the
+// background checks, which run for every user.
 type HTTPMonitorRepositoryI interface {
-	FindAll(ctx context.Context, page, pageSize int) ([]model.HTTPMonitorModel, int64, error)
-	FindByID(ctx context.Context, monitorID uint64) (model.HTTPMonitorModel, error)
+	FindAll(ctx context.Context, owner Owner, page, pageSize int) ([]model.HTTPMonitorModel, int64, error)
+	FindAllEnabled(ctx context.Context) ([]model.HTTPMonitorModel, error)
+	FindByID(ctx context.Context, owner Owner, monitorID uint64) (model.HTTPMonitorModel, error)
+	FindByIDUnscoped(ctx context.Context, monitorID uint64) (model.HTTPMonitorModel, error)
+	FindContactIDs(ctx context.Context, monitorIDs []uint64) (map[uint64][]uint64, error)
 	Create(ctx context.Context, monitor model.HTTPMonitorModel) (model.HTTPMonitorModel, error)
-	Update(ctx context.Context, monitor model.HTTPMonitorModel) (model.HTTPMonitorModel, error)
-	Delete(ctx context.Context, monitorID uint64) error
+	Update(ctx context.Context, owner Owner, monitor model.HTTPMonitorModel) (model.HTTPMonitorModel, error)
+	SetEnabled(ctx context.Context, owner Owner, monitorID uint64, isEnabled bool) error
+	Delete(ctx context.Context, owner Owner, monitorID uint64) error
 	AssignContacts(ctx context.Context, monitorID uint64, contactIDs []uint64) error
+	UpdateCheckResult(
+		ctx context.Context,
+		monitorID uint64,
+		checkedAt time.Time,
+		status string,
+		consecutiveFailures int,
+	) error
 }
 
 type HTTPMonitorRepository struct {
@@ -32,6 +47,7 @@ func NewHTTPMonitorRepository(db *database.PingoDB) *HTTPMonitorRepository {
 
 func (r *HTTPMonitorRepository) FindAll(
 	ctx context.Context,
+	owner Owner,
 	page, pageSize int,
 ) ([]model.HTTPMonitorModel, int64, error) {
 	ctx, otelSpan := trace.Span(ctx, "HTTPMonitorRepository.FindAll")
@@ -40,14 +56,22 @@ func (r *HTTPMonitorRepository) FindAll(
 	// Calculate offset
 	offset := (page - 1) * pageSize
 
+	ownerCondition, ownerArgs := owner.condition()
+
 	// Get total count
 	var total int64
-	if err := r.DB.Model(&model.HTTPMonitorModel{}).Count(&total).Error; err != nil {
+	err := r.DB.WithContext(ctx).
+		Model(&model.HTTPMonitorModel{}).
+		Where(ownerCondition, ownerArgs...).
+		Count(&total).Error
+	if err != nil {
 		return nil, 0, err
 	}
 
 	// Get paginated results
 	monitors, err := gorm.G[model.HTTPMonitorModel](r.DB).
+		Where(ownerCondition, ownerArgs...).
+		Order("id ASC").
 		Limit(pageSize).
 		Offset(offset).
 		Find(ctx)
@@ -58,12 +82,32 @@ func (r *HTTPMonitorRepository) FindAll(
 	return monitors, total, nil
 }
 
-func (r *HTTPMonitorRepository) FindByID(ctx context.Context, monitorID uint64) (model.HTTPMonitorModel, error) {
+func (r *HTTPMonitorRepository) FindAllEnabled(ctx context.Context) ([]model.HTTPMonitorModel, error) {
+	ctx, otelSpan := trace.Span(ctx, "HTTPMonitorRepository.FindAllEnabled")
+	defer otelSpan.End()
+
+	monitors, err := gorm.G[model.HTTPMonitorModel](r.DB).
+		Where("is_enabled = ?", true).
+		Order("id ASC").
+		Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return monitors, nil
+}
+
+func (r *HTTPMonitorRepository) FindByID(
+	ctx context.Context,
+	owner Owner,
+	monitorID uint64,
+) (model.HTTPMonitorModel, error) {
 	ctx, otelSpan := trace.Span(ctx, "HTTPMonitorRepository.FindByID")
 	defer otelSpan.End()
 
+	ownerCondition, ownerArgs := owner.condition()
 	monitor, err := gorm.G[model.HTTPMonitorModel](r.DB).
 		Where("id = ?", monitorID).
+		Where(ownerCondition, ownerArgs...).
 		Limit(1).
 		First(ctx)
 
@@ -76,6 +120,59 @@ func (r *HTTPMonitorRepository) FindByID(ctx context.Context, monitorID uint64)
 	return monitor, nil
 }
 
+// FindByIDUnscoped finds the monitor whoever owns it, for the checks and deliveries run in the
+// background. Requests of a user go through FindByID.
+func (r *HTTPMonitorRepository) FindByIDUnscoped(
+	ctx context.Context,
+	monitorID uint64,
+) (model.HTTPMonitorModel, error) {
+	ctx, otelSpan := trace.Span(ctx, "HTTPMonitorRepository.FindByIDUnscoped")
+	defer otelSpan.End()
+
+	monitor, err := gorm.G[model.HTTPMonitorModel](r.DB).
+		Where("id = ?", monitorID).
+		Limit(1).
+		First(ctx)
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.HTTPMonitorModel{}, errs.ErrRecordNotFound
+		}
+		return model.HTTPMonitorModel{}, err
+	}
+	return monitor, nil
+}
+
+// FindContactIDs returns the IDs of the contacts assigned to each of the given monitors.
+func (r *HTTPMonitorRepository) FindContactIDs(
+	ctx context.Context,
+	monitorIDs []uint64,
+) (map[uint64][]uint64, error) {
+	ctx, otelSpan := trace.Span(ctx, "HTTPMonitorRepository.FindContactIDs")
+	defer otelSpan.End()
+
+	contactIDs := make(map[uint64][]uint64, len(monitorIDs))
+	if len(monitorIDs) == 0 {
+		return contactIDs, nil
+	}
+
+	monitorContacts, err := gorm.G[model.HTTPMonitorContactModel](r.DB).
+		Where("http_monitor_id IN ?", monitorIDs).
+		Order("contact_id ASC").
+		Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, monitorContact := range monitorContacts {
+		contactIDs[monitorContact.HTTPMonitorID] = append(
+			contactIDs[monitorContact.HTTPMonitorID],
+			monitorContact.ContactID,
+		)
+	}
+	return contactIDs, nil
+}
+
 func (r *HTTPMonitorRepository) Create(
 	ctx context.Context,
 	monitor model.HTTPMonitorModel,
@@ -83,30 +180,75 @@ func (r *HTTPMonitorRepository) Create(
 	ctx, otelSpan := trace.Span(ctx, "HTTPMonitorRepository.Create")
 	defer otelSpan.End()
 
-	err := gorm.G[model.HTTPMonitorModel](r.DB).Create(ctx, &monitor)
+	err := gorm.G[model.HTTPMonitorModel](r.Conn(ctx)).Create(ctx, &monitor)
 	return monitor, err
 }
 
 func (r *HTTPMonitorRepository) Update(
 	ctx context.Context,
+	owner Owner,
 	monitor model.HTTPMonitorModel,
 ) (model.HTTPMonitorModel, error) {
 	ctx, otelSpan := trace.Span(ctx, "HTTPMonitorRepository.Update")
 	defer otelSpan.End()
 
-	_, err := gorm.G[model.HTTPMonitorModel](r.DB).Updates(ctx, monitor)
-	if err != nil {
-		return model.HTTPMonitorModel{}, err
+	// the editable columns are selected so zero values (e.g. is_enabled = false) are persisted
+	ownerCondition, ownerArgs := owner.condition()
+	result := r.Conn(ctx).
+		Model(&monitor).
+		Where(ownerCondition, ownerArgs...).
+		Select(
+			"name",
+			"check_timeout",
+			"fail_threshold",
+			"check_interval_seconds",
+			"is_enabled",
+			"http_url",
+			"http_method",
+			"request_headers",
+			"valid_response_statuses",
+		).
+		Updates(&monitor)
+	if result.Error != nil {
+		return model.HTTPMonitorModel{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return model.HTTPMonitorModel{}, errs.ErrRecordNotFound
 	}
 	return monitor, nil
 }
 
-func (r *HTTPMonitorRepository) Delete(ctx context.Context, monitorID uint64) error {
+func (r *HTTPMonitorRepository) SetEnabled(
+	ctx context.Context,
+	owner Owner,
+	monitorID uint64,
+	isEnabled bool,
+) error {
+	ctx, otelSpan := trace.Span(ctx, "HTTPMonitorRepository.SetEnabled")
+	defer otelSpan.End()
+
+	ownerCondition, ownerArgs := owner.condition()
+	rowsAffected, err := gorm.G[model.HTTPMonitorModel](r.Conn(ctx)).
+		Where("id = ?", monitorID).
+		Where(ownerCondition, ownerArgs...).
+		Update(ctx, "is_enabled", isEnabled)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errs.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *HTTPMonitorRepository) Delete(ctx context.Context, owner Owner, monitorID uint64) error {
 	ctx, otelSpan := trace.Span(ctx, "HTTPMonitorRepository.Delete")
 	defer otelSpan.End()
 
-	rowsAffected, err := gorm.G[model.HTTPMonitorModel](r.DB).
+	ownerCondition, ownerArgs := owner.condition()
+	rowsAffected, err := gorm.G[model.HTTPMonitorModel](r.Conn(ctx)).
 		Where("id = ?", monitorID).
+		Where(ownerCondition, ownerArgs...).
 		Delete(ctx)
 	if err != nil {
 		return err
@@ -117,39 +259,60 @@ func (r *HTTPMonitorRepository) Delete(ctx context.Context, monitorID uint64) er
 	return nil
 }
 
+// AssignContacts replaces the contacts of the monitor. Called within a transaction,
+// the contacts are replaced in a savepoint of that transaction.
 func (r *HTTPMonitorRepository) AssignContacts(ctx context.Context, monitorID uint64, contactIDs []uint64) error {
 	ctx, otelSpan := trace.Span(ctx, "HTTPMonitorRepository.AssignContacts")
 	defer otelSpan.End()
 
-	// start a transaction
-	tx := r.DB.Begin()
+	return r.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		_, err := gorm.G[model.HTTPMonitorContactModel](tx).
+			Where("http_monitor_id = ?", monitorID).
+			Delete(ctx)
+		if err != nil {
+			return err
+		}
 
-	_, err := gorm.G[model.HTTPMonitorContactModel](tx).
-		Where("http_monitor_id = ?", monitorID).
-		Delete(ctx)
+		if len(contactIDs) == 0 {
+			return nil
+		}
 
-	if err != nil {
-		tx.Rollback()
-		return err
-	}
+		var monitorContacts []model.HTTPMonitorContactModel
+		for _, contactID := range contactIDs {
+			monitorContacts = append(monitorContacts, model.HTTPMonitorContactModel{
+				HTTPMonitorID: monitorID,
+				ContactID:     contactID,
+			})
+		}
 
-	var monitorContacts []model.HTTPMonitorContactModel
-	for _, contactID := range contactIDs {
-		monitorContacts = append(monitorContacts, model.HTTPMonitorContactModel{
-			HTTPMonitorID: monitorID,
-			ContactID:     contactID,
-		})
-	}
+		return gorm.G[model.HTTPMonitorContactModel](tx).CreateInBatches(ctx, &monitorContacts, len(monitorContacts))
+	})
+}
 
-	err = gorm.G[model.HTTPMonitorContactModel](tx).CreateInBatches(ctx, &monitorContacts, len(monitorContacts))
-	if err != nil {
-		tx.Rollback()
-		return err
-	}
+func (r *HTTPMonitorRepository) UpdateCheckResult(
+	ctx context.Context,
+	monitorID uint64,
+	checkedAt time.Time,
+	status string,
+	consecutiveFailures int,
+) error {
+	ctx, otelSpan := trace.Span(ctx, "HTTPMonitorRepository.UpdateCheckResult")
+	defer otelSpan.End()
 
-	if commitErr := tx.Commit().Error; commitErr != nil {
-		return commitErr
+	// a map is used so zero values (e.g. consecutive_failures = 0) are persisted
+	result := r.Conn(ctx).
+		Model(&model.HTTPMonitorModel{}).
+		Where("id = ?", monitorID).
+		Updates(map[string]any{
+			"last_checked_at":      checkedAt,
+			"last_status":          status,
+			"consecutive_failures": consecutiveFailures,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errs.ErrRecordNotFound
 	}
-
 	return nil
 }
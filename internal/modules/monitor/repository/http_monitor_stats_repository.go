@@ -0,0 +1,202 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+)
+
+const maxErrorBreakdownEntries = 10
+
+// checkStatsQuery aggregates the checks inside [@from, @to] per monitor.
+// Every check is assumed to hold its result until the next check (or @to for
+// the last one), which gives the up time in seconds next to the check counts.
+const checkStatsQuery = `
+WITH windowed AS (
+	SELECT
+		http_monitor_id,
+		success,
+		response_time_ms,
+		EXTRACT(EPOCH FROM (
+			LEAD(checked_at, 1, CAST(@to AS TIMESTAMP)) OVER (PARTITION BY http_monitor_id ORDER BY checked_at)
+			- checked_at
+		)) AS duration_seconds
+	FROM http_monitor_checks
+	WHERE checked_at >= @from AND checked_at <= @to %s
+)
+SELECT
+	http_monitor_id AS monitor_id,
+	COUNT(*) AS total_checks,
+	COUNT(*) FILTER (WHERE success) AS successful_checks,
+	CAST(COALESCE(SUM(duration_seconds), 0) AS DOUBLE PRECISION) AS observed_seconds,
+	CAST(COALESCE(SUM(duration_seconds) FILTER (WHERE success), 0) AS DOUBLE PRECISION) AS up_seconds,
+	CAST(AVG(response_time_ms) AS DOUBLE PRECISION) AS avg_response_time_ms,
+	percentile_cont(0.5) WITHIN GROUP (ORDER BY response_time_ms) AS p50_response_time_ms,
+	percentile_cont(0.95) WITHIN GROUP (ORDER BY response_time_ms) AS p95_response_time_ms,
+	percentile_cont(0.99) WITHIN GROUP (ORDER BY response_time_ms) AS p99_response_time_ms
+FROM windowed
+GROUP BY http_monitor_id`
+
+const errorBreakdownQuery = `
+SELECT
+	status_code,
+	COALESCE(error_message, '') AS error_message,
+	COUNT(*) AS count
+FROM http_monitor_checks
+WHERE http_monitor_id = @monitor_id
+	AND checked_at >= @from AND checked_at <= @to
+	AND NOT success
+GROUP BY status_code, error_message
+ORDER BY count DESC, status_code
+LIMIT @limit`
+
+// HTTPMonitorCheckStats holds the aggregated checks of a monitor over a time window.
+// The response time aggregates are null when no check recorded a response time.
+type HTTPMonitorCheckStats struct {
+	MonitorID         uint64          `gorm:"column:monitor_id"`
+	TotalChecks       int64           `gorm:"column:total_checks"`
+	SuccessfulChecks  int64           `gorm:"column:successful_checks"`
+	ObservedSeconds   float64         `gorm:"column:observed_seconds"`
+	UpSeconds         float64         `gorm:"column:up_seconds"`
+	AvgResponseTimeMs sql.NullFloat64 `gorm:"column:avg_response_time_ms"`
+	P50ResponseTimeMs sql.NullFloat64 `gorm:"column:p50_response_time_ms"`
+	P95ResponseTimeMs sql.NullFloat64 `gorm:"column:p95_response_time_ms"`
+	P99ResponseTimeMs sql.NullFloat64 `gorm:"column:p99_response_time_ms"`
+}
+
+// HTTPMonitorStatsSummary holds the check statistics of a monitor next to its details,
+// monitors without checks in the window have zero counts.
+type HTTPMonitorStatsSummary struct {
+	HTTPMonitorCheckStats
+	MonitorName string         `gorm:"column:monitor_name"`
+	IsEnabled   bool           `gorm:"column:is_enabled"`
+	LastStatus  sql.NullString `gorm:"column:last_status"`
+}
+
+type HTTPMonitorCheckErrorCount struct {
+	StatusCode   sql.NullInt32 `gorm:"column:status_code"`
+	ErrorMessage string        `gorm:"column:error_message"`
+	Count        int64         `gorm:"column:count"`
+}
+
+type HTTPMonitorStatsRepositoryI interface {
+	FindCheckStats(ctx context.Context, monitorID uint64, from, to time.Time) (HTTPMonitorCheckStats, error)
+	FindErrorBreakdown(
+		ctx context.Context,
+		monitorID uint64,
+		from, to time.Time,
+	) ([]HTTPMonitorCheckErrorCount, error)
+	FindStatsSummary(ctx context.Context, owner Owner, from, to time.Time) ([]HTTPMonitorStatsSummary, error)
+}
+
+type HTTPMonitorStatsRepository struct {
+	*database.PingoDB
+}
+
+var _ HTTPMonitorStatsRepositoryI = (*HTTPMonitorStatsRepository)(nil)
+
+func NewHTTPMonitorStatsRepository(db *database.PingoDB) *HTTPMonitorStatsRepository {
+	return &HTTPMonitorStatsRepository{db}
+}
+
+func (r *HTTPMonitorStatsRepository) FindCheckStats(
+	ctx context.Context,
+	monitorID uint64,
+	from, to time.Time,
+) (HTTPMonitorCheckStats, error) {
+	ctx, otelSpan := trace.Span(ctx, "HTTPMonitorStatsRepository.FindCheckStats")
+	defer otelSpan.End()
+
+	query := fmt.Sprintf(checkStatsQuery, "AND http_monitor_id = @monitor_id")
+
+	var stats []HTTPMonitorCheckStats
+	err := r.DB.WithContext(ctx).
+		Raw(query, map[string]any{"monitor_id": monitorID, "from": from, "to": to}).
+		Scan(&stats).Error
+	if err != nil {
+		return HTTPMonitorCheckStats{}, err
+	}
+
+	// a monitor without checks in the window has no group
+	if len(stats) == 0 {
+		return HTTPMonitorCheckStats{MonitorID: monitorID}, nil
+	}
+
+	return stats[0], nil
+}
+
+func (r *HTTPMonitorStatsRepository) FindErrorBreakdown(
+	ctx context.Context,
+	monitorID uint64,
+	from, to time.Time,
+) ([]HTTPMonitorCheckErrorCount, error) {
+	ctx, otelSpan := trace.Span(ctx, "HTTPMonitorStatsRepository.FindErrorBreakdown")
+	defer otelSpan.End()
+
+	var errorCounts []HTTPMonitorCheckErrorCount
+	err := r.DB.WithContext(ctx).
+		Raw(errorBreakdownQuery, map[string]any{
+			"monitor_id": monitorID,
+			"from":       from,
+			"to":         to,
+			"limit":      maxErrorBreakdownEntries,
+		}).
+		Scan(&errorCounts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return errorCounts, nil
+}
+
+// FindStatsSummary returns the statistics of every monitor of the owner.
+func (r *HTTPMonitorStatsRepository) FindStatsSummary(
+	ctx context.Context,
+	owner Owner,
+	from, to time.Time,
+) ([]HTTPMonitorStatsSummary, error) {
+	ctx, otelSpan := trace.Span(ctx, "HTTPMonitorStatsRepository.FindStatsSummary")
+	defer otelSpan.End()
+
+	ownerCondition, args := owner.namedCondition()
+	args["from"] = from
+	args["to"] = to
+
+	query := `
+WITH stats AS (` + fmt.Sprintf(
+		checkStatsQuery,
+		"AND http_monitor_id IN (SELECT id FROM http_monitors WHERE "+ownerCondition+")",
+	) + `
+)
+SELECT
+	m.id AS monitor_id,
+	m.name AS monitor_name,
+	m.is_enabled,
+	m.last_status,
+	COALESCE(s.total_checks, 0) AS total_checks,
+	COALESCE(s.successful_checks, 0) AS successful_checks,
+	COALESCE(s.observed_seconds, 0) AS observed_seconds,
+	COALESCE(s.up_seconds, 0) AS up_seconds,
+	s.avg_response_time_ms,
+	s.p50_response_time_ms,
+	s.p95_response_time_ms,
+	s.p99_response_time_ms
+FROM http_monitors m
+LEFT JOIN stats s ON s.monitor_id = m.id
+WHERE m.id IN (SELECT id FROM http_monitors WHERE ` + ownerCondition + `)
+ORDER BY m.id`
+
+	var summaries []HTTPMonitorStatsSummary
+	err := r.DB.WithContext(ctx).
+		Raw(query, args).
+		Scan(&summaries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return summaries, nil
+}
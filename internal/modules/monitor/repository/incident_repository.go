@@ -0,0 +1,233 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	"github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+	"gorm.io/gorm"
+)
+
+// incidentMetricsQuery computes the repair time of resolved incidents and the time
+// between an incident being resolved and the next incident of the same monitor.
+const incidentMetricsQuery = `
+SELECT
+	COUNT(*) AS total_incidents,
+	COUNT(*) FILTER (WHERE resolved_at IS NULL) AS open_incidents,
+	CAST(AVG(duration_seconds) AS DOUBLE PRECISION) AS mttr_seconds,
+	CAST(AVG(EXTRACT(EPOCH FROM (started_at - previous_resolved_at))) AS DOUBLE PRECISION) AS mtbf_seconds
+FROM (
+	SELECT
+		started_at,
+		resolved_at,
+		duration_seconds,
+		LAG(resolved_at) OVER (PARTITION BY http_monitor_id ORDER BY started_at) AS previous_resolved_at
+	FROM incidents
+	WHERE %s
+) AS filtered`
+
+// IncidentFilter narrows down incidents, zero and nil fields are ignored.
+// From and To bound the incident start time.
+type IncidentFilter struct {
+	MonitorID uint64
+	Open      *bool
+	From      *time.Time
+	To        *time.Time
+}
+
+type IncidentMetrics struct {
+	TotalIncidents int64           `gorm:"column:total_incidents"`
+	OpenIncidents  int64           `gorm:"column:open_incidents"`
+	MTTRSeconds    sql.NullFloat64 `gorm:"column:mttr_seconds"`
+	MTBFSeconds    sql.NullFloat64 `gorm:"column:mtbf_seconds"`
+}
+
+type IncidentRepositoryI interface {
+	FindByID(ctx context.Context, incidentID uint64) (model.IncidentModel, error)
+	FindOpenByMonitorID(ctx context.Context, monitorID uint64) (model.IncidentModel, error)
+	FindAll(
+		ctx context.Context,
+		owner Owner,
+		filter IncidentFilter,
+		page, pageSize int,
+	) ([]model.IncidentModel, int64, error)
+	FindMetrics(ctx context.Context, owner Owner, filter IncidentFilter) (IncidentMetrics, error)
+	Create(ctx context.Context, incident model.IncidentModel) (model.IncidentModel, error)
+	Resolve(ctx context.Context, incident model.IncidentModel) error
+}
+
+type IncidentRepository struct {
+	*database.PingoDB
+}
+
+var _ IncidentRepositoryI = (*IncidentRepository)(nil)
+
+func NewIncidentRepository(db *database.PingoDB) *IncidentRepository {
+	return &IncidentRepository{db}
+}
+
+func (r *IncidentRepository) FindByID(ctx context.Context, incidentID uint64) (model.IncidentModel, error) {
+	ctx, otelSpan := trace.Span(ctx, "IncidentRepository.FindByID")
+	defer otelSpan.End()
+
+	incident, err := gorm.G[model.IncidentModel](r.DB).
+		Where("id = ?", incidentID).
+		Limit(1).
+		First(ctx)
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.IncidentModel{}, errs.ErrRecordNotFound
+		}
+		return model.IncidentModel{}, err
+	}
+	return incident, nil
+}
+
+func (r *IncidentRepository) FindOpenByMonitorID(
+	ctx context.Context,
+	monitorID uint64,
+) (model.IncidentModel, error) {
+	ctx, otelSpan := trace.Span(ctx, "IncidentRepository.FindOpenByMonitorID")
+	defer otelSpan.End()
+
+	incident, err := gorm.G[model.IncidentModel](r.DB).
+		Where("http_monitor_id = ? AND resolved_at IS NULL", monitorID).
+		Limit(1).
+		First(ctx)
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.IncidentModel{}, errs.ErrRecordNotFound
+		}
+		return model.IncidentModel{}, err
+	}
+	return incident, nil
+}
+
+// FindAll returns the incidents of the monitors of the owner matching the filter.
+func (r *IncidentRepository) FindAll(
+	ctx context.Context,
+	owner Owner,
+	filter IncidentFilter,
+	page, pageSize int,
+) ([]model.IncidentModel, int64, error) {
+	ctx, otelSpan := trace.Span(ctx, "IncidentRepository.FindAll")
+	defer otelSpan.End()
+
+	// Calculate offset
+	offset := (page - 1) * pageSize
+
+	conditions, args := r.filterConditions(owner, filter)
+
+	// Get total count
+	total, err := gorm.G[model.IncidentModel](r.DB).Where(conditions, args...).Count(ctx, "*")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Get paginated results
+	incidents, err := gorm.G[model.IncidentModel](r.DB).
+		Where(conditions, args...).
+		Order("started_at DESC").
+		Order("id DESC").
+		Limit(pageSize).
+		Offset(offset).
+		Find(ctx)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return incidents, total, nil
+}
+
+func (r *IncidentRepository) FindMetrics(
+	ctx context.Context,
+	owner Owner,
+	filter IncidentFilter,
+) (IncidentMetrics, error) {
+	ctx, otelSpan := trace.Span(ctx, "IncidentRepository.FindMetrics")
+	defer otelSpan.End()
+
+	conditions, args := r.filterConditions(owner, filter)
+
+	var metrics IncidentMetrics
+	err := r.DB.WithContext(ctx).
+		Raw(fmt.Sprintf(incidentMetricsQuery, conditions), args...).
+		Scan(&metrics).Error
+	if err != nil {
+		return IncidentMetrics{}, err
+	}
+
+	return metrics, nil
+}
+
+func (r *IncidentRepository) Create(
+	ctx context.Context,
+	incident model.IncidentModel,
+) (model.IncidentModel, error) {
+	ctx, otelSpan := trace.Span(ctx, "IncidentRepository.Create")
+	defer otelSpan.End()
+
+	err := gorm.G[model.IncidentModel](r.Conn(ctx)).Create(ctx, &incident)
+	return incident, err
+}
+
+// Resolve stores the resolution fields of an open incident.
+func (r *IncidentRepository) Resolve(ctx context.Context, incident model.IncidentModel) error {
+	ctx, otelSpan := trace.Span(ctx, "IncidentRepository.Resolve")
+	defer otelSpan.End()
+
+	result := r.Conn(ctx).
+		Model(&model.IncidentModel{}).
+		Where("id = ? AND resolved_at IS NULL", incident.ID).
+		Updates(map[string]any{
+			"resolved_at":       incident.ResolvedAt,
+			"recovery_check_id": incident.RecoveryCheckID,
+			"duration_seconds":  incident.DurationSeconds,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errs.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// filterConditions turns the filter into a WHERE clause shared by the list and metrics queries.
+func (r *IncidentRepository) filterConditions(owner Owner, filter IncidentFilter) (string, []any) {
+	ownerCondition, args := owner.monitorsCondition()
+	conditions := []string{ownerCondition}
+
+	// Add optional filters
+	if filter.MonitorID != 0 {
+		conditions = append(conditions, "http_monitor_id = ?")
+		args = append(args, filter.MonitorID)
+	}
+	if filter.Open != nil && *filter.Open {
+		conditions = append(conditions, "resolved_at IS NULL")
+	}
+	if filter.Open != nil && !*filter.Open {
+		conditions = append(conditions, "resolved_at IS NOT NULL")
+	}
+	if filter.From != nil {
+		conditions = append(conditions, "started_at >= ?")
+		args = append(args, *filter.From)
+	}
+	if filter.To != nil {
+		conditions = append(conditions, "started_at <= ?")
+		args = append(args, *filter.To)
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
@@ -7,6 +7,8 @@ import (
 
 	model "github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
 	mock "github.com/stretchr/testify/mock"
+
+	repository "github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
 )
 
 // MockContactRepositoryI is an autogenerated mock type for the ContactRepositoryI type
@@ -79,17 +81,17 @@ func (_c *MockContactRepositoryI_Create_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
-// Delete provides a mock function with given fields: ctx, contactID
-func (_m *MockContactRepositoryI) Delete(ctx context.Context, contactID uint64) error {
-	ret := _m.Called(ctx, contactID)
+// Delete provides a mock function with given fields: ctx, owner, contactID
+func (_m *MockContactRepositoryI) Delete(ctx context.Context, owner repository.Owner, contactID uint64) error {
+	ret := _m.Called(ctx, owner, contactID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
-		r0 = rf(ctx, contactID)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, uint64) error); ok {
+		r0 = rf(ctx, owner, contactID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -104,14 +106,15 @@ type MockContactRepositoryI_Delete_Call struct {
 
 // Delete is a helper method to define mock.On call
 //   - ctx context.Context
+//   - owner repository.Owner
 //   - contactID uint64
-func (_e *MockContactRepositoryI_Expecter) Delete(ctx interface{}, contactID interface{}) *MockContactRepositoryI_Delete_Call {
-	return &MockContactRepositoryI_Delete_Call{Call: _e.mock.On("Delete", ctx, contactID)}
+func (_e *MockContactRepositoryI_Expecter) Delete(ctx interface{}, owner interface{}, contactID interface{}) *MockContactRepositoryI_Delete_Call {
+	return &MockContactRepositoryI_Delete_Call{Call: _e.mock.On("Delete", ctx, owner, contactID)}
 }
 
-func (_c *MockContactRepositoryI_Delete_Call) Run(run func(ctx context.Context, contactID uint64)) *MockContactRepositoryI_Delete_Call {
+func (_c *MockContactRepositoryI_Delete_Call) Run(run func(ctx context.Context, owner repository.Owner, contactID uint64)) *MockContactRepositoryI_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64))
+		run(args[0].(context.Context), args[1].(repository.Owner), args[2].(uint64))
 	})
 	return _c
 }
@@ -121,14 +124,14 @@ func (_c *MockContactRepositoryI_Delete_Call) Return(_a0 error) *MockContactRepo
 	return _c
 }
 
-func (_c *MockContactRepositoryI_Delete_Call) RunAndReturn(run func(context.Context, uint64) error) *MockContactRepositoryI_Delete_Call {
+func (_c *MockContactRepositoryI_Delete_Call) RunAndReturn(run func(context.Context, repository.Owner, uint64) error) *MockContactRepositoryI_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindAll provides a mock function with given fields: ctx
-func (_m *MockContactRepositoryI) FindAll(ctx context.Context) ([]model.ContactModel, error) {
-	ret := _m.Called(ctx)
+// FindAll provides a mock function with given fields: ctx, owner
+func (_m *MockContactRepositoryI) FindAll(ctx context.Context, owner repository.Owner) ([]model.ContactModel, error) {
+	ret := _m.Called(ctx, owner)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindAll")
@@ -136,19 +139,19 @@ func (_m *MockContactRepositoryI) FindAll(ctx context.Context) ([]model.ContactM
 
 	var r0 []model.ContactModel
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]model.ContactModel, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner) ([]model.ContactModel, error)); ok {
+		return rf(ctx, owner)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []model.ContactModel); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner) []model.ContactModel); ok {
+		r0 = rf(ctx, owner)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.ContactModel)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.Owner) error); ok {
+		r1 = rf(ctx, owner)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -163,13 +166,14 @@ type MockContactRepositoryI_FindAll_Call struct {
 
 // FindAll is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockContactRepositoryI_Expecter) FindAll(ctx interface{}) *MockContactRepositoryI_FindAll_Call {
-	return &MockContactRepositoryI_FindAll_Call{Call: _e.mock.On("FindAll", ctx)}
+//   - owner repository.Owner
+func (_e *MockContactRepositoryI_Expecter) FindAll(ctx interface{}, owner interface{}) *MockContactRepositoryI_FindAll_Call {
+	return &MockContactRepositoryI_FindAll_Call{Call: _e.mock.On("FindAll", ctx, owner)}
 }
 
-func (_c *MockContactRepositoryI_FindAll_Call) Run(run func(ctx context.Context)) *MockContactRepositoryI_FindAll_Call {
+func (_c *MockContactRepositoryI_FindAll_Call) Run(run func(ctx context.Context, owner repository.Owner)) *MockContactRepositoryI_FindAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(repository.Owner))
 	})
 	return _c
 }
@@ -179,14 +183,132 @@ func (_c *MockContactRepositoryI_FindAll_Call) Return(_a0 []model.ContactModel,
 	return _c
 }
 
-func (_c *MockContactRepositoryI_FindAll_Call) RunAndReturn(run func(context.Context) ([]model.ContactModel, error)) *MockContactRepositoryI_FindAll_Call {
+func (_c *MockContactRepositoryI_FindAll_Call) RunAndReturn(run func(context.Context, repository.Owner) ([]model.ContactModel, error)) *MockContactRepositoryI_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function with given fields: ctx, owner, contactID
+func (_m *MockContactRepositoryI) FindByID(ctx context.Context, owner repository.Owner, contactID uint64) (model.ContactModel, error) {
+	ret := _m.Called(ctx, owner, contactID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 model.ContactModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, uint64) (model.ContactModel, error)); ok {
+		return rf(ctx, owner, contactID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, uint64) model.ContactModel); ok {
+		r0 = rf(ctx, owner, contactID)
+	} else {
+		r0 = ret.Get(0).(model.ContactModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.Owner, uint64) error); ok {
+		r1 = rf(ctx, owner, contactID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockContactRepositoryI_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockContactRepositoryI_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - owner repository.Owner
+//   - contactID uint64
+func (_e *MockContactRepositoryI_Expecter) FindByID(ctx interface{}, owner interface{}, contactID interface{}) *MockContactRepositoryI_FindByID_Call {
+	return &MockContactRepositoryI_FindByID_Call{Call: _e.mock.On("FindByID", ctx, owner, contactID)}
+}
+
+func (_c *MockContactRepositoryI_FindByID_Call) Run(run func(ctx context.Context, owner repository.Owner, contactID uint64)) *MockContactRepositoryI_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.Owner), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockContactRepositoryI_FindByID_Call) Return(_a0 model.ContactModel, _a1 error) *MockContactRepositoryI_FindByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContactRepositoryI_FindByID_Call) RunAndReturn(run func(context.Context, repository.Owner, uint64) (model.ContactModel, error)) *MockContactRepositoryI_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByIDs provides a mock function with given fields: ctx, owner, contactIDs
+func (_m *MockContactRepositoryI) FindByIDs(ctx context.Context, owner repository.Owner, contactIDs []uint64) ([]model.ContactModel, error) {
+	ret := _m.Called(ctx, owner, contactIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByIDs")
+	}
+
+	var r0 []model.ContactModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, []uint64) ([]model.ContactModel, error)); ok {
+		return rf(ctx, owner, contactIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, []uint64) []model.ContactModel); ok {
+		r0 = rf(ctx, owner, contactIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ContactModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.Owner, []uint64) error); ok {
+		r1 = rf(ctx, owner, contactIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockContactRepositoryI_FindByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByIDs'
+type MockContactRepositoryI_FindByIDs_Call struct {
+	*mock.Call
+}
+
+// FindByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - owner repository.Owner
+//   - contactIDs []uint64
+func (_e *MockContactRepositoryI_Expecter) FindByIDs(ctx interface{}, owner interface{}, contactIDs interface{}) *MockContactRepositoryI_FindByIDs_Call {
+	return &MockContactRepositoryI_FindByIDs_Call{Call: _e.mock.On("FindByIDs", ctx, owner, contactIDs)}
+}
+
+func (_c *MockContactRepositoryI_FindByIDs_Call) Run(run func(ctx context.Context, owner repository.Owner, contactIDs []uint64)) *MockContactRepositoryI_FindByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.Owner), args[2].([]uint64))
+	})
+	return _c
+}
+
+func (_c *MockContactRepositoryI_FindByIDs_Call) Return(_a0 []model.ContactModel, _a1 error) *MockContactRepositoryI_FindByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContactRepositoryI_FindByIDs_Call) RunAndReturn(run func(context.Context, repository.Owner, []uint64) ([]model.ContactModel, error)) *MockContactRepositoryI_FindByIDs_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindByName provides a mock function with given fields: ctx, name
-func (_m *MockContactRepositoryI) FindByName(ctx context.Context, name string) (model.ContactModel, error) {
-	ret := _m.Called(ctx, name)
+// FindByName provides a mock function with given fields: ctx, owner, name
+func (_m *MockContactRepositoryI) FindByName(ctx context.Context, owner repository.Owner, name string) (model.ContactModel, error) {
+	ret := _m.Called(ctx, owner, name)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindByName")
@@ -194,17 +316,17 @@ func (_m *MockContactRepositoryI) FindByName(ctx context.Context, name string) (
 
 	var r0 model.ContactModel
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (model.ContactModel, error)); ok {
-		return rf(ctx, name)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, string) (model.ContactModel, error)); ok {
+		return rf(ctx, owner, name)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) model.ContactModel); ok {
-		r0 = rf(ctx, name)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, string) model.ContactModel); ok {
+		r0 = rf(ctx, owner, name)
 	} else {
 		r0 = ret.Get(0).(model.ContactModel)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, name)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.Owner, string) error); ok {
+		r1 = rf(ctx, owner, name)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -219,14 +341,15 @@ type MockContactRepositoryI_FindByName_Call struct {
 
 // FindByName is a helper method to define mock.On call
 //   - ctx context.Context
+//   - owner repository.Owner
 //   - name string
-func (_e *MockContactRepositoryI_Expecter) FindByName(ctx interface{}, name interface{}) *MockContactRepositoryI_FindByName_Call {
-	return &MockContactRepositoryI_FindByName_Call{Call: _e.mock.On("FindByName", ctx, name)}
+func (_e *MockContactRepositoryI_Expecter) FindByName(ctx interface{}, owner interface{}, name interface{}) *MockContactRepositoryI_FindByName_Call {
+	return &MockContactRepositoryI_FindByName_Call{Call: _e.mock.On("FindByName", ctx, owner, name)}
 }
 
-func (_c *MockContactRepositoryI_FindByName_Call) Run(run func(ctx context.Context, name string)) *MockContactRepositoryI_FindByName_Call {
+func (_c *MockContactRepositoryI_FindByName_Call) Run(run func(ctx context.Context, owner repository.Owner, name string)) *MockContactRepositoryI_FindByName_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(repository.Owner), args[2].(string))
 	})
 	return _c
 }
@@ -236,14 +359,14 @@ func (_c *MockContactRepositoryI_FindByName_Call) Return(_a0 model.ContactModel,
 	return _c
 }
 
-func (_c *MockContactRepositoryI_FindByName_Call) RunAndReturn(run func(context.Context, string) (model.ContactModel, error)) *MockContactRepositoryI_FindByName_Call {
+func (_c *MockContactRepositoryI_FindByName_Call) RunAndReturn(run func(context.Context, repository.Owner, string) (model.ContactModel, error)) *MockContactRepositoryI_FindByName_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function with given fields: ctx, contact
-func (_m *MockContactRepositoryI) Update(ctx context.Context, contact model.ContactModel) (model.ContactModel, error) {
-	ret := _m.Called(ctx, contact)
+// Update provides a mock function with given fields: ctx, owner, contact
+func (_m *MockContactRepositoryI) Update(ctx context.Context, owner repository.Owner, contact model.ContactModel) (model.ContactModel, error) {
+	ret := _m.Called(ctx, owner, contact)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
@@ -251,17 +374,17 @@ func (_m *MockContactRepositoryI) Update(ctx context.Context, contact model.Cont
 
 	var r0 model.ContactModel
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, model.ContactModel) (model.ContactModel, error)); ok {
-		return rf(ctx, contact)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, model.ContactModel) (model.ContactModel, error)); ok {
+		return rf(ctx, owner, contact)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, model.ContactModel) model.ContactModel); ok {
-		r0 = rf(ctx, contact)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, model.ContactModel) model.ContactModel); ok {
+		r0 = rf(ctx, owner, contact)
 	} else {
 		r0 = ret.Get(0).(model.ContactModel)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, model.ContactModel) error); ok {
-		r1 = rf(ctx, contact)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.Owner, model.ContactModel) error); ok {
+		r1 = rf(ctx, owner, contact)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -276,14 +399,15 @@ type MockContactRepositoryI_Update_Call struct {
 
 // Update is a helper method to define mock.On call
 //   - ctx context.Context
+//   - owner repository.Owner
 //   - contact model.ContactModel
-func (_e *MockContactRepositoryI_Expecter) Update(ctx interface{}, contact interface{}) *MockContactRepositoryI_Update_Call {
-	return &MockContactRepositoryI_Update_Call{Call: _e.mock.On("Update", ctx, contact)}
+func (_e *MockContactRepositoryI_Expecter) Update(ctx interface{}, owner interface{}, contact interface{}) *MockContactRepositoryI_Update_Call {
+	return &MockContactRepositoryI_Update_Call{Call: _e.mock.On("Update", ctx, owner, contact)}
 }
 
-func (_c *MockContactRepositoryI_Update_Call) Run(run func(ctx context.Context, contact model.ContactModel)) *MockContactRepositoryI_Update_Call {
+func (_c *MockContactRepositoryI_Update_Call) Run(run func(ctx context.Context, owner repository.Owner, contact model.ContactModel)) *MockContactRepositoryI_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(model.ContactModel))
+		run(args[0].(context.Context), args[1].(repository.Owner), args[2].(model.ContactModel))
 	})
 	return _c
 }
@@ -293,7 +417,56 @@ func (_c *MockContactRepositoryI_Update_Call) Return(_a0 model.ContactModel, _a1
 	return _c
 }
 
-func (_c *MockContactRepositoryI_Update_Call) RunAndReturn(run func(context.Context, model.ContactModel) (model.ContactModel, error)) *MockContactRepositoryI_Update_Call {
+func (_c *MockContactRepositoryI_Update_Call) RunAndReturn(run func(context.Context, repository.Owner, model.ContactModel) (model.ContactModel, error)) *MockContactRepositoryI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSigningSecret provides a mock function with given fields: ctx, owner, contactID, signingSecret
+func (_m *MockContactRepositoryI) UpdateSigningSecret(ctx context.Context, owner repository.Owner, contactID uint64, signingSecret string) error {
+	ret := _m.Called(ctx, owner, contactID, signingSecret)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSigningSecret")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, uint64, string) error); ok {
+		r0 = rf(ctx, owner, contactID, signingSecret)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockContactRepositoryI_UpdateSigningSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSigningSecret'
+type MockContactRepositoryI_UpdateSigningSecret_Call struct {
+	*mock.Call
+}
+
+// UpdateSigningSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - owner repository.Owner
+//   - contactID uint64
+//   - signingSecret string
+func (_e *MockContactRepositoryI_Expecter) UpdateSigningSecret(ctx interface{}, owner interface{}, contactID interface{}, signingSecret interface{}) *MockContactRepositoryI_UpdateSigningSecret_Call {
+	return &MockContactRepositoryI_UpdateSigningSecret_Call{Call: _e.mock.On("UpdateSigningSecret", ctx, owner, contactID, signingSecret)}
+}
+
+func (_c *MockContactRepositoryI_UpdateSigningSecret_Call) Run(run func(ctx context.Context, owner repository.Owner, contactID uint64, signingSecret string)) *MockContactRepositoryI_UpdateSigningSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.Owner), args[2].(uint64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockContactRepositoryI_UpdateSigningSecret_Call) Return(_a0 error) *MockContactRepositoryI_UpdateSigningSecret_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContactRepositoryI_UpdateSigningSecret_Call) RunAndReturn(run func(context.Context, repository.Owner, uint64, string) error) *MockContactRepositoryI_UpdateSigningSecret_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -8,7 +8,7 @@ import (
 	model "github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
 	mock "github.com/stretchr/testify/mock"
 
-	time "time"
+	repository "github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
 )
 
 // MockHTTPMonitorCheckRepositoryI is an autogenerated mock type for the HTTPMonitorCheckRepositoryI type
@@ -81,9 +81,9 @@ func (_c *MockHTTPMonitorCheckRepositoryI_Create_Call) RunAndReturn(run func(con
 	return _c
 }
 
-// FindAll provides a mock function with given fields: ctx, monitorID, from, to, page, pageSize
-func (_m *MockHTTPMonitorCheckRepositoryI) FindAll(ctx context.Context, monitorID uint64, from *time.Time, to *time.Time, page int, pageSize int) ([]model.HTTPMonitorCheckModel, int64, error) {
-	ret := _m.Called(ctx, monitorID, from, to, page, pageSize)
+// FindAll provides a mock function with given fields: ctx, monitorID, filter, page, pageSize
+func (_m *MockHTTPMonitorCheckRepositoryI) FindAll(ctx context.Context, monitorID uint64, filter repository.HTTPMonitorCheckFilter, page int, pageSize int) ([]model.HTTPMonitorCheckModel, int64, error) {
+	ret := _m.Called(ctx, monitorID, filter, page, pageSize)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindAll")
@@ -92,25 +92,25 @@ func (_m *MockHTTPMonitorCheckRepositoryI) FindAll(ctx context.Context, monitorI
 	var r0 []model.HTTPMonitorCheckModel
 	var r1 int64
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, *time.Time, *time.Time, int, int) ([]model.HTTPMonitorCheckModel, int64, error)); ok {
-		return rf(ctx, monitorID, from, to, page, pageSize)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, repository.HTTPMonitorCheckFilter, int, int) ([]model.HTTPMonitorCheckModel, int64, error)); ok {
+		return rf(ctx, monitorID, filter, page, pageSize)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, *time.Time, *time.Time, int, int) []model.HTTPMonitorCheckModel); ok {
-		r0 = rf(ctx, monitorID, from, to, page, pageSize)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, repository.HTTPMonitorCheckFilter, int, int) []model.HTTPMonitorCheckModel); ok {
+		r0 = rf(ctx, monitorID, filter, page, pageSize)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.HTTPMonitorCheckModel)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint64, *time.Time, *time.Time, int, int) int64); ok {
-		r1 = rf(ctx, monitorID, from, to, page, pageSize)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, repository.HTTPMonitorCheckFilter, int, int) int64); ok {
+		r1 = rf(ctx, monitorID, filter, page, pageSize)
 	} else {
 		r1 = ret.Get(1).(int64)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, uint64, *time.Time, *time.Time, int, int) error); ok {
-		r2 = rf(ctx, monitorID, from, to, page, pageSize)
+	if rf, ok := ret.Get(2).(func(context.Context, uint64, repository.HTTPMonitorCheckFilter, int, int) error); ok {
+		r2 = rf(ctx, monitorID, filter, page, pageSize)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -126,17 +126,16 @@ type MockHTTPMonitorCheckRepositoryI_FindAll_Call struct {
 // FindAll is a helper method to define mock.On call
 //   - ctx context.Context
 //   - monitorID uint64
-//   - from *time.Time
-//   - to *time.Time
+//   - filter repository.HTTPMonitorCheckFilter
 //   - page int
 //   - pageSize int
-func (_e *MockHTTPMonitorCheckRepositoryI_Expecter) FindAll(ctx interface{}, monitorID interface{}, from interface{}, to interface{}, page interface{}, pageSize interface{}) *MockHTTPMonitorCheckRepositoryI_FindAll_Call {
-	return &MockHTTPMonitorCheckRepositoryI_FindAll_Call{Call: _e.mock.On("FindAll", ctx, monitorID, from, to, page, pageSize)}
+func (_e *MockHTTPMonitorCheckRepositoryI_Expecter) FindAll(ctx interface{}, monitorID interface{}, filter interface{}, page interface{}, pageSize interface{}) *MockHTTPMonitorCheckRepositoryI_FindAll_Call {
+	return &MockHTTPMonitorCheckRepositoryI_FindAll_Call{Call: _e.mock.On("FindAll", ctx, monitorID, filter, page, pageSize)}
 }
 
-func (_c *MockHTTPMonitorCheckRepositoryI_FindAll_Call) Run(run func(ctx context.Context, monitorID uint64, from *time.Time, to *time.Time, page int, pageSize int)) *MockHTTPMonitorCheckRepositoryI_FindAll_Call {
+func (_c *MockHTTPMonitorCheckRepositoryI_FindAll_Call) Run(run func(ctx context.Context, monitorID uint64, filter repository.HTTPMonitorCheckFilter, page int, pageSize int)) *MockHTTPMonitorCheckRepositoryI_FindAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64), args[2].(*time.Time), args[3].(*time.Time), args[4].(int), args[5].(int))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(repository.HTTPMonitorCheckFilter), args[3].(int), args[4].(int))
 	})
 	return _c
 }
@@ -146,7 +145,7 @@ func (_c *MockHTTPMonitorCheckRepositoryI_FindAll_Call) Return(_a0 []model.HTTPM
 	return _c
 }
 
-func (_c *MockHTTPMonitorCheckRepositoryI_FindAll_Call) RunAndReturn(run func(context.Context, uint64, *time.Time, *time.Time, int, int) ([]model.HTTPMonitorCheckModel, int64, error)) *MockHTTPMonitorCheckRepositoryI_FindAll_Call {
+func (_c *MockHTTPMonitorCheckRepositoryI_FindAll_Call) RunAndReturn(run func(context.Context, uint64, repository.HTTPMonitorCheckFilter, int, int) ([]model.HTTPMonitorCheckModel, int64, error)) *MockHTTPMonitorCheckRepositoryI_FindAll_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -208,6 +207,63 @@ func (_c *MockHTTPMonitorCheckRepositoryI_FindByID_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// FindFirstFailedSinceLastSuccess provides a mock function with given fields: ctx, monitorID
+func (_m *MockHTTPMonitorCheckRepositoryI) FindFirstFailedSinceLastSuccess(ctx context.Context, monitorID uint64) (model.HTTPMonitorCheckModel, error) {
+	ret := _m.Called(ctx, monitorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFirstFailedSinceLastSuccess")
+	}
+
+	var r0 model.HTTPMonitorCheckModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (model.HTTPMonitorCheckModel, error)); ok {
+		return rf(ctx, monitorID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) model.HTTPMonitorCheckModel); ok {
+		r0 = rf(ctx, monitorID)
+	} else {
+		r0 = ret.Get(0).(model.HTTPMonitorCheckModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, monitorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockHTTPMonitorCheckRepositoryI_FindFirstFailedSinceLastSuccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindFirstFailedSinceLastSuccess'
+type MockHTTPMonitorCheckRepositoryI_FindFirstFailedSinceLastSuccess_Call struct {
+	*mock.Call
+}
+
+// FindFirstFailedSinceLastSuccess is a helper method to define mock.On call
+//   - ctx context.Context
+//   - monitorID uint64
+func (_e *MockHTTPMonitorCheckRepositoryI_Expecter) FindFirstFailedSinceLastSuccess(ctx interface{}, monitorID interface{}) *MockHTTPMonitorCheckRepositoryI_FindFirstFailedSinceLastSuccess_Call {
+	return &MockHTTPMonitorCheckRepositoryI_FindFirstFailedSinceLastSuccess_Call{Call: _e.mock.On("FindFirstFailedSinceLastSuccess", ctx, monitorID)}
+}
+
+func (_c *MockHTTPMonitorCheckRepositoryI_FindFirstFailedSinceLastSuccess_Call) Run(run func(ctx context.Context, monitorID uint64)) *MockHTTPMonitorCheckRepositoryI_FindFirstFailedSinceLastSuccess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockHTTPMonitorCheckRepositoryI_FindFirstFailedSinceLastSuccess_Call) Return(_a0 model.HTTPMonitorCheckModel, _a1 error) *MockHTTPMonitorCheckRepositoryI_FindFirstFailedSinceLastSuccess_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockHTTPMonitorCheckRepositoryI_FindFirstFailedSinceLastSuccess_Call) RunAndReturn(run func(context.Context, uint64) (model.HTTPMonitorCheckModel, error)) *MockHTTPMonitorCheckRepositoryI_FindFirstFailedSinceLastSuccess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockHTTPMonitorCheckRepositoryI creates a new instance of MockHTTPMonitorCheckRepositoryI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockHTTPMonitorCheckRepositoryI(t interface {
@@ -7,6 +7,10 @@ import (
 
 	model "github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
 	mock "github.com/stretchr/testify/mock"
+
+	repository "github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+
+	time "time"
 )
 
 // MockHTTPMonitorRepositoryI is an autogenerated mock type for the HTTPMonitorRepositoryI type
@@ -127,17 +131,17 @@ func (_c *MockHTTPMonitorRepositoryI_Create_Call) RunAndReturn(run func(context.
 	return _c
 }
 
-// Delete provides a mock function with given fields: ctx, monitorID
-func (_m *MockHTTPMonitorRepositoryI) Delete(ctx context.Context, monitorID uint64) error {
-	ret := _m.Called(ctx, monitorID)
+// Delete provides a mock function with given fields: ctx, owner, monitorID
+func (_m *MockHTTPMonitorRepositoryI) Delete(ctx context.Context, owner repository.Owner, monitorID uint64) error {
+	ret := _m.Called(ctx, owner, monitorID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
-		r0 = rf(ctx, monitorID)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, uint64) error); ok {
+		r0 = rf(ctx, owner, monitorID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -152,14 +156,15 @@ type MockHTTPMonitorRepositoryI_Delete_Call struct {
 
 // Delete is a helper method to define mock.On call
 //   - ctx context.Context
+//   - owner repository.Owner
 //   - monitorID uint64
-func (_e *MockHTTPMonitorRepositoryI_Expecter) Delete(ctx interface{}, monitorID interface{}) *MockHTTPMonitorRepositoryI_Delete_Call {
-	return &MockHTTPMonitorRepositoryI_Delete_Call{Call: _e.mock.On("Delete", ctx, monitorID)}
+func (_e *MockHTTPMonitorRepositoryI_Expecter) Delete(ctx interface{}, owner interface{}, monitorID interface{}) *MockHTTPMonitorRepositoryI_Delete_Call {
+	return &MockHTTPMonitorRepositoryI_Delete_Call{Call: _e.mock.On("Delete", ctx, owner, monitorID)}
 }
 
-func (_c *MockHTTPMonitorRepositoryI_Delete_Call) Run(run func(ctx context.Context, monitorID uint64)) *MockHTTPMonitorRepositoryI_Delete_Call {
+func (_c *MockHTTPMonitorRepositoryI_Delete_Call) Run(run func(ctx context.Context, owner repository.Owner, monitorID uint64)) *MockHTTPMonitorRepositoryI_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64))
+		run(args[0].(context.Context), args[1].(repository.Owner), args[2].(uint64))
 	})
 	return _c
 }
@@ -169,14 +174,14 @@ func (_c *MockHTTPMonitorRepositoryI_Delete_Call) Return(_a0 error) *MockHTTPMon
 	return _c
 }
 
-func (_c *MockHTTPMonitorRepositoryI_Delete_Call) RunAndReturn(run func(context.Context, uint64) error) *MockHTTPMonitorRepositoryI_Delete_Call {
+func (_c *MockHTTPMonitorRepositoryI_Delete_Call) RunAndReturn(run func(context.Context, repository.Owner, uint64) error) *MockHTTPMonitorRepositoryI_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindAll provides a mock function with given fields: ctx, page, pageSize
-func (_m *MockHTTPMonitorRepositoryI) FindAll(ctx context.Context, page int, pageSize int) ([]model.HTTPMonitorModel, int64, error) {
-	ret := _m.Called(ctx, page, pageSize)
+// FindAll provides a mock function with given fields: ctx, owner, page, pageSize
+func (_m *MockHTTPMonitorRepositoryI) FindAll(ctx context.Context, owner repository.Owner, page int, pageSize int) ([]model.HTTPMonitorModel, int64, error) {
+	ret := _m.Called(ctx, owner, page, pageSize)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindAll")
@@ -185,25 +190,25 @@ func (_m *MockHTTPMonitorRepositoryI) FindAll(ctx context.Context, page int, pag
 	var r0 []model.HTTPMonitorModel
 	var r1 int64
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]model.HTTPMonitorModel, int64, error)); ok {
-		return rf(ctx, page, pageSize)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, int, int) ([]model.HTTPMonitorModel, int64, error)); ok {
+		return rf(ctx, owner, page, pageSize)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int, int) []model.HTTPMonitorModel); ok {
-		r0 = rf(ctx, page, pageSize)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, int, int) []model.HTTPMonitorModel); ok {
+		r0 = rf(ctx, owner, page, pageSize)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.HTTPMonitorModel)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int, int) int64); ok {
-		r1 = rf(ctx, page, pageSize)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.Owner, int, int) int64); ok {
+		r1 = rf(ctx, owner, page, pageSize)
 	} else {
 		r1 = ret.Get(1).(int64)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
-		r2 = rf(ctx, page, pageSize)
+	if rf, ok := ret.Get(2).(func(context.Context, repository.Owner, int, int) error); ok {
+		r2 = rf(ctx, owner, page, pageSize)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -218,15 +223,16 @@ type MockHTTPMonitorRepositoryI_FindAll_Call struct {
 
 // FindAll is a helper method to define mock.On call
 //   - ctx context.Context
+//   - owner repository.Owner
 //   - page int
 //   - pageSize int
-func (_e *MockHTTPMonitorRepositoryI_Expecter) FindAll(ctx interface{}, page interface{}, pageSize interface{}) *MockHTTPMonitorRepositoryI_FindAll_Call {
-	return &MockHTTPMonitorRepositoryI_FindAll_Call{Call: _e.mock.On("FindAll", ctx, page, pageSize)}
+func (_e *MockHTTPMonitorRepositoryI_Expecter) FindAll(ctx interface{}, owner interface{}, page interface{}, pageSize interface{}) *MockHTTPMonitorRepositoryI_FindAll_Call {
+	return &MockHTTPMonitorRepositoryI_FindAll_Call{Call: _e.mock.On("FindAll", ctx, owner, page, pageSize)}
 }
 
-func (_c *MockHTTPMonitorRepositoryI_FindAll_Call) Run(run func(ctx context.Context, page int, pageSize int)) *MockHTTPMonitorRepositoryI_FindAll_Call {
+func (_c *MockHTTPMonitorRepositoryI_FindAll_Call) Run(run func(ctx context.Context, owner repository.Owner, page int, pageSize int)) *MockHTTPMonitorRepositoryI_FindAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int), args[2].(int))
+		run(args[0].(context.Context), args[1].(repository.Owner), args[2].(int), args[3].(int))
 	})
 	return _c
 }
@@ -236,19 +242,135 @@ func (_c *MockHTTPMonitorRepositoryI_FindAll_Call) Return(_a0 []model.HTTPMonito
 	return _c
 }
 
-func (_c *MockHTTPMonitorRepositoryI_FindAll_Call) RunAndReturn(run func(context.Context, int, int) ([]model.HTTPMonitorModel, int64, error)) *MockHTTPMonitorRepositoryI_FindAll_Call {
+func (_c *MockHTTPMonitorRepositoryI_FindAll_Call) RunAndReturn(run func(context.Context, repository.Owner, int, int) ([]model.HTTPMonitorModel, int64, error)) *MockHTTPMonitorRepositoryI_FindAll_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindByID provides a mock function with given fields: ctx, monitorID
-func (_m *MockHTTPMonitorRepositoryI) FindByID(ctx context.Context, monitorID uint64) (model.HTTPMonitorModel, error) {
-	ret := _m.Called(ctx, monitorID)
+// FindAllEnabled provides a mock function with given fields: ctx
+func (_m *MockHTTPMonitorRepositoryI) FindAllEnabled(ctx context.Context) ([]model.HTTPMonitorModel, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllEnabled")
+	}
+
+	var r0 []model.HTTPMonitorModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]model.HTTPMonitorModel, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []model.HTTPMonitorModel); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.HTTPMonitorModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockHTTPMonitorRepositoryI_FindAllEnabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAllEnabled'
+type MockHTTPMonitorRepositoryI_FindAllEnabled_Call struct {
+	*mock.Call
+}
+
+// FindAllEnabled is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockHTTPMonitorRepositoryI_Expecter) FindAllEnabled(ctx interface{}) *MockHTTPMonitorRepositoryI_FindAllEnabled_Call {
+	return &MockHTTPMonitorRepositoryI_FindAllEnabled_Call{Call: _e.mock.On("FindAllEnabled", ctx)}
+}
+
+func (_c *MockHTTPMonitorRepositoryI_FindAllEnabled_Call) Run(run func(ctx context.Context)) *MockHTTPMonitorRepositoryI_FindAllEnabled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockHTTPMonitorRepositoryI_FindAllEnabled_Call) Return(_a0 []model.HTTPMonitorModel, _a1 error) *MockHTTPMonitorRepositoryI_FindAllEnabled_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockHTTPMonitorRepositoryI_FindAllEnabled_Call) RunAndReturn(run func(context.Context) ([]model.HTTPMonitorModel, error)) *MockHTTPMonitorRepositoryI_FindAllEnabled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function with given fields: ctx, owner, monitorID
+func (_m *MockHTTPMonitorRepositoryI) FindByID(ctx context.Context, owner repository.Owner, monitorID uint64) (model.HTTPMonitorModel, error) {
+	ret := _m.Called(ctx, owner, monitorID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindByID")
 	}
 
+	var r0 model.HTTPMonitorModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, uint64) (model.HTTPMonitorModel, error)); ok {
+		return rf(ctx, owner, monitorID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, uint64) model.HTTPMonitorModel); ok {
+		r0 = rf(ctx, owner, monitorID)
+	} else {
+		r0 = ret.Get(0).(model.HTTPMonitorModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.Owner, uint64) error); ok {
+		r1 = rf(ctx, owner, monitorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockHTTPMonitorRepositoryI_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockHTTPMonitorRepositoryI_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - owner repository.Owner
+//   - monitorID uint64
+func (_e *MockHTTPMonitorRepositoryI_Expecter) FindByID(ctx interface{}, owner interface{}, monitorID interface{}) *MockHTTPMonitorRepositoryI_FindByID_Call {
+	return &MockHTTPMonitorRepositoryI_FindByID_Call{Call: _e.mock.On("FindByID", ctx, owner, monitorID)}
+}
+
+func (_c *MockHTTPMonitorRepositoryI_FindByID_Call) Run(run func(ctx context.Context, owner repository.Owner, monitorID uint64)) *MockHTTPMonitorRepositoryI_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.Owner), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockHTTPMonitorRepositoryI_FindByID_Call) Return(_a0 model.HTTPMonitorModel, _a1 error) *MockHTTPMonitorRepositoryI_FindByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockHTTPMonitorRepositoryI_FindByID_Call) RunAndReturn(run func(context.Context, repository.Owner, uint64) (model.HTTPMonitorModel, error)) *MockHTTPMonitorRepositoryI_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByIDUnscoped provides a mock function with given fields: ctx, monitorID
+func (_m *MockHTTPMonitorRepositoryI) FindByIDUnscoped(ctx context.Context, monitorID uint64) (model.HTTPMonitorModel, error) {
+	ret := _m.Called(ctx, monitorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByIDUnscoped")
+	}
+
 	var r0 model.HTTPMonitorModel
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, uint64) (model.HTTPMonitorModel, error)); ok {
@@ -269,38 +391,146 @@ func (_m *MockHTTPMonitorRepositoryI) FindByID(ctx context.Context, monitorID ui
 	return r0, r1
 }
 
-// MockHTTPMonitorRepositoryI_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
-type MockHTTPMonitorRepositoryI_FindByID_Call struct {
+// MockHTTPMonitorRepositoryI_FindByIDUnscoped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByIDUnscoped'
+type MockHTTPMonitorRepositoryI_FindByIDUnscoped_Call struct {
 	*mock.Call
 }
 
-// FindByID is a helper method to define mock.On call
+// FindByIDUnscoped is a helper method to define mock.On call
 //   - ctx context.Context
 //   - monitorID uint64
-func (_e *MockHTTPMonitorRepositoryI_Expecter) FindByID(ctx interface{}, monitorID interface{}) *MockHTTPMonitorRepositoryI_FindByID_Call {
-	return &MockHTTPMonitorRepositoryI_FindByID_Call{Call: _e.mock.On("FindByID", ctx, monitorID)}
+func (_e *MockHTTPMonitorRepositoryI_Expecter) FindByIDUnscoped(ctx interface{}, monitorID interface{}) *MockHTTPMonitorRepositoryI_FindByIDUnscoped_Call {
+	return &MockHTTPMonitorRepositoryI_FindByIDUnscoped_Call{Call: _e.mock.On("FindByIDUnscoped", ctx, monitorID)}
 }
 
-func (_c *MockHTTPMonitorRepositoryI_FindByID_Call) Run(run func(ctx context.Context, monitorID uint64)) *MockHTTPMonitorRepositoryI_FindByID_Call {
+func (_c *MockHTTPMonitorRepositoryI_FindByIDUnscoped_Call) Run(run func(ctx context.Context, monitorID uint64)) *MockHTTPMonitorRepositoryI_FindByIDUnscoped_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uint64))
 	})
 	return _c
 }
 
-func (_c *MockHTTPMonitorRepositoryI_FindByID_Call) Return(_a0 model.HTTPMonitorModel, _a1 error) *MockHTTPMonitorRepositoryI_FindByID_Call {
+func (_c *MockHTTPMonitorRepositoryI_FindByIDUnscoped_Call) Return(_a0 model.HTTPMonitorModel, _a1 error) *MockHTTPMonitorRepositoryI_FindByIDUnscoped_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockHTTPMonitorRepositoryI_FindByID_Call) RunAndReturn(run func(context.Context, uint64) (model.HTTPMonitorModel, error)) *MockHTTPMonitorRepositoryI_FindByID_Call {
+func (_c *MockHTTPMonitorRepositoryI_FindByIDUnscoped_Call) RunAndReturn(run func(context.Context, uint64) (model.HTTPMonitorModel, error)) *MockHTTPMonitorRepositoryI_FindByIDUnscoped_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function with given fields: ctx, monitor
-func (_m *MockHTTPMonitorRepositoryI) Update(ctx context.Context, monitor model.HTTPMonitorModel) (model.HTTPMonitorModel, error) {
-	ret := _m.Called(ctx, monitor)
+// FindContactIDs provides a mock function with given fields: ctx, monitorIDs
+func (_m *MockHTTPMonitorRepositoryI) FindContactIDs(ctx context.Context, monitorIDs []uint64) (map[uint64][]uint64, error) {
+	ret := _m.Called(ctx, monitorIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindContactIDs")
+	}
+
+	var r0 map[uint64][]uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64) (map[uint64][]uint64, error)); ok {
+		return rf(ctx, monitorIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64) map[uint64][]uint64); ok {
+		r0 = rf(ctx, monitorIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint64][]uint64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uint64) error); ok {
+		r1 = rf(ctx, monitorIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockHTTPMonitorRepositoryI_FindContactIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindContactIDs'
+type MockHTTPMonitorRepositoryI_FindContactIDs_Call struct {
+	*mock.Call
+}
+
+// FindContactIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - monitorIDs []uint64
+func (_e *MockHTTPMonitorRepositoryI_Expecter) FindContactIDs(ctx interface{}, monitorIDs interface{}) *MockHTTPMonitorRepositoryI_FindContactIDs_Call {
+	return &MockHTTPMonitorRepositoryI_FindContactIDs_Call{Call: _e.mock.On("FindContactIDs", ctx, monitorIDs)}
+}
+
+func (_c *MockHTTPMonitorRepositoryI_FindContactIDs_Call) Run(run func(ctx context.Context, monitorIDs []uint64)) *MockHTTPMonitorRepositoryI_FindContactIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uint64))
+	})
+	return _c
+}
+
+func (_c *MockHTTPMonitorRepositoryI_FindContactIDs_Call) Return(_a0 map[uint64][]uint64, _a1 error) *MockHTTPMonitorRepositoryI_FindContactIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockHTTPMonitorRepositoryI_FindContactIDs_Call) RunAndReturn(run func(context.Context, []uint64) (map[uint64][]uint64, error)) *MockHTTPMonitorRepositoryI_FindContactIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetEnabled provides a mock function with given fields: ctx, owner, monitorID, isEnabled
+func (_m *MockHTTPMonitorRepositoryI) SetEnabled(ctx context.Context, owner repository.Owner, monitorID uint64, isEnabled bool) error {
+	ret := _m.Called(ctx, owner, monitorID, isEnabled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetEnabled")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, uint64, bool) error); ok {
+		r0 = rf(ctx, owner, monitorID, isEnabled)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockHTTPMonitorRepositoryI_SetEnabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEnabled'
+type MockHTTPMonitorRepositoryI_SetEnabled_Call struct {
+	*mock.Call
+}
+
+// SetEnabled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - owner repository.Owner
+//   - monitorID uint64
+//   - isEnabled bool
+func (_e *MockHTTPMonitorRepositoryI_Expecter) SetEnabled(ctx interface{}, owner interface{}, monitorID interface{}, isEnabled interface{}) *MockHTTPMonitorRepositoryI_SetEnabled_Call {
+	return &MockHTTPMonitorRepositoryI_SetEnabled_Call{Call: _e.mock.On("SetEnabled", ctx, owner, monitorID, isEnabled)}
+}
+
+func (_c *MockHTTPMonitorRepositoryI_SetEnabled_Call) Run(run func(ctx context.Context, owner repository.Owner, monitorID uint64, isEnabled bool)) *MockHTTPMonitorRepositoryI_SetEnabled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.Owner), args[2].(uint64), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *MockHTTPMonitorRepositoryI_SetEnabled_Call) Return(_a0 error) *MockHTTPMonitorRepositoryI_SetEnabled_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockHTTPMonitorRepositoryI_SetEnabled_Call) RunAndReturn(run func(context.Context, repository.Owner, uint64, bool) error) *MockHTTPMonitorRepositoryI_SetEnabled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, owner, monitor
+func (_m *MockHTTPMonitorRepositoryI) Update(ctx context.Context, owner repository.Owner, monitor model.HTTPMonitorModel) (model.HTTPMonitorModel, error) {
+	ret := _m.Called(ctx, owner, monitor)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
@@ -308,17 +538,17 @@ func (_m *MockHTTPMonitorRepositoryI) Update(ctx context.Context, monitor model.
 
 	var r0 model.HTTPMonitorModel
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, model.HTTPMonitorModel) (model.HTTPMonitorModel, error)); ok {
-		return rf(ctx, monitor)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, model.HTTPMonitorModel) (model.HTTPMonitorModel, error)); ok {
+		return rf(ctx, owner, monitor)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, model.HTTPMonitorModel) model.HTTPMonitorModel); ok {
-		r0 = rf(ctx, monitor)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, model.HTTPMonitorModel) model.HTTPMonitorModel); ok {
+		r0 = rf(ctx, owner, monitor)
 	} else {
 		r0 = ret.Get(0).(model.HTTPMonitorModel)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, model.HTTPMonitorModel) error); ok {
-		r1 = rf(ctx, monitor)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.Owner, model.HTTPMonitorModel) error); ok {
+		r1 = rf(ctx, owner, monitor)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -333,14 +563,15 @@ type MockHTTPMonitorRepositoryI_Update_Call struct {
 
 // Update is a helper method to define mock.On call
 //   - ctx context.Context
+//   - owner repository.Owner
 //   - monitor model.HTTPMonitorModel
-func (_e *MockHTTPMonitorRepositoryI_Expecter) Update(ctx interface{}, monitor interface{}) *MockHTTPMonitorRepositoryI_Update_Call {
-	return &MockHTTPMonitorRepositoryI_Update_Call{Call: _e.mock.On("Update", ctx, monitor)}
+func (_e *MockHTTPMonitorRepositoryI_Expecter) Update(ctx interface{}, owner interface{}, monitor interface{}) *MockHTTPMonitorRepositoryI_Update_Call {
+	return &MockHTTPMonitorRepositoryI_Update_Call{Call: _e.mock.On("Update", ctx, owner, monitor)}
 }
 
-func (_c *MockHTTPMonitorRepositoryI_Update_Call) Run(run func(ctx context.Context, monitor model.HTTPMonitorModel)) *MockHTTPMonitorRepositoryI_Update_Call {
+func (_c *MockHTTPMonitorRepositoryI_Update_Call) Run(run func(ctx context.Context, owner repository.Owner, monitor model.HTTPMonitorModel)) *MockHTTPMonitorRepositoryI_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(model.HTTPMonitorModel))
+		run(args[0].(context.Context), args[1].(repository.Owner), args[2].(model.HTTPMonitorModel))
 	})
 	return _c
 }
@@ -350,7 +581,57 @@ func (_c *MockHTTPMonitorRepositoryI_Update_Call) Return(_a0 model.HTTPMonitorMo
 	return _c
 }
 
-func (_c *MockHTTPMonitorRepositoryI_Update_Call) RunAndReturn(run func(context.Context, model.HTTPMonitorModel) (model.HTTPMonitorModel, error)) *MockHTTPMonitorRepositoryI_Update_Call {
+func (_c *MockHTTPMonitorRepositoryI_Update_Call) RunAndReturn(run func(context.Context, repository.Owner, model.HTTPMonitorModel) (model.HTTPMonitorModel, error)) *MockHTTPMonitorRepositoryI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCheckResult provides a mock function with given fields: ctx, monitorID, checkedAt, status, consecutiveFailures
+func (_m *MockHTTPMonitorRepositoryI) UpdateCheckResult(ctx context.Context, monitorID uint64, checkedAt time.Time, status string, consecutiveFailures int) error {
+	ret := _m.Called(ctx, monitorID, checkedAt, status, consecutiveFailures)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCheckResult")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time, string, int) error); ok {
+		r0 = rf(ctx, monitorID, checkedAt, status, consecutiveFailures)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockHTTPMonitorRepositoryI_UpdateCheckResult_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCheckResult'
+type MockHTTPMonitorRepositoryI_UpdateCheckResult_Call struct {
+	*mock.Call
+}
+
+// UpdateCheckResult is a helper method to define mock.On call
+//   - ctx context.Context
+//   - monitorID uint64
+//   - checkedAt time.Time
+//   - status string
+//   - consecutiveFailures int
+func (_e *MockHTTPMonitorRepositoryI_Expecter) UpdateCheckResult(ctx interface{}, monitorID interface{}, checkedAt interface{}, status interface{}, consecutiveFailures interface{}) *MockHTTPMonitorRepositoryI_UpdateCheckResult_Call {
+	return &MockHTTPMonitorRepositoryI_UpdateCheckResult_Call{Call: _e.mock.On("UpdateCheckResult", ctx, monitorID, checkedAt, status, consecutiveFailures)}
+}
+
+func (_c *MockHTTPMonitorRepositoryI_UpdateCheckResult_Call) Run(run func(ctx context.Context, monitorID uint64, checkedAt time.Time, status string, consecutiveFailures int)) *MockHTTPMonitorRepositoryI_UpdateCheckResult_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Time), args[3].(string), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *MockHTTPMonitorRepositoryI_UpdateCheckResult_Call) Return(_a0 error) *MockHTTPMonitorRepositoryI_UpdateCheckResult_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockHTTPMonitorRepositoryI_UpdateCheckResult_Call) RunAndReturn(run func(context.Context, uint64, time.Time, string, int) error) *MockHTTPMonitorRepositoryI_UpdateCheckResult_Call {
 	_c.Call.Return(run)
 	return _c
 }
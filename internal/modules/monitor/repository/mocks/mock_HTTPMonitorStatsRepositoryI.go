@@ -0,0 +1,219 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	time "time"
+
+	repository "github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockHTTPMonitorStatsRepositoryI is an autogenerated mock type for the HTTPMonitorStatsRepositoryI type
+type MockHTTPMonitorStatsRepositoryI struct {
+	mock.Mock
+}
+
+type MockHTTPMonitorStatsRepositoryI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockHTTPMonitorStatsRepositoryI) EXPECT() *MockHTTPMonitorStatsRepositoryI_Expecter {
+	return &MockHTTPMonitorStatsRepositoryI_Expecter{mock: &_m.Mock}
+}
+
+// FindCheckStats provides a mock function with given fields: ctx, monitorID, from, to
+func (_m *MockHTTPMonitorStatsRepositoryI) FindCheckStats(ctx context.Context, monitorID uint64, from time.Time, to time.Time) (repository.HTTPMonitorCheckStats, error) {
+	ret := _m.Called(ctx, monitorID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindCheckStats")
+	}
+
+	var r0 repository.HTTPMonitorCheckStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time, time.Time) (repository.HTTPMonitorCheckStats, error)); ok {
+		return rf(ctx, monitorID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time, time.Time) repository.HTTPMonitorCheckStats); ok {
+		r0 = rf(ctx, monitorID, from, to)
+	} else {
+		r0 = ret.Get(0).(repository.HTTPMonitorCheckStats)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, monitorID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockHTTPMonitorStatsRepositoryI_FindCheckStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindCheckStats'
+type MockHTTPMonitorStatsRepositoryI_FindCheckStats_Call struct {
+	*mock.Call
+}
+
+// FindCheckStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - monitorID uint64
+//   - from time.Time
+//   - to time.Time
+func (_e *MockHTTPMonitorStatsRepositoryI_Expecter) FindCheckStats(ctx interface{}, monitorID interface{}, from interface{}, to interface{}) *MockHTTPMonitorStatsRepositoryI_FindCheckStats_Call {
+	return &MockHTTPMonitorStatsRepositoryI_FindCheckStats_Call{Call: _e.mock.On("FindCheckStats", ctx, monitorID, from, to)}
+}
+
+func (_c *MockHTTPMonitorStatsRepositoryI_FindCheckStats_Call) Run(run func(ctx context.Context, monitorID uint64, from time.Time, to time.Time)) *MockHTTPMonitorStatsRepositoryI_FindCheckStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockHTTPMonitorStatsRepositoryI_FindCheckStats_Call) Return(_a0 repository.HTTPMonitorCheckStats, _a1 error) *MockHTTPMonitorStatsRepositoryI_FindCheckStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockHTTPMonitorStatsRepositoryI_FindCheckStats_Call) RunAndReturn(run func(context.Context, uint64, time.Time, time.Time) (repository.HTTPMonitorCheckStats, error)) *MockHTTPMonitorStatsRepositoryI_FindCheckStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindErrorBreakdown provides a mock function with given fields: ctx, monitorID, from, to
+func (_m *MockHTTPMonitorStatsRepositoryI) FindErrorBreakdown(ctx context.Context, monitorID uint64, from time.Time, to time.Time) ([]repository.HTTPMonitorCheckErrorCount, error) {
+	ret := _m.Called(ctx, monitorID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindErrorBreakdown")
+	}
+
+	var r0 []repository.HTTPMonitorCheckErrorCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time, time.Time) ([]repository.HTTPMonitorCheckErrorCount, error)); ok {
+		return rf(ctx, monitorID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time, time.Time) []repository.HTTPMonitorCheckErrorCount); ok {
+		r0 = rf(ctx, monitorID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.HTTPMonitorCheckErrorCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, monitorID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockHTTPMonitorStatsRepositoryI_FindErrorBreakdown_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindErrorBreakdown'
+type MockHTTPMonitorStatsRepositoryI_FindErrorBreakdown_Call struct {
+	*mock.Call
+}
+
+// FindErrorBreakdown is a helper method to define mock.On call
+//   - ctx context.Context
+//   - monitorID uint64
+//   - from time.Time
+//   - to time.Time
+func (_e *MockHTTPMonitorStatsRepositoryI_Expecter) FindErrorBreakdown(ctx interface{}, monitorID interface{}, from interface{}, to interface{}) *MockHTTPMonitorStatsRepositoryI_FindErrorBreakdown_Call {
+	return &MockHTTPMonitorStatsRepositoryI_FindErrorBreakdown_Call{Call: _e.mock.On("FindErrorBreakdown", ctx, monitorID, from, to)}
+}
+
+func (_c *MockHTTPMonitorStatsRepositoryI_FindErrorBreakdown_Call) Run(run func(ctx context.Context, monitorID uint64, from time.Time, to time.Time)) *MockHTTPMonitorStatsRepositoryI_FindErrorBreakdown_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockHTTPMonitorStatsRepositoryI_FindErrorBreakdown_Call) Return(_a0 []repository.HTTPMonitorCheckErrorCount, _a1 error) *MockHTTPMonitorStatsRepositoryI_FindErrorBreakdown_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockHTTPMonitorStatsRepositoryI_FindErrorBreakdown_Call) RunAndReturn(run func(context.Context, uint64, time.Time, time.Time) ([]repository.HTTPMonitorCheckErrorCount, error)) *MockHTTPMonitorStatsRepositoryI_FindErrorBreakdown_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindStatsSummary provides a mock function with given fields: ctx, owner, from, to
+func (_m *MockHTTPMonitorStatsRepositoryI) FindStatsSummary(ctx context.Context, owner repository.Owner, from time.Time, to time.Time) ([]repository.HTTPMonitorStatsSummary, error) {
+	ret := _m.Called(ctx, owner, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindStatsSummary")
+	}
+
+	var r0 []repository.HTTPMonitorStatsSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, time.Time, time.Time) ([]repository.HTTPMonitorStatsSummary, error)); ok {
+		return rf(ctx, owner, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, time.Time, time.Time) []repository.HTTPMonitorStatsSummary); ok {
+		r0 = rf(ctx, owner, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.HTTPMonitorStatsSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.Owner, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, owner, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockHTTPMonitorStatsRepositoryI_FindStatsSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindStatsSummary'
+type MockHTTPMonitorStatsRepositoryI_FindStatsSummary_Call struct {
+	*mock.Call
+}
+
+// FindStatsSummary is a helper method to define mock.On call
+//   - ctx context.Context
+//   - owner repository.Owner
+//   - from time.Time
+//   - to time.Time
+func (_e *MockHTTPMonitorStatsRepositoryI_Expecter) FindStatsSummary(ctx interface{}, owner interface{}, from interface{}, to interface{}) *MockHTTPMonitorStatsRepositoryI_FindStatsSummary_Call {
+	return &MockHTTPMonitorStatsRepositoryI_FindStatsSummary_Call{Call: _e.mock.On("FindStatsSummary", ctx, owner, from, to)}
+}
+
+func (_c *MockHTTPMonitorStatsRepositoryI_FindStatsSummary_Call) Run(run func(ctx context.Context, owner repository.Owner, from time.Time, to time.Time)) *MockHTTPMonitorStatsRepositoryI_FindStatsSummary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.Owner), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockHTTPMonitorStatsRepositoryI_FindStatsSummary_Call) Return(_a0 []repository.HTTPMonitorStatsSummary, _a1 error) *MockHTTPMonitorStatsRepositoryI_FindStatsSummary_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockHTTPMonitorStatsRepositoryI_FindStatsSummary_Call) RunAndReturn(run func(context.Context, repository.Owner, time.Time, time.Time) ([]repository.HTTPMonitorStatsSummary, error)) *MockHTTPMonitorStatsRepositoryI_FindStatsSummary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockHTTPMonitorStatsRepositoryI creates a new instance of MockHTTPMonitorStatsRepositoryI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockHTTPMonitorStatsRepositoryI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockHTTPMonitorStatsRepositoryI {
+	mock := &MockHTTPMonitorStatsRepositoryI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
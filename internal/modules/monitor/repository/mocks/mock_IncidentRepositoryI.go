@@ -0,0 +1,384 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	mock "github.com/stretchr/testify/mock"
+
+	repository "github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+)
+
+// MockIncidentRepositoryI is an autogenerated mock type for the IncidentRepositoryI type
+type MockIncidentRepositoryI struct {
+	mock.Mock
+}
+
+type MockIncidentRepositoryI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIncidentRepositoryI) EXPECT() *MockIncidentRepositoryI_Expecter {
+	return &MockIncidentRepositoryI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, incident
+func (_m *MockIncidentRepositoryI) Create(ctx context.Context, incident model.IncidentModel) (model.IncidentModel, error) {
+	ret := _m.Called(ctx, incident)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.IncidentModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.IncidentModel) (model.IncidentModel, error)); ok {
+		return rf(ctx, incident)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.IncidentModel) model.IncidentModel); ok {
+		r0 = rf(ctx, incident)
+	} else {
+		r0 = ret.Get(0).(model.IncidentModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.IncidentModel) error); ok {
+		r1 = rf(ctx, incident)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIncidentRepositoryI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIncidentRepositoryI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - incident model.IncidentModel
+func (_e *MockIncidentRepositoryI_Expecter) Create(ctx interface{}, incident interface{}) *MockIncidentRepositoryI_Create_Call {
+	return &MockIncidentRepositoryI_Create_Call{Call: _e.mock.On("Create", ctx, incident)}
+}
+
+func (_c *MockIncidentRepositoryI_Create_Call) Run(run func(ctx context.Context, incident model.IncidentModel)) *MockIncidentRepositoryI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.IncidentModel))
+	})
+	return _c
+}
+
+func (_c *MockIncidentRepositoryI_Create_Call) Return(_a0 model.IncidentModel, _a1 error) *MockIncidentRepositoryI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIncidentRepositoryI_Create_Call) RunAndReturn(run func(context.Context, model.IncidentModel) (model.IncidentModel, error)) *MockIncidentRepositoryI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function with given fields: ctx, owner, filter, page, pageSize
+func (_m *MockIncidentRepositoryI) FindAll(ctx context.Context, owner repository.Owner, filter repository.IncidentFilter, page int, pageSize int) ([]model.IncidentModel, int64, error) {
+	ret := _m.Called(ctx, owner, filter, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []model.IncidentModel
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, repository.IncidentFilter, int, int) ([]model.IncidentModel, int64, error)); ok {
+		return rf(ctx, owner, filter, page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, repository.IncidentFilter, int, int) []model.IncidentModel); ok {
+		r0 = rf(ctx, owner, filter, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.IncidentModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.Owner, repository.IncidentFilter, int, int) int64); ok {
+		r1 = rf(ctx, owner, filter, page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, repository.Owner, repository.IncidentFilter, int, int) error); ok {
+		r2 = rf(ctx, owner, filter, page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockIncidentRepositoryI_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockIncidentRepositoryI_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - owner repository.Owner
+//   - filter repository.IncidentFilter
+//   - page int
+//   - pageSize int
+func (_e *MockIncidentRepositoryI_Expecter) FindAll(ctx interface{}, owner interface{}, filter interface{}, page interface{}, pageSize interface{}) *MockIncidentRepositoryI_FindAll_Call {
+	return &MockIncidentRepositoryI_FindAll_Call{Call: _e.mock.On("FindAll", ctx, owner, filter, page, pageSize)}
+}
+
+func (_c *MockIncidentRepositoryI_FindAll_Call) Run(run func(ctx context.Context, owner repository.Owner, filter repository.IncidentFilter, page int, pageSize int)) *MockIncidentRepositoryI_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.Owner), args[2].(repository.IncidentFilter), args[3].(int), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *MockIncidentRepositoryI_FindAll_Call) Return(_a0 []model.IncidentModel, _a1 int64, _a2 error) *MockIncidentRepositoryI_FindAll_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockIncidentRepositoryI_FindAll_Call) RunAndReturn(run func(context.Context, repository.Owner, repository.IncidentFilter, int, int) ([]model.IncidentModel, int64, error)) *MockIncidentRepositoryI_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function with given fields: ctx, incidentID
+func (_m *MockIncidentRepositoryI) FindByID(ctx context.Context, incidentID uint64) (model.IncidentModel, error) {
+	ret := _m.Called(ctx, incidentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 model.IncidentModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (model.IncidentModel, error)); ok {
+		return rf(ctx, incidentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) model.IncidentModel); ok {
+		r0 = rf(ctx, incidentID)
+	} else {
+		r0 = ret.Get(0).(model.IncidentModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, incidentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIncidentRepositoryI_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockIncidentRepositoryI_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - incidentID uint64
+func (_e *MockIncidentRepositoryI_Expecter) FindByID(ctx interface{}, incidentID interface{}) *MockIncidentRepositoryI_FindByID_Call {
+	return &MockIncidentRepositoryI_FindByID_Call{Call: _e.mock.On("FindByID", ctx, incidentID)}
+}
+
+func (_c *MockIncidentRepositoryI_FindByID_Call) Run(run func(ctx context.Context, incidentID uint64)) *MockIncidentRepositoryI_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockIncidentRepositoryI_FindByID_Call) Return(_a0 model.IncidentModel, _a1 error) *MockIncidentRepositoryI_FindByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIncidentRepositoryI_FindByID_Call) RunAndReturn(run func(context.Context, uint64) (model.IncidentModel, error)) *MockIncidentRepositoryI_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindMetrics provides a mock function with given fields: ctx, owner, filter
+func (_m *MockIncidentRepositoryI) FindMetrics(ctx context.Context, owner repository.Owner, filter repository.IncidentFilter) (repository.IncidentMetrics, error) {
+	ret := _m.Called(ctx, owner, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMetrics")
+	}
+
+	var r0 repository.IncidentMetrics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, repository.IncidentFilter) (repository.IncidentMetrics, error)); ok {
+		return rf(ctx, owner, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, repository.IncidentFilter) repository.IncidentMetrics); ok {
+		r0 = rf(ctx, owner, filter)
+	} else {
+		r0 = ret.Get(0).(repository.IncidentMetrics)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.Owner, repository.IncidentFilter) error); ok {
+		r1 = rf(ctx, owner, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIncidentRepositoryI_FindMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMetrics'
+type MockIncidentRepositoryI_FindMetrics_Call struct {
+	*mock.Call
+}
+
+// FindMetrics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - owner repository.Owner
+//   - filter repository.IncidentFilter
+func (_e *MockIncidentRepositoryI_Expecter) FindMetrics(ctx interface{}, owner interface{}, filter interface{}) *MockIncidentRepositoryI_FindMetrics_Call {
+	return &MockIncidentRepositoryI_FindMetrics_Call{Call: _e.mock.On("FindMetrics", ctx, owner, filter)}
+}
+
+func (_c *MockIncidentRepositoryI_FindMetrics_Call) Run(run func(ctx context.Context, owner repository.Owner, filter repository.IncidentFilter)) *MockIncidentRepositoryI_FindMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.Owner), args[2].(repository.IncidentFilter))
+	})
+	return _c
+}
+
+func (_c *MockIncidentRepositoryI_FindMetrics_Call) Return(_a0 repository.IncidentMetrics, _a1 error) *MockIncidentRepositoryI_FindMetrics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIncidentRepositoryI_FindMetrics_Call) RunAndReturn(run func(context.Context, repository.Owner, repository.IncidentFilter) (repository.IncidentMetrics, error)) *MockIncidentRepositoryI_FindMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindOpenByMonitorID provides a mock function with given fields: ctx, monitorID
+func (_m *MockIncidentRepositoryI) FindOpenByMonitorID(ctx context.Context, monitorID uint64) (model.IncidentModel, error) {
+	ret := _m.Called(ctx, monitorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindOpenByMonitorID")
+	}
+
+	var r0 model.IncidentModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (model.IncidentModel, error)); ok {
+		return rf(ctx, monitorID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) model.IncidentModel); ok {
+		r0 = rf(ctx, monitorID)
+	} else {
+		r0 = ret.Get(0).(model.IncidentModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, monitorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIncidentRepositoryI_FindOpenByMonitorID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindOpenByMonitorID'
+type MockIncidentRepositoryI_FindOpenByMonitorID_Call struct {
+	*mock.Call
+}
+
+// FindOpenByMonitorID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - monitorID uint64
+func (_e *MockIncidentRepositoryI_Expecter) FindOpenByMonitorID(ctx interface{}, monitorID interface{}) *MockIncidentRepositoryI_FindOpenByMonitorID_Call {
+	return &MockIncidentRepositoryI_FindOpenByMonitorID_Call{Call: _e.mock.On("FindOpenByMonitorID", ctx, monitorID)}
+}
+
+func (_c *MockIncidentRepositoryI_FindOpenByMonitorID_Call) Run(run func(ctx context.Context, monitorID uint64)) *MockIncidentRepositoryI_FindOpenByMonitorID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockIncidentRepositoryI_FindOpenByMonitorID_Call) Return(_a0 model.IncidentModel, _a1 error) *MockIncidentRepositoryI_FindOpenByMonitorID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIncidentRepositoryI_FindOpenByMonitorID_Call) RunAndReturn(run func(context.Context, uint64) (model.IncidentModel, error)) *MockIncidentRepositoryI_FindOpenByMonitorID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Resolve provides a mock function with given fields: ctx, incident
+func (_m *MockIncidentRepositoryI) Resolve(ctx context.Context, incident model.IncidentModel) error {
+	ret := _m.Called(ctx, incident)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resolve")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.IncidentModel) error); ok {
+		r0 = rf(ctx, incident)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIncidentRepositoryI_Resolve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resolve'
+type MockIncidentRepositoryI_Resolve_Call struct {
+	*mock.Call
+}
+
+// Resolve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - incident model.IncidentModel
+func (_e *MockIncidentRepositoryI_Expecter) Resolve(ctx interface{}, incident interface{}) *MockIncidentRepositoryI_Resolve_Call {
+	return &MockIncidentRepositoryI_Resolve_Call{Call: _e.mock.On("Resolve", ctx, incident)}
+}
+
+func (_c *MockIncidentRepositoryI_Resolve_Call) Run(run func(ctx context.Context, incident model.IncidentModel)) *MockIncidentRepositoryI_Resolve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.IncidentModel))
+	})
+	return _c
+}
+
+func (_c *MockIncidentRepositoryI_Resolve_Call) Return(_a0 error) *MockIncidentRepositoryI_Resolve_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIncidentRepositoryI_Resolve_Call) RunAndReturn(run func(context.Context, model.IncidentModel) error) *MockIncidentRepositoryI_Resolve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIncidentRepositoryI creates a new instance of MockIncidentRepositoryI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIncidentRepositoryI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIncidentRepositoryI {
+	mock := &MockIncidentRepositoryI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
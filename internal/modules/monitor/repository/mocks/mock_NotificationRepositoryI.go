@@ -7,6 +7,10 @@ import (
 
 	model "github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
 	mock "github.com/stretchr/testify/mock"
+
+	repository "github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+
+	time "time"
 )
 
 // MockNotificationRepositoryI is an autogenerated mock type for the NotificationRepositoryI type
@@ -22,6 +26,125 @@ func (_m *MockNotificationRepositoryI) EXPECT() *MockNotificationRepositoryI_Exp
 	return &MockNotificationRepositoryI_Expecter{mock: &_m.Mock}
 }
 
+// ClaimDue provides a mock function with given fields: ctx, now, leaseUntil, limit
+func (_m *MockNotificationRepositoryI) ClaimDue(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]model.NotificationModel, error) {
+	ret := _m.Called(ctx, now, leaseUntil, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDue")
+	}
+
+	var r0 []model.NotificationModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) ([]model.NotificationModel, error)); ok {
+		return rf(ctx, now, leaseUntil, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) []model.NotificationModel); ok {
+		r0 = rf(ctx, now, leaseUntil, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.NotificationModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, int) error); ok {
+		r1 = rf(ctx, now, leaseUntil, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNotificationRepositoryI_ClaimDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDue'
+type MockNotificationRepositoryI_ClaimDue_Call struct {
+	*mock.Call
+}
+
+// ClaimDue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - leaseUntil time.Time
+//   - limit int
+func (_e *MockNotificationRepositoryI_Expecter) ClaimDue(ctx interface{}, now interface{}, leaseUntil interface{}, limit interface{}) *MockNotificationRepositoryI_ClaimDue_Call {
+	return &MockNotificationRepositoryI_ClaimDue_Call{Call: _e.mock.On("ClaimDue", ctx, now, leaseUntil, limit)}
+}
+
+func (_c *MockNotificationRepositoryI_ClaimDue_Call) Run(run func(ctx context.Context, now time.Time, leaseUntil time.Time, limit int)) *MockNotificationRepositoryI_ClaimDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepositoryI_ClaimDue_Call) Return(_a0 []model.NotificationModel, _a1 error) *MockNotificationRepositoryI_ClaimDue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNotificationRepositoryI_ClaimDue_Call) RunAndReturn(run func(context.Context, time.Time, time.Time, int) ([]model.NotificationModel, error)) *MockNotificationRepositoryI_ClaimDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClaimPermanentlyFailed provides a mock function with given fields: ctx, notificationID, leaseUntil
+func (_m *MockNotificationRepositoryI) ClaimPermanentlyFailed(ctx context.Context, notificationID uint64, leaseUntil time.Time) (bool, error) {
+	ret := _m.Called(ctx, notificationID, leaseUntil)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimPermanentlyFailed")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time) (bool, error)); ok {
+		return rf(ctx, notificationID, leaseUntil)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time) bool); ok {
+		r0 = rf(ctx, notificationID, leaseUntil)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, time.Time) error); ok {
+		r1 = rf(ctx, notificationID, leaseUntil)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNotificationRepositoryI_ClaimPermanentlyFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimPermanentlyFailed'
+type MockNotificationRepositoryI_ClaimPermanentlyFailed_Call struct {
+	*mock.Call
+}
+
+// ClaimPermanentlyFailed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notificationID uint64
+//   - leaseUntil time.Time
+func (_e *MockNotificationRepositoryI_Expecter) ClaimPermanentlyFailed(ctx interface{}, notificationID interface{}, leaseUntil interface{}) *MockNotificationRepositoryI_ClaimPermanentlyFailed_Call {
+	return &MockNotificationRepositoryI_ClaimPermanentlyFailed_Call{Call: _e.mock.On("ClaimPermanentlyFailed", ctx, notificationID, leaseUntil)}
+}
+
+func (_c *MockNotificationRepositoryI_ClaimPermanentlyFailed_Call) Run(run func(ctx context.Context, notificationID uint64, leaseUntil time.Time)) *MockNotificationRepositoryI_ClaimPermanentlyFailed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepositoryI_ClaimPermanentlyFailed_Call) Return(_a0 bool, _a1 error) *MockNotificationRepositoryI_ClaimPermanentlyFailed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNotificationRepositoryI_ClaimPermanentlyFailed_Call) RunAndReturn(run func(context.Context, uint64, time.Time) (bool, error)) *MockNotificationRepositoryI_ClaimPermanentlyFailed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx, notification
 func (_m *MockNotificationRepositoryI) Create(ctx context.Context, notification model.NotificationModel) (model.NotificationModel, error) {
 	ret := _m.Called(ctx, notification)
@@ -79,9 +202,9 @@ func (_c *MockNotificationRepositoryI_Create_Call) RunAndReturn(run func(context
 	return _c
 }
 
-// FindByID provides a mock function with given fields: ctx, notificationID
-func (_m *MockNotificationRepositoryI) FindByID(ctx context.Context, notificationID uint64) (model.NotificationModel, error) {
-	ret := _m.Called(ctx, notificationID)
+// FindByID provides a mock function with given fields: ctx, owner, notificationID
+func (_m *MockNotificationRepositoryI) FindByID(ctx context.Context, owner repository.Owner, notificationID uint64) (model.NotificationModel, error) {
+	ret := _m.Called(ctx, owner, notificationID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindByID")
@@ -89,17 +212,17 @@ func (_m *MockNotificationRepositoryI) FindByID(ctx context.Context, notificatio
 
 	var r0 model.NotificationModel
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64) (model.NotificationModel, error)); ok {
-		return rf(ctx, notificationID)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, uint64) (model.NotificationModel, error)); ok {
+		return rf(ctx, owner, notificationID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64) model.NotificationModel); ok {
-		r0 = rf(ctx, notificationID)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, uint64) model.NotificationModel); ok {
+		r0 = rf(ctx, owner, notificationID)
 	} else {
 		r0 = ret.Get(0).(model.NotificationModel)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
-		r1 = rf(ctx, notificationID)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.Owner, uint64) error); ok {
+		r1 = rf(ctx, owner, notificationID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -114,14 +237,15 @@ type MockNotificationRepositoryI_FindByID_Call struct {
 
 // FindByID is a helper method to define mock.On call
 //   - ctx context.Context
+//   - owner repository.Owner
 //   - notificationID uint64
-func (_e *MockNotificationRepositoryI_Expecter) FindByID(ctx interface{}, notificationID interface{}) *MockNotificationRepositoryI_FindByID_Call {
-	return &MockNotificationRepositoryI_FindByID_Call{Call: _e.mock.On("FindByID", ctx, notificationID)}
+func (_e *MockNotificationRepositoryI_Expecter) FindByID(ctx interface{}, owner interface{}, notificationID interface{}) *MockNotificationRepositoryI_FindByID_Call {
+	return &MockNotificationRepositoryI_FindByID_Call{Call: _e.mock.On("FindByID", ctx, owner, notificationID)}
 }
 
-func (_c *MockNotificationRepositoryI_FindByID_Call) Run(run func(ctx context.Context, notificationID uint64)) *MockNotificationRepositoryI_FindByID_Call {
+func (_c *MockNotificationRepositoryI_FindByID_Call) Run(run func(ctx context.Context, owner repository.Owner, notificationID uint64)) *MockNotificationRepositoryI_FindByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64))
+		run(args[0].(context.Context), args[1].(repository.Owner), args[2].(uint64))
 	})
 	return _c
 }
@@ -131,7 +255,7 @@ func (_c *MockNotificationRepositoryI_FindByID_Call) Return(_a0 model.Notificati
 	return _c
 }
 
-func (_c *MockNotificationRepositoryI_FindByID_Call) RunAndReturn(run func(context.Context, uint64) (model.NotificationModel, error)) *MockNotificationRepositoryI_FindByID_Call {
+func (_c *MockNotificationRepositoryI_FindByID_Call) RunAndReturn(run func(context.Context, repository.Owner, uint64) (model.NotificationModel, error)) *MockNotificationRepositoryI_FindByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -195,6 +319,74 @@ func (_c *MockNotificationRepositoryI_FindByMonitorID_Call) RunAndReturn(run fun
 	return _c
 }
 
+// FindPermanentlyFailed provides a mock function with given fields: ctx, owner, page, pageSize
+func (_m *MockNotificationRepositoryI) FindPermanentlyFailed(ctx context.Context, owner repository.Owner, page int, pageSize int) ([]model.NotificationModel, int64, error) {
+	ret := _m.Called(ctx, owner, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPermanentlyFailed")
+	}
+
+	var r0 []model.NotificationModel
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, int, int) ([]model.NotificationModel, int64, error)); ok {
+		return rf(ctx, owner, page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, int, int) []model.NotificationModel); ok {
+		r0 = rf(ctx, owner, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.NotificationModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.Owner, int, int) int64); ok {
+		r1 = rf(ctx, owner, page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, repository.Owner, int, int) error); ok {
+		r2 = rf(ctx, owner, page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockNotificationRepositoryI_FindPermanentlyFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPermanentlyFailed'
+type MockNotificationRepositoryI_FindPermanentlyFailed_Call struct {
+	*mock.Call
+}
+
+// FindPermanentlyFailed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - owner repository.Owner
+//   - page int
+//   - pageSize int
+func (_e *MockNotificationRepositoryI_Expecter) FindPermanentlyFailed(ctx interface{}, owner interface{}, page interface{}, pageSize interface{}) *MockNotificationRepositoryI_FindPermanentlyFailed_Call {
+	return &MockNotificationRepositoryI_FindPermanentlyFailed_Call{Call: _e.mock.On("FindPermanentlyFailed", ctx, owner, page, pageSize)}
+}
+
+func (_c *MockNotificationRepositoryI_FindPermanentlyFailed_Call) Run(run func(ctx context.Context, owner repository.Owner, page int, pageSize int)) *MockNotificationRepositoryI_FindPermanentlyFailed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.Owner), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepositoryI_FindPermanentlyFailed_Call) Return(_a0 []model.NotificationModel, _a1 int64, _a2 error) *MockNotificationRepositoryI_FindPermanentlyFailed_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockNotificationRepositoryI_FindPermanentlyFailed_Call) RunAndReturn(run func(context.Context, repository.Owner, int, int) ([]model.NotificationModel, int64, error)) *MockNotificationRepositoryI_FindPermanentlyFailed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, notification
 func (_m *MockNotificationRepositoryI) Update(ctx context.Context, notification model.NotificationModel) (model.NotificationModel, error) {
 	ret := _m.Called(ctx, notification)
@@ -252,6 +444,53 @@ func (_c *MockNotificationRepositoryI_Update_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// UpdateDelivery provides a mock function with given fields: ctx, notification
+func (_m *MockNotificationRepositoryI) UpdateDelivery(ctx context.Context, notification model.NotificationModel) error {
+	ret := _m.Called(ctx, notification)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDelivery")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.NotificationModel) error); ok {
+		r0 = rf(ctx, notification)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotificationRepositoryI_UpdateDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDelivery'
+type MockNotificationRepositoryI_UpdateDelivery_Call struct {
+	*mock.Call
+}
+
+// UpdateDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notification model.NotificationModel
+func (_e *MockNotificationRepositoryI_Expecter) UpdateDelivery(ctx interface{}, notification interface{}) *MockNotificationRepositoryI_UpdateDelivery_Call {
+	return &MockNotificationRepositoryI_UpdateDelivery_Call{Call: _e.mock.On("UpdateDelivery", ctx, notification)}
+}
+
+func (_c *MockNotificationRepositoryI_UpdateDelivery_Call) Run(run func(ctx context.Context, notification model.NotificationModel)) *MockNotificationRepositoryI_UpdateDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.NotificationModel))
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepositoryI_UpdateDelivery_Call) Return(_a0 error) *MockNotificationRepositoryI_UpdateDelivery_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationRepositoryI_UpdateDelivery_Call) RunAndReturn(run func(context.Context, model.NotificationModel) error) *MockNotificationRepositoryI_UpdateDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockNotificationRepositoryI creates a new instance of MockNotificationRepositoryI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockNotificationRepositoryI(t interface {
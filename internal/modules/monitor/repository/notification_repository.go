@@ -3,19 +3,43 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/enum"
 	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
 	"github.com/cristiano-pacheco/pingo/internal/shared/errs"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
 	"gorm.io/gorm"
 )
 
+// claimDueQuery locks the due rows it claims and skips the ones locked by another replica.
+const claimDueQuery = `
+UPDATE notifications
+SET next_attempt_at = @lease_until, updated_at = NOW()
+WHERE id IN (
+	SELECT id
+	FROM notifications
+	WHERE next_attempt_at <= @now AND status IN @statuses
+	ORDER BY next_attempt_at
+	LIMIT @limit
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING *`
+
 type NotificationRepositoryI interface {
-	FindByID(ctx context.Context, notificationID uint64) (model.NotificationModel, error)
+	FindByID(ctx context.Context, owner Owner, notificationID uint64) (model.NotificationModel, error)
 	FindByMonitorID(ctx context.Context, monitorID uint64) ([]model.NotificationModel, error)
 	Create(ctx context.Context, notification model.NotificationModel) (model.NotificationModel, error)
 	Update(ctx context.Context, notification model.NotificationModel) (model.NotificationModel, error)
+	UpdateDelivery(ctx context.Context, notification model.NotificationModel) error
+	FindPermanentlyFailed(
+		ctx context.Context,
+		owner Owner,
+		page, pageSize int,
+	) ([]model.NotificationModel, int64, error)
+	ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]model.NotificationModel, error)
+	ClaimPermanentlyFailed(ctx context.Context, notificationID uint64, leaseUntil time.Time) (bool, error)
 }
 
 type NotificationRepository struct {
@@ -28,12 +52,19 @@ func NewNotificationRepository(db *database.PingoDB) *NotificationRepository {
 	return &NotificationRepository{db}
 }
 
-func (r *NotificationRepository) FindByID(ctx context.Context, notificationID uint64) (model.NotificationModel, error) {
+// FindByID finds a notification of the monitors of the owner.
+func (r *NotificationRepository) FindByID(
+	ctx context.Context,
+	owner Owner,
+	notificationID uint64,
+) (model.NotificationModel, error) {
 	ctx, otelSpan := trace.Span(ctx, "NotificationRepository.FindByID")
 	defer otelSpan.End()
 
+	ownerCondition, ownerArgs := owner.monitorsCondition()
 	notification, err := gorm.G[model.NotificationModel](r.DB).
 		Where("id = ?", notificationID).
+		Where(ownerCondition, ownerArgs...).
 		Limit(1).
 		First(ctx)
 
@@ -88,3 +119,117 @@ func (r *NotificationRepository) Update(
 	}
 	return notification, nil
 }
+
+// UpdateDelivery stores the outcome of a delivery attempt, a null error message clears a previous one
+// and a null next attempt time takes the notification out of the retry queue.
+func (r *NotificationRepository) UpdateDelivery(ctx context.Context, notification model.NotificationModel) error {
+	ctx, otelSpan := trace.Span(ctx, "NotificationRepository.UpdateDelivery")
+	defer otelSpan.End()
+
+	result := r.Conn(ctx).
+		Model(&model.NotificationModel{}).
+		Where("id = ?", notification.ID).
+		Updates(map[string]any{
+			"status":          notification.Status,
+			"sent_at":         notification.SentAt,
+			"error_message":   notification.ErrorMessage,
+			"attempt_count":   notification.AttemptCount,
+			"next_attempt_at": notification.NextAttemptAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errs.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// FindPermanentlyFailed lists the failed notifications of the monitors of the owner that ran out of
+// attempts, most recent first.
+func (r *NotificationRepository) FindPermanentlyFailed(
+	ctx context.Context,
+	owner Owner,
+	page, pageSize int,
+) ([]model.NotificationModel, int64, error) {
+	ctx, otelSpan := trace.Span(ctx, "NotificationRepository.FindPermanentlyFailed")
+	defer otelSpan.End()
+
+	// Calculate offset
+	offset := (page - 1) * pageSize
+
+	ownerCondition, ownerArgs := owner.monitorsCondition()
+
+	// Get total count
+	total, err := gorm.G[model.NotificationModel](r.DB).
+		Where("status = ? AND next_attempt_at IS NULL", enum.NotificationStatusFailed).
+		Where(ownerCondition, ownerArgs...).
+		Count(ctx, "*")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Get paginated results
+	notifications, err := gorm.G[model.NotificationModel](r.DB).
+		Where("status = ? AND next_attempt_at IS NULL", enum.NotificationStatusFailed).
+		Where(ownerCondition, ownerArgs...).
+		Order("updated_at DESC").
+		Order("id DESC").
+		Limit(pageSize).
+		Offset(offset).
+		Find(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return notifications, total, nil
+}
+
+// ClaimDue takes up to limit notifications due for a delivery attempt and pushes their next attempt
+// to leaseUntil, so no other replica picks them up while they are being sent. Notifications of a
+// replica that dies mid-delivery become due again once the lease expires.
+func (r *NotificationRepository) ClaimDue(
+	ctx context.Context,
+	now, leaseUntil time.Time,
+	limit int,
+) ([]model.NotificationModel, error) {
+	ctx, otelSpan := trace.Span(ctx, "NotificationRepository.ClaimDue")
+	defer otelSpan.End()
+
+	var notifications []model.NotificationModel
+	err := r.DB.WithContext(ctx).
+		Raw(claimDueQuery, map[string]any{
+			"now":         now,
+			"lease_until": leaseUntil,
+			"limit":       limit,
+			"statuses":    []string{enum.NotificationStatusPending, enum.NotificationStatusFailed},
+		}).
+		Scan(&notifications).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+// ClaimPermanentlyFailed puts a notification that ran out of attempts back in the queue until
+// leaseUntil, it reports false when the notification is not permanently failed.
+func (r *NotificationRepository) ClaimPermanentlyFailed(
+	ctx context.Context,
+	notificationID uint64,
+	leaseUntil time.Time,
+) (bool, error) {
+	ctx, otelSpan := trace.Span(ctx, "NotificationRepository.ClaimPermanentlyFailed")
+	defer otelSpan.End()
+
+	result := r.DB.WithContext(ctx).
+		Model(&model.NotificationModel{}).
+		Where("id = ? AND status = ? AND next_attempt_at IS NULL", notificationID, enum.NotificationStatusFailed).
+		Update("next_attempt_at", leaseUntil)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
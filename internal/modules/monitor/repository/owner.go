@@ -0,0 +1,41 @@
+package repository
+
+import "github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+
+// Owner identifies the resources a request reaches: the resources shared in the organization when
+// OrganizationID is set, otherwise the personal resources of the user. The resources of other
+// owners are reported as not found.
+type Owner struct {
+	UserID         uint64
+	OrganizationID uint64
+}
+
+// NewMonitorOwner returns the owner of the monitor, the background checks and deliveries reach its
+// contacts through it.
+func NewMonitorOwner(monitor model.HTTPMonitorModel) Owner {
+	// IDs come from BIGSERIAL columns, the conversion can't overflow
+	organizationID := uint64(monitor.OrganizationID.Int64) // #nosec G115
+	return Owner{UserID: monitor.UserID, OrganizationID: organizationID}
+}
+
+// condition narrows down contacts and monitors to the ones of the owner.
+func (o Owner) condition() (string, []any) {
+	if o.OrganizationID != 0 {
+		return "organization_id = ?", []any{o.OrganizationID}
+	}
+	return "user_id = ? AND organization_id IS NULL", []any{o.UserID}
+}
+
+// monitorsCondition narrows down the rows referencing a monitor to the ones of the monitors of the owner.
+func (o Owner) monitorsCondition() (string, []any) {
+	condition, args := o.condition()
+	return "http_monitor_id IN (SELECT id FROM http_monitors WHERE " + condition + ")", args
+}
+
+// namedCondition is condition for raw queries taking named arguments.
+func (o Owner) namedCondition() (string, map[string]any) {
+	if o.OrganizationID != 0 {
+		return "organization_id = @organization_id", map[string]any{"organization_id": o.OrganizationID}
+	}
+	return "user_id = @user_id AND organization_id IS NULL", map[string]any{"user_id": o.UserID}
+}
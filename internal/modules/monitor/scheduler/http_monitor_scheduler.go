@@ -0,0 +1,367 @@
+package scheduler
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/cache"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/service"
+	"github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"go.uber.org/fx"
+)
+
+const (
+	defaultWorkers       = 10
+	defaultSyncInterval  = 30 * time.Second
+	defaultCheckInterval = 300 * time.Second
+	// maxJitter bounds the random delay added when a monitor is scheduled, so monitors
+	// sharing the same interval are spread without postponing their first check for too long.
+	maxJitter = time.Minute
+	// leaseGracePeriod keeps the lease alive until the owner checks the monitor again,
+	// even when that check is dispatched slightly late.
+	leaseGracePeriod = 5 * time.Second
+)
+
+type HTTPMonitorSchedulerI interface {
+	Refresh()
+}
+
+type checkResult struct {
+	monitorID uint64
+	// removed is set when the monitor was deleted or disabled since it was scheduled.
+	removed bool
+}
+
+// HTTPMonitorScheduler checks every enabled monitor according to its check interval.
+// Due monitors are kept in a priority queue and dispatched to a bounded pool of workers.
+// The enabled monitors are reloaded periodically, so created, updated, disabled and
+// deleted monitors are picked up without restarting the application.
+// Every replica schedules every monitor, a check lease decides which one runs it.
+type HTTPMonitorScheduler struct {
+	httpMonitorCheckerService  service.HTTPMonitorCheckerServiceI
+	httpMonitorRepository      repository.HTTPMonitorRepositoryI
+	httpMonitorCheckLeaseCache cache.HTTPMonitorCheckLeaseCacheI
+	logger                     logger.Logger
+	workers                    int
+	syncInterval               time.Duration
+
+	// the fields below are owned by the Run loop
+	queue     monitorQueue
+	scheduled map[uint64]*scheduledMonitor
+	inFlight  map[uint64]struct{}
+
+	jobs    chan uint64
+	results chan checkResult
+	refresh chan struct{}
+	stopped chan struct{}
+}
+
+var _ HTTPMonitorSchedulerI = (*HTTPMonitorScheduler)(nil)
+
+// NewHTTPMonitorScheduler creates an HTTPMonitorScheduler that automatically
+// starts/stops with the Fx lifecycle.
+func NewHTTPMonitorScheduler(
+	httpMonitorCheckerService service.HTTPMonitorCheckerServiceI,
+	httpMonitorRepository repository.HTTPMonitorRepositoryI,
+	httpMonitorCheckLeaseCache cache.HTTPMonitorCheckLeaseCacheI,
+	cfg config.Config,
+	logger logger.Logger,
+	lc fx.Lifecycle,
+) *HTTPMonitorScheduler {
+	workers := cfg.MonitorScheduler.Workers
+	if workers <= 0 {
+		workers = defaultWorkers
+	}
+
+	syncInterval := defaultSyncInterval
+	if cfg.MonitorScheduler.SyncIntervalSeconds > 0 {
+		syncInterval = time.Duration(cfg.MonitorScheduler.SyncIntervalSeconds) * time.Second
+	}
+
+	s := &HTTPMonitorScheduler{
+		httpMonitorCheckerService:  httpMonitorCheckerService,
+		httpMonitorRepository:      httpMonitorRepository,
+		httpMonitorCheckLeaseCache: httpMonitorCheckLeaseCache,
+		logger:                     logger,
+		workers:                    workers,
+		syncInterval:               syncInterval,
+		scheduled:                  make(map[uint64]*scheduledMonitor),
+		inFlight:                   make(map[uint64]struct{}),
+		// at most one job per worker is in flight, so neither channel blocks the Run loop
+		jobs:    make(chan uint64, workers),
+		results: make(chan checkResult, workers),
+		refresh: make(chan struct{}, 1),
+		stopped: make(chan struct{}),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			go func() {
+				logger.Info().Msgf("Starting HTTP monitor scheduler with %d workers...", s.workers)
+
+				if err := s.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+					logger.Error().Msgf("HTTP monitor scheduler stopped with error: %v", err)
+					return
+				}
+				logger.Info().Msg("HTTP monitor scheduler stopped gracefully")
+			}()
+
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-s.stopped:
+				return nil
+			case <-stopCtx.Done():
+				return stopCtx.Err()
+			}
+		},
+	})
+
+	return s
+}
+
+// Refresh requests an immediate reload of the enabled monitors.
+// It never blocks, pending requests are coalesced.
+func (s *HTTPMonitorScheduler) Refresh() {
+	select {
+	case s.refresh <- struct{}{}:
+	default:
+	}
+}
+
+// Run schedules the monitor checks until the context is canceled.
+// Checks already running when the context is canceled are allowed to finish,
+// so a shutdown doesn't record them as failures.
+func (s *HTTPMonitorScheduler) Run(ctx context.Context) error {
+	defer close(s.stopped)
+
+	checkCtx := context.WithoutCancel(ctx)
+
+	var wg sync.WaitGroup
+	for range s.workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.work(ctx, checkCtx)
+		}()
+	}
+	defer func() {
+		close(s.jobs)
+		wg.Wait()
+	}()
+
+	s.sync(ctx)
+
+	syncTicker := time.NewTicker(s.syncInterval)
+	defer syncTicker.Stop()
+
+	timer := time.NewTimer(s.syncInterval)
+	defer timer.Stop()
+
+	for {
+		s.dispatch(time.Now())
+
+		// when every worker is busy the loop is woken up by the next check result
+		var timerC <-chan time.Time
+		if next := s.queue.peek(); next != nil && len(s.inFlight) < s.workers {
+			timer.Reset(time.Until(next.dueAt))
+			timerC = timer.C
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-syncTicker.C:
+			s.sync(ctx)
+		case <-s.refresh:
+			s.sync(ctx)
+		case result := <-s.results:
+			s.complete(result)
+		case <-timerC:
+		}
+	}
+}
+
+// sync reconciles the due queue with the enabled monitors stored in the database.
+func (s *HTTPMonitorScheduler) sync(ctx context.Context) {
+	ctx, span := trace.Span(ctx, "HTTPMonitorScheduler.sync")
+	defer span.End()
+
+	monitors, err := s.httpMonitorRepository.FindAllEnabled(ctx)
+	if err != nil {
+		s.logger.Error().Msgf("error finding enabled monitors: %v", err)
+		return
+	}
+
+	now := time.Now()
+	enabled := make(map[uint64]struct{}, len(monitors))
+	for _, monitor := range monitors {
+		enabled[monitor.ID] = struct{}{}
+		interval := s.checkInterval(monitor)
+
+		item, ok := s.scheduled[monitor.ID]
+		if !ok {
+			item = &scheduledMonitor{
+				monitorID: monitor.ID,
+				interval:  interval,
+				dueAt:     s.firstDueAt(monitor, interval, now),
+			}
+			s.scheduled[monitor.ID] = item
+			heap.Push(&s.queue, item)
+			continue
+		}
+
+		if item.interval != interval {
+			item.interval = interval
+			item.dueAt = now.Add(s.jitter(interval))
+			heap.Fix(&s.queue, item.index)
+		}
+	}
+
+	for monitorID := range s.scheduled {
+		if _, ok := enabled[monitorID]; !ok {
+			s.unschedule(monitorID)
+		}
+	}
+}
+
+// dispatch hands the due monitors over to the workers while there is a free worker.
+func (s *HTTPMonitorScheduler) dispatch(now time.Time) {
+	for len(s.inFlight) < s.workers {
+		item := s.queue.peek()
+		if item == nil || item.dueAt.After(now) {
+			return
+		}
+
+		// keep the monitor on its cadence, unless it fell behind by more than one interval
+		item.dueAt = item.dueAt.Add(item.interval)
+		if !item.dueAt.After(now) {
+			item.dueAt = now.Add(item.interval)
+		}
+		heap.Fix(&s.queue, item.index)
+
+		// a check that takes longer than the interval is not run twice at the same time
+		if _, ok := s.inFlight[item.monitorID]; ok {
+			continue
+		}
+
+		s.inFlight[item.monitorID] = struct{}{}
+		s.jobs <- item.monitorID
+	}
+}
+
+func (s *HTTPMonitorScheduler) complete(result checkResult) {
+	delete(s.inFlight, result.monitorID)
+	if result.removed {
+		s.unschedule(result.monitorID)
+	}
+}
+
+func (s *HTTPMonitorScheduler) unschedule(monitorID uint64) {
+	item, ok := s.scheduled[monitorID]
+	if !ok {
+		return
+	}
+	heap.Remove(&s.queue, item.index)
+	delete(s.scheduled, monitorID)
+}
+
+func (s *HTTPMonitorScheduler) work(ctx, checkCtx context.Context) {
+	for monitorID := range s.jobs {
+		// jobs still buffered when the scheduler stops are dropped
+		if ctx.Err() != nil {
+			continue
+		}
+		s.results <- checkResult{
+			monitorID: monitorID,
+			removed:   s.check(checkCtx, monitorID),
+		}
+	}
+}
+
+// check reloads the monitor so the check runs against its latest state and reports
+// whether the monitor was deleted or disabled in the meantime.
+func (s *HTTPMonitorScheduler) check(ctx context.Context, monitorID uint64) bool {
+	ctx, span := trace.Span(ctx, "HTTPMonitorScheduler.check")
+	defer span.End()
+
+	monitor, err := s.httpMonitorRepository.FindByIDUnscoped(ctx, monitorID)
+	if err != nil {
+		if errors.Is(err, errs.ErrRecordNotFound) {
+			return true
+		}
+		s.logger.Error().Msgf("error finding monitor by ID %d: %v", monitorID, err)
+		return false
+	}
+
+	if !monitor.IsEnabled {
+		return true
+	}
+
+	acquired, err := s.httpMonitorCheckLeaseCache.Acquire(ctx, monitor.ID, s.leaseTTL(monitor))
+	if err != nil {
+		s.logger.Error().Msgf("error acquiring check lease for monitor ID %d: %v", monitor.ID, err)
+		return false
+	}
+	if !acquired {
+		// another replica owns the monitor
+		return false
+	}
+
+	// failures are logged by the checker, the monitor is checked again on the next interval
+	_, _ = s.httpMonitorCheckerService.Check(ctx, monitor)
+	return false
+}
+
+// firstDueAt keeps the cadence of monitors checked before the scheduler started,
+// otherwise the first check is delayed by a random jitter.
+func (s *HTTPMonitorScheduler) firstDueAt(
+	monitor model.HTTPMonitorModel,
+	interval time.Duration,
+	now time.Time,
+) time.Time {
+	if monitor.LastCheckedAt.Valid {
+		dueAt := monitor.LastCheckedAt.Time.Add(interval)
+		if dueAt.After(now) {
+			return dueAt
+		}
+	}
+	return now.Add(s.jitter(interval))
+}
+
+func (s *HTTPMonitorScheduler) checkInterval(monitor model.HTTPMonitorModel) time.Duration {
+	if monitor.CheckIntervalSeconds <= 0 {
+		return defaultCheckInterval
+	}
+	return time.Duration(monitor.CheckIntervalSeconds) * time.Second
+}
+
+// leaseTTL covers the whole interval, so the other replicas skip the monitor until the
+// owner checks it again, and it is never shorter than the check itself.
+func (s *HTTPMonitorScheduler) leaseTTL(monitor model.HTTPMonitorModel) time.Duration {
+	checkTimeout := time.Duration(monitor.CheckTimeout) * time.Second
+	return max(s.checkInterval(monitor), checkTimeout) + leaseGracePeriod
+}
+
+// jitter returns a random delay lower than the interval, bounded by maxJitter.
+func (s *HTTPMonitorScheduler) jitter(interval time.Duration) time.Duration {
+	window := min(interval, maxJitter)
+	if window <= 0 {
+		return 0
+	}
+	// the jitter only spreads the load, it doesn't need a secure source
+	return rand.N(window) // #nosec G404
+}
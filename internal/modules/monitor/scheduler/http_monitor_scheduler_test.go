@@ -0,0 +1,196 @@
+package scheduler_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/fx"
+
+	cache_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/cache/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/scheduler"
+	service_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/service/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+)
+
+const waitTimeout = 3 * time.Second
+
+// noopLifecycle lets the tests drive the scheduler through Run instead of the Fx hooks.
+type noopLifecycle struct{}
+
+func (noopLifecycle) Append(fx.Hook) {}
+
+type HTTPMonitorSchedulerTestSuite struct {
+	suite.Suite
+	sut                            *scheduler.HTTPMonitorScheduler
+	httpMonitorCheckerServiceMock  *service_mocks.MockHTTPMonitorCheckerServiceI
+	httpMonitorRepositoryMock      *repository_mocks.MockHTTPMonitorRepositoryI
+	httpMonitorCheckLeaseCacheMock *cache_mocks.MockHTTPMonitorCheckLeaseCacheI
+	cancel                         context.CancelFunc
+	done                           chan struct{}
+}
+
+func (s *HTTPMonitorSchedulerTestSuite) SetupTest() {
+	cfg := config.Config{
+		Log: config.Log{
+			LogLevel: "disabled",
+		},
+		MonitorScheduler: config.MonitorScheduler{
+			Workers: 2,
+		},
+	}
+
+	s.httpMonitorCheckerServiceMock = service_mocks.NewMockHTTPMonitorCheckerServiceI(s.T())
+	s.httpMonitorRepositoryMock = repository_mocks.NewMockHTTPMonitorRepositoryI(s.T())
+	s.httpMonitorCheckLeaseCacheMock = cache_mocks.NewMockHTTPMonitorCheckLeaseCacheI(s.T())
+
+	s.sut = scheduler.NewHTTPMonitorScheduler(
+		s.httpMonitorCheckerServiceMock,
+		s.httpMonitorRepositoryMock,
+		s.httpMonitorCheckLeaseCacheMock,
+		cfg,
+		logger.New(cfg),
+		noopLifecycle{},
+	)
+}
+
+func TestHTTPMonitorSchedulerSuite(t *testing.T) {
+	suite.Run(t, new(HTTPMonitorSchedulerTestSuite))
+}
+
+func (s *HTTPMonitorSchedulerTestSuite) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.done = make(chan struct{})
+	go func() {
+		defer close(s.done)
+		_ = s.sut.Run(ctx)
+	}()
+}
+
+func (s *HTTPMonitorSchedulerTestSuite) stop() {
+	s.cancel()
+	select {
+	case <-s.done:
+	case <-time.After(waitTimeout):
+		s.Fail("scheduler did not stop")
+	}
+}
+
+func (s *HTTPMonitorSchedulerTestSuite) newMonitor() model.HTTPMonitorModel {
+	return model.HTTPMonitorModel{
+		ID:                   1,
+		Name:                 "test monitor",
+		CheckIntervalSeconds: 1,
+		IsEnabled:            true,
+	}
+}
+
+func (s *HTTPMonitorSchedulerTestSuite) expectCheck(monitor model.HTTPMonitorModel) chan struct{} {
+	checked := make(chan struct{}, 10)
+	s.httpMonitorCheckerServiceMock.On("Check", mock.Anything, monitor).
+		Run(func(_ mock.Arguments) {
+			checked <- struct{}{}
+		}).
+		Return(model.HTTPMonitorCheckModel{}, nil)
+	return checked
+}
+
+func (s *HTTPMonitorSchedulerTestSuite) TestRun_EnabledMonitor_ChecksOnEachInterval() {
+	// Arrange
+	monitor := s.newMonitor()
+	s.httpMonitorRepositoryMock.On("FindAllEnabled", mock.Anything).Return([]model.HTTPMonitorModel{monitor}, nil)
+	s.httpMonitorRepositoryMock.On("FindByIDUnscoped", mock.Anything, monitor.ID).Return(monitor, nil)
+	s.httpMonitorCheckLeaseCacheMock.On("Acquire", mock.Anything, monitor.ID, 6*time.Second).Return(true, nil)
+	checked := s.expectCheck(monitor)
+
+	// Act
+	s.start()
+
+	// Assert
+	for range 2 {
+		select {
+		case <-checked:
+		case <-time.After(waitTimeout):
+			s.FailNow("monitor was not checked")
+		}
+	}
+	s.stop()
+}
+
+func (s *HTTPMonitorSchedulerTestSuite) TestRun_MonitorDeleted_StopsScheduling() {
+	// Arrange
+	monitor := s.newMonitor()
+	var findCalls atomic.Int32
+	s.httpMonitorRepositoryMock.On("FindAllEnabled", mock.Anything).Return([]model.HTTPMonitorModel{monitor}, nil)
+	s.httpMonitorRepositoryMock.On("FindByIDUnscoped", mock.Anything, monitor.ID).
+		Run(func(_ mock.Arguments) {
+			findCalls.Add(1)
+		}).
+		Return(model.HTTPMonitorModel{}, errs.ErrRecordNotFound)
+
+	// Act
+	s.start()
+	time.Sleep(2500 * time.Millisecond)
+	s.stop()
+
+	// Assert
+	s.Equal(int32(1), findCalls.Load())
+	s.httpMonitorCheckerServiceMock.AssertNotCalled(s.T(), "Check", mock.Anything, mock.Anything)
+}
+
+func (s *HTTPMonitorSchedulerTestSuite) TestRun_LeaseOwnedByAnotherReplica_SkipsCheck() {
+	// Arrange
+	monitor := s.newMonitor()
+	leaseAttempted := make(chan struct{}, 10)
+	s.httpMonitorRepositoryMock.On("FindAllEnabled", mock.Anything).Return([]model.HTTPMonitorModel{monitor}, nil)
+	s.httpMonitorRepositoryMock.On("FindByIDUnscoped", mock.Anything, monitor.ID).Return(monitor, nil)
+	s.httpMonitorCheckLeaseCacheMock.On("Acquire", mock.Anything, monitor.ID, mock.Anything).
+		Run(func(_ mock.Arguments) {
+			leaseAttempted <- struct{}{}
+		}).
+		Return(false, nil)
+
+	// Act
+	s.start()
+
+	// Assert
+	for range 2 {
+		select {
+		case <-leaseAttempted:
+		case <-time.After(waitTimeout):
+			s.FailNow("check lease was not requested")
+		}
+	}
+	s.stop()
+	s.httpMonitorCheckerServiceMock.AssertNotCalled(s.T(), "Check", mock.Anything, mock.Anything)
+}
+
+func (s *HTTPMonitorSchedulerTestSuite) TestRefresh_MonitorCreated_SchedulesMonitor() {
+	// Arrange
+	monitor := s.newMonitor()
+	s.httpMonitorRepositoryMock.On("FindAllEnabled", mock.Anything).Return([]model.HTTPMonitorModel{}, nil).Once()
+	s.httpMonitorRepositoryMock.On("FindAllEnabled", mock.Anything).Return([]model.HTTPMonitorModel{monitor}, nil)
+	s.httpMonitorRepositoryMock.On("FindByIDUnscoped", mock.Anything, monitor.ID).Return(monitor, nil)
+	s.httpMonitorCheckLeaseCacheMock.On("Acquire", mock.Anything, monitor.ID, mock.Anything).Return(true, nil)
+	checked := s.expectCheck(monitor)
+	s.start()
+
+	// Act
+	s.sut.Refresh()
+
+	// Assert
+	select {
+	case <-checked:
+	case <-time.After(waitTimeout):
+		s.FailNow("monitor was not checked")
+	}
+	s.stop()
+}
@@ -0,0 +1,64 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// MockHTTPMonitorSchedulerI is an autogenerated mock type for the HTTPMonitorSchedulerI type
+type MockHTTPMonitorSchedulerI struct {
+	mock.Mock
+}
+
+type MockHTTPMonitorSchedulerI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockHTTPMonitorSchedulerI) EXPECT() *MockHTTPMonitorSchedulerI_Expecter {
+	return &MockHTTPMonitorSchedulerI_Expecter{mock: &_m.Mock}
+}
+
+// Refresh provides a mock function with no fields
+func (_m *MockHTTPMonitorSchedulerI) Refresh() {
+	_m.Called()
+}
+
+// MockHTTPMonitorSchedulerI_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type MockHTTPMonitorSchedulerI_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+func (_e *MockHTTPMonitorSchedulerI_Expecter) Refresh() *MockHTTPMonitorSchedulerI_Refresh_Call {
+	return &MockHTTPMonitorSchedulerI_Refresh_Call{Call: _e.mock.On("Refresh")}
+}
+
+func (_c *MockHTTPMonitorSchedulerI_Refresh_Call) Run(run func()) *MockHTTPMonitorSchedulerI_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockHTTPMonitorSchedulerI_Refresh_Call) Return() *MockHTTPMonitorSchedulerI_Refresh_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHTTPMonitorSchedulerI_Refresh_Call) RunAndReturn(run func()) *MockHTTPMonitorSchedulerI_Refresh_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockHTTPMonitorSchedulerI creates a new instance of MockHTTPMonitorSchedulerI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockHTTPMonitorSchedulerI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockHTTPMonitorSchedulerI {
+	mock := &MockHTTPMonitorSchedulerI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,57 @@
+package scheduler
+
+import (
+	"container/heap"
+	"time"
+)
+
+// scheduledMonitor is an entry of the due queue.
+type scheduledMonitor struct {
+	monitorID uint64
+	interval  time.Duration
+	dueAt     time.Time
+	index     int
+}
+
+// monitorQueue is a min-heap of scheduled monitors ordered by due time.
+type monitorQueue []*scheduledMonitor
+
+var _ heap.Interface = (*monitorQueue)(nil)
+
+func (q monitorQueue) Len() int {
+	return len(q)
+}
+
+func (q monitorQueue) Less(i, j int) bool {
+	return q[i].dueAt.Before(q[j].dueAt)
+}
+
+func (q monitorQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *monitorQueue) Push(x any) {
+	item, _ := x.(*scheduledMonitor)
+	item.index = len(*q)
+	*q = append(*q, item)
+}
+
+func (q *monitorQueue) Pop() any {
+	old := *q
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	item.index = -1
+	*q = old[:n-1]
+	return item
+}
+
+// peek returns the monitor with the earliest due time without removing it.
+func (q monitorQueue) peek() *scheduledMonitor {
+	if len(q) == 0 {
+		return nil
+	}
+	return q[0]
+}
@@ -0,0 +1,96 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/service"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"go.uber.org/fx"
+)
+
+const defaultRetryInterval = 15 * time.Second
+
+// NotificationRetryScheduler periodically sends the notifications whose next delivery attempt is due.
+// Every replica runs it, due notifications are claimed so each one is sent by a single replica.
+type NotificationRetryScheduler struct {
+	notificationDispatcherService service.NotificationDispatcherServiceI
+	logger                        logger.Logger
+	interval                      time.Duration
+	stopped                       chan struct{}
+}
+
+// NewNotificationRetryScheduler creates a NotificationRetryScheduler that automatically
+// starts/stops with the Fx lifecycle.
+func NewNotificationRetryScheduler(
+	notificationDispatcherService service.NotificationDispatcherServiceI,
+	cfg config.Config,
+	logger logger.Logger,
+	lc fx.Lifecycle,
+) *NotificationRetryScheduler {
+	interval := defaultRetryInterval
+	if cfg.Notification.RetryIntervalSeconds > 0 {
+		interval = time.Duration(cfg.Notification.RetryIntervalSeconds) * time.Second
+	}
+
+	s := &NotificationRetryScheduler{
+		notificationDispatcherService: notificationDispatcherService,
+		logger:                        logger,
+		interval:                      interval,
+		stopped:                       make(chan struct{}),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			go func() {
+				logger.Info().Msgf("Starting notification retry scheduler every %s...", s.interval)
+
+				if err := s.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+					logger.Error().Msgf("notification retry scheduler stopped with error: %v", err)
+					return
+				}
+				logger.Info().Msg("notification retry scheduler stopped gracefully")
+			}()
+
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-s.stopped:
+				return nil
+			case <-stopCtx.Done():
+				return stopCtx.Err()
+			}
+		},
+	})
+
+	return s
+}
+
+// Run retries the due notifications on every tick until the context is canceled.
+// A retry round already running when the context is canceled is allowed to finish,
+// so its outcomes are recorded.
+func (s *NotificationRetryScheduler) Run(ctx context.Context) error {
+	defer close(s.stopped)
+
+	retryCtx := context.WithoutCancel(ctx)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := s.notificationDispatcherService.RetryDue(retryCtx); err != nil {
+				s.logger.Error().Msgf("error retrying due notifications: %v", err)
+			}
+		}
+	}
+}
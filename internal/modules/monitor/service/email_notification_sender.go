@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/ui/email/templates"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/mailer"
+)
+
+const (
+	emailLayoutTemplate      = "layout_default.gohtml"
+	emailLayoutSectionName   = "htmlBody"
+	failureEmailTemplate     = "monitor_failure.gohtml"
+	recoveryEmailTemplate    = "monitor_recovery.gohtml"
+	maintenanceEmailTemplate = "monitor_maintenance.gohtml"
+)
+
+// emailAlertData is the data rendered by the monitor alert templates,
+// optional values are empty when the alert doesn't carry them.
+type emailAlertData struct {
+	Title            string
+	MonitorName      string
+	MonitorURL       string
+	MonitorLink      string
+	StatusCode       string
+	ErrorMessage     string
+	ResponseTime     string
+	StartedAt        string
+	IncidentDuration string
+}
+
+type EmailNotificationSender struct {
+	mailerTemplate mailer.Template
+	mailerSMTP     mailer.SMTP
+	logger         logger.Logger
+	cfg            config.Config
+}
+
+var _ NotificationSenderI = (*EmailNotificationSender)(nil)
+
+func NewEmailNotificationSender(
+	mailerTemplate mailer.Template,
+	mailerSMTP mailer.SMTP,
+	logger logger.Logger,
+	cfg config.Config,
+) *EmailNotificationSender {
+	return &EmailNotificationSender{
+		mailerTemplate: mailerTemplate,
+		mailerSMTP:     mailerSMTP,
+		logger:         logger,
+		cfg:            cfg,
+	}
+}
+
+// Send renders the template of the alert type and mails it to the address of the contact.
+func (s *EmailNotificationSender) Send(ctx context.Context, alert MonitorAlert, contact model.ContactModel) error {
+	ctx, span := trace.Span(ctx, "EmailNotificationSender.Send")
+	defer span.End()
+
+	templatePath, subject := s.template(alert)
+	data := s.data(alert, subject)
+
+	content, err := s.mailerTemplate.CompileTemplate(mailer.CompileTemplateInput{
+		TemplateName:        emailLayoutTemplate,
+		LayoutTpl:           emailLayoutTemplate,
+		TemplatePath:        templatePath,
+		TemplateSectionName: emailLayoutSectionName,
+		TemplateFS:          templates.EmailTemplatesFS,
+		Data:                data,
+	})
+	if err != nil {
+		s.logger.Error().Msgf("error compiling %s email template: %v", alert.Type, err)
+		return err
+	}
+
+	md := mailer.MailData{
+		Sender:  s.cfg.MAIL.Sender,
+		ToName:  contact.Name,
+		ToEmail: contact.ContactData,
+		Subject: subject,
+		Content: content,
+	}
+
+	err = s.mailerSMTP.Send(ctx, md)
+	if err != nil {
+		s.logger.Error().Msgf("error sending the %s email to contact ID %d: %v", alert.Type, contact.ID, err)
+		return err
+	}
+
+	return nil
+}
+
+func (s *EmailNotificationSender) template(alert MonitorAlert) (string, string) {
+	switch alert.Type {
+	case enum.NotificationTypeRecovery:
+		return recoveryEmailTemplate, fmt.Sprintf("%s is back up", alert.Monitor.Name)
+	case enum.NotificationTypeMaintenance:
+		return maintenanceEmailTemplate, fmt.Sprintf("%s is under maintenance", alert.Monitor.Name)
+	default:
+		return failureEmailTemplate, fmt.Sprintf("%s is down", alert.Monitor.Name)
+	}
+}
+
+func (s *EmailNotificationSender) data(alert MonitorAlert, title string) emailAlertData {
+	data := emailAlertData{
+		Title:       title,
+		MonitorName: alert.Monitor.Name,
+		MonitorURL:  alert.Monitor.HTTPURL,
+		MonitorLink: fmt.Sprintf("%s/monitors/%d", s.cfg.App.BaseURL, alert.Monitor.ID),
+	}
+
+	if alert.Check.StatusCode.Valid {
+		data.StatusCode = strconv.Itoa(int(alert.Check.StatusCode.Int32))
+	}
+	if alert.Check.ResponseTimeMs.Valid {
+		data.ResponseTime = fmt.Sprintf("%d ms", alert.Check.ResponseTimeMs.Int32)
+	}
+
+	// the incident keeps the error of the check that started it
+	data.ErrorMessage = alert.Check.ErrorMessage.String
+	if data.ErrorMessage == "" {
+		data.ErrorMessage = alert.Incident.ErrorMessage.String
+	}
+
+	if !alert.Incident.StartedAt.IsZero() {
+		data.StartedAt = alert.Incident.StartedAt.UTC().Format(time.RFC1123)
+	}
+	if alert.Incident.DurationSeconds.Valid {
+		data.IncidentDuration = (time.Duration(alert.Incident.DurationSeconds.Int64) * time.Second).String()
+	}
+
+	return data
+}
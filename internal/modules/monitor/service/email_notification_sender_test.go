@@ -0,0 +1,179 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/service"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/mailer"
+	mailer_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/mailer/mocks"
+)
+
+type EmailNotificationSenderTestSuite struct {
+	suite.Suite
+	sut        *service.EmailNotificationSender
+	mailerSMTP *mailer_mocks.MockSMTP
+	logger     logger.Logger
+	cfg        config.Config
+}
+
+func (s *EmailNotificationSenderTestSuite) SetupTest() {
+	s.mailerSMTP = mailer_mocks.NewMockSMTP(s.T())
+
+	s.cfg = config.Config{
+		MAIL: config.MAIL{
+			Sender: "alerts@example.com",
+		},
+		App: config.App{
+			BaseURL: "https://pingo.example.com",
+		},
+		Log: config.Log{
+			LogLevel: "disabled",
+		},
+	}
+	s.logger = logger.New(s.cfg)
+
+	s.sut = service.NewEmailNotificationSender(
+		mailer.NewMailerTemplate(),
+		s.mailerSMTP,
+		s.logger,
+		s.cfg,
+	)
+}
+
+func TestEmailNotificationSenderSuite(t *testing.T) {
+	suite.Run(t, new(EmailNotificationSenderTestSuite))
+}
+
+func (s *EmailNotificationSenderTestSuite) newContact() model.ContactModel {
+	return model.ContactModel{
+		ID:          3,
+		Name:        "Ops",
+		ContactType: enum.ContactTypeEmail,
+		ContactData: "ops@example.com",
+		IsEnabled:   true,
+	}
+}
+
+func (s *EmailNotificationSenderTestSuite) newMonitor() model.HTTPMonitorModel {
+	return model.HTTPMonitorModel{ID: 1, Name: "Example", HTTPURL: "https://example.com/health"}
+}
+
+func (s *EmailNotificationSenderTestSuite) TestSend_FailureAlert_SendsFailureEmail() {
+	// Arrange
+	ctx := context.Background()
+	contact := s.newContact()
+	alert := service.MonitorAlert{
+		Type:    enum.NotificationTypeFailure,
+		Monitor: s.newMonitor(),
+		Check: model.HTTPMonitorCheckModel{
+			ID:             9,
+			ResponseTimeMs: sql.NullInt32{Int32: 245, Valid: true},
+			StatusCode:     sql.NullInt32{Int32: 503, Valid: true},
+			ErrorMessage:   sql.NullString{String: "unexpected response status code 503", Valid: true},
+		},
+		Incident: model.IncidentModel{ID: 4, StartedAt: time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)},
+	}
+
+	var sent mailer.MailData
+	s.mailerSMTP.On("Send", mock.Anything, mock.AnythingOfType("mailer.MailData")).
+		Run(func(args mock.Arguments) { sent = args.Get(1).(mailer.MailData) }).
+		Return(nil)
+
+	// Act
+	err := s.sut.Send(ctx, alert, contact)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal("alerts@example.com", sent.Sender)
+	s.Equal("ops@example.com", sent.ToEmail)
+	s.Equal("Ops", sent.ToName)
+	s.Equal("Example is down", sent.Subject)
+	s.Contains(sent.Content, "<!DOCTYPE html>")
+	s.Contains(sent.Content, "https://example.com/health")
+	s.Contains(sent.Content, "503")
+	s.Contains(sent.Content, "unexpected response status code 503")
+	s.Contains(sent.Content, "245 ms")
+	s.Contains(sent.Content, "https://pingo.example.com/monitors/1")
+}
+
+func (s *EmailNotificationSenderTestSuite) TestSend_RecoveryAlert_SendsRecoveryEmailWithDuration() {
+	// Arrange
+	ctx := context.Background()
+	contact := s.newContact()
+	alert := service.MonitorAlert{
+		Type:    enum.NotificationTypeRecovery,
+		Monitor: s.newMonitor(),
+		Check: model.HTTPMonitorCheckModel{
+			ID:             12,
+			Success:        true,
+			ResponseTimeMs: sql.NullInt32{Int32: 80, Valid: true},
+			StatusCode:     sql.NullInt32{Int32: 200, Valid: true},
+		},
+		Incident: model.IncidentModel{ID: 4, DurationSeconds: sql.NullInt64{Int64: 600, Valid: true}},
+	}
+
+	var sent mailer.MailData
+	s.mailerSMTP.On("Send", mock.Anything, mock.AnythingOfType("mailer.MailData")).
+		Run(func(args mock.Arguments) { sent = args.Get(1).(mailer.MailData) }).
+		Return(nil)
+
+	// Act
+	err := s.sut.Send(ctx, alert, contact)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal("Example is back up", sent.Subject)
+	s.Contains(sent.Content, "Incident duration: 10m0s")
+	s.Contains(sent.Content, "80 ms")
+}
+
+func (s *EmailNotificationSenderTestSuite) TestSend_MaintenanceAlert_SendsMaintenanceEmail() {
+	// Arrange
+	ctx := context.Background()
+	contact := s.newContact()
+	alert := service.MonitorAlert{
+		Type:    enum.NotificationTypeMaintenance,
+		Monitor: s.newMonitor(),
+	}
+
+	var sent mailer.MailData
+	s.mailerSMTP.On("Send", mock.Anything, mock.AnythingOfType("mailer.MailData")).
+		Run(func(args mock.Arguments) { sent = args.Get(1).(mailer.MailData) }).
+		Return(nil)
+
+	// Act
+	err := s.sut.Send(ctx, alert, contact)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal("Example is under maintenance", sent.Subject)
+	s.Contains(sent.Content, "under maintenance")
+	s.NotContains(sent.Content, "Status code")
+}
+
+func (s *EmailNotificationSenderTestSuite) TestSend_SMTPFails_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	contact := s.newContact()
+	alert := service.MonitorAlert{Type: enum.NotificationTypeFailure, Monitor: s.newMonitor()}
+	sendErr := errors.New("smtp unavailable")
+
+	s.mailerSMTP.On("Send", mock.Anything, mock.AnythingOfType("mailer.MailData")).Return(sendErr)
+
+	// Act
+	err := s.sut.Send(ctx, alert, contact)
+
+	// Assert
+	s.Require().ErrorIs(err, sendErr)
+}
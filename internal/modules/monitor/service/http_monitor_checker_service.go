@@ -0,0 +1,262 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event/producer"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+)
+
+const (
+	defaultCheckTimeout = 30 * time.Second
+	// maxResponseBodySize limits how much of the response body is drained so the
+	// connection can be reused without reading arbitrarily large payloads.
+	maxResponseBodySize = 1 * 1024 * 1024
+)
+
+type HTTPMonitorCheckerServiceI interface {
+	CheckAll(ctx context.Context) error
+	Check(ctx context.Context, monitor model.HTTPMonitorModel) (model.HTTPMonitorCheckModel, error)
+}
+
+type HTTPMonitorCheckerService struct {
+	httpMonitorRepository      repository.HTTPMonitorRepositoryI
+	httpMonitorCheckRepository repository.HTTPMonitorCheckRepositoryI
+	httpMonitorIncidentService HTTPMonitorIncidentServiceI
+	checkCompletedProducer     producer.CheckCompletedProducerI
+	txManager                  database.TxManagerI
+	httpClient                 *http.Client
+	logger                     logger.Logger
+}
+
+var _ HTTPMonitorCheckerServiceI = (*HTTPMonitorCheckerService)(nil)
+
+func NewHTTPMonitorCheckerService(
+	httpMonitorRepository repository.HTTPMonitorRepositoryI,
+	httpMonitorCheckRepository repository.HTTPMonitorCheckRepositoryI,
+	httpMonitorIncidentService HTTPMonitorIncidentServiceI,
+	checkCompletedProducer producer.CheckCompletedProducerI,
+	txManager database.TxManagerI,
+	logger logger.Logger,
+) *HTTPMonitorCheckerService {
+	httpClient := &http.Client{
+		// the status code returned by the monitored endpoint is what gets validated,
+		// so redirects are not followed
+		CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	return &HTTPMonitorCheckerService{
+		httpMonitorRepository:      httpMonitorRepository,
+		httpMonitorCheckRepository: httpMonitorCheckRepository,
+		httpMonitorIncidentService: httpMonitorIncidentService,
+		checkCompletedProducer:     checkCompletedProducer,
+		txManager:                  txManager,
+		httpClient:                 httpClient,
+		logger:                     logger,
+	}
+}
+
+// CheckAll runs a single check for every enabled monitor.
+func (s *HTTPMonitorCheckerService) CheckAll(ctx context.Context) error {
+	ctx, span := trace.Span(ctx, "HTTPMonitorCheckerService.CheckAll")
+	defer span.End()
+
+	monitors, err := s.httpMonitorRepository.FindAllEnabled(ctx)
+	if err != nil {
+		s.logger.Error().Msgf("error finding enabled monitors: %v", err)
+		return err
+	}
+
+	var checkErrs []error
+	for _, monitor := range monitors {
+		if _, err = s.Check(ctx, monitor); err != nil {
+			checkErrs = append(checkErrs, err)
+		}
+	}
+
+	return errors.Join(checkErrs...)
+}
+
+// Check probes the monitor endpoint, records the check result with its CheckCompleted event,
+// updates the monitor status and tracks its incidents.
+// A failing endpoint is not an error, only failures to persist the result are returned.
+func (s *HTTPMonitorCheckerService) Check(
+	ctx context.Context,
+	monitor model.HTTPMonitorModel,
+) (model.HTTPMonitorCheckModel, error) {
+	ctx, span := trace.Span(ctx, "HTTPMonitorCheckerService.Check")
+	defer span.End()
+
+	check := s.probe(ctx, monitor)
+
+	status := enum.MonitorStatusUp
+	consecutiveFailures := 0
+	if !check.Success {
+		status = enum.MonitorStatusDown
+		consecutiveFailures = monitor.ConsecutiveFailures + 1
+	}
+
+	var createdCheck model.HTTPMonitorCheckModel
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		createdCheck, err = s.httpMonitorCheckRepository.Create(ctx, check)
+		if err != nil {
+			s.logger.Error().Msgf("error creating check for monitor ID %d: %v", monitor.ID, err)
+			return err
+		}
+
+		err = s.httpMonitorRepository.UpdateCheckResult(ctx, monitor.ID, check.CheckedAt, status, consecutiveFailures)
+		if err != nil {
+			s.logger.Error().Msgf("error updating check result for monitor ID %d: %v", monitor.ID, err)
+			return err
+		}
+
+		err = s.checkCompletedProducer.Produce(ctx, s.checkCompletedMessage(createdCheck))
+		if err != nil {
+			s.logger.Error().Msgf("error producing check completed event for monitor ID %d: %v", monitor.ID, err)
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return model.HTTPMonitorCheckModel{}, err
+	}
+
+	err = s.httpMonitorIncidentService.Track(ctx, monitor, createdCheck, consecutiveFailures)
+	if err != nil {
+		return model.HTTPMonitorCheckModel{}, err
+	}
+
+	return createdCheck, nil
+}
+
+func (s *HTTPMonitorCheckerService) probe(
+	ctx context.Context,
+	monitor model.HTTPMonitorModel,
+) model.HTTPMonitorCheckModel {
+	check := model.HTTPMonitorCheckModel{
+		HTTPMonitorID: monitor.ID,
+		CheckedAt:     time.Now().UTC(),
+	}
+
+	headers, err := s.parseRequestHeaders(monitor.RequestHeaders)
+	if err != nil {
+		check.ErrorMessage = sql.NullString{String: err.Error(), Valid: true}
+		return check
+	}
+
+	timeout := defaultCheckTimeout
+	if monitor.CheckTimeout > 0 {
+		timeout = time.Duration(monitor.CheckTimeout) * time.Second
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	method := strings.ToUpper(monitor.HTTPMethod)
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	req, err := http.NewRequestWithContext(reqCtx, method, monitor.HTTPURL, nil)
+	if err != nil {
+		check.ErrorMessage = sql.NullString{String: err.Error(), Valid: true}
+		return check
+	}
+
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	start := time.Now()
+	res, err := s.httpClient.Do(req)
+	elapsed := time.Since(start)
+	check.ResponseTimeMs = sql.NullInt32{Int32: s.toMilliseconds(elapsed), Valid: true}
+
+	if err != nil {
+		check.ErrorMessage = sql.NullString{String: err.Error(), Valid: true}
+		return check
+	}
+	defer res.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, maxResponseBodySize))
+
+	// status codes are bounded by the HTTP spec, the conversion can't overflow
+	statusCode := int32(res.StatusCode) // #nosec G115
+	check.StatusCode = sql.NullInt32{Int32: statusCode, Valid: true}
+	check.Success = s.isValidStatus(statusCode, monitor.ValidResponseStatuses)
+	if !check.Success {
+		message := fmt.Sprintf("unexpected response status code %d", res.StatusCode)
+		check.ErrorMessage = sql.NullString{String: message, Valid: true}
+	}
+
+	return check
+}
+
+func (s *HTTPMonitorCheckerService) checkCompletedMessage(
+	check model.HTTPMonitorCheckModel,
+) event.CheckCompletedMessage {
+	message := event.CheckCompletedMessage{
+		MonitorID: check.HTTPMonitorID,
+		CheckID:   check.ID,
+		CheckedAt: check.CheckedAt,
+		Success:   check.Success,
+	}
+	if check.StatusCode.Valid {
+		message.StatusCode = &check.StatusCode.Int32
+	}
+	if check.ResponseTimeMs.Valid {
+		message.ResponseTimeMs = &check.ResponseTimeMs.Int32
+	}
+	if check.ErrorMessage.Valid {
+		message.ErrorMessage = &check.ErrorMessage.String
+	}
+	return message
+}
+
+func (s *HTTPMonitorCheckerService) parseRequestHeaders(requestHeaders string) (map[string]string, error) {
+	headers := map[string]string{}
+	if strings.TrimSpace(requestHeaders) == "" {
+		return headers, nil
+	}
+
+	if err := json.Unmarshal([]byte(requestHeaders), &headers); err != nil {
+		return nil, fmt.Errorf("invalid request headers: %w", err)
+	}
+	return headers, nil
+}
+
+// isValidStatus accepts any 2xx status when the monitor has no valid statuses configured.
+func (s *HTTPMonitorCheckerService) isValidStatus(statusCode int32, validStatuses []int32) bool {
+	if len(validStatuses) == 0 {
+		return statusCode >= http.StatusOK && statusCode < http.StatusMultipleChoices
+	}
+	return slices.Contains(validStatuses, statusCode)
+}
+
+func (s *HTTPMonitorCheckerService) toMilliseconds(d time.Duration) int32 {
+	ms := d.Milliseconds()
+	if ms > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int32(ms) // #nosec G115
+}
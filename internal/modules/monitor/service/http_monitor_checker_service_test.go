@@ -0,0 +1,373 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event"
+	producer_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/event/producer/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/service"
+	service_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/service/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	database_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/database/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+)
+
+type HTTPMonitorCheckerServiceTestSuite struct {
+	suite.Suite
+	sut                            *service.HTTPMonitorCheckerService
+	httpMonitorRepositoryMock      *repository_mocks.MockHTTPMonitorRepositoryI
+	httpMonitorCheckRepositoryMock *repository_mocks.MockHTTPMonitorCheckRepositoryI
+	httpMonitorIncidentServiceMock *service_mocks.MockHTTPMonitorIncidentServiceI
+	checkCompletedProducerMock     *producer_mocks.MockCheckCompletedProducerI
+	txManagerMock                  *database_mocks.MockTxManagerI
+	logger                         logger.Logger
+}
+
+func (s *HTTPMonitorCheckerServiceTestSuite) SetupTest() {
+	cfg := config.Config{
+		Log: config.Log{
+			LogLevel: "disabled",
+		},
+	}
+	s.logger = logger.New(cfg)
+
+	s.httpMonitorRepositoryMock = repository_mocks.NewMockHTTPMonitorRepositoryI(s.T())
+	s.httpMonitorCheckRepositoryMock = repository_mocks.NewMockHTTPMonitorCheckRepositoryI(s.T())
+	s.httpMonitorIncidentServiceMock = service_mocks.NewMockHTTPMonitorIncidentServiceI(s.T())
+	s.checkCompletedProducerMock = producer_mocks.NewMockCheckCompletedProducerI(s.T())
+	s.txManagerMock = database_mocks.NewMockTxManagerI(s.T())
+	s.txManagerMock.On("WithinTransaction", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) }).
+		Maybe()
+
+	s.sut = service.NewHTTPMonitorCheckerService(
+		s.httpMonitorRepositoryMock,
+		s.httpMonitorCheckRepositoryMock,
+		s.httpMonitorIncidentServiceMock,
+		s.checkCompletedProducerMock,
+		s.txManagerMock,
+		s.logger,
+	)
+}
+
+func TestHTTPMonitorCheckerServiceSuite(t *testing.T) {
+	suite.Run(t, new(HTTPMonitorCheckerServiceTestSuite))
+}
+
+func (s *HTTPMonitorCheckerServiceTestSuite) newMonitor(url string) model.HTTPMonitorModel {
+	return model.HTTPMonitorModel{
+		ID:                    1,
+		Name:                  "test monitor",
+		CheckTimeout:          5,
+		FailThreshold:         3,
+		IsEnabled:             true,
+		HTTPURL:               url,
+		HTTPMethod:            http.MethodGet,
+		RequestHeaders:        `{"X-Api-Key": "secret"}`,
+		ValidResponseStatuses: pq.Int32Array{http.StatusOK},
+		ConsecutiveFailures:   2,
+	}
+}
+
+func (s *HTTPMonitorCheckerServiceTestSuite) expectCheckCreated(captured *model.HTTPMonitorCheckModel) {
+	s.httpMonitorCheckRepositoryMock.On("Create", mock.Anything, mock.AnythingOfType("model.HTTPMonitorCheckModel")).
+		Run(func(args mock.Arguments) {
+			check, ok := args.Get(1).(model.HTTPMonitorCheckModel)
+			s.Require().True(ok)
+			*captured = check
+		}).
+		Return(func(_ context.Context, check model.HTTPMonitorCheckModel) (model.HTTPMonitorCheckModel, error) {
+			check.ID = 10
+			return check, nil
+		})
+}
+
+func (s *HTTPMonitorCheckerServiceTestSuite) expectCheckCompleted() {
+	s.checkCompletedProducerMock.On("Produce", mock.Anything, mock.AnythingOfType("event.CheckCompletedMessage")).
+		Return(nil)
+}
+
+func (s *HTTPMonitorCheckerServiceTestSuite) TestCheck_ValidStatus_RecordsSuccessAndResetsFailures() {
+	// Arrange
+	ctx := context.Background()
+	var receivedMethod, receivedHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedMethod = r.Method
+		receivedHeader = r.Header.Get("X-Api-Key")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	monitor := s.newMonitor(server.URL)
+	var createdCheck model.HTTPMonitorCheckModel
+	var producedMessage event.CheckCompletedMessage
+	s.expectCheckCreated(&createdCheck)
+	s.httpMonitorRepositoryMock.On(
+		"UpdateCheckResult", mock.Anything, monitor.ID, mock.AnythingOfType("time.Time"), enum.MonitorStatusUp, 0,
+	).Return(nil)
+	s.checkCompletedProducerMock.On("Produce", mock.Anything, mock.AnythingOfType("event.CheckCompletedMessage")).
+		Run(func(args mock.Arguments) {
+			message, ok := args.Get(1).(event.CheckCompletedMessage)
+			s.Require().True(ok)
+			producedMessage = message
+		}).
+		Return(nil)
+	s.httpMonitorIncidentServiceMock.On(
+		"Track", mock.Anything, monitor, mock.AnythingOfType("model.HTTPMonitorCheckModel"), 0,
+	).Return(nil)
+
+	// Act
+	result, err := s.sut.Check(ctx, monitor)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(uint64(10), result.ID)
+	s.True(createdCheck.Success)
+	s.Equal(int32(http.StatusOK), createdCheck.StatusCode.Int32)
+	s.True(createdCheck.ResponseTimeMs.Valid)
+	s.False(createdCheck.ErrorMessage.Valid)
+	s.Equal(http.MethodGet, receivedMethod)
+	s.Equal("secret", receivedHeader)
+	s.Equal(monitor.ID, producedMessage.MonitorID)
+	s.Equal(uint64(10), producedMessage.CheckID)
+	s.True(producedMessage.Success)
+	s.Require().NotNil(producedMessage.StatusCode)
+	s.Equal(int32(http.StatusOK), *producedMessage.StatusCode)
+	s.Nil(producedMessage.ErrorMessage)
+}
+
+func (s *HTTPMonitorCheckerServiceTestSuite) TestCheck_InvalidStatus_RecordsFailureAndIncrementsFailures() {
+	// Arrange
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	monitor := s.newMonitor(server.URL)
+	var createdCheck model.HTTPMonitorCheckModel
+	s.expectCheckCreated(&createdCheck)
+	s.httpMonitorRepositoryMock.On(
+		"UpdateCheckResult", mock.Anything, monitor.ID, mock.AnythingOfType("time.Time"), enum.MonitorStatusDown, 3,
+	).Return(nil)
+	s.expectCheckCompleted()
+	s.httpMonitorIncidentServiceMock.On(
+		"Track", mock.Anything, monitor, mock.AnythingOfType("model.HTTPMonitorCheckModel"), 3,
+	).Return(nil)
+
+	// Act
+	_, err := s.sut.Check(ctx, monitor)
+
+	// Assert
+	s.Require().NoError(err)
+	s.False(createdCheck.Success)
+	s.Equal(int32(http.StatusInternalServerError), createdCheck.StatusCode.Int32)
+	s.Contains(createdCheck.ErrorMessage.String, "500")
+}
+
+func (s *HTTPMonitorCheckerServiceTestSuite) TestCheck_RedirectNotInValidStatuses_RecordsFailure() {
+	// Arrange
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/elsewhere", http.StatusMovedPermanently)
+	}))
+	defer server.Close()
+
+	monitor := s.newMonitor(server.URL)
+	var createdCheck model.HTTPMonitorCheckModel
+	s.expectCheckCreated(&createdCheck)
+	s.httpMonitorRepositoryMock.On(
+		"UpdateCheckResult", mock.Anything, monitor.ID, mock.AnythingOfType("time.Time"), enum.MonitorStatusDown, 3,
+	).Return(nil)
+	s.expectCheckCompleted()
+	s.httpMonitorIncidentServiceMock.On(
+		"Track", mock.Anything, monitor, mock.AnythingOfType("model.HTTPMonitorCheckModel"), 3,
+	).Return(nil)
+
+	// Act
+	_, err := s.sut.Check(ctx, monitor)
+
+	// Assert
+	s.Require().NoError(err)
+	s.False(createdCheck.Success)
+	s.Equal(int32(http.StatusMovedPermanently), createdCheck.StatusCode.Int32)
+}
+
+func (s *HTTPMonitorCheckerServiceTestSuite) TestCheck_Timeout_RecordsFailureWithoutStatusCode() {
+	// Arrange
+	ctx := context.Background()
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		select {
+		case <-release:
+		case <-time.After(5 * time.Second):
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	defer close(release)
+
+	monitor := s.newMonitor(server.URL)
+	monitor.CheckTimeout = 1
+	var createdCheck model.HTTPMonitorCheckModel
+	s.expectCheckCreated(&createdCheck)
+	s.httpMonitorRepositoryMock.On(
+		"UpdateCheckResult", mock.Anything, monitor.ID, mock.AnythingOfType("time.Time"), enum.MonitorStatusDown, 3,
+	).Return(nil)
+	s.expectCheckCompleted()
+	s.httpMonitorIncidentServiceMock.On(
+		"Track", mock.Anything, monitor, mock.AnythingOfType("model.HTTPMonitorCheckModel"), 3,
+	).Return(nil)
+
+	// Act
+	_, err := s.sut.Check(ctx, monitor)
+
+	// Assert
+	s.Require().NoError(err)
+	s.False(createdCheck.Success)
+	s.False(createdCheck.StatusCode.Valid)
+	s.True(createdCheck.ErrorMessage.Valid)
+}
+
+func (s *HTTPMonitorCheckerServiceTestSuite) TestCheck_InvalidRequestHeaders_RecordsFailure() {
+	// Arrange
+	ctx := context.Background()
+	monitor := s.newMonitor("http://127.0.0.1:1")
+	monitor.RequestHeaders = "not-json"
+	var createdCheck model.HTTPMonitorCheckModel
+	s.expectCheckCreated(&createdCheck)
+	s.httpMonitorRepositoryMock.On(
+		"UpdateCheckResult", mock.Anything, monitor.ID, mock.AnythingOfType("time.Time"), enum.MonitorStatusDown, 3,
+	).Return(nil)
+	s.expectCheckCompleted()
+	s.httpMonitorIncidentServiceMock.On(
+		"Track", mock.Anything, monitor, mock.AnythingOfType("model.HTTPMonitorCheckModel"), 3,
+	).Return(nil)
+
+	// Act
+	_, err := s.sut.Check(ctx, monitor)
+
+	// Assert
+	s.Require().NoError(err)
+	s.False(createdCheck.Success)
+	s.Contains(createdCheck.ErrorMessage.String, "invalid request headers")
+}
+
+func (s *HTTPMonitorCheckerServiceTestSuite) TestCheck_CreateCheckFails_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	monitor := s.newMonitor(server.URL)
+	createErr := errors.New("database error")
+	s.httpMonitorCheckRepositoryMock.On("Create", mock.Anything, mock.AnythingOfType("model.HTTPMonitorCheckModel")).
+		Return(model.HTTPMonitorCheckModel{}, createErr)
+
+	// Act
+	_, err := s.sut.Check(ctx, monitor)
+
+	// Assert
+	s.Require().ErrorIs(err, createErr)
+}
+
+func (s *HTTPMonitorCheckerServiceTestSuite) TestCheck_ProduceCheckCompletedFails_ReturnsErrorWithoutTracking() {
+	// Arrange
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	monitor := s.newMonitor(server.URL)
+	produceErr := errors.New("outbox error")
+	var createdCheck model.HTTPMonitorCheckModel
+	s.expectCheckCreated(&createdCheck)
+	s.httpMonitorRepositoryMock.On(
+		"UpdateCheckResult", mock.Anything, monitor.ID, mock.AnythingOfType("time.Time"), enum.MonitorStatusUp, 0,
+	).Return(nil)
+	s.checkCompletedProducerMock.On("Produce", mock.Anything, mock.AnythingOfType("event.CheckCompletedMessage")).
+		Return(produceErr)
+
+	// Act
+	_, err := s.sut.Check(ctx, monitor)
+
+	// Assert
+	s.Require().ErrorIs(err, produceErr)
+	s.httpMonitorIncidentServiceMock.AssertNotCalled(
+		s.T(), "Track", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	)
+}
+
+func (s *HTTPMonitorCheckerServiceTestSuite) TestCheck_TrackIncidentFails_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	monitor := s.newMonitor(server.URL)
+	trackErr := errors.New("database error")
+	var createdCheck model.HTTPMonitorCheckModel
+	s.expectCheckCreated(&createdCheck)
+	s.httpMonitorRepositoryMock.On(
+		"UpdateCheckResult", mock.Anything, monitor.ID, mock.AnythingOfType("time.Time"), enum.MonitorStatusDown, 3,
+	).Return(nil)
+	s.expectCheckCompleted()
+	s.httpMonitorIncidentServiceMock.On(
+		"Track", mock.Anything, monitor, mock.AnythingOfType("model.HTTPMonitorCheckModel"), 3,
+	).Return(trackErr)
+
+	// Act
+	_, err := s.sut.Check(ctx, monitor)
+
+	// Assert
+	s.Require().ErrorIs(err, trackErr)
+}
+
+func (s *HTTPMonitorCheckerServiceTestSuite) TestCheckAll_EnabledMonitors_ChecksEachMonitor() {
+	// Arrange
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	firstMonitor := s.newMonitor(server.URL)
+	secondMonitor := s.newMonitor(server.URL)
+	secondMonitor.ID = 2
+	monitors := []model.HTTPMonitorModel{firstMonitor, secondMonitor}
+
+	var createdCheck model.HTTPMonitorCheckModel
+	s.httpMonitorRepositoryMock.On("FindAllEnabled", mock.Anything).Return(monitors, nil)
+	s.expectCheckCreated(&createdCheck)
+	s.httpMonitorRepositoryMock.On(
+		"UpdateCheckResult", mock.Anything, mock.Anything, mock.AnythingOfType("time.Time"), enum.MonitorStatusUp, 0,
+	).Return(nil).Twice()
+	s.checkCompletedProducerMock.On("Produce", mock.Anything, mock.AnythingOfType("event.CheckCompletedMessage")).
+		Return(nil).Twice()
+	s.httpMonitorIncidentServiceMock.On(
+		"Track", mock.Anything, mock.Anything, mock.AnythingOfType("model.HTTPMonitorCheckModel"), 0,
+	).Return(nil).Twice()
+
+	// Act
+	err := s.sut.CheckAll(ctx)
+
+	// Assert
+	s.Require().NoError(err)
+}
@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event/producer"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+)
+
+// timeoutErrorMessages are the fragments the HTTP client uses when a request runs out of time.
+var timeoutErrorMessages = []string{
+	"context deadline exceeded",
+	"Client.Timeout exceeded",
+	"i/o timeout",
+}
+
+type HTTPMonitorIncidentServiceI interface {
+	Track(
+		ctx context.Context,
+		monitor model.HTTPMonitorModel,
+		check model.HTTPMonitorCheckModel,
+		consecutiveFailures int,
+	) error
+}
+
+type HTTPMonitorIncidentService struct {
+	incidentRepository         repository.IncidentRepositoryI
+	httpMonitorCheckRepository repository.HTTPMonitorCheckRepositoryI
+	notificationDispatcher     NotificationDispatcherServiceI
+	monitorDownProducer        producer.MonitorDownProducerI
+	monitorRecoveredProducer   producer.MonitorRecoveredProducerI
+	txManager                  database.TxManagerI
+	logger                     logger.Logger
+}
+
+var _ HTTPMonitorIncidentServiceI = (*HTTPMonitorIncidentService)(nil)
+
+func NewHTTPMonitorIncidentService(
+	incidentRepository repository.IncidentRepositoryI,
+	httpMonitorCheckRepository repository.HTTPMonitorCheckRepositoryI,
+	notificationDispatcher NotificationDispatcherServiceI,
+	monitorDownProducer producer.MonitorDownProducerI,
+	monitorRecoveredProducer producer.MonitorRecoveredProducerI,
+	txManager database.TxManagerI,
+	logger logger.Logger,
+) *HTTPMonitorIncidentService {
+	return &HTTPMonitorIncidentService{
+		incidentRepository:         incidentRepository,
+		httpMonitorCheckRepository: httpMonitorCheckRepository,
+		notificationDispatcher:     notificationDispatcher,
+		monitorDownProducer:        monitorDownProducer,
+		monitorRecoveredProducer:   monitorRecoveredProducer,
+		txManager:                  txManager,
+		logger:                     logger,
+	}
+}
+
+// Track opens an incident once the failures of the monitor reach its threshold and resolves
+// the open incident on the first successful check, publishing MonitorDown and MonitorRecovered
+// and notifying the monitor contacts of both.
+// The monitor is the state before the check, consecutiveFailures is the count after it.
+func (s *HTTPMonitorIncidentService) Track(
+	ctx context.Context,
+	monitor model.HTTPMonitorModel,
+	check model.HTTPMonitorCheckModel,
+	consecutiveFailures int,
+) error {
+	ctx, span := trace.Span(ctx, "HTTPMonitorIncidentService.Track")
+	defer span.End()
+
+	// the open incident decides, not the failure counter: the counter is already reset when
+	// resolving the incident failed on an earlier successful check
+	if check.Success {
+		return s.resolve(ctx, monitor, check)
+	}
+
+	if consecutiveFailures < max(int(monitor.FailThreshold), 1) {
+		return nil
+	}
+
+	return s.open(ctx, monitor, check)
+}
+
+func (s *HTTPMonitorIncidentService) open(
+	ctx context.Context,
+	monitor model.HTTPMonitorModel,
+	check model.HTTPMonitorCheckModel,
+) error {
+	_, err := s.incidentRepository.FindOpenByMonitorID(ctx, monitor.ID)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, shared_errs.ErrRecordNotFound) {
+		s.logger.Error().Msgf("error finding open incident for monitor ID %d: %v", monitor.ID, err)
+		return err
+	}
+
+	firstFailedCheck, err := s.httpMonitorCheckRepository.FindFirstFailedSinceLastSuccess(ctx, monitor.ID)
+	if err != nil {
+		if !errors.Is(err, shared_errs.ErrRecordNotFound) {
+			s.logger.Error().Msgf("error finding first failed check for monitor ID %d: %v", monitor.ID, err)
+			return err
+		}
+		firstFailedCheck = check
+	}
+
+	incident := model.IncidentModel{
+		HTTPMonitorID:      monitor.ID,
+		FirstFailedCheckID: s.checkID(firstFailedCheck),
+		Cause:              s.cause(firstFailedCheck),
+		StatusCode:         firstFailedCheck.StatusCode,
+		ErrorMessage:       firstFailedCheck.ErrorMessage,
+		StartedAt:          firstFailedCheck.CheckedAt,
+	}
+
+	// a failure to publish the event rolls the incident back, it's opened again on the next check
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		incident, err = s.incidentRepository.Create(ctx, incident)
+		if err != nil {
+			s.logger.Error().Msgf("error creating incident for monitor ID %d: %v", monitor.ID, err)
+			return err
+		}
+
+		err = s.monitorDownProducer.Produce(ctx, s.monitorDownMessage(monitor, incident))
+		if err != nil {
+			s.logger.Error().Msgf("error producing monitor down event for monitor ID %d: %v", monitor.ID, err)
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.notificationDispatcher.Dispatch(ctx, MonitorAlert{
+		Type:     enum.NotificationTypeFailure,
+		Monitor:  monitor,
+		Check:    check,
+		Incident: incident,
+	})
+}
+
+func (s *HTTPMonitorIncidentService) resolve(
+	ctx context.Context,
+	monitor model.HTTPMonitorModel,
+	check model.HTTPMonitorCheckModel,
+) error {
+	incident, err := s.incidentRepository.FindOpenByMonitorID(ctx, monitor.ID)
+	if err != nil {
+		// failures below the threshold don't open an incident
+		if errors.Is(err, shared_errs.ErrRecordNotFound) {
+			return nil
+		}
+		s.logger.Error().Msgf("error finding open incident for monitor ID %d: %v", monitor.ID, err)
+		return err
+	}
+
+	duration := max(check.CheckedAt.Sub(incident.StartedAt), 0)
+	incident.ResolvedAt = sql.NullTime{Time: check.CheckedAt, Valid: true}
+	incident.RecoveryCheckID = s.checkID(check)
+	incident.DurationSeconds = sql.NullInt64{Int64: int64(duration.Seconds()), Valid: true}
+
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		err = s.incidentRepository.Resolve(ctx, incident)
+		if err != nil {
+			s.logger.Error().Msgf("error resolving incident ID %d: %v", incident.ID, err)
+			return err
+		}
+
+		err = s.monitorRecoveredProducer.Produce(ctx, event.MonitorRecoveredMessage{
+			MonitorID:       monitor.ID,
+			MonitorName:     monitor.Name,
+			IncidentID:      incident.ID,
+			StartedAt:       incident.StartedAt,
+			ResolvedAt:      incident.ResolvedAt.Time,
+			DurationSeconds: incident.DurationSeconds.Int64,
+		})
+		if err != nil {
+			s.logger.Error().Msgf("error producing monitor recovered event for monitor ID %d: %v", monitor.ID, err)
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.notificationDispatcher.Dispatch(ctx, MonitorAlert{
+		Type:     enum.NotificationTypeRecovery,
+		Monitor:  monitor,
+		Check:    check,
+		Incident: incident,
+	})
+}
+
+func (s *HTTPMonitorIncidentService) monitorDownMessage(
+	monitor model.HTTPMonitorModel,
+	incident model.IncidentModel,
+) event.MonitorDownMessage {
+	message := event.MonitorDownMessage{
+		MonitorID:   monitor.ID,
+		MonitorName: monitor.Name,
+		IncidentID:  incident.ID,
+		Cause:       incident.Cause,
+		StartedAt:   incident.StartedAt,
+	}
+	if incident.StatusCode.Valid {
+		message.StatusCode = &incident.StatusCode.Int32
+	}
+	if incident.ErrorMessage.Valid {
+		message.ErrorMessage = &incident.ErrorMessage.String
+	}
+	return message
+}
+
+// cause classifies a failed check, the check only keeps the error message of the request.
+func (s *HTTPMonitorIncidentService) cause(check model.HTTPMonitorCheckModel) string {
+	if check.StatusCode.Valid {
+		return enum.IncidentCauseUnexpectedStatus
+	}
+
+	for _, message := range timeoutErrorMessages {
+		if strings.Contains(check.ErrorMessage.String, message) {
+			return enum.IncidentCauseTimeout
+		}
+	}
+
+	return enum.IncidentCauseRequestError
+}
+
+func (s *HTTPMonitorIncidentService) checkID(check model.HTTPMonitorCheckModel) sql.NullInt64 {
+	// check IDs come from a BIGSERIAL column, the conversion can't overflow
+	return sql.NullInt64{Int64: int64(check.ID), Valid: check.ID != 0} // #nosec G115
+}
@@ -0,0 +1,359 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event"
+	producer_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/event/producer/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/service"
+	service_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/service/mocks"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	database_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/database/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+)
+
+type HTTPMonitorIncidentServiceTestSuite struct {
+	suite.Suite
+	sut                            *service.HTTPMonitorIncidentService
+	incidentRepositoryMock         *repository_mocks.MockIncidentRepositoryI
+	httpMonitorCheckRepositoryMock *repository_mocks.MockHTTPMonitorCheckRepositoryI
+	notificationDispatcherMock     *service_mocks.MockNotificationDispatcherServiceI
+	monitorDownProducerMock        *producer_mocks.MockMonitorDownProducerI
+	monitorRecoveredProducerMock   *producer_mocks.MockMonitorRecoveredProducerI
+	txManagerMock                  *database_mocks.MockTxManagerI
+	logger                         logger.Logger
+}
+
+func (s *HTTPMonitorIncidentServiceTestSuite) SetupTest() {
+	cfg := config.Config{
+		Log: config.Log{
+			LogLevel: "disabled",
+		},
+	}
+	s.logger = logger.New(cfg)
+
+	s.incidentRepositoryMock = repository_mocks.NewMockIncidentRepositoryI(s.T())
+	s.httpMonitorCheckRepositoryMock = repository_mocks.NewMockHTTPMonitorCheckRepositoryI(s.T())
+	s.notificationDispatcherMock = service_mocks.NewMockNotificationDispatcherServiceI(s.T())
+	s.monitorDownProducerMock = producer_mocks.NewMockMonitorDownProducerI(s.T())
+	s.monitorRecoveredProducerMock = producer_mocks.NewMockMonitorRecoveredProducerI(s.T())
+	s.txManagerMock = database_mocks.NewMockTxManagerI(s.T())
+	s.txManagerMock.On("WithinTransaction", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) }).
+		Maybe()
+
+	s.sut = service.NewHTTPMonitorIncidentService(
+		s.incidentRepositoryMock,
+		s.httpMonitorCheckRepositoryMock,
+		s.notificationDispatcherMock,
+		s.monitorDownProducerMock,
+		s.monitorRecoveredProducerMock,
+		s.txManagerMock,
+		s.logger,
+	)
+}
+
+func TestHTTPMonitorIncidentServiceSuite(t *testing.T) {
+	suite.Run(t, new(HTTPMonitorIncidentServiceTestSuite))
+}
+
+func (s *HTTPMonitorIncidentServiceTestSuite) newMonitor(consecutiveFailures int) model.HTTPMonitorModel {
+	return model.HTTPMonitorModel{
+		ID:                  1,
+		FailThreshold:       3,
+		ConsecutiveFailures: consecutiveFailures,
+	}
+}
+
+func (s *HTTPMonitorIncidentServiceTestSuite) newFailedCheck(
+	id uint64,
+	checkedAt time.Time,
+) model.HTTPMonitorCheckModel {
+	return model.HTTPMonitorCheckModel{
+		ID:            id,
+		HTTPMonitorID: 1,
+		CheckedAt:     checkedAt,
+		StatusCode:    sql.NullInt32{Int32: 503, Valid: true},
+		ErrorMessage:  sql.NullString{String: "unexpected response status code 503", Valid: true},
+	}
+}
+
+func (s *HTTPMonitorIncidentServiceTestSuite) TestTrack_ThresholdReached_OpensIncidentAtFirstFailedCheck() {
+	// Arrange
+	ctx := context.Background()
+	monitor := s.newMonitor(2)
+	startedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	firstFailedCheck := s.newFailedCheck(7, startedAt)
+	check := s.newFailedCheck(9, startedAt.Add(2*time.Minute))
+
+	expectedIncident := model.IncidentModel{
+		HTTPMonitorID:      monitor.ID,
+		FirstFailedCheckID: sql.NullInt64{Int64: 7, Valid: true},
+		Cause:              enum.IncidentCauseUnexpectedStatus,
+		StatusCode:         firstFailedCheck.StatusCode,
+		ErrorMessage:       firstFailedCheck.ErrorMessage,
+		StartedAt:          startedAt,
+	}
+	createdIncident := expectedIncident
+	createdIncident.ID = 4
+	statusCode := int32(503)
+	errorMessage := "unexpected response status code 503"
+
+	s.incidentRepositoryMock.On("FindOpenByMonitorID", mock.Anything, monitor.ID).
+		Return(model.IncidentModel{}, shared_errs.ErrRecordNotFound)
+	s.httpMonitorCheckRepositoryMock.On("FindFirstFailedSinceLastSuccess", mock.Anything, monitor.ID).
+		Return(firstFailedCheck, nil)
+	s.incidentRepositoryMock.On("Create", mock.Anything, expectedIncident).Return(createdIncident, nil)
+	s.monitorDownProducerMock.On("Produce", mock.Anything, event.MonitorDownMessage{
+		MonitorID:    monitor.ID,
+		IncidentID:   createdIncident.ID,
+		Cause:        enum.IncidentCauseUnexpectedStatus,
+		StatusCode:   &statusCode,
+		ErrorMessage: &errorMessage,
+		StartedAt:    startedAt,
+	}).Return(nil)
+	s.notificationDispatcherMock.On("Dispatch", mock.Anything, service.MonitorAlert{
+		Type:     enum.NotificationTypeFailure,
+		Monitor:  monitor,
+		Check:    check,
+		Incident: createdIncident,
+	}).Return(nil)
+
+	// Act
+	err := s.sut.Track(ctx, monitor, check, 3)
+
+	// Assert
+	s.Require().NoError(err)
+}
+
+func (s *HTTPMonitorIncidentServiceTestSuite) TestTrack_BelowThreshold_DoesNothing() {
+	// Arrange
+	ctx := context.Background()
+	monitor := s.newMonitor(0)
+	check := s.newFailedCheck(9, time.Now().UTC())
+
+	// Act
+	err := s.sut.Track(ctx, monitor, check, 1)
+
+	// Assert
+	s.Require().NoError(err)
+	s.incidentRepositoryMock.AssertNotCalled(s.T(), "FindOpenByMonitorID", mock.Anything, mock.Anything)
+}
+
+func (s *HTTPMonitorIncidentServiceTestSuite) TestTrack_IncidentAlreadyOpen_DoesNotOpenAnother() {
+	// Arrange
+	ctx := context.Background()
+	monitor := s.newMonitor(5)
+	check := s.newFailedCheck(9, time.Now().UTC())
+
+	s.incidentRepositoryMock.On("FindOpenByMonitorID", mock.Anything, monitor.ID).
+		Return(model.IncidentModel{ID: 4, HTTPMonitorID: monitor.ID}, nil)
+
+	// Act
+	err := s.sut.Track(ctx, monitor, check, 6)
+
+	// Assert
+	s.Require().NoError(err)
+	s.incidentRepositoryMock.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+	s.notificationDispatcherMock.AssertNotCalled(s.T(), "Dispatch", mock.Anything, mock.Anything)
+}
+
+func (s *HTTPMonitorIncidentServiceTestSuite) TestTrack_TimeoutWithoutStatusCode_OpensTimeoutIncident() {
+	// Arrange
+	ctx := context.Background()
+	monitor := s.newMonitor(2)
+	check := model.HTTPMonitorCheckModel{
+		ID:            9,
+		HTTPMonitorID: monitor.ID,
+		CheckedAt:     time.Now().UTC(),
+		ErrorMessage: sql.NullString{
+			String: `Get "https://example.com": context deadline exceeded`,
+			Valid:  true,
+		},
+	}
+
+	s.incidentRepositoryMock.On("FindOpenByMonitorID", mock.Anything, monitor.ID).
+		Return(model.IncidentModel{}, shared_errs.ErrRecordNotFound)
+	s.httpMonitorCheckRepositoryMock.On("FindFirstFailedSinceLastSuccess", mock.Anything, monitor.ID).
+		Return(check, nil)
+	s.incidentRepositoryMock.On("Create", mock.Anything, mock.MatchedBy(func(incident model.IncidentModel) bool {
+		return incident.Cause == enum.IncidentCauseTimeout && !incident.StatusCode.Valid
+	})).Return(model.IncidentModel{ID: 4}, nil)
+	s.monitorDownProducerMock.On("Produce", mock.Anything, mock.AnythingOfType("event.MonitorDownMessage")).
+		Return(nil)
+	s.notificationDispatcherMock.On("Dispatch", mock.Anything, mock.AnythingOfType("service.MonitorAlert")).
+		Return(nil)
+
+	// Act
+	err := s.sut.Track(ctx, monitor, check, 3)
+
+	// Assert
+	s.Require().NoError(err)
+}
+
+func (s *HTTPMonitorIncidentServiceTestSuite) TestTrack_Recovery_ResolvesOpenIncidentWithDuration() {
+	// Arrange
+	ctx := context.Background()
+	monitor := s.newMonitor(4)
+	startedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	check := model.HTTPMonitorCheckModel{
+		ID:            12,
+		HTTPMonitorID: monitor.ID,
+		CheckedAt:     startedAt.Add(10 * time.Minute),
+		Success:       true,
+	}
+	openIncident := model.IncidentModel{ID: 4, HTTPMonitorID: monitor.ID, StartedAt: startedAt}
+
+	s.incidentRepositoryMock.On("FindOpenByMonitorID", mock.Anything, monitor.ID).Return(openIncident, nil)
+	s.incidentRepositoryMock.On("Resolve", mock.Anything, mock.MatchedBy(func(incident model.IncidentModel) bool {
+		return incident.ID == openIncident.ID &&
+			incident.ResolvedAt.Time.Equal(check.CheckedAt) &&
+			incident.RecoveryCheckID.Int64 == 12 &&
+			incident.DurationSeconds.Int64 == 600
+	})).Return(nil)
+	s.monitorRecoveredProducerMock.On("Produce", mock.Anything, event.MonitorRecoveredMessage{
+		MonitorID:       monitor.ID,
+		IncidentID:      openIncident.ID,
+		StartedAt:       startedAt,
+		ResolvedAt:      check.CheckedAt,
+		DurationSeconds: 600,
+	}).Return(nil)
+	s.notificationDispatcherMock.On("Dispatch", mock.Anything, mock.MatchedBy(func(alert service.MonitorAlert) bool {
+		return alert.Type == enum.NotificationTypeRecovery &&
+			alert.Incident.ID == openIncident.ID &&
+			alert.Incident.DurationSeconds.Int64 == 600
+	})).Return(nil)
+
+	// Act
+	err := s.sut.Track(ctx, monitor, check, 0)
+
+	// Assert
+	s.Require().NoError(err)
+}
+
+func (s *HTTPMonitorIncidentServiceTestSuite) TestTrack_RecoveryBelowThreshold_DoesNothing() {
+	// Arrange
+	ctx := context.Background()
+	monitor := s.newMonitor(1)
+	check := model.HTTPMonitorCheckModel{ID: 12, HTTPMonitorID: monitor.ID, Success: true}
+
+	s.incidentRepositoryMock.On("FindOpenByMonitorID", mock.Anything, monitor.ID).
+		Return(model.IncidentModel{}, shared_errs.ErrRecordNotFound)
+
+	// Act
+	err := s.sut.Track(ctx, monitor, check, 0)
+
+	// Assert
+	s.Require().NoError(err)
+	s.incidentRepositoryMock.AssertNotCalled(s.T(), "Resolve", mock.Anything, mock.Anything)
+}
+
+func (s *HTTPMonitorIncidentServiceTestSuite) TestTrack_ResolveFailedOnEarlierRecovery_ResolvesOnNextSuccess() {
+	// Arrange
+	ctx := context.Background()
+	// the failure counter was reset by the earlier successful check whose resolve failed
+	monitor := s.newMonitor(0)
+	startedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	check := model.HTTPMonitorCheckModel{
+		ID:            13,
+		HTTPMonitorID: monitor.ID,
+		CheckedAt:     startedAt.Add(15 * time.Minute),
+		Success:       true,
+	}
+	openIncident := model.IncidentModel{ID: 4, HTTPMonitorID: monitor.ID, StartedAt: startedAt}
+
+	s.incidentRepositoryMock.On("FindOpenByMonitorID", mock.Anything, monitor.ID).Return(openIncident, nil)
+	s.incidentRepositoryMock.On("Resolve", mock.Anything, mock.MatchedBy(func(incident model.IncidentModel) bool {
+		return incident.ID == openIncident.ID && incident.RecoveryCheckID.Int64 == 13
+	})).Return(nil)
+	s.monitorRecoveredProducerMock.On("Produce", mock.Anything, mock.Anything).Return(nil)
+	s.notificationDispatcherMock.On("Dispatch", mock.Anything, mock.Anything).Return(nil)
+
+	// Act
+	err := s.sut.Track(ctx, monitor, check, 0)
+
+	// Assert
+	s.Require().NoError(err)
+	s.incidentRepositoryMock.AssertCalled(s.T(), "Resolve", mock.Anything, mock.Anything)
+}
+
+func (s *HTTPMonitorIncidentServiceTestSuite) TestTrack_CreateFails_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	monitor := s.newMonitor(2)
+	check := s.newFailedCheck(9, time.Now().UTC())
+	createErr := errors.New("database error")
+
+	s.incidentRepositoryMock.On("FindOpenByMonitorID", mock.Anything, monitor.ID).
+		Return(model.IncidentModel{}, shared_errs.ErrRecordNotFound)
+	s.httpMonitorCheckRepositoryMock.On("FindFirstFailedSinceLastSuccess", mock.Anything, monitor.ID).
+		Return(check, nil)
+	s.incidentRepositoryMock.On("Create", mock.Anything, mock.AnythingOfType("model.IncidentModel")).
+		Return(model.IncidentModel{}, createErr)
+
+	// Act
+	err := s.sut.Track(ctx, monitor, check, 3)
+
+	// Assert
+	s.Require().ErrorIs(err, createErr)
+}
+
+func (s *HTTPMonitorIncidentServiceTestSuite) TestTrack_ProduceMonitorDownFails_ReturnsErrorWithoutDispatching() {
+	// Arrange
+	ctx := context.Background()
+	monitor := s.newMonitor(2)
+	check := s.newFailedCheck(9, time.Now().UTC())
+	produceErr := errors.New("outbox error")
+
+	s.incidentRepositoryMock.On("FindOpenByMonitorID", mock.Anything, monitor.ID).
+		Return(model.IncidentModel{}, shared_errs.ErrRecordNotFound)
+	s.httpMonitorCheckRepositoryMock.On("FindFirstFailedSinceLastSuccess", mock.Anything, monitor.ID).
+		Return(check, nil)
+	s.incidentRepositoryMock.On("Create", mock.Anything, mock.AnythingOfType("model.IncidentModel")).
+		Return(model.IncidentModel{ID: 4}, nil)
+	s.monitorDownProducerMock.On("Produce", mock.Anything, mock.AnythingOfType("event.MonitorDownMessage")).
+		Return(produceErr)
+
+	// Act
+	err := s.sut.Track(ctx, monitor, check, 3)
+
+	// Assert
+	s.Require().ErrorIs(err, produceErr)
+	s.notificationDispatcherMock.AssertNotCalled(s.T(), "Dispatch", mock.Anything, mock.Anything)
+}
+
+func (s *HTTPMonitorIncidentServiceTestSuite) TestTrack_DispatchFails_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	monitor := s.newMonitor(2)
+	check := s.newFailedCheck(9, time.Now().UTC())
+	dispatchErr := errors.New("database error")
+
+	s.incidentRepositoryMock.On("FindOpenByMonitorID", mock.Anything, monitor.ID).
+		Return(model.IncidentModel{}, shared_errs.ErrRecordNotFound)
+	s.httpMonitorCheckRepositoryMock.On("FindFirstFailedSinceLastSuccess", mock.Anything, monitor.ID).
+		Return(check, nil)
+	s.incidentRepositoryMock.On("Create", mock.Anything, mock.AnythingOfType("model.IncidentModel")).
+		Return(model.IncidentModel{ID: 4}, nil)
+	s.monitorDownProducerMock.On("Produce", mock.Anything, mock.AnythingOfType("event.MonitorDownMessage")).
+		Return(nil)
+	s.notificationDispatcherMock.On("Dispatch", mock.Anything, mock.AnythingOfType("service.MonitorAlert")).
+		Return(dispatchErr)
+
+	// Act
+	err := s.sut.Track(ctx, monitor, check, 3)
+
+	// Assert
+	s.Require().ErrorIs(err, dispatchErr)
+}
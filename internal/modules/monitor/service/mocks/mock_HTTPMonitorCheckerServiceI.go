@@ -0,0 +1,140 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockHTTPMonitorCheckerServiceI is an autogenerated mock type for the HTTPMonitorCheckerServiceI type
+type MockHTTPMonitorCheckerServiceI struct {
+	mock.Mock
+}
+
+type MockHTTPMonitorCheckerServiceI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockHTTPMonitorCheckerServiceI) EXPECT() *MockHTTPMonitorCheckerServiceI_Expecter {
+	return &MockHTTPMonitorCheckerServiceI_Expecter{mock: &_m.Mock}
+}
+
+// Check provides a mock function with given fields: ctx, monitor
+func (_m *MockHTTPMonitorCheckerServiceI) Check(ctx context.Context, monitor model.HTTPMonitorModel) (model.HTTPMonitorCheckModel, error) {
+	ret := _m.Called(ctx, monitor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 model.HTTPMonitorCheckModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.HTTPMonitorModel) (model.HTTPMonitorCheckModel, error)); ok {
+		return rf(ctx, monitor)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.HTTPMonitorModel) model.HTTPMonitorCheckModel); ok {
+		r0 = rf(ctx, monitor)
+	} else {
+		r0 = ret.Get(0).(model.HTTPMonitorCheckModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.HTTPMonitorModel) error); ok {
+		r1 = rf(ctx, monitor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockHTTPMonitorCheckerServiceI_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type MockHTTPMonitorCheckerServiceI_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - ctx context.Context
+//   - monitor model.HTTPMonitorModel
+func (_e *MockHTTPMonitorCheckerServiceI_Expecter) Check(ctx interface{}, monitor interface{}) *MockHTTPMonitorCheckerServiceI_Check_Call {
+	return &MockHTTPMonitorCheckerServiceI_Check_Call{Call: _e.mock.On("Check", ctx, monitor)}
+}
+
+func (_c *MockHTTPMonitorCheckerServiceI_Check_Call) Run(run func(ctx context.Context, monitor model.HTTPMonitorModel)) *MockHTTPMonitorCheckerServiceI_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.HTTPMonitorModel))
+	})
+	return _c
+}
+
+func (_c *MockHTTPMonitorCheckerServiceI_Check_Call) Return(_a0 model.HTTPMonitorCheckModel, _a1 error) *MockHTTPMonitorCheckerServiceI_Check_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockHTTPMonitorCheckerServiceI_Check_Call) RunAndReturn(run func(context.Context, model.HTTPMonitorModel) (model.HTTPMonitorCheckModel, error)) *MockHTTPMonitorCheckerServiceI_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckAll provides a mock function with given fields: ctx
+func (_m *MockHTTPMonitorCheckerServiceI) CheckAll(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockHTTPMonitorCheckerServiceI_CheckAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckAll'
+type MockHTTPMonitorCheckerServiceI_CheckAll_Call struct {
+	*mock.Call
+}
+
+// CheckAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockHTTPMonitorCheckerServiceI_Expecter) CheckAll(ctx interface{}) *MockHTTPMonitorCheckerServiceI_CheckAll_Call {
+	return &MockHTTPMonitorCheckerServiceI_CheckAll_Call{Call: _e.mock.On("CheckAll", ctx)}
+}
+
+func (_c *MockHTTPMonitorCheckerServiceI_CheckAll_Call) Run(run func(ctx context.Context)) *MockHTTPMonitorCheckerServiceI_CheckAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockHTTPMonitorCheckerServiceI_CheckAll_Call) Return(_a0 error) *MockHTTPMonitorCheckerServiceI_CheckAll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockHTTPMonitorCheckerServiceI_CheckAll_Call) RunAndReturn(run func(context.Context) error) *MockHTTPMonitorCheckerServiceI_CheckAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockHTTPMonitorCheckerServiceI creates a new instance of MockHTTPMonitorCheckerServiceI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockHTTPMonitorCheckerServiceI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockHTTPMonitorCheckerServiceI {
+	mock := &MockHTTPMonitorCheckerServiceI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
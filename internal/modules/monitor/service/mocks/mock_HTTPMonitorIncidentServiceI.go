@@ -0,0 +1,86 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockHTTPMonitorIncidentServiceI is an autogenerated mock type for the HTTPMonitorIncidentServiceI type
+type MockHTTPMonitorIncidentServiceI struct {
+	mock.Mock
+}
+
+type MockHTTPMonitorIncidentServiceI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockHTTPMonitorIncidentServiceI) EXPECT() *MockHTTPMonitorIncidentServiceI_Expecter {
+	return &MockHTTPMonitorIncidentServiceI_Expecter{mock: &_m.Mock}
+}
+
+// Track provides a mock function with given fields: ctx, monitor, check, consecutiveFailures
+func (_m *MockHTTPMonitorIncidentServiceI) Track(ctx context.Context, monitor model.HTTPMonitorModel, check model.HTTPMonitorCheckModel, consecutiveFailures int) error {
+	ret := _m.Called(ctx, monitor, check, consecutiveFailures)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Track")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.HTTPMonitorModel, model.HTTPMonitorCheckModel, int) error); ok {
+		r0 = rf(ctx, monitor, check, consecutiveFailures)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockHTTPMonitorIncidentServiceI_Track_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Track'
+type MockHTTPMonitorIncidentServiceI_Track_Call struct {
+	*mock.Call
+}
+
+// Track is a helper method to define mock.On call
+//   - ctx context.Context
+//   - monitor model.HTTPMonitorModel
+//   - check model.HTTPMonitorCheckModel
+//   - consecutiveFailures int
+func (_e *MockHTTPMonitorIncidentServiceI_Expecter) Track(ctx interface{}, monitor interface{}, check interface{}, consecutiveFailures interface{}) *MockHTTPMonitorIncidentServiceI_Track_Call {
+	return &MockHTTPMonitorIncidentServiceI_Track_Call{Call: _e.mock.On("Track", ctx, monitor, check, consecutiveFailures)}
+}
+
+func (_c *MockHTTPMonitorIncidentServiceI_Track_Call) Run(run func(ctx context.Context, monitor model.HTTPMonitorModel, check model.HTTPMonitorCheckModel, consecutiveFailures int)) *MockHTTPMonitorIncidentServiceI_Track_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.HTTPMonitorModel), args[2].(model.HTTPMonitorCheckModel), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockHTTPMonitorIncidentServiceI_Track_Call) Return(_a0 error) *MockHTTPMonitorIncidentServiceI_Track_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockHTTPMonitorIncidentServiceI_Track_Call) RunAndReturn(run func(context.Context, model.HTTPMonitorModel, model.HTTPMonitorCheckModel, int) error) *MockHTTPMonitorIncidentServiceI_Track_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockHTTPMonitorIncidentServiceI creates a new instance of MockHTTPMonitorIncidentServiceI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockHTTPMonitorIncidentServiceI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockHTTPMonitorIncidentServiceI {
+	mock := &MockHTTPMonitorIncidentServiceI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
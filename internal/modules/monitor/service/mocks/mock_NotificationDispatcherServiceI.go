@@ -0,0 +1,192 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	mock "github.com/stretchr/testify/mock"
+
+	repository "github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+
+	service "github.com/cristiano-pacheco/pingo/internal/modules/monitor/service"
+)
+
+// MockNotificationDispatcherServiceI is an autogenerated mock type for the NotificationDispatcherServiceI type
+type MockNotificationDispatcherServiceI struct {
+	mock.Mock
+}
+
+type MockNotificationDispatcherServiceI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotificationDispatcherServiceI) EXPECT() *MockNotificationDispatcherServiceI_Expecter {
+	return &MockNotificationDispatcherServiceI_Expecter{mock: &_m.Mock}
+}
+
+// Dispatch provides a mock function with given fields: ctx, alert
+func (_m *MockNotificationDispatcherServiceI) Dispatch(ctx context.Context, alert service.MonitorAlert) error {
+	ret := _m.Called(ctx, alert)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Dispatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, service.MonitorAlert) error); ok {
+		r0 = rf(ctx, alert)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotificationDispatcherServiceI_Dispatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Dispatch'
+type MockNotificationDispatcherServiceI_Dispatch_Call struct {
+	*mock.Call
+}
+
+// Dispatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alert service.MonitorAlert
+func (_e *MockNotificationDispatcherServiceI_Expecter) Dispatch(ctx interface{}, alert interface{}) *MockNotificationDispatcherServiceI_Dispatch_Call {
+	return &MockNotificationDispatcherServiceI_Dispatch_Call{Call: _e.mock.On("Dispatch", ctx, alert)}
+}
+
+func (_c *MockNotificationDispatcherServiceI_Dispatch_Call) Run(run func(ctx context.Context, alert service.MonitorAlert)) *MockNotificationDispatcherServiceI_Dispatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(service.MonitorAlert))
+	})
+	return _c
+}
+
+func (_c *MockNotificationDispatcherServiceI_Dispatch_Call) Return(_a0 error) *MockNotificationDispatcherServiceI_Dispatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationDispatcherServiceI_Dispatch_Call) RunAndReturn(run func(context.Context, service.MonitorAlert) error) *MockNotificationDispatcherServiceI_Dispatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Retry provides a mock function with given fields: ctx, owner, notificationID
+func (_m *MockNotificationDispatcherServiceI) Retry(ctx context.Context, owner repository.Owner, notificationID uint64) (model.NotificationModel, error) {
+	ret := _m.Called(ctx, owner, notificationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Retry")
+	}
+
+	var r0 model.NotificationModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, uint64) (model.NotificationModel, error)); ok {
+		return rf(ctx, owner, notificationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Owner, uint64) model.NotificationModel); ok {
+		r0 = rf(ctx, owner, notificationID)
+	} else {
+		r0 = ret.Get(0).(model.NotificationModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.Owner, uint64) error); ok {
+		r1 = rf(ctx, owner, notificationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNotificationDispatcherServiceI_Retry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Retry'
+type MockNotificationDispatcherServiceI_Retry_Call struct {
+	*mock.Call
+}
+
+// Retry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - owner repository.Owner
+//   - notificationID uint64
+func (_e *MockNotificationDispatcherServiceI_Expecter) Retry(ctx interface{}, owner interface{}, notificationID interface{}) *MockNotificationDispatcherServiceI_Retry_Call {
+	return &MockNotificationDispatcherServiceI_Retry_Call{Call: _e.mock.On("Retry", ctx, owner, notificationID)}
+}
+
+func (_c *MockNotificationDispatcherServiceI_Retry_Call) Run(run func(ctx context.Context, owner repository.Owner, notificationID uint64)) *MockNotificationDispatcherServiceI_Retry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.Owner), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockNotificationDispatcherServiceI_Retry_Call) Return(_a0 model.NotificationModel, _a1 error) *MockNotificationDispatcherServiceI_Retry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNotificationDispatcherServiceI_Retry_Call) RunAndReturn(run func(context.Context, repository.Owner, uint64) (model.NotificationModel, error)) *MockNotificationDispatcherServiceI_Retry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetryDue provides a mock function with given fields: ctx
+func (_m *MockNotificationDispatcherServiceI) RetryDue(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryDue")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotificationDispatcherServiceI_RetryDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryDue'
+type MockNotificationDispatcherServiceI_RetryDue_Call struct {
+	*mock.Call
+}
+
+// RetryDue is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockNotificationDispatcherServiceI_Expecter) RetryDue(ctx interface{}) *MockNotificationDispatcherServiceI_RetryDue_Call {
+	return &MockNotificationDispatcherServiceI_RetryDue_Call{Call: _e.mock.On("RetryDue", ctx)}
+}
+
+func (_c *MockNotificationDispatcherServiceI_RetryDue_Call) Run(run func(ctx context.Context)) *MockNotificationDispatcherServiceI_RetryDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockNotificationDispatcherServiceI_RetryDue_Call) Return(_a0 error) *MockNotificationDispatcherServiceI_RetryDue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationDispatcherServiceI_RetryDue_Call) RunAndReturn(run func(context.Context) error) *MockNotificationDispatcherServiceI_RetryDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNotificationDispatcherServiceI creates a new instance of MockNotificationDispatcherServiceI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotificationDispatcherServiceI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotificationDispatcherServiceI {
+	mock := &MockNotificationDispatcherServiceI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
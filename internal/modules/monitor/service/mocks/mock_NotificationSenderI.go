@@ -0,0 +1,87 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	mock "github.com/stretchr/testify/mock"
+
+	service "github.com/cristiano-pacheco/pingo/internal/modules/monitor/service"
+)
+
+// MockNotificationSenderI is an autogenerated mock type for the NotificationSenderI type
+type MockNotificationSenderI struct {
+	mock.Mock
+}
+
+type MockNotificationSenderI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotificationSenderI) EXPECT() *MockNotificationSenderI_Expecter {
+	return &MockNotificationSenderI_Expecter{mock: &_m.Mock}
+}
+
+// Send provides a mock function with given fields: ctx, alert, contact
+func (_m *MockNotificationSenderI) Send(ctx context.Context, alert service.MonitorAlert, contact model.ContactModel) error {
+	ret := _m.Called(ctx, alert, contact)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, service.MonitorAlert, model.ContactModel) error); ok {
+		r0 = rf(ctx, alert, contact)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotificationSenderI_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type MockNotificationSenderI_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alert service.MonitorAlert
+//   - contact model.ContactModel
+func (_e *MockNotificationSenderI_Expecter) Send(ctx interface{}, alert interface{}, contact interface{}) *MockNotificationSenderI_Send_Call {
+	return &MockNotificationSenderI_Send_Call{Call: _e.mock.On("Send", ctx, alert, contact)}
+}
+
+func (_c *MockNotificationSenderI_Send_Call) Run(run func(ctx context.Context, alert service.MonitorAlert, contact model.ContactModel)) *MockNotificationSenderI_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(service.MonitorAlert), args[2].(model.ContactModel))
+	})
+	return _c
+}
+
+func (_c *MockNotificationSenderI_Send_Call) Return(_a0 error) *MockNotificationSenderI_Send_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationSenderI_Send_Call) RunAndReturn(run func(context.Context, service.MonitorAlert, model.ContactModel) error) *MockNotificationSenderI_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNotificationSenderI creates a new instance of MockNotificationSenderI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotificationSenderI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotificationSenderI {
+	mock := &MockNotificationSenderI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
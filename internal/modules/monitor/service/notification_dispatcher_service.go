@@ -0,0 +1,393 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event/producer"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+)
+
+const (
+	defaultMaxAttempts    = 5
+	defaultRetryBaseDelay = 30 * time.Second
+	maxRetryDelay         = time.Hour
+	// deliveryLease keeps a notification out of the retry queue while it's being sent,
+	// it must outlast the slowest sender.
+	deliveryLease = 5 * time.Minute
+	// retryBatchSize bounds how many due notifications a single RetryDue call sends.
+	retryBatchSize = 50
+)
+
+type NotificationDispatcherServiceI interface {
+	Dispatch(ctx context.Context, alert MonitorAlert) error
+	RetryDue(ctx context.Context) error
+	Retry(ctx context.Context, owner repository.Owner, notificationID uint64) (model.NotificationModel, error)
+}
+
+type NotificationDispatcherService struct {
+	httpMonitorRepository      repository.HTTPMonitorRepositoryI
+	httpMonitorCheckRepository repository.HTTPMonitorCheckRepositoryI
+	incidentRepository         repository.IncidentRepositoryI
+	contactRepository          repository.ContactRepositoryI
+	notificationRepository     repository.NotificationRepositoryI
+	senders                    NotificationSenders
+	notificationSentProducer   producer.NotificationSentProducerI
+	txManager                  database.TxManagerI
+	logger                     logger.Logger
+	maxAttempts                int
+	retryBaseDelay             time.Duration
+}
+
+var _ NotificationDispatcherServiceI = (*NotificationDispatcherService)(nil)
+
+func NewNotificationDispatcherService(
+	httpMonitorRepository repository.HTTPMonitorRepositoryI,
+	httpMonitorCheckRepository repository.HTTPMonitorCheckRepositoryI,
+	incidentRepository repository.IncidentRepositoryI,
+	contactRepository repository.ContactRepositoryI,
+	notificationRepository repository.NotificationRepositoryI,
+	senders NotificationSenders,
+	notificationSentProducer producer.NotificationSentProducerI,
+	txManager database.TxManagerI,
+	cfg config.Config,
+	logger logger.Logger,
+) *NotificationDispatcherService {
+	maxAttempts := cfg.Notification.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultMaxAttempts
+	}
+
+	retryBaseDelay := defaultRetryBaseDelay
+	if cfg.Notification.RetryBaseDelaySeconds > 0 {
+		retryBaseDelay = time.Duration(cfg.Notification.RetryBaseDelaySeconds) * time.Second
+	}
+
+	return &NotificationDispatcherService{
+		httpMonitorRepository:      httpMonitorRepository,
+		httpMonitorCheckRepository: httpMonitorCheckRepository,
+		incidentRepository:         incidentRepository,
+		contactRepository:          contactRepository,
+		notificationRepository:     notificationRepository,
+		senders:                    senders,
+		notificationSentProducer:   notificationSentProducer,
+		txManager:                  txManager,
+		logger:                     logger,
+		maxAttempts:                maxAttempts,
+		retryBaseDelay:             retryBaseDelay,
+	}
+}
+
+// Dispatch creates a notification for every enabled contact of the monitor and sends it
+// through the channel of the contact. A failed delivery is recorded on the notification and
+// retried later, only failures to load contacts or persist notifications are returned.
+func (s *NotificationDispatcherService) Dispatch(ctx context.Context, alert MonitorAlert) error {
+	ctx, span := trace.Span(ctx, "NotificationDispatcherService.Dispatch")
+	defer span.End()
+
+	contactIDs, err := s.httpMonitorRepository.FindContactIDs(ctx, []uint64{alert.Monitor.ID})
+	if err != nil {
+		s.logger.Error().Msgf("error finding contacts of monitor ID %d: %v", alert.Monitor.ID, err)
+		return err
+	}
+
+	if len(contactIDs[alert.Monitor.ID]) == 0 {
+		return nil
+	}
+
+	contacts, err := s.contactRepository.FindByIDs(
+		ctx,
+		repository.NewMonitorOwner(alert.Monitor),
+		contactIDs[alert.Monitor.ID],
+	)
+	if err != nil {
+		s.logger.Error().Msgf("error finding contacts by IDs for monitor ID %d: %v", alert.Monitor.ID, err)
+		return err
+	}
+
+	var dispatchErrs []error
+	for _, contact := range contacts {
+		if !contact.IsEnabled {
+			continue
+		}
+		if err = s.notify(ctx, alert, contact); err != nil {
+			dispatchErrs = append(dispatchErrs, err)
+		}
+	}
+
+	return errors.Join(dispatchErrs...)
+}
+
+// RetryDue sends the notifications whose next attempt is due.
+func (s *NotificationDispatcherService) RetryDue(ctx context.Context) error {
+	ctx, span := trace.Span(ctx, "NotificationDispatcherService.RetryDue")
+	defer span.End()
+
+	now := time.Now().UTC()
+	notifications, err := s.notificationRepository.ClaimDue(ctx, now, now.Add(deliveryLease), retryBatchSize)
+	if err != nil {
+		s.logger.Error().Msgf("error claiming due notifications: %v", err)
+		return err
+	}
+
+	var retryErrs []error
+	for _, notification := range notifications {
+		if _, err = s.redeliver(ctx, notification); err != nil {
+			retryErrs = append(retryErrs, err)
+		}
+	}
+
+	return errors.Join(retryErrs...)
+}
+
+// Retry sends a permanently failed notification of a monitor of the owner once more.
+func (s *NotificationDispatcherService) Retry(
+	ctx context.Context,
+	owner repository.Owner,
+	notificationID uint64,
+) (model.NotificationModel, error) {
+	ctx, span := trace.Span(ctx, "NotificationDispatcherService.Retry")
+	defer span.End()
+
+	notification, err := s.notificationRepository.FindByID(ctx, owner, notificationID)
+	if err != nil {
+		return model.NotificationModel{}, err
+	}
+
+	// claiming the notification keeps a concurrent retry from sending it twice
+	claimed, err := s.notificationRepository.ClaimPermanentlyFailed(
+		ctx,
+		notificationID,
+		time.Now().UTC().Add(deliveryLease),
+	)
+	if err != nil {
+		s.logger.Error().Msgf("error claiming notification ID %d: %v", notificationID, err)
+		return model.NotificationModel{}, err
+	}
+	if !claimed {
+		return model.NotificationModel{}, errs.ErrNotificationNotRetryable
+	}
+
+	return s.redeliver(ctx, notification)
+}
+
+func (s *NotificationDispatcherService) notify(
+	ctx context.Context,
+	alert MonitorAlert,
+	contact model.ContactModel,
+) error {
+	notification, err := s.notificationRepository.Create(ctx, model.NotificationModel{
+		HTTPMonitorID:      alert.Monitor.ID,
+		ContactID:          contact.ID,
+		IncidentID:         s.nullableID(alert.Incident.ID),
+		HTTPMonitorCheckID: s.nullableID(alert.Check.ID),
+		NotificationType:   alert.Type,
+		Message:            s.message(alert),
+		Status:             enum.NotificationStatusPending,
+		// the notification is picked up by the retry queue if this replica dies before sending it
+		NextAttemptAt: sql.NullTime{Time: time.Now().UTC().Add(deliveryLease), Valid: true},
+	})
+	if err != nil {
+		s.logger.Error().Msgf("error creating notification for contact ID %d: %v", contact.ID, err)
+		return err
+	}
+
+	_, err = s.deliver(ctx, notification, alert, contact)
+	return err
+}
+
+// redeliver rebuilds the alert of a stored notification and sends it again.
+func (s *NotificationDispatcherService) redeliver(
+	ctx context.Context,
+	notification model.NotificationModel,
+) (model.NotificationModel, error) {
+	alert, contact, err := s.alert(ctx, notification)
+	if err != nil {
+		s.logger.Error().Msgf("error loading the alert of notification ID %d: %v", notification.ID, err)
+		return model.NotificationModel{}, err
+	}
+
+	if !contact.IsEnabled {
+		notification.Status = enum.NotificationStatusFailed
+		notification.ErrorMessage = sql.NullString{String: "contact is disabled", Valid: true}
+		notification.NextAttemptAt = sql.NullTime{}
+		return notification, s.updateDelivery(ctx, notification)
+	}
+
+	return s.deliver(ctx, notification, alert, contact)
+}
+
+// deliver makes a delivery attempt and records its outcome, along with NotificationSent when
+// it succeeds. A failed attempt is scheduled for a retry with an exponential backoff until
+// the notification runs out of attempts.
+func (s *NotificationDispatcherService) deliver(
+	ctx context.Context,
+	notification model.NotificationModel,
+	alert MonitorAlert,
+	contact model.ContactModel,
+) (model.NotificationModel, error) {
+	notification.AttemptCount++
+
+	sendErr := s.send(ctx, alert, contact)
+	now := time.Now().UTC()
+
+	notification.NextAttemptAt = sql.NullTime{}
+	if sendErr != nil {
+		s.logger.Error().Msgf(
+			"error sending notification ID %d (attempt %d): %v",
+			notification.ID,
+			notification.AttemptCount,
+			sendErr,
+		)
+		notification.Status = enum.NotificationStatusFailed
+		notification.ErrorMessage = sql.NullString{String: sendErr.Error(), Valid: true}
+		if notification.AttemptCount < s.maxAttempts {
+			nextAttemptAt := now.Add(s.backoff(notification.AttemptCount))
+			notification.NextAttemptAt = sql.NullTime{Time: nextAttemptAt, Valid: true}
+		}
+		return notification, s.updateDelivery(ctx, notification)
+	}
+
+	notification.Status = enum.NotificationStatusSent
+	notification.SentAt = sql.NullTime{Time: now, Valid: true}
+	notification.ErrorMessage = sql.NullString{}
+
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		err := s.updateDelivery(ctx, notification)
+		if err != nil {
+			return err
+		}
+
+		err = s.notificationSentProducer.Produce(ctx, s.notificationSentMessage(notification))
+		if err != nil {
+			s.logger.Error().
+				Msgf("error producing notification sent event for notification ID %d: %v", notification.ID, err)
+			return err
+		}
+
+		return nil
+	})
+	return notification, err
+}
+
+func (s *NotificationDispatcherService) updateDelivery(
+	ctx context.Context,
+	notification model.NotificationModel,
+) error {
+	err := s.notificationRepository.UpdateDelivery(ctx, notification)
+	if err != nil {
+		s.logger.Error().Msgf("error updating delivery of notification ID %d: %v", notification.ID, err)
+		return err
+	}
+	return nil
+}
+
+func (s *NotificationDispatcherService) send(
+	ctx context.Context,
+	alert MonitorAlert,
+	contact model.ContactModel,
+) error {
+	sender, ok := s.senders[contact.ContactType]
+	if !ok {
+		return fmt.Errorf("%w: %s", errs.ErrUnsupportedContactType, contact.ContactType)
+	}
+	return sender.Send(ctx, alert, contact)
+}
+
+// alert loads what a stored notification was sent about.
+func (s *NotificationDispatcherService) alert(
+	ctx context.Context,
+	notification model.NotificationModel,
+) (MonitorAlert, model.ContactModel, error) {
+	alert := MonitorAlert{Type: notification.NotificationType}
+
+	monitor, err := s.httpMonitorRepository.FindByIDUnscoped(ctx, notification.HTTPMonitorID)
+	if err != nil {
+		return MonitorAlert{}, model.ContactModel{}, err
+	}
+	alert.Monitor = monitor
+
+	contact, err := s.contactRepository.FindByID(ctx, repository.NewMonitorOwner(monitor), notification.ContactID)
+	if err != nil {
+		return MonitorAlert{}, model.ContactModel{}, err
+	}
+
+	if notification.HTTPMonitorCheckID.Valid {
+		// IDs come from BIGSERIAL columns, the conversion can't overflow
+		checkID := uint64(notification.HTTPMonitorCheckID.Int64) // #nosec G115
+		alert.Check, err = s.httpMonitorCheckRepository.FindByID(ctx, checkID)
+		if err != nil {
+			return MonitorAlert{}, model.ContactModel{}, err
+		}
+	}
+
+	if notification.IncidentID.Valid {
+		incidentID := uint64(notification.IncidentID.Int64) // #nosec G115
+		alert.Incident, err = s.incidentRepository.FindByID(ctx, incidentID)
+		if err != nil {
+			return MonitorAlert{}, model.ContactModel{}, err
+		}
+	}
+
+	return alert, contact, nil
+}
+
+func (s *NotificationDispatcherService) notificationSentMessage(
+	notification model.NotificationModel,
+) event.NotificationSentMessage {
+	message := event.NotificationSentMessage{
+		NotificationID:   notification.ID,
+		MonitorID:        notification.HTTPMonitorID,
+		ContactID:        notification.ContactID,
+		NotificationType: notification.NotificationType,
+		AttemptCount:     notification.AttemptCount,
+		SentAt:           notification.SentAt.Time,
+	}
+	if notification.IncidentID.Valid {
+		// IDs come from BIGSERIAL columns, the conversion can't overflow
+		incidentID := uint64(notification.IncidentID.Int64) // #nosec G115
+		message.IncidentID = &incidentID
+	}
+	return message
+}
+
+// backoff doubles the base delay for every attempt already made, up to maxRetryDelay.
+func (s *NotificationDispatcherService) backoff(attempt int) time.Duration {
+	delay := s.retryBaseDelay
+	for i := 1; i < attempt && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
+
+func (s *NotificationDispatcherService) nullableID(id uint64) sql.NullInt64 {
+	// IDs come from BIGSERIAL columns, the conversion can't overflow
+	return sql.NullInt64{Int64: int64(id), Valid: id != 0} // #nosec G115
+}
+
+func (s *NotificationDispatcherService) message(alert MonitorAlert) string {
+	switch alert.Type {
+	case enum.NotificationTypeRecovery:
+		duration := time.Duration(alert.Incident.DurationSeconds.Int64) * time.Second
+		return fmt.Sprintf("Monitor %q is back up after %s", alert.Monitor.Name, duration)
+	case enum.NotificationTypeMaintenance:
+		return fmt.Sprintf("Monitor %q is under maintenance", alert.Monitor.Name)
+	default:
+		reason := "check failed"
+		if alert.Incident.ErrorMessage.Valid {
+			reason = alert.Incident.ErrorMessage.String
+		}
+		return fmt.Sprintf("Monitor %q is down: %s", alert.Monitor.Name, reason)
+	}
+}
@@ -0,0 +1,497 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event"
+	producer_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/event/producer/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/service"
+	service_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/service/mocks"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	database_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/database/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+)
+
+type NotificationDispatcherServiceTestSuite struct {
+	suite.Suite
+	sut                            *service.NotificationDispatcherService
+	httpMonitorRepositoryMock      *repository_mocks.MockHTTPMonitorRepositoryI
+	httpMonitorCheckRepositoryMock *repository_mocks.MockHTTPMonitorCheckRepositoryI
+	incidentRepositoryMock         *repository_mocks.MockIncidentRepositoryI
+	contactRepositoryMock          *repository_mocks.MockContactRepositoryI
+	notificationRepositoryMock     *repository_mocks.MockNotificationRepositoryI
+	emailSenderMock                *service_mocks.MockNotificationSenderI
+	notificationSentProducerMock   *producer_mocks.MockNotificationSentProducerI
+	txManagerMock                  *database_mocks.MockTxManagerI
+	logger                         logger.Logger
+}
+
+func (s *NotificationDispatcherServiceTestSuite) SetupTest() {
+	cfg := config.Config{
+		Notification: config.Notification{
+			MaxAttempts:           3,
+			RetryBaseDelaySeconds: 30,
+		},
+		Log: config.Log{
+			LogLevel: "disabled",
+		},
+	}
+	s.logger = logger.New(cfg)
+
+	s.httpMonitorRepositoryMock = repository_mocks.NewMockHTTPMonitorRepositoryI(s.T())
+	s.httpMonitorCheckRepositoryMock = repository_mocks.NewMockHTTPMonitorCheckRepositoryI(s.T())
+	s.incidentRepositoryMock = repository_mocks.NewMockIncidentRepositoryI(s.T())
+	s.contactRepositoryMock = repository_mocks.NewMockContactRepositoryI(s.T())
+	s.notificationRepositoryMock = repository_mocks.NewMockNotificationRepositoryI(s.T())
+	s.emailSenderMock = service_mocks.NewMockNotificationSenderI(s.T())
+	s.notificationSentProducerMock = producer_mocks.NewMockNotificationSentProducerI(s.T())
+	s.txManagerMock = database_mocks.NewMockTxManagerI(s.T())
+	s.txManagerMock.On("WithinTransaction", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) }).
+		Maybe()
+
+	s.sut = service.NewNotificationDispatcherService(
+		s.httpMonitorRepositoryMock,
+		s.httpMonitorCheckRepositoryMock,
+		s.incidentRepositoryMock,
+		s.contactRepositoryMock,
+		s.notificationRepositoryMock,
+		service.NotificationSenders{enum.ContactTypeEmail: s.emailSenderMock},
+		s.notificationSentProducerMock,
+		s.txManagerMock,
+		cfg,
+		s.logger,
+	)
+}
+
+func TestNotificationDispatcherServiceSuite(t *testing.T) {
+	suite.Run(t, new(NotificationDispatcherServiceTestSuite))
+}
+
+func (s *NotificationDispatcherServiceTestSuite) newAlert() service.MonitorAlert {
+	return service.MonitorAlert{
+		Type:    enum.NotificationTypeFailure,
+		Monitor: model.HTTPMonitorModel{ID: 1, UserID: 7, Name: "Example"},
+		Check:   model.HTTPMonitorCheckModel{ID: 9, HTTPMonitorID: 1},
+		Incident: model.IncidentModel{
+			ID:            4,
+			HTTPMonitorID: 1,
+			ErrorMessage:  sql.NullString{String: "unexpected response status code 503", Valid: true},
+		},
+	}
+}
+
+func (s *NotificationDispatcherServiceTestSuite) newEmailContact(id uint64) model.ContactModel {
+	return model.ContactModel{
+		ID:          id,
+		Name:        "Ops",
+		ContactType: enum.ContactTypeEmail,
+		ContactData: "ops@example.com",
+		IsEnabled:   true,
+	}
+}
+
+func (s *NotificationDispatcherServiceTestSuite) TestDispatch_SendSucceeds_MarksNotificationSent() {
+	// Arrange
+	ctx := context.Background()
+	alert := s.newAlert()
+	contact := s.newEmailContact(3)
+
+	var created model.NotificationModel
+	var delivered model.NotificationModel
+	var produced event.NotificationSentMessage
+	s.httpMonitorRepositoryMock.On("FindContactIDs", mock.Anything, []uint64{1}).
+		Return(map[uint64][]uint64{1: {3}}, nil)
+	s.contactRepositoryMock.On("FindByIDs", mock.Anything, repository.NewMonitorOwner(alert.Monitor), []uint64{3}).
+		Return([]model.ContactModel{contact}, nil)
+	s.notificationRepositoryMock.On("Create", mock.Anything, mock.AnythingOfType("model.NotificationModel")).
+		Run(func(args mock.Arguments) { created = args.Get(1).(model.NotificationModel) }).
+		Return(func(_ context.Context, notification model.NotificationModel) model.NotificationModel {
+			notification.ID = 20
+			return notification
+		}, nil)
+	s.emailSenderMock.On("Send", mock.Anything, alert, contact).Return(nil)
+	s.notificationRepositoryMock.On("UpdateDelivery", mock.Anything, mock.AnythingOfType("model.NotificationModel")).
+		Run(func(args mock.Arguments) { delivered = args.Get(1).(model.NotificationModel) }).
+		Return(nil)
+	s.notificationSentProducerMock.On("Produce", mock.Anything, mock.AnythingOfType("event.NotificationSentMessage")).
+		Run(func(args mock.Arguments) { produced = args.Get(1).(event.NotificationSentMessage) }).
+		Return(nil)
+
+	// Act
+	err := s.sut.Dispatch(ctx, alert)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(uint64(1), created.HTTPMonitorID)
+	s.Equal(uint64(3), created.ContactID)
+	s.Equal(sql.NullInt64{Int64: 4, Valid: true}, created.IncidentID)
+	s.Equal(sql.NullInt64{Int64: 9, Valid: true}, created.HTTPMonitorCheckID)
+	s.Equal(enum.NotificationTypeFailure, created.NotificationType)
+	s.Equal(`Monitor "Example" is down: unexpected response status code 503`, created.Message)
+	s.Equal(enum.NotificationStatusPending, created.Status)
+	s.True(created.NextAttemptAt.Valid)
+	s.Equal(uint64(20), delivered.ID)
+	s.Equal(enum.NotificationStatusSent, delivered.Status)
+	s.Equal(1, delivered.AttemptCount)
+	s.True(delivered.SentAt.Valid)
+	s.False(delivered.ErrorMessage.Valid)
+	s.False(delivered.NextAttemptAt.Valid)
+	s.Equal(uint64(20), produced.NotificationID)
+	s.Equal(uint64(1), produced.MonitorID)
+	s.Equal(uint64(3), produced.ContactID)
+	s.Require().NotNil(produced.IncidentID)
+	s.Equal(uint64(4), *produced.IncidentID)
+	s.Equal(enum.NotificationTypeFailure, produced.NotificationType)
+	s.Equal(1, produced.AttemptCount)
+	s.Equal(delivered.SentAt.Time, produced.SentAt)
+}
+
+func (s *NotificationDispatcherServiceTestSuite) TestDispatch_SendFails_SchedulesRetry() {
+	// Arrange
+	ctx := context.Background()
+	alert := s.newAlert()
+	contact := s.newEmailContact(3)
+
+	s.httpMonitorRepositoryMock.On("FindContactIDs", mock.Anything, []uint64{1}).
+		Return(map[uint64][]uint64{1: {3}}, nil)
+	s.contactRepositoryMock.On("FindByIDs", mock.Anything, repository.NewMonitorOwner(alert.Monitor), []uint64{3}).
+		Return([]model.ContactModel{contact}, nil)
+	s.notificationRepositoryMock.On("Create", mock.Anything, mock.AnythingOfType("model.NotificationModel")).
+		Return(model.NotificationModel{ID: 20}, nil)
+	s.emailSenderMock.On("Send", mock.Anything, alert, contact).Return(errors.New("smtp unavailable"))
+
+	var delivered model.NotificationModel
+	s.notificationRepositoryMock.On("UpdateDelivery", mock.Anything, mock.AnythingOfType("model.NotificationModel")).
+		Run(func(args mock.Arguments) { delivered = args.Get(1).(model.NotificationModel) }).
+		Return(nil)
+
+	// Act
+	before := time.Now().UTC()
+	err := s.sut.Dispatch(ctx, alert)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(enum.NotificationStatusFailed, delivered.Status)
+	s.Equal(1, delivered.AttemptCount)
+	s.Equal(sql.NullString{String: "smtp unavailable", Valid: true}, delivered.ErrorMessage)
+	s.False(delivered.SentAt.Valid)
+	s.Require().True(delivered.NextAttemptAt.Valid)
+	s.WithinDuration(before.Add(30*time.Second), delivered.NextAttemptAt.Time, 5*time.Second)
+}
+
+func (s *NotificationDispatcherServiceTestSuite) TestDispatch_UnsupportedContactType_MarksNotificationFailed() {
+	// Arrange
+	ctx := context.Background()
+	alert := s.newAlert()
+	contact := s.newEmailContact(3)
+	contact.ContactType = enum.ContactTypeWebhook
+
+	s.httpMonitorRepositoryMock.On("FindContactIDs", mock.Anything, []uint64{1}).
+		Return(map[uint64][]uint64{1: {3}}, nil)
+	s.contactRepositoryMock.On("FindByIDs", mock.Anything, repository.NewMonitorOwner(alert.Monitor), []uint64{3}).
+		Return([]model.ContactModel{contact}, nil)
+	s.notificationRepositoryMock.On("Create", mock.Anything, mock.AnythingOfType("model.NotificationModel")).
+		Return(model.NotificationModel{ID: 20}, nil)
+	var delivered model.NotificationModel
+	s.notificationRepositoryMock.On("UpdateDelivery", mock.Anything, mock.AnythingOfType("model.NotificationModel")).
+		Run(func(args mock.Arguments) { delivered = args.Get(1).(model.NotificationModel) }).
+		Return(nil)
+
+	// Act
+	err := s.sut.Dispatch(ctx, alert)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(enum.NotificationStatusFailed, delivered.Status)
+	s.Contains(delivered.ErrorMessage.String, enum.ContactTypeWebhook)
+	s.emailSenderMock.AssertNotCalled(s.T(), "Send", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *NotificationDispatcherServiceTestSuite) TestDispatch_DisabledContact_IsSkipped() {
+	// Arrange
+	ctx := context.Background()
+	alert := s.newAlert()
+	contact := s.newEmailContact(3)
+	contact.IsEnabled = false
+
+	s.httpMonitorRepositoryMock.On("FindContactIDs", mock.Anything, []uint64{1}).
+		Return(map[uint64][]uint64{1: {3}}, nil)
+	s.contactRepositoryMock.On("FindByIDs", mock.Anything, repository.NewMonitorOwner(alert.Monitor), []uint64{3}).
+		Return([]model.ContactModel{contact}, nil)
+
+	// Act
+	err := s.sut.Dispatch(ctx, alert)
+
+	// Assert
+	s.Require().NoError(err)
+	s.notificationRepositoryMock.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *NotificationDispatcherServiceTestSuite) TestDispatch_NoContacts_DoesNothing() {
+	// Arrange
+	ctx := context.Background()
+	alert := s.newAlert()
+
+	s.httpMonitorRepositoryMock.On("FindContactIDs", mock.Anything, []uint64{1}).
+		Return(map[uint64][]uint64{}, nil)
+
+	// Act
+	err := s.sut.Dispatch(ctx, alert)
+
+	// Assert
+	s.Require().NoError(err)
+	s.contactRepositoryMock.AssertNotCalled(s.T(), "FindByIDs", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *NotificationDispatcherServiceTestSuite) TestDispatch_CreateFails_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	alert := s.newAlert()
+	contact := s.newEmailContact(3)
+	createErr := errors.New("database error")
+
+	s.httpMonitorRepositoryMock.On("FindContactIDs", mock.Anything, []uint64{1}).
+		Return(map[uint64][]uint64{1: {3}}, nil)
+	s.contactRepositoryMock.On("FindByIDs", mock.Anything, repository.NewMonitorOwner(alert.Monitor), []uint64{3}).
+		Return([]model.ContactModel{contact}, nil)
+	s.notificationRepositoryMock.On("Create", mock.Anything, mock.AnythingOfType("model.NotificationModel")).
+		Return(model.NotificationModel{}, createErr)
+
+	// Act
+	err := s.sut.Dispatch(ctx, alert)
+
+	// Assert
+	s.Require().ErrorIs(err, createErr)
+	s.emailSenderMock.AssertNotCalled(s.T(), "Send", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *NotificationDispatcherServiceTestSuite) newStoredNotification(attemptCount int) model.NotificationModel {
+	return model.NotificationModel{
+		ID:                 20,
+		HTTPMonitorID:      1,
+		ContactID:          3,
+		IncidentID:         sql.NullInt64{Int64: 4, Valid: true},
+		HTTPMonitorCheckID: sql.NullInt64{Int64: 9, Valid: true},
+		NotificationType:   enum.NotificationTypeFailure,
+		Status:             enum.NotificationStatusFailed,
+		ErrorMessage:       sql.NullString{String: "smtp unavailable", Valid: true},
+		AttemptCount:       attemptCount,
+		NextAttemptAt:      sql.NullTime{Time: time.Now().UTC(), Valid: true},
+	}
+}
+
+func (s *NotificationDispatcherServiceTestSuite) expectAlertLoaded(
+	alert service.MonitorAlert,
+	contact model.ContactModel,
+) {
+	s.httpMonitorRepositoryMock.On("FindByIDUnscoped", mock.Anything, uint64(1)).Return(alert.Monitor, nil)
+	s.contactRepositoryMock.On("FindByID", mock.Anything, repository.NewMonitorOwner(alert.Monitor), uint64(3)).
+		Return(contact, nil)
+	s.httpMonitorCheckRepositoryMock.On("FindByID", mock.Anything, uint64(9)).Return(alert.Check, nil)
+	s.incidentRepositoryMock.On("FindByID", mock.Anything, uint64(4)).Return(alert.Incident, nil)
+}
+
+func (s *NotificationDispatcherServiceTestSuite) TestRetryDue_SendFailsAgain_DoublesBackoff() {
+	// Arrange
+	ctx := context.Background()
+	alert := s.newAlert()
+	contact := s.newEmailContact(3)
+
+	s.notificationRepositoryMock.On("ClaimDue", mock.Anything, mock.Anything, mock.Anything, 50).
+		Return([]model.NotificationModel{s.newStoredNotification(1)}, nil)
+	s.expectAlertLoaded(alert, contact)
+	s.emailSenderMock.On("Send", mock.Anything, alert, contact).Return(errors.New("smtp unavailable"))
+
+	var delivered model.NotificationModel
+	s.notificationRepositoryMock.On("UpdateDelivery", mock.Anything, mock.AnythingOfType("model.NotificationModel")).
+		Run(func(args mock.Arguments) { delivered = args.Get(1).(model.NotificationModel) }).
+		Return(nil)
+
+	// Act
+	before := time.Now().UTC()
+	err := s.sut.RetryDue(ctx)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(2, delivered.AttemptCount)
+	s.Equal(enum.NotificationStatusFailed, delivered.Status)
+	s.Require().True(delivered.NextAttemptAt.Valid)
+	s.WithinDuration(before.Add(time.Minute), delivered.NextAttemptAt.Time, 5*time.Second)
+}
+
+func (s *NotificationDispatcherServiceTestSuite) TestRetryDue_LastAttemptFails_FailsPermanently() {
+	// Arrange
+	ctx := context.Background()
+	alert := s.newAlert()
+	contact := s.newEmailContact(3)
+
+	s.notificationRepositoryMock.On("ClaimDue", mock.Anything, mock.Anything, mock.Anything, 50).
+		Return([]model.NotificationModel{s.newStoredNotification(2)}, nil)
+	s.expectAlertLoaded(alert, contact)
+	s.emailSenderMock.On("Send", mock.Anything, alert, contact).Return(errors.New("smtp unavailable"))
+
+	var delivered model.NotificationModel
+	s.notificationRepositoryMock.On("UpdateDelivery", mock.Anything, mock.AnythingOfType("model.NotificationModel")).
+		Run(func(args mock.Arguments) { delivered = args.Get(1).(model.NotificationModel) }).
+		Return(nil)
+
+	// Act
+	err := s.sut.RetryDue(ctx)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(3, delivered.AttemptCount)
+	s.Equal(enum.NotificationStatusFailed, delivered.Status)
+	s.False(delivered.NextAttemptAt.Valid)
+}
+
+func (s *NotificationDispatcherServiceTestSuite) TestRetryDue_SendSucceeds_MarksNotificationSent() {
+	// Arrange
+	ctx := context.Background()
+	alert := s.newAlert()
+	contact := s.newEmailContact(3)
+
+	s.notificationRepositoryMock.On("ClaimDue", mock.Anything, mock.Anything, mock.Anything, 50).
+		Return([]model.NotificationModel{s.newStoredNotification(1)}, nil)
+	s.expectAlertLoaded(alert, contact)
+	s.emailSenderMock.On("Send", mock.Anything, alert, contact).Return(nil)
+
+	var delivered model.NotificationModel
+	s.notificationRepositoryMock.On("UpdateDelivery", mock.Anything, mock.AnythingOfType("model.NotificationModel")).
+		Run(func(args mock.Arguments) { delivered = args.Get(1).(model.NotificationModel) }).
+		Return(nil)
+	s.notificationSentProducerMock.On("Produce", mock.Anything, mock.AnythingOfType("event.NotificationSentMessage")).
+		Return(nil)
+
+	// Act
+	err := s.sut.RetryDue(ctx)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(enum.NotificationStatusSent, delivered.Status)
+	s.Equal(2, delivered.AttemptCount)
+	s.False(delivered.ErrorMessage.Valid)
+	s.False(delivered.NextAttemptAt.Valid)
+}
+
+func (s *NotificationDispatcherServiceTestSuite) TestRetryDue_ProduceNotificationSentFails_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	alert := s.newAlert()
+	contact := s.newEmailContact(3)
+	produceErr := errors.New("outbox error")
+
+	s.notificationRepositoryMock.On("ClaimDue", mock.Anything, mock.Anything, mock.Anything, 50).
+		Return([]model.NotificationModel{s.newStoredNotification(1)}, nil)
+	s.expectAlertLoaded(alert, contact)
+	s.emailSenderMock.On("Send", mock.Anything, alert, contact).Return(nil)
+	s.notificationRepositoryMock.On("UpdateDelivery", mock.Anything, mock.AnythingOfType("model.NotificationModel")).
+		Return(nil)
+	s.notificationSentProducerMock.On("Produce", mock.Anything, mock.AnythingOfType("event.NotificationSentMessage")).
+		Return(produceErr)
+
+	// Act
+	err := s.sut.RetryDue(ctx)
+
+	// Assert
+	s.Require().ErrorIs(err, produceErr)
+}
+
+func (s *NotificationDispatcherServiceTestSuite) TestRetryDue_DisabledContact_FailsPermanently() {
+	// Arrange
+	ctx := context.Background()
+	alert := s.newAlert()
+	contact := s.newEmailContact(3)
+	contact.IsEnabled = false
+
+	s.notificationRepositoryMock.On("ClaimDue", mock.Anything, mock.Anything, mock.Anything, 50).
+		Return([]model.NotificationModel{s.newStoredNotification(1)}, nil)
+	s.expectAlertLoaded(alert, contact)
+
+	var delivered model.NotificationModel
+	s.notificationRepositoryMock.On("UpdateDelivery", mock.Anything, mock.AnythingOfType("model.NotificationModel")).
+		Run(func(args mock.Arguments) { delivered = args.Get(1).(model.NotificationModel) }).
+		Return(nil)
+
+	// Act
+	err := s.sut.RetryDue(ctx)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(sql.NullString{String: "contact is disabled", Valid: true}, delivered.ErrorMessage)
+	s.False(delivered.NextAttemptAt.Valid)
+	s.emailSenderMock.AssertNotCalled(s.T(), "Send", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *NotificationDispatcherServiceTestSuite) TestRetry_PermanentlyFailed_SendsAgain() {
+	// Arrange
+	ctx := context.Background()
+	alert := s.newAlert()
+	contact := s.newEmailContact(3)
+	notification := s.newStoredNotification(3)
+	notification.NextAttemptAt = sql.NullTime{}
+
+	s.notificationRepositoryMock.On("FindByID", mock.Anything, repository.Owner{UserID: 7}, uint64(20)).
+		Return(notification, nil)
+	s.notificationRepositoryMock.On("ClaimPermanentlyFailed", mock.Anything, uint64(20), mock.Anything).
+		Return(true, nil)
+	s.expectAlertLoaded(alert, contact)
+	s.emailSenderMock.On("Send", mock.Anything, alert, contact).Return(nil)
+	s.notificationRepositoryMock.On("UpdateDelivery", mock.Anything, mock.AnythingOfType("model.NotificationModel")).
+		Return(nil)
+	s.notificationSentProducerMock.On("Produce", mock.Anything, mock.AnythingOfType("event.NotificationSentMessage")).
+		Return(nil)
+
+	// Act
+	result, err := s.sut.Retry(ctx, repository.Owner{UserID: 7}, 20)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(enum.NotificationStatusSent, result.Status)
+	s.Equal(4, result.AttemptCount)
+}
+
+func (s *NotificationDispatcherServiceTestSuite) TestRetry_NotPermanentlyFailed_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+
+	s.notificationRepositoryMock.On("FindByID", mock.Anything, repository.Owner{UserID: 7}, uint64(20)).
+		Return(s.newStoredNotification(1), nil)
+	s.notificationRepositoryMock.On("ClaimPermanentlyFailed", mock.Anything, uint64(20), mock.Anything).
+		Return(false, nil)
+
+	// Act
+	_, err := s.sut.Retry(ctx, repository.Owner{UserID: 7}, 20)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrNotificationNotRetryable)
+	s.emailSenderMock.AssertNotCalled(s.T(), "Send", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *NotificationDispatcherServiceTestSuite) TestRetry_NotificationNotFound_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+
+	s.notificationRepositoryMock.On("FindByID", mock.Anything, repository.Owner{UserID: 7}, uint64(20)).
+		Return(model.NotificationModel{}, shared_errs.ErrRecordNotFound)
+
+	// Act
+	_, err := s.sut.Retry(ctx, repository.Owner{UserID: 7}, 20)
+
+	// Assert
+	s.Require().ErrorIs(err, shared_errs.ErrRecordNotFound)
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+)
+
+// MonitorAlert is a monitor state change worth notifying its contacts about.
+// Type is one of the enum.NotificationType values.
+type MonitorAlert struct {
+	Type     string
+	Monitor  model.HTTPMonitorModel
+	Check    model.HTTPMonitorCheckModel
+	Incident model.IncidentModel
+}
+
+// NotificationSenderI delivers an alert through a single channel, such as email or webhook.
+type NotificationSenderI interface {
+	Send(ctx context.Context, alert MonitorAlert, contact model.ContactModel) error
+}
+
+// NotificationSenders maps a contact type to the sender of its channel.
+type NotificationSenders map[string]NotificationSenderI
+
+func NewNotificationSenders(
+	emailSender *EmailNotificationSender,
+	webhookSender *WebhookNotificationSender,
+) NotificationSenders {
+	return NotificationSenders{
+		enum.ContactTypeEmail:   emailSender,
+		enum.ContactTypeWebhook: webhookSender,
+	}
+}
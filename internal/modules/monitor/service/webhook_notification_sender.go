@@ -0,0 +1,163 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/pkg/webhook"
+)
+
+const (
+	defaultWebhookTimeout = 10 * time.Second
+	webhookUserAgent      = "Pingo-Webhook/" + webhook.Version
+)
+
+type WebhookNotificationSender struct {
+	httpClient *http.Client
+	logger     logger.Logger
+}
+
+var _ NotificationSenderI = (*WebhookNotificationSender)(nil)
+
+func NewWebhookNotificationSender(cfg config.Config, logger logger.Logger) *WebhookNotificationSender {
+	timeout := defaultWebhookTimeout
+	if cfg.Notification.WebhookTimeoutSeconds > 0 {
+		timeout = time.Duration(cfg.Notification.WebhookTimeoutSeconds) * time.Second
+	}
+
+	return &WebhookNotificationSender{
+		httpClient: &http.Client{Timeout: timeout},
+		logger:     logger,
+	}
+}
+
+// Send POSTs the alert as a webhook.Event to the URL of the contact, signed with its secret.
+// A contact without a secret is never delivered unsigned, the delivery fails until a secret is
+// rotated in.
+// Any response outside the 2xx range is a failed delivery.
+func (s *WebhookNotificationSender) Send(ctx context.Context, alert MonitorAlert, contact model.ContactModel) error {
+	ctx, span := trace.Span(ctx, "WebhookNotificationSender.Send")
+	defer span.End()
+
+	if !contact.SigningSecret.Valid {
+		err := errs.ErrMissingSigningSecret
+		s.logger.Error().Msgf("error sending the %s webhook to contact ID %d: %v", alert.Type, contact.ID, err)
+		return err
+	}
+
+	body, err := json.Marshal(s.event(alert))
+	if err != nil {
+		s.logger.Error().Msgf("error encoding the %s webhook event: %v", alert.Type, err)
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, contact.ContactData, bytes.NewReader(body))
+	if err != nil {
+		s.logger.Error().Msgf("error creating the webhook request for contact ID %d: %v", contact.ID, err)
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", webhookUserAgent)
+
+	signedAt := time.Now()
+	req.Header.Set(webhook.TimestampHeader, strconv.FormatInt(signedAt.Unix(), 10))
+	req.Header.Set(webhook.SignatureHeader, webhook.Sign(contact.SigningSecret.String, signedAt, body))
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		s.logger.Error().Msgf("error sending the %s webhook to contact ID %d: %v", alert.Type, contact.ID, err)
+		return err
+	}
+	defer res.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, maxResponseBodySize))
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		err = fmt.Errorf("webhook responded with status code %d", res.StatusCode)
+		s.logger.Error().Msgf("error sending the %s webhook to contact ID %d: %v", alert.Type, contact.ID, err)
+		return err
+	}
+
+	return nil
+}
+
+func (s *WebhookNotificationSender) event(alert MonitorAlert) webhook.Event {
+	event := webhook.Event{
+		Version:    webhook.Version,
+		Type:       s.eventType(alert.Type),
+		OccurredAt: time.Now().UTC(),
+		Monitor: webhook.Monitor{
+			ID:     alert.Monitor.ID,
+			Name:   alert.Monitor.Name,
+			URL:    alert.Monitor.HTTPURL,
+			Method: alert.Monitor.HTTPMethod,
+		},
+	}
+
+	if alert.Check.ID != 0 {
+		event.Check = &webhook.Check{
+			ID:             alert.Check.ID,
+			CheckedAt:      alert.Check.CheckedAt,
+			Success:        alert.Check.Success,
+			StatusCode:     s.int32Ptr(alert.Check.StatusCode),
+			ResponseTimeMs: s.int32Ptr(alert.Check.ResponseTimeMs),
+			ErrorMessage:   s.stringPtr(alert.Check.ErrorMessage),
+		}
+	}
+
+	if alert.Incident.ID != 0 {
+		event.Incident = &webhook.Incident{
+			ID:           alert.Incident.ID,
+			Cause:        alert.Incident.Cause,
+			StartedAt:    alert.Incident.StartedAt,
+			StatusCode:   s.int32Ptr(alert.Incident.StatusCode),
+			ErrorMessage: s.stringPtr(alert.Incident.ErrorMessage),
+		}
+		if alert.Incident.ResolvedAt.Valid {
+			event.Incident.ResolvedAt = &alert.Incident.ResolvedAt.Time
+		}
+		if alert.Incident.DurationSeconds.Valid {
+			event.Incident.DurationSeconds = &alert.Incident.DurationSeconds.Int64
+		}
+	}
+
+	return event
+}
+
+func (s *WebhookNotificationSender) eventType(notificationType string) string {
+	switch notificationType {
+	case enum.NotificationTypeRecovery:
+		return webhook.EventTypeMonitorUp
+	case enum.NotificationTypeMaintenance:
+		return webhook.EventTypeMonitorMaintenance
+	default:
+		return webhook.EventTypeMonitorDown
+	}
+}
+
+func (s *WebhookNotificationSender) int32Ptr(value sql.NullInt32) *int32 {
+	if !value.Valid {
+		return nil
+	}
+	return &value.Int32
+}
+
+func (s *WebhookNotificationSender) stringPtr(value sql.NullString) *string {
+	if !value.Valid {
+		return nil
+	}
+	return &value.String
+}
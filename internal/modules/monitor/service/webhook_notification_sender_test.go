@@ -0,0 +1,252 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/service"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/pkg/webhook"
+)
+
+const webhookSecret = "0f4c2a9e8b7d6c5b4a3928171615141312111009080706050403020100ffeedd"
+
+type WebhookNotificationSenderTestSuite struct {
+	suite.Suite
+	sut    *service.WebhookNotificationSender
+	logger logger.Logger
+	cfg    config.Config
+}
+
+func (s *WebhookNotificationSenderTestSuite) SetupTest() {
+	s.cfg = config.Config{
+		Notification: config.Notification{
+			WebhookTimeoutSeconds: 1,
+		},
+		Log: config.Log{
+			LogLevel: "disabled",
+		},
+	}
+	s.logger = logger.New(s.cfg)
+
+	s.sut = service.NewWebhookNotificationSender(s.cfg, s.logger)
+}
+
+func TestWebhookNotificationSenderSuite(t *testing.T) {
+	suite.Run(t, new(WebhookNotificationSenderTestSuite))
+}
+
+func (s *WebhookNotificationSenderTestSuite) newContact(url string) model.ContactModel {
+	return model.ContactModel{
+		ID:            3,
+		Name:          "Ops",
+		ContactType:   enum.ContactTypeWebhook,
+		ContactData:   url,
+		SigningSecret: sql.NullString{String: webhookSecret, Valid: true},
+		IsEnabled:     true,
+	}
+}
+
+func (s *WebhookNotificationSenderTestSuite) newAlert() service.MonitorAlert {
+	startedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	return service.MonitorAlert{
+		Type: enum.NotificationTypeFailure,
+		Monitor: model.HTTPMonitorModel{
+			ID:         1,
+			Name:       "Example",
+			HTTPURL:    "https://example.com/health",
+			HTTPMethod: "GET",
+		},
+		Check: model.HTTPMonitorCheckModel{
+			ID:             9,
+			HTTPMonitorID:  1,
+			CheckedAt:      startedAt.Add(2 * time.Minute),
+			ResponseTimeMs: sql.NullInt32{Int32: 245, Valid: true},
+			StatusCode:     sql.NullInt32{Int32: 503, Valid: true},
+			ErrorMessage:   sql.NullString{String: "unexpected response status code 503", Valid: true},
+		},
+		Incident: model.IncidentModel{
+			ID:            4,
+			HTTPMonitorID: 1,
+			Cause:         enum.IncidentCauseUnexpectedStatus,
+			StatusCode:    sql.NullInt32{Int32: 503, Valid: true},
+			ErrorMessage:  sql.NullString{String: "unexpected response status code 503", Valid: true},
+			StartedAt:     startedAt,
+		},
+	}
+}
+
+func (s *WebhookNotificationSenderTestSuite) TestSend_FailureAlert_PostsVersionedEvent() {
+	// Arrange
+	ctx := context.Background()
+	alert := s.newAlert()
+
+	var received webhook.Event
+	var contentType string
+	var verifyErr error
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		_, verifyErr = webhook.VerifyRequest(r, webhookSecret, webhook.DefaultTolerance)
+		s.NoError(json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	// Act
+	err := s.sut.Send(ctx, alert, s.newContact(receiver.URL))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().NoError(verifyErr)
+	s.Equal("application/json", contentType)
+	s.Equal(webhook.Version, received.Version)
+	s.Equal(webhook.EventTypeMonitorDown, received.Type)
+	s.False(received.OccurredAt.IsZero())
+	s.Equal(webhook.Monitor{ID: 1, Name: "Example", URL: "https://example.com/health", Method: "GET"}, received.Monitor)
+	s.Require().NotNil(received.Check)
+	s.Equal(uint64(9), received.Check.ID)
+	s.Equal(int32(503), *received.Check.StatusCode)
+	s.Equal(int32(245), *received.Check.ResponseTimeMs)
+	s.Require().NotNil(received.Incident)
+	s.Equal(uint64(4), received.Incident.ID)
+	s.Equal(enum.IncidentCauseUnexpectedStatus, received.Incident.Cause)
+	s.Nil(received.Incident.ResolvedAt)
+}
+
+func (s *WebhookNotificationSenderTestSuite) TestSend_RecoveryAlert_PostsResolvedIncident() {
+	// Arrange
+	ctx := context.Background()
+	alert := s.newAlert()
+	alert.Type = enum.NotificationTypeRecovery
+	alert.Incident.ResolvedAt = sql.NullTime{Time: alert.Incident.StartedAt.Add(10 * time.Minute), Valid: true}
+	alert.Incident.DurationSeconds = sql.NullInt64{Int64: 600, Valid: true}
+
+	var received webhook.Event
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.NoError(json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer receiver.Close()
+
+	// Act
+	err := s.sut.Send(ctx, alert, s.newContact(receiver.URL))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(webhook.EventTypeMonitorUp, received.Type)
+	s.Require().NotNil(received.Incident)
+	s.Require().NotNil(received.Incident.DurationSeconds)
+	s.Equal(int64(600), *received.Incident.DurationSeconds)
+	s.Require().NotNil(received.Incident.ResolvedAt)
+}
+
+func (s *WebhookNotificationSenderTestSuite) TestSend_MaintenanceAlert_OmitsCheckAndIncident() {
+	// Arrange
+	ctx := context.Background()
+	alert := service.MonitorAlert{
+		Type:    enum.NotificationTypeMaintenance,
+		Monitor: model.HTTPMonitorModel{ID: 1, Name: "Example"},
+	}
+
+	var received map[string]any
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.NoError(json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer receiver.Close()
+
+	// Act
+	err := s.sut.Send(ctx, alert, s.newContact(receiver.URL))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(webhook.EventTypeMonitorMaintenance, received["type"])
+	s.NotContains(received, "check")
+	s.NotContains(received, "incident")
+}
+
+func (s *WebhookNotificationSenderTestSuite) TestSend_Non2xxResponse_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer receiver.Close()
+
+	// Act
+	err := s.sut.Send(ctx, s.newAlert(), s.newContact(receiver.URL))
+
+	// Assert
+	s.Require().EqualError(err, "webhook responded with status code 500")
+}
+
+func (s *WebhookNotificationSenderTestSuite) TestSend_SlowReceiver_ReturnsTimeoutError() {
+	// Arrange
+	ctx := context.Background()
+	release := make(chan struct{})
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer receiver.Close()
+	defer close(release)
+
+	// Act
+	err := s.sut.Send(ctx, s.newAlert(), s.newContact(receiver.URL))
+
+	// Assert
+	s.Require().Error(err)
+	s.Contains(err.Error(), "Client.Timeout exceeded")
+}
+
+func (s *WebhookNotificationSenderTestSuite) TestSend_OtherSecret_FailsVerification() {
+	// Arrange
+	ctx := context.Background()
+
+	var verifyErr error
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, verifyErr = webhook.VerifyRequest(r, "another-secret", webhook.DefaultTolerance)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer receiver.Close()
+
+	// Act
+	err := s.sut.Send(ctx, s.newAlert(), s.newContact(receiver.URL))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().ErrorIs(verifyErr, webhook.ErrInvalidSignature)
+}
+
+func (s *WebhookNotificationSenderTestSuite) TestSend_ContactWithoutSecret_ReturnsErrorWithoutPosting() {
+	// Arrange
+	ctx := context.Background()
+
+	posted := false
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		posted = true
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer receiver.Close()
+
+	contact := s.newContact(receiver.URL)
+	contact.SigningSecret = sql.NullString{}
+
+	// Act
+	err := s.sut.Send(ctx, s.newAlert(), contact)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrMissingSigningSecret)
+	s.False(posted)
+}
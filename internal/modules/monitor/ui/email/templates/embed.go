@@ -0,0 +1,6 @@
+package templates
+
+import "embed"
+
+//go:embed *.gohtml
+var EmailTemplatesFS embed.FS
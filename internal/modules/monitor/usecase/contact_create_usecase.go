@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 
 	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/enum"
@@ -12,21 +13,26 @@ import (
 	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+	"github.com/cristiano-pacheco/pingo/pkg/webhook"
 
 	"github.com/cristiano-pacheco/go-otel/trace"
 )
 
 type ContactCreateInput struct {
-	Name        string `validate:"required,min=3,max=255"`
-	ContactType string `validate:"required,oneof=email webhook"`
-	ContactData string `validate:"required,max=500"`
+	UserID         uint64 `validate:"required"`
+	OrganizationID uint64
+	Name           string `validate:"required,min=3,max=255"`
+	ContactType    string `validate:"required,oneof=email webhook"`
+	ContactData    string `validate:"required,max=500"`
 }
 
+// ContactCreateOutput carries the signing secret of webhook contacts, it's empty for other types.
 type ContactCreateOutput struct {
-	ContactID   uint64
-	Name        string
-	ContactType string
-	ContactData string
+	ContactID     uint64
+	Name          string
+	ContactType   string
+	ContactData   string
+	SigningSecret string
 }
 
 type ContactCreateUseCase struct {
@@ -73,7 +79,8 @@ func (uc *ContactCreateUseCase) Execute(ctx context.Context, input ContactCreate
 	}
 
 	// Check if contact with the same name already exists
-	contact, err := uc.contactRepository.FindByName(ctx, input.Name)
+	owner := repository.Owner{UserID: input.UserID, OrganizationID: input.OrganizationID}
+	contact, err := uc.contactRepository.FindByName(ctx, owner, input.Name)
 	if err != nil && !errors.Is(err, shared_errs.ErrRecordNotFound) {
 		uc.logger.Error().Msgf("error finding contact by name: %v", err)
 		return output, err
@@ -84,10 +91,22 @@ func (uc *ContactCreateUseCase) Execute(ctx context.Context, input ContactCreate
 	}
 
 	contactModel := model.ContactModel{
-		Name:        input.Name,
-		ContactType: contactTypeEnum.String(),
-		ContactData: input.ContactData,
-		IsEnabled:   true,
+		UserID:         input.UserID,
+		OrganizationID: nullableOrganizationID(input.OrganizationID),
+		Name:           input.Name,
+		ContactType:    contactTypeEnum.String(),
+		ContactData:    input.ContactData,
+		IsEnabled:      true,
+	}
+
+	// webhook deliveries are signed with a secret of the contact
+	if contactModel.ContactType == enum.ContactTypeWebhook {
+		signingSecret, secretErr := webhook.GenerateSecret()
+		if secretErr != nil {
+			uc.logger.Error().Msgf("error generating contact signing secret: %v", secretErr)
+			return output, secretErr
+		}
+		contactModel.SigningSecret = sql.NullString{String: signingSecret, Valid: true}
 	}
 
 	createdContact, err := uc.contactRepository.Create(ctx, contactModel)
@@ -97,10 +116,11 @@ func (uc *ContactCreateUseCase) Execute(ctx context.Context, input ContactCreate
 	}
 
 	output = ContactCreateOutput{
-		ContactID:   createdContact.ID,
-		Name:        createdContact.Name,
-		ContactType: createdContact.ContactType,
-		ContactData: createdContact.ContactData,
+		ContactID:     createdContact.ID,
+		Name:          createdContact.Name,
+		ContactType:   createdContact.ContactType,
+		ContactData:   createdContact.ContactData,
+		SigningSecret: createdContact.SigningSecret.String,
 	}
 
 	return output, nil
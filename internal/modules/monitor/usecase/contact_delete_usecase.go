@@ -11,7 +11,9 @@ import (
 )
 
 type ContactDeleteInput struct {
-	ContactID uint64 `validate:"required"`
+	UserID         uint64 `validate:"required"`
+	OrganizationID uint64
+	ContactID      uint64 `validate:"required"`
 }
 
 type ContactDeleteUseCase struct {
@@ -41,7 +43,8 @@ func (uc *ContactDeleteUseCase) Execute(ctx context.Context, input ContactDelete
 		return err
 	}
 
-	err = uc.contactRepository.Delete(ctx, input.ContactID)
+	owner := repository.Owner{UserID: input.UserID, OrganizationID: input.OrganizationID}
+	err = uc.contactRepository.Delete(ctx, owner, input.ContactID)
 	if err != nil {
 		uc.logger.Error().Msgf("error deleting contact: %v", err)
 		return err
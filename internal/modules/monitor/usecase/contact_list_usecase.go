@@ -9,6 +9,11 @@ import (
 	"github.com/cristiano-pacheco/go-otel/trace"
 )
 
+type ContactListInput struct {
+	UserID         uint64
+	OrganizationID uint64
+}
+
 type ContactListOutput struct {
 	Contacts []ContactListItem
 }
@@ -36,13 +41,14 @@ func NewContactListUseCase(
 	}
 }
 
-func (uc *ContactListUseCase) Execute(ctx context.Context) (ContactListOutput, error) {
+func (uc *ContactListUseCase) Execute(ctx context.Context, input ContactListInput) (ContactListOutput, error) {
 	ctx, span := trace.Span(ctx, "ContactListUseCase.Execute")
 	defer span.End()
 
 	output := ContactListOutput{}
 
-	contacts, err := uc.contactRepository.FindAll(ctx)
+	owner := repository.Owner{UserID: input.UserID, OrganizationID: input.OrganizationID}
+	contacts, err := uc.contactRepository.FindAll(ctx, owner)
 	if err != nil {
 		uc.logger.Error().Msgf("error finding all contacts: %v", err)
 		return output, err
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+	"github.com/cristiano-pacheco/pingo/pkg/webhook"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+)
+
+type ContactRotateSecretInput struct {
+	UserID         uint64 `validate:"required"`
+	OrganizationID uint64
+	ContactID      uint64 `validate:"required"`
+}
+
+type ContactRotateSecretOutput struct {
+	ContactID     uint64
+	SigningSecret string
+}
+
+type ContactRotateSecretUseCase struct {
+	contactRepository repository.ContactRepositoryI
+	validate          validator.Validate
+	logger            logger.Logger
+}
+
+func NewContactRotateSecretUseCase(
+	contactRepository repository.ContactRepositoryI,
+	validate validator.Validate,
+	logger logger.Logger,
+) *ContactRotateSecretUseCase {
+	return &ContactRotateSecretUseCase{
+		contactRepository: contactRepository,
+		validate:          validate,
+		logger:            logger,
+	}
+}
+
+// Execute replaces the signing secret of a webhook contact, deliveries are signed
+// with the new secret right away.
+func (uc *ContactRotateSecretUseCase) Execute(
+	ctx context.Context,
+	input ContactRotateSecretInput,
+) (ContactRotateSecretOutput, error) {
+	ctx, span := trace.Span(ctx, "ContactRotateSecretUseCase.Execute")
+	defer span.End()
+
+	output := ContactRotateSecretOutput{}
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return output, err
+	}
+
+	owner := repository.Owner{UserID: input.UserID, OrganizationID: input.OrganizationID}
+	contact, err := uc.contactRepository.FindByID(ctx, owner, input.ContactID)
+	if err != nil {
+		if !errors.Is(err, shared_errs.ErrRecordNotFound) {
+			uc.logger.Error().Msgf("error finding contact by ID %d: %v", input.ContactID, err)
+		}
+		return output, err
+	}
+
+	if contact.ContactType != enum.ContactTypeWebhook {
+		return output, errs.ErrContactNotWebhook
+	}
+
+	signingSecret, err := webhook.GenerateSecret()
+	if err != nil {
+		uc.logger.Error().Msgf("error generating contact signing secret: %v", err)
+		return output, err
+	}
+
+	err = uc.contactRepository.UpdateSigningSecret(ctx, owner, contact.ID, signingSecret)
+	if err != nil {
+		uc.logger.Error().Msgf("error updating signing secret of contact ID %d: %v", contact.ID, err)
+		return output, err
+	}
+
+	output = ContactRotateSecretOutput{
+		ContactID:     contact.ID,
+		SigningSecret: signingSecret,
+	}
+
+	return output, nil
+}
@@ -0,0 +1,141 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/usecase"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	shared_validator_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/validator/mocks"
+)
+
+type ContactRotateSecretUseCaseTestSuite struct {
+	suite.Suite
+	sut                   *usecase.ContactRotateSecretUseCase
+	contactRepositoryMock *repository_mocks.MockContactRepositoryI
+	validatorMock         *shared_validator_mocks.MockValidate
+	logger                logger.Logger
+}
+
+func (s *ContactRotateSecretUseCaseTestSuite) SetupTest() {
+	s.contactRepositoryMock = repository_mocks.NewMockContactRepositoryI(s.T())
+	s.validatorMock = shared_validator_mocks.NewMockValidate(s.T())
+
+	cfg := config.Config{
+		Log: config.Log{
+			LogLevel: "disabled",
+		},
+	}
+	s.logger = logger.New(cfg)
+
+	s.sut = usecase.NewContactRotateSecretUseCase(
+		s.contactRepositoryMock,
+		s.validatorMock,
+		s.logger,
+	)
+}
+
+func TestContactRotateSecretUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(ContactRotateSecretUseCaseTestSuite))
+}
+
+func (s *ContactRotateSecretUseCaseTestSuite) TestExecute_WebhookContact_StoresNewSecret() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.ContactRotateSecretInput{UserID: 5, ContactID: 3}
+	owner := repository.Owner{UserID: input.UserID}
+	contact := model.ContactModel{
+		ID:            3,
+		ContactType:   enum.ContactTypeWebhook,
+		ContactData:   "https://example.com/hooks/pingo",
+		SigningSecret: sql.NullString{String: "old-secret", Valid: true},
+	}
+
+	var storedSecret string
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.contactRepositoryMock.On("FindByID", mock.Anything, owner, input.ContactID).Return(contact, nil)
+	s.contactRepositoryMock.
+		On("UpdateSigningSecret", mock.Anything, owner, input.ContactID, mock.AnythingOfType("string")).
+		Run(func(args mock.Arguments) { storedSecret = args.String(3) }).
+		Return(nil)
+
+	// Act
+	output, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(input.ContactID, output.ContactID)
+	s.Len(output.SigningSecret, 64)
+	s.NotEqual("old-secret", output.SigningSecret)
+	s.Equal(storedSecret, output.SigningSecret)
+}
+
+func (s *ContactRotateSecretUseCaseTestSuite) TestExecute_EmailContact_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.ContactRotateSecretInput{UserID: 5, ContactID: 3}
+	owner := repository.Owner{UserID: input.UserID}
+	contact := model.ContactModel{ID: 3, ContactType: enum.ContactTypeEmail, ContactData: "ops@example.com"}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.contactRepositoryMock.On("FindByID", mock.Anything, owner, input.ContactID).Return(contact, nil)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrContactNotWebhook)
+	s.contactRepositoryMock.AssertNotCalled(
+		s.T(), "UpdateSigningSecret", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	)
+}
+
+func (s *ContactRotateSecretUseCaseTestSuite) TestExecute_ContactNotFound_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.ContactRotateSecretInput{UserID: 5, ContactID: 3}
+	owner := repository.Owner{UserID: input.UserID}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.contactRepositoryMock.On("FindByID", mock.Anything, owner, input.ContactID).
+		Return(model.ContactModel{}, shared_errs.ErrRecordNotFound)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, shared_errs.ErrRecordNotFound)
+}
+
+func (s *ContactRotateSecretUseCaseTestSuite) TestExecute_UpdateFails_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.ContactRotateSecretInput{UserID: 5, ContactID: 3}
+	owner := repository.Owner{UserID: input.UserID}
+	contact := model.ContactModel{ID: 3, ContactType: enum.ContactTypeWebhook}
+	updateErr := errors.New("database error")
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.contactRepositoryMock.On("FindByID", mock.Anything, owner, input.ContactID).Return(contact, nil)
+	s.contactRepositoryMock.
+		On("UpdateSigningSecret", mock.Anything, owner, input.ContactID, mock.AnythingOfType("string")).
+		Return(updateErr)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, updateErr)
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 
 	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/enum"
@@ -12,16 +13,25 @@ import (
 	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+	"github.com/cristiano-pacheco/pingo/pkg/webhook"
 
 	"github.com/cristiano-pacheco/go-otel/trace"
 )
 
 type ContactUpdateInput struct {
-	ContactID   uint64 `validate:"required"`
-	Name        string `validate:"required,min=3,max=255"`
-	ContactType string `validate:"required,oneof=email webhook"`
-	ContactData string `validate:"required,max=500"`
-	IsEnabled   bool
+	UserID         uint64 `validate:"required"`
+	OrganizationID uint64
+	ContactID      uint64 `validate:"required"`
+	Name           string `validate:"required,min=3,max=255"`
+	ContactType    string `validate:"required,oneof=email webhook"`
+	ContactData    string `validate:"required,max=500"`
+	IsEnabled      bool
+}
+
+// ContactUpdateOutput carries the signing secret generated for a contact changed to a webhook,
+// it's empty when the contact already had a secret or is not a webhook.
+type ContactUpdateOutput struct {
+	SigningSecret string
 }
 
 type ContactUpdateUseCase struct {
@@ -45,36 +55,47 @@ func NewContactUpdateUseCase(
 	}
 }
 
-func (uc *ContactUpdateUseCase) Execute(ctx context.Context, input ContactUpdateInput) error {
+func (uc *ContactUpdateUseCase) Execute(ctx context.Context, input ContactUpdateInput) (ContactUpdateOutput, error) {
 	ctx, span := trace.Span(ctx, "ContactUpdateUseCase.Execute")
 	defer span.End()
 
+	output := ContactUpdateOutput{}
+
 	err := uc.validate.Struct(input)
 	if err != nil {
-		return err
+		return output, err
 	}
 
 	// Validate contact type using the enum
 	contactTypeEnum, err := enum.NewContactTypeEnum(input.ContactType)
 	if err != nil {
-		return err
+		return output, err
 	}
 
 	// Validate contact data based on contact type
 	if validationErr := uc.contactValidator.Validate(input.ContactType, input.ContactData); validationErr != nil {
-		return validationErr
+		return output, validationErr
+	}
+
+	owner := repository.Owner{UserID: input.UserID, OrganizationID: input.OrganizationID}
+	currentContact, err := uc.contactRepository.FindByID(ctx, owner, input.ContactID)
+	if err != nil {
+		if !errors.Is(err, shared_errs.ErrRecordNotFound) {
+			uc.logger.Error().Msgf("error finding contact by ID %d: %v", input.ContactID, err)
+		}
+		return output, err
 	}
 
 	// Check if another contact with the same name already exists
-	existingContact, err := uc.contactRepository.FindByName(ctx, input.Name)
+	existingContact, err := uc.contactRepository.FindByName(ctx, owner, input.Name)
 	if err != nil && !errors.Is(err, shared_errs.ErrRecordNotFound) {
 		uc.logger.Error().Msgf("error finding contact by name: %v", err)
-		return err
+		return output, err
 	}
 
 	// If a contact with the same name exists and it's not the one being updated
 	if existingContact.ID != 0 && existingContact.ID != input.ContactID {
-		return errs.ErrContactNameAlreadyInUse
+		return output, errs.ErrContactNameAlreadyInUse
 	}
 
 	contactModel := model.ContactModel{
@@ -85,11 +106,26 @@ func (uc *ContactUpdateUseCase) Execute(ctx context.Context, input ContactUpdate
 		IsEnabled:   input.IsEnabled,
 	}
 
-	_, err = uc.contactRepository.Update(ctx, contactModel)
+	// a contact changed to a webhook is given a signing secret the way create does, an existing
+	// secret is left untouched, it's only ever returned by create, this update and rotate
+	if contactModel.ContactType == enum.ContactTypeWebhook && !currentContact.SigningSecret.Valid {
+		signingSecret, secretErr := webhook.GenerateSecret()
+		if secretErr != nil {
+			uc.logger.Error().Msgf("error generating contact signing secret: %v", secretErr)
+			return output, secretErr
+		}
+		contactModel.SigningSecret = sql.NullString{String: signingSecret, Valid: true}
+	}
+
+	_, err = uc.contactRepository.Update(ctx, owner, contactModel)
 	if err != nil {
 		uc.logger.Error().Msgf("error updating contact: %v", err)
-		return err
+		return output, err
+	}
+
+	output = ContactUpdateOutput{
+		SigningSecret: contactModel.SigningSecret.String,
 	}
 
-	return nil
+	return output, nil
 }
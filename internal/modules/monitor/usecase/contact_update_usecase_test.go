@@ -0,0 +1,141 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/usecase"
+	validator_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/validator/mocks"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	shared_validator_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/validator/mocks"
+)
+
+type ContactUpdateUseCaseTestSuite struct {
+	suite.Suite
+	sut                   *usecase.ContactUpdateUseCase
+	contactValidatorMock  *validator_mocks.MockContactValidatorI
+	contactRepositoryMock *repository_mocks.MockContactRepositoryI
+	validatorMock         *shared_validator_mocks.MockValidate
+	logger                logger.Logger
+}
+
+func (s *ContactUpdateUseCaseTestSuite) SetupTest() {
+	s.contactValidatorMock = validator_mocks.NewMockContactValidatorI(s.T())
+	s.contactRepositoryMock = repository_mocks.NewMockContactRepositoryI(s.T())
+	s.validatorMock = shared_validator_mocks.NewMockValidate(s.T())
+
+	cfg := config.Config{
+		Log: config.Log{
+			LogLevel: "disabled",
+		},
+	}
+	s.logger = logger.New(cfg)
+
+	s.sut = usecase.NewContactUpdateUseCase(
+		s.contactValidatorMock,
+		s.contactRepositoryMock,
+		s.validatorMock,
+		s.logger,
+	)
+}
+
+func TestContactUpdateUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(ContactUpdateUseCaseTestSuite))
+}
+
+func (s *ContactUpdateUseCaseTestSuite) newWebhookInput() usecase.ContactUpdateInput {
+	return usecase.ContactUpdateInput{
+		UserID:      5,
+		ContactID:   3,
+		Name:        "Ops",
+		ContactType: enum.ContactTypeWebhook,
+		ContactData: "https://example.com/hooks/pingo",
+		IsEnabled:   true,
+	}
+}
+
+func (s *ContactUpdateUseCaseTestSuite) expectValidInput(input usecase.ContactUpdateInput) {
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.contactValidatorMock.On("Validate", input.ContactType, input.ContactData).Return(nil)
+	s.contactRepositoryMock.On("FindByName", mock.Anything, repository.Owner{UserID: input.UserID}, input.Name).
+		Return(model.ContactModel{}, shared_errs.ErrRecordNotFound)
+}
+
+func (s *ContactUpdateUseCaseTestSuite) TestExecute_EmailChangedToWebhook_GeneratesSigningSecret() {
+	// Arrange
+	ctx := context.Background()
+	input := s.newWebhookInput()
+	owner := repository.Owner{UserID: input.UserID}
+	contact := model.ContactModel{ID: 3, ContactType: enum.ContactTypeEmail, ContactData: "ops@example.com"}
+
+	var storedSecret sql.NullString
+	s.expectValidInput(input)
+	s.contactRepositoryMock.On("FindByID", mock.Anything, owner, input.ContactID).Return(contact, nil)
+	s.contactRepositoryMock.On("Update", mock.Anything, owner, mock.AnythingOfType("model.ContactModel")).
+		Run(func(args mock.Arguments) { storedSecret = args.Get(2).(model.ContactModel).SigningSecret }).
+		Return(model.ContactModel{}, nil)
+
+	// Act
+	output, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Len(output.SigningSecret, 64)
+	s.True(storedSecret.Valid)
+	s.Equal(storedSecret.String, output.SigningSecret)
+}
+
+func (s *ContactUpdateUseCaseTestSuite) TestExecute_WebhookWithSecret_KeepsSigningSecret() {
+	// Arrange
+	ctx := context.Background()
+	input := s.newWebhookInput()
+	owner := repository.Owner{UserID: input.UserID}
+	contact := model.ContactModel{
+		ID:            3,
+		ContactType:   enum.ContactTypeWebhook,
+		ContactData:   input.ContactData,
+		SigningSecret: sql.NullString{String: "current-secret", Valid: true},
+	}
+
+	s.expectValidInput(input)
+	s.contactRepositoryMock.On("FindByID", mock.Anything, owner, input.ContactID).Return(contact, nil)
+	expectedContact := mock.MatchedBy(func(contact model.ContactModel) bool {
+		return !contact.SigningSecret.Valid
+	})
+	s.contactRepositoryMock.On("Update", mock.Anything, owner, expectedContact).Return(model.ContactModel{}, nil)
+
+	// Act
+	output, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Empty(output.SigningSecret)
+}
+
+func (s *ContactUpdateUseCaseTestSuite) TestExecute_ContactNotFound_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := s.newWebhookInput()
+	owner := repository.Owner{UserID: input.UserID}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.contactValidatorMock.On("Validate", input.ContactType, input.ContactData).Return(nil)
+	s.contactRepositoryMock.On("FindByID", mock.Anything, owner, input.ContactID).
+		Return(model.ContactModel{}, shared_errs.ErrRecordNotFound)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, shared_errs.ErrRecordNotFound)
+}
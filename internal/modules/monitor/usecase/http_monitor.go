@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+)
+
+const defaultCheckIntervalSeconds = 300
+
+type HTTPMonitorOutput struct {
+	MonitorID             uint64
+	Name                  string
+	HTTPURL               string
+	HTTPMethod            string
+	CheckTimeout          int
+	FailThreshold         int16
+	CheckIntervalSeconds  int
+	RequestHeaders        map[string]string
+	ValidResponseStatuses []int32
+	IsEnabled             bool
+	LastCheckedAt         *time.Time
+	LastStatus            *string
+	ConsecutiveFailures   int
+	ContactIDs            []uint64
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+}
+
+func newHTTPMonitorOutput(monitor model.HTTPMonitorModel, contactIDs []uint64) HTTPMonitorOutput {
+	output := HTTPMonitorOutput{
+		MonitorID:             monitor.ID,
+		Name:                  monitor.Name,
+		HTTPURL:               monitor.HTTPURL,
+		HTTPMethod:            monitor.HTTPMethod,
+		CheckTimeout:          monitor.CheckTimeout,
+		FailThreshold:         monitor.FailThreshold,
+		CheckIntervalSeconds:  monitor.CheckIntervalSeconds,
+		RequestHeaders:        map[string]string{},
+		ValidResponseStatuses: monitor.ValidResponseStatuses,
+		IsEnabled:             monitor.IsEnabled,
+		ConsecutiveFailures:   monitor.ConsecutiveFailures,
+		ContactIDs:            contactIDs,
+		CreatedAt:             monitor.CreatedAt,
+		UpdatedAt:             monitor.UpdatedAt,
+	}
+
+	// the headers are validated before being stored, a malformed value is returned as empty
+	_ = json.Unmarshal([]byte(monitor.RequestHeaders), &output.RequestHeaders)
+
+	if output.ContactIDs == nil {
+		output.ContactIDs = []uint64{}
+	}
+	if monitor.LastCheckedAt.Valid {
+		output.LastCheckedAt = &monitor.LastCheckedAt.Time
+	}
+	if monitor.LastStatus.Valid {
+		output.LastStatus = &monitor.LastStatus.String
+	}
+
+	return output
+}
+
+// encodeRequestHeaders stores the headers as the JSON object expected by the request_headers column.
+func encodeRequestHeaders(headers map[string]string) (string, error) {
+	if headers == nil {
+		headers = map[string]string{}
+	}
+
+	encoded, err := json.Marshal(headers)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
+
+// checkContactsExist returns the deduplicated contact IDs or ErrContactNotFound when any of them doesn't exist
+// or belongs to another owner.
+func checkContactsExist(
+	ctx context.Context,
+	contactRepository repository.ContactRepositoryI,
+	owner repository.Owner,
+	contactIDs []uint64,
+) ([]uint64, error) {
+	uniqueContactIDs := slices.Clone(contactIDs)
+	slices.Sort(uniqueContactIDs)
+	uniqueContactIDs = slices.Compact(uniqueContactIDs)
+
+	if len(uniqueContactIDs) == 0 {
+		return []uint64{}, nil
+	}
+
+	contacts, err := contactRepository.FindByIDs(ctx, owner, uniqueContactIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(contacts) != len(uniqueContactIDs) {
+		return nil, errs.ErrContactNotFound
+	}
+
+	return uniqueContactIDs, nil
+}
+
+// nullableOrganizationID keeps the resources created outside of an organization personal.
+func nullableOrganizationID(organizationID uint64) sql.NullInt64 {
+	// IDs come from BIGSERIAL columns, the conversion can't overflow
+	return sql.NullInt64{Int64: int64(organizationID), Valid: organizationID != 0} // #nosec G115
+}
@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+)
+
+type HTTPMonitorCheckOutput struct {
+	CheckID        uint64
+	MonitorID      uint64
+	CheckedAt      time.Time
+	ResponseTimeMs *int32
+	StatusCode     *int32
+	Success        bool
+	ErrorMessage   *string
+}
+
+func newHTTPMonitorCheckOutput(check model.HTTPMonitorCheckModel) HTTPMonitorCheckOutput {
+	output := HTTPMonitorCheckOutput{
+		CheckID:   check.ID,
+		MonitorID: check.HTTPMonitorID,
+		CheckedAt: check.CheckedAt,
+		Success:   check.Success,
+	}
+
+	if check.ResponseTimeMs.Valid {
+		output.ResponseTimeMs = &check.ResponseTimeMs.Int32
+	}
+	if check.StatusCode.Valid {
+		output.StatusCode = &check.StatusCode.Int32
+	}
+	if check.ErrorMessage.Valid {
+		output.ErrorMessage = &check.ErrorMessage.String
+	}
+
+	return output
+}
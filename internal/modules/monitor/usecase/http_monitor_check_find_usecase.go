@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+)
+
+type HTTPMonitorCheckFindInput struct {
+	UserID         uint64 `validate:"required"`
+	OrganizationID uint64
+	MonitorID      uint64 `validate:"required"`
+	CheckID        uint64 `validate:"required"`
+}
+
+type HTTPMonitorCheckFindUseCase struct {
+	httpMonitorRepository      repository.HTTPMonitorRepositoryI
+	httpMonitorCheckRepository repository.HTTPMonitorCheckRepositoryI
+	validate                   validator.Validate
+	logger                     logger.Logger
+}
+
+func NewHTTPMonitorCheckFindUseCase(
+	httpMonitorRepository repository.HTTPMonitorRepositoryI,
+	httpMonitorCheckRepository repository.HTTPMonitorCheckRepositoryI,
+	validate validator.Validate,
+	logger logger.Logger,
+) *HTTPMonitorCheckFindUseCase {
+	return &HTTPMonitorCheckFindUseCase{
+		httpMonitorRepository:      httpMonitorRepository,
+		httpMonitorCheckRepository: httpMonitorCheckRepository,
+		validate:                   validate,
+		logger:                     logger,
+	}
+}
+
+func (uc *HTTPMonitorCheckFindUseCase) Execute(
+	ctx context.Context,
+	input HTTPMonitorCheckFindInput,
+) (HTTPMonitorCheckOutput, error) {
+	ctx, span := trace.Span(ctx, "HTTPMonitorCheckFindUseCase.Execute")
+	defer span.End()
+
+	output := HTTPMonitorCheckOutput{}
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return output, err
+	}
+
+	// the checks of a monitor of another user are reported as not found
+	owner := repository.Owner{UserID: input.UserID, OrganizationID: input.OrganizationID}
+	_, err = uc.httpMonitorRepository.FindByID(ctx, owner, input.MonitorID)
+	if err != nil {
+		if !errors.Is(err, shared_errs.ErrRecordNotFound) {
+			uc.logger.Error().Msgf("error finding monitor by ID %d: %v", input.MonitorID, err)
+		}
+		return output, err
+	}
+
+	check, err := uc.httpMonitorCheckRepository.FindByID(ctx, input.CheckID)
+	if err != nil {
+		if !errors.Is(err, shared_errs.ErrRecordNotFound) {
+			uc.logger.Error().Msgf("error finding check by ID %d: %v", input.CheckID, err)
+		}
+		return output, err
+	}
+
+	// a check of another monitor is reported as not found
+	if check.HTTPMonitorID != input.MonitorID {
+		return output, shared_errs.ErrRecordNotFound
+	}
+
+	return newHTTPMonitorCheckOutput(check), nil
+}
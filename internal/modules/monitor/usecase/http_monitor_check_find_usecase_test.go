@@ -0,0 +1,118 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/usecase"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	shared_validator_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/validator/mocks"
+)
+
+type HTTPMonitorCheckFindUseCaseTestSuite struct {
+	suite.Suite
+	sut                            *usecase.HTTPMonitorCheckFindUseCase
+	httpMonitorRepositoryMock      *repository_mocks.MockHTTPMonitorRepositoryI
+	httpMonitorCheckRepositoryMock *repository_mocks.MockHTTPMonitorCheckRepositoryI
+	validatorMock                  *shared_validator_mocks.MockValidate
+	logger                         logger.Logger
+}
+
+func (s *HTTPMonitorCheckFindUseCaseTestSuite) SetupTest() {
+	s.httpMonitorRepositoryMock = repository_mocks.NewMockHTTPMonitorRepositoryI(s.T())
+	s.httpMonitorCheckRepositoryMock = repository_mocks.NewMockHTTPMonitorCheckRepositoryI(s.T())
+	s.validatorMock = shared_validator_mocks.NewMockValidate(s.T())
+
+	cfg := config.Config{
+		Log: config.Log{
+			LogLevel: "disabled",
+		},
+	}
+	s.logger = logger.New(cfg)
+
+	s.sut = usecase.NewHTTPMonitorCheckFindUseCase(
+		s.httpMonitorRepositoryMock,
+		s.httpMonitorCheckRepositoryMock,
+		s.validatorMock,
+		s.logger,
+	)
+}
+
+func TestHTTPMonitorCheckFindUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(HTTPMonitorCheckFindUseCaseTestSuite))
+}
+
+func (s *HTTPMonitorCheckFindUseCaseTestSuite) TestExecute_CheckOfMonitor_ReturnsCheck() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.HTTPMonitorCheckFindInput{UserID: 3, MonitorID: 10, CheckID: 7}
+	owner := repository.Owner{UserID: input.UserID}
+	check := model.HTTPMonitorCheckModel{
+		ID:            input.CheckID,
+		HTTPMonitorID: input.MonitorID,
+		CheckedAt:     time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC),
+		Success:       true,
+	}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.httpMonitorRepositoryMock.On("FindByID", mock.Anything, owner, input.MonitorID).
+		Return(model.HTTPMonitorModel{ID: input.MonitorID, UserID: input.UserID}, nil)
+	s.httpMonitorCheckRepositoryMock.On("FindByID", mock.Anything, input.CheckID).Return(check, nil)
+
+	// Act
+	output, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(input.CheckID, output.CheckID)
+	s.Equal(input.MonitorID, output.MonitorID)
+	s.Equal(check.CheckedAt, output.CheckedAt)
+	s.True(output.Success)
+	s.Nil(output.StatusCode)
+}
+
+func (s *HTTPMonitorCheckFindUseCaseTestSuite) TestExecute_CheckOfAnotherMonitor_ReturnsNotFound() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.HTTPMonitorCheckFindInput{UserID: 3, MonitorID: 10, CheckID: 7}
+	owner := repository.Owner{UserID: input.UserID}
+	check := model.HTTPMonitorCheckModel{ID: input.CheckID, HTTPMonitorID: 11}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.httpMonitorRepositoryMock.On("FindByID", mock.Anything, owner, input.MonitorID).
+		Return(model.HTTPMonitorModel{ID: input.MonitorID, UserID: input.UserID}, nil)
+	s.httpMonitorCheckRepositoryMock.On("FindByID", mock.Anything, input.CheckID).Return(check, nil)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, shared_errs.ErrRecordNotFound)
+}
+
+func (s *HTTPMonitorCheckFindUseCaseTestSuite) TestExecute_MonitorOfAnotherUser_ReturnsNotFound() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.HTTPMonitorCheckFindInput{UserID: 3, MonitorID: 10, CheckID: 7}
+	owner := repository.Owner{UserID: input.UserID}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.httpMonitorRepositoryMock.On("FindByID", mock.Anything, owner, input.MonitorID).
+		Return(model.HTTPMonitorModel{}, shared_errs.ErrRecordNotFound)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, shared_errs.ErrRecordNotFound)
+	s.httpMonitorCheckRepositoryMock.AssertNotCalled(s.T(), "FindByID", mock.Anything, mock.Anything)
+}
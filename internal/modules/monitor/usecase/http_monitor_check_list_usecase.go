@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+)
+
+type HTTPMonitorCheckListInput struct {
+	UserID         uint64 `validate:"required"`
+	OrganizationID uint64
+	MonitorID      uint64 `validate:"required"`
+	From           *time.Time
+	To             *time.Time
+	Success        *bool
+	StatusCodes    []int32 `validate:"dive,min=100,max=599"`
+	Page           int     `validate:"required,min=1"`
+	PageSize       int     `validate:"required,min=1,max=100"`
+}
+
+type HTTPMonitorCheckListOutput struct {
+	Checks []HTTPMonitorCheckOutput
+	Total  int64
+}
+
+type HTTPMonitorCheckListUseCase struct {
+	httpMonitorRepository      repository.HTTPMonitorRepositoryI
+	httpMonitorCheckRepository repository.HTTPMonitorCheckRepositoryI
+	validate                   validator.Validate
+	logger                     logger.Logger
+}
+
+func NewHTTPMonitorCheckListUseCase(
+	httpMonitorRepository repository.HTTPMonitorRepositoryI,
+	httpMonitorCheckRepository repository.HTTPMonitorCheckRepositoryI,
+	validate validator.Validate,
+	logger logger.Logger,
+) *HTTPMonitorCheckListUseCase {
+	return &HTTPMonitorCheckListUseCase{
+		httpMonitorRepository:      httpMonitorRepository,
+		httpMonitorCheckRepository: httpMonitorCheckRepository,
+		validate:                   validate,
+		logger:                     logger,
+	}
+}
+
+func (uc *HTTPMonitorCheckListUseCase) Execute(
+	ctx context.Context,
+	input HTTPMonitorCheckListInput,
+) (HTTPMonitorCheckListOutput, error) {
+	ctx, span := trace.Span(ctx, "HTTPMonitorCheckListUseCase.Execute")
+	defer span.End()
+
+	output := HTTPMonitorCheckListOutput{}
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return output, err
+	}
+
+	if input.From != nil && input.To != nil && input.From.After(*input.To) {
+		return output, errs.ErrInvalidTimeRange
+	}
+
+	// the monitor is looked up so an unknown monitor is reported instead of an empty list
+	owner := repository.Owner{UserID: input.UserID, OrganizationID: input.OrganizationID}
+	_, err = uc.httpMonitorRepository.FindByID(ctx, owner, input.MonitorID)
+	if err != nil {
+		if !errors.Is(err, shared_errs.ErrRecordNotFound) {
+			uc.logger.Error().Msgf("error finding monitor by ID %d: %v", input.MonitorID, err)
+		}
+		return output, err
+	}
+
+	// checks are stored in UTC
+	filter := repository.HTTPMonitorCheckFilter{
+		From:        toUTC(input.From),
+		To:          toUTC(input.To),
+		Success:     input.Success,
+		StatusCodes: input.StatusCodes,
+	}
+
+	checks, total, err := uc.httpMonitorCheckRepository.FindAll(
+		ctx,
+		input.MonitorID,
+		filter,
+		input.Page,
+		input.PageSize,
+	)
+	if err != nil {
+		uc.logger.Error().Msgf("error finding checks of monitor ID %d: %v", input.MonitorID, err)
+		return output, err
+	}
+
+	output.Total = total
+	output.Checks = make([]HTTPMonitorCheckOutput, len(checks))
+	for i, check := range checks {
+		output.Checks[i] = newHTTPMonitorCheckOutput(check)
+	}
+
+	return output, nil
+}
+
+func toUTC(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
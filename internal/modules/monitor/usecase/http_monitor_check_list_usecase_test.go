@@ -0,0 +1,166 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/usecase"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	shared_validator_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/validator/mocks"
+)
+
+type HTTPMonitorCheckListUseCaseTestSuite struct {
+	suite.Suite
+	sut                            *usecase.HTTPMonitorCheckListUseCase
+	httpMonitorRepositoryMock      *repository_mocks.MockHTTPMonitorRepositoryI
+	httpMonitorCheckRepositoryMock *repository_mocks.MockHTTPMonitorCheckRepositoryI
+	validatorMock                  *shared_validator_mocks.MockValidate
+	logger                         logger.Logger
+}
+
+func (s *HTTPMonitorCheckListUseCaseTestSuite) SetupTest() {
+	s.httpMonitorRepositoryMock = repository_mocks.NewMockHTTPMonitorRepositoryI(s.T())
+	s.httpMonitorCheckRepositoryMock = repository_mocks.NewMockHTTPMonitorCheckRepositoryI(s.T())
+	s.validatorMock = shared_validator_mocks.NewMockValidate(s.T())
+
+	cfg := config.Config{
+		Log: config.Log{
+			LogLevel: "disabled",
+		},
+	}
+	s.logger = logger.New(cfg)
+
+	s.sut = usecase.NewHTTPMonitorCheckListUseCase(
+		s.httpMonitorRepositoryMock,
+		s.httpMonitorCheckRepositoryMock,
+		s.validatorMock,
+		s.logger,
+	)
+}
+
+func TestHTTPMonitorCheckListUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(HTTPMonitorCheckListUseCaseTestSuite))
+}
+
+func (s *HTTPMonitorCheckListUseCaseTestSuite) TestExecute_ValidInput_ReturnsFilteredChecksInUTC() {
+	// Arrange
+	ctx := context.Background()
+	location := time.FixedZone("UTC-3", -3*60*60)
+	from := time.Date(2026, 10, 1, 9, 0, 0, 0, location)
+	to := time.Date(2026, 10, 2, 9, 0, 0, 0, location)
+	success := false
+	input := usecase.HTTPMonitorCheckListInput{
+		UserID:      5,
+		MonitorID:   10,
+		From:        &from,
+		To:          &to,
+		Success:     &success,
+		StatusCodes: []int32{500, 503},
+		Page:        1,
+		PageSize:    20,
+	}
+	owner := repository.Owner{UserID: input.UserID}
+
+	fromUTC := from.UTC()
+	toUTC := to.UTC()
+	expectedFilter := repository.HTTPMonitorCheckFilter{
+		From:        &fromUTC,
+		To:          &toUTC,
+		Success:     &success,
+		StatusCodes: []int32{500, 503},
+	}
+	checks := []model.HTTPMonitorCheckModel{
+		{
+			ID:            7,
+			HTTPMonitorID: input.MonitorID,
+			CheckedAt:     fromUTC.Add(time.Hour),
+			StatusCode:    sql.NullInt32{Int32: 503, Valid: true},
+			ErrorMessage:  sql.NullString{String: "unexpected status code 503", Valid: true},
+		},
+	}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.httpMonitorRepositoryMock.On("FindByID", mock.Anything, owner, input.MonitorID).
+		Return(model.HTTPMonitorModel{ID: input.MonitorID}, nil)
+	s.httpMonitorCheckRepositoryMock.On("FindAll", mock.Anything, input.MonitorID, expectedFilter, 1, 20).
+		Return(checks, int64(1), nil)
+
+	// Act
+	output, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(int64(1), output.Total)
+	s.Require().Len(output.Checks, 1)
+	s.Equal(uint64(7), output.Checks[0].CheckID)
+	s.Equal(int32(503), *output.Checks[0].StatusCode)
+	s.Nil(output.Checks[0].ResponseTimeMs)
+	s.Equal("unexpected status code 503", *output.Checks[0].ErrorMessage)
+}
+
+func (s *HTTPMonitorCheckListUseCaseTestSuite) TestExecute_FromAfterTo_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	from := time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC)
+	to := from.Add(-time.Hour)
+	input := usecase.HTTPMonitorCheckListInput{
+		UserID:    5,
+		MonitorID: 10,
+		From:      &from,
+		To:        &to,
+		Page:      1,
+		PageSize:  20,
+	}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidTimeRange)
+}
+
+func (s *HTTPMonitorCheckListUseCaseTestSuite) TestExecute_MonitorNotFound_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.HTTPMonitorCheckListInput{UserID: 5, MonitorID: 10, Page: 1, PageSize: 20}
+	owner := repository.Owner{UserID: input.UserID}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.httpMonitorRepositoryMock.On("FindByID", mock.Anything, owner, input.MonitorID).
+		Return(model.HTTPMonitorModel{}, shared_errs.ErrRecordNotFound)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, shared_errs.ErrRecordNotFound)
+}
+
+func (s *HTTPMonitorCheckListUseCaseTestSuite) TestExecute_ValidationFails_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.HTTPMonitorCheckListInput{UserID: 5, MonitorID: 10, Page: 1, PageSize: 500}
+	validationError := errors.New("validation error")
+
+	s.validatorMock.On("Struct", input).Return(validationError)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, validationError)
+}
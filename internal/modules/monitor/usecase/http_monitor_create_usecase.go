@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event/producer"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/scheduler"
+	monitor_validator "github.com/cristiano-pacheco/pingo/internal/modules/monitor/validator"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+	"github.com/lib/pq"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+)
+
+type HTTPMonitorCreateInput struct {
+	UserID                uint64 `validate:"required"`
+	OrganizationID        uint64
+	Name                  string `validate:"required,min=3,max=255"`
+	HTTPURL               string `validate:"required,max=2048"`
+	HTTPMethod            string `validate:"required"`
+	CheckTimeout          int    `validate:"required"`
+	FailThreshold         int16  `validate:"required"`
+	CheckIntervalSeconds  int
+	RequestHeaders        map[string]string
+	ValidResponseStatuses []int32 `validate:"required"`
+	ContactIDs            []uint64
+}
+
+type HTTPMonitorCreateUseCase struct {
+	httpMonitorValidator   monitor_validator.HTTPMonitorValidatorI
+	httpMonitorRepository  repository.HTTPMonitorRepositoryI
+	contactRepository      repository.ContactRepositoryI
+	httpMonitorScheduler   scheduler.HTTPMonitorSchedulerI
+	monitorCreatedProducer producer.MonitorCreatedProducerI
+	txManager              database.TxManagerI
+	validate               validator.Validate
+	logger                 logger.Logger
+}
+
+func NewHTTPMonitorCreateUseCase(
+	httpMonitorValidator monitor_validator.HTTPMonitorValidatorI,
+	httpMonitorRepository repository.HTTPMonitorRepositoryI,
+	contactRepository repository.ContactRepositoryI,
+	httpMonitorScheduler scheduler.HTTPMonitorSchedulerI,
+	monitorCreatedProducer producer.MonitorCreatedProducerI,
+	txManager database.TxManagerI,
+	validate validator.Validate,
+	logger logger.Logger,
+) *HTTPMonitorCreateUseCase {
+	return &HTTPMonitorCreateUseCase{
+		httpMonitorValidator:   httpMonitorValidator,
+		httpMonitorRepository:  httpMonitorRepository,
+		contactRepository:      contactRepository,
+		httpMonitorScheduler:   httpMonitorScheduler,
+		monitorCreatedProducer: monitorCreatedProducer,
+		txManager:              txManager,
+		validate:               validate,
+		logger:                 logger,
+	}
+}
+
+func (uc *HTTPMonitorCreateUseCase) Execute(
+	ctx context.Context,
+	input HTTPMonitorCreateInput,
+) (HTTPMonitorOutput, error) {
+	ctx, span := trace.Span(ctx, "HTTPMonitorCreateUseCase.Execute")
+	defer span.End()
+
+	output := HTTPMonitorOutput{}
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return output, err
+	}
+
+	requestHeaders, err := encodeRequestHeaders(input.RequestHeaders)
+	if err != nil {
+		return output, err
+	}
+
+	checkIntervalSeconds := input.CheckIntervalSeconds
+	if checkIntervalSeconds == 0 {
+		checkIntervalSeconds = defaultCheckIntervalSeconds
+	}
+
+	monitorModel := model.HTTPMonitorModel{
+		UserID:                input.UserID,
+		OrganizationID:        nullableOrganizationID(input.OrganizationID),
+		Name:                  input.Name,
+		CheckTimeout:          input.CheckTimeout,
+		FailThreshold:         input.FailThreshold,
+		CheckIntervalSeconds:  checkIntervalSeconds,
+		IsEnabled:             true,
+		HTTPURL:               input.HTTPURL,
+		HTTPMethod:            strings.ToUpper(input.HTTPMethod),
+		RequestHeaders:        requestHeaders,
+		ValidResponseStatuses: pq.Int32Array(input.ValidResponseStatuses),
+	}
+
+	if validationErr := uc.httpMonitorValidator.Validate(monitorModel); validationErr != nil {
+		return output, validationErr
+	}
+
+	owner := repository.Owner{UserID: input.UserID, OrganizationID: input.OrganizationID}
+	contactIDs, err := checkContactsExist(ctx, uc.contactRepository, owner, input.ContactIDs)
+	if err != nil {
+		if !errors.Is(err, errs.ErrContactNotFound) {
+			uc.logger.Error().Msgf("error finding contacts: %v", err)
+		}
+		return output, err
+	}
+
+	// the event is stored with the monitor, so it is neither lost nor sent for a rolled back monitor
+	var createdMonitor model.HTTPMonitorModel
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		createdMonitor, err = uc.httpMonitorRepository.Create(ctx, monitorModel)
+		if err != nil {
+			uc.logger.Error().Msgf("error creating monitor: %v", err)
+			return err
+		}
+
+		err = uc.httpMonitorRepository.AssignContacts(ctx, createdMonitor.ID, contactIDs)
+		if err != nil {
+			uc.logger.Error().Msgf("error assigning contacts to monitor ID %d: %v", createdMonitor.ID, err)
+			return err
+		}
+
+		err = uc.monitorCreatedProducer.Produce(ctx, event.MonitorCreatedMessage{
+			MonitorID:            createdMonitor.ID,
+			Name:                 createdMonitor.Name,
+			HTTPURL:              createdMonitor.HTTPURL,
+			HTTPMethod:           createdMonitor.HTTPMethod,
+			CheckIntervalSeconds: createdMonitor.CheckIntervalSeconds,
+			IsEnabled:            createdMonitor.IsEnabled,
+			ContactIDs:           contactIDs,
+			OccurredAt:           time.Now().UTC(),
+		})
+		if err != nil {
+			uc.logger.Error().Msgf("error producing monitor created event: %v", err)
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return output, err
+	}
+
+	uc.httpMonitorScheduler.Refresh()
+
+	return newHTTPMonitorOutput(createdMonitor, contactIDs), nil
+}
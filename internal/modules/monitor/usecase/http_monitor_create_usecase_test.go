@@ -0,0 +1,254 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event"
+	producer_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/event/producer/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository/mocks"
+	scheduler_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/scheduler/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/usecase"
+	validator_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/validator/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	database_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/database/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	shared_validator_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/validator/mocks"
+)
+
+type HTTPMonitorCreateUseCaseTestSuite struct {
+	suite.Suite
+	sut                        *usecase.HTTPMonitorCreateUseCase
+	httpMonitorValidatorMock   *validator_mocks.MockHTTPMonitorValidatorI
+	httpMonitorRepositoryMock  *repository_mocks.MockHTTPMonitorRepositoryI
+	contactRepositoryMock      *repository_mocks.MockContactRepositoryI
+	httpMonitorSchedulerMock   *scheduler_mocks.MockHTTPMonitorSchedulerI
+	monitorCreatedProducerMock *producer_mocks.MockMonitorCreatedProducerI
+	txManagerMock              *database_mocks.MockTxManagerI
+	validatorMock              *shared_validator_mocks.MockValidate
+	logger                     logger.Logger
+}
+
+func (s *HTTPMonitorCreateUseCaseTestSuite) SetupTest() {
+	s.httpMonitorValidatorMock = validator_mocks.NewMockHTTPMonitorValidatorI(s.T())
+	s.httpMonitorRepositoryMock = repository_mocks.NewMockHTTPMonitorRepositoryI(s.T())
+	s.contactRepositoryMock = repository_mocks.NewMockContactRepositoryI(s.T())
+	s.httpMonitorSchedulerMock = scheduler_mocks.NewMockHTTPMonitorSchedulerI(s.T())
+	s.monitorCreatedProducerMock = producer_mocks.NewMockMonitorCreatedProducerI(s.T())
+	s.txManagerMock = database_mocks.NewMockTxManagerI(s.T())
+	s.txManagerMock.On("WithinTransaction", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) }).
+		Maybe()
+	s.validatorMock = shared_validator_mocks.NewMockValidate(s.T())
+
+	cfg := config.Config{
+		Log: config.Log{
+			LogLevel: "disabled",
+		},
+	}
+	s.logger = logger.New(cfg)
+
+	s.sut = usecase.NewHTTPMonitorCreateUseCase(
+		s.httpMonitorValidatorMock,
+		s.httpMonitorRepositoryMock,
+		s.contactRepositoryMock,
+		s.httpMonitorSchedulerMock,
+		s.monitorCreatedProducerMock,
+		s.txManagerMock,
+		s.validatorMock,
+		s.logger,
+	)
+}
+
+func TestHTTPMonitorCreateUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(HTTPMonitorCreateUseCaseTestSuite))
+}
+
+func (s *HTTPMonitorCreateUseCaseTestSuite) newInput() usecase.HTTPMonitorCreateInput {
+	return usecase.HTTPMonitorCreateInput{
+		UserID:                5,
+		Name:                  "Example",
+		HTTPURL:               "https://example.com/health",
+		HTTPMethod:            "get",
+		CheckTimeout:          30,
+		FailThreshold:         3,
+		RequestHeaders:        map[string]string{"Authorization": "Bearer token"},
+		ValidResponseStatuses: []int32{200},
+		ContactIDs:            []uint64{2, 1, 2},
+	}
+}
+
+func (s *HTTPMonitorCreateUseCaseTestSuite) TestExecute_ValidInput_CreatesMonitorWithContacts() {
+	// Arrange
+	ctx := context.Background()
+	input := s.newInput()
+	owner := repository.Owner{UserID: input.UserID}
+
+	expectedMonitor := model.HTTPMonitorModel{
+		UserID:                input.UserID,
+		Name:                  input.Name,
+		CheckTimeout:          input.CheckTimeout,
+		FailThreshold:         input.FailThreshold,
+		CheckIntervalSeconds:  300,
+		IsEnabled:             true,
+		HTTPURL:               input.HTTPURL,
+		HTTPMethod:            "GET",
+		RequestHeaders:        `{"Authorization":"Bearer token"}`,
+		ValidResponseStatuses: pq.Int32Array{200},
+	}
+	createdMonitor := expectedMonitor
+	createdMonitor.ID = 10
+	contacts := []model.ContactModel{{ID: 1}, {ID: 2}}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.httpMonitorValidatorMock.On("Validate", expectedMonitor).Return(nil)
+	s.contactRepositoryMock.On("FindByIDs", mock.Anything, owner, []uint64{1, 2}).Return(contacts, nil)
+	s.httpMonitorRepositoryMock.On("Create", mock.Anything, expectedMonitor).Return(createdMonitor, nil)
+	s.httpMonitorRepositoryMock.On("AssignContacts", mock.Anything, createdMonitor.ID, []uint64{1, 2}).Return(nil)
+	expectedMessage := mock.MatchedBy(func(msg event.MonitorCreatedMessage) bool {
+		return msg.MonitorID == createdMonitor.ID && msg.HTTPMethod == "GET" && len(msg.ContactIDs) == 2
+	})
+	s.monitorCreatedProducerMock.On("Produce", mock.Anything, expectedMessage).Return(nil)
+	s.httpMonitorSchedulerMock.On("Refresh").Return()
+
+	// Act
+	output, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(createdMonitor.ID, output.MonitorID)
+	s.Equal("GET", output.HTTPMethod)
+	s.Equal(input.RequestHeaders, output.RequestHeaders)
+	s.Equal([]uint64{1, 2}, output.ContactIDs)
+	s.True(output.IsEnabled)
+}
+
+func (s *HTTPMonitorCreateUseCaseTestSuite) TestExecute_InOrganization_CreatesSharedMonitor() {
+	// Arrange
+	ctx := context.Background()
+	input := s.newInput()
+	input.OrganizationID = 8
+	owner := repository.Owner{UserID: input.UserID, OrganizationID: input.OrganizationID}
+	contacts := []model.ContactModel{{ID: 1}, {ID: 2}}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.httpMonitorValidatorMock.On("Validate", mock.AnythingOfType("model.HTTPMonitorModel")).Return(nil)
+	s.contactRepositoryMock.On("FindByIDs", mock.Anything, owner, []uint64{1, 2}).Return(contacts, nil)
+	expectedMonitor := mock.MatchedBy(func(monitor model.HTTPMonitorModel) bool {
+		return monitor.UserID == input.UserID && monitor.OrganizationID == sql.NullInt64{Int64: 8, Valid: true}
+	})
+	s.httpMonitorRepositoryMock.On("Create", mock.Anything, expectedMonitor).
+		Return(model.HTTPMonitorModel{ID: 10}, nil)
+	s.httpMonitorRepositoryMock.On("AssignContacts", mock.Anything, uint64(10), []uint64{1, 2}).Return(nil)
+	s.monitorCreatedProducerMock.On("Produce", mock.Anything, mock.Anything).Return(nil)
+	s.httpMonitorSchedulerMock.On("Refresh").Return()
+
+	// Act
+	output, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(uint64(10), output.MonitorID)
+}
+
+func (s *HTTPMonitorCreateUseCaseTestSuite) TestExecute_ValidationFails_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := s.newInput()
+	validationError := errors.New("validation error")
+
+	s.validatorMock.On("Struct", input).Return(validationError)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, validationError)
+}
+
+func (s *HTTPMonitorCreateUseCaseTestSuite) TestExecute_InvalidMonitor_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := s.newInput()
+	input.CheckTimeout = 500
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.httpMonitorValidatorMock.On("Validate", mock.AnythingOfType("model.HTTPMonitorModel")).
+		Return(errs.ErrInvalidCheckTimeout)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidCheckTimeout)
+}
+
+func (s *HTTPMonitorCreateUseCaseTestSuite) TestExecute_ContactNotFound_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := s.newInput()
+	owner := repository.Owner{UserID: input.UserID}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.httpMonitorValidatorMock.On("Validate", mock.AnythingOfType("model.HTTPMonitorModel")).Return(nil)
+	s.contactRepositoryMock.On("FindByIDs", mock.Anything, owner, []uint64{1, 2}).
+		Return([]model.ContactModel{{ID: 1}}, nil)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrContactNotFound)
+	s.httpMonitorRepositoryMock.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *HTTPMonitorCreateUseCaseTestSuite) TestExecute_CreateFails_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := s.newInput()
+	input.ContactIDs = nil
+	createErr := errors.New("database error")
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.httpMonitorValidatorMock.On("Validate", mock.AnythingOfType("model.HTTPMonitorModel")).Return(nil)
+	s.httpMonitorRepositoryMock.On("Create", mock.Anything, mock.AnythingOfType("model.HTTPMonitorModel")).
+		Return(model.HTTPMonitorModel{}, createErr)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, createErr)
+}
+
+func (s *HTTPMonitorCreateUseCaseTestSuite) TestExecute_ProduceFails_ReturnsErrorWithoutRefreshing() {
+	// Arrange
+	ctx := context.Background()
+	input := s.newInput()
+	input.ContactIDs = nil
+	produceErr := errors.New("outbox error")
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.httpMonitorValidatorMock.On("Validate", mock.AnythingOfType("model.HTTPMonitorModel")).Return(nil)
+	s.httpMonitorRepositoryMock.On("Create", mock.Anything, mock.AnythingOfType("model.HTTPMonitorModel")).
+		Return(model.HTTPMonitorModel{ID: 10}, nil)
+	s.httpMonitorRepositoryMock.On("AssignContacts", mock.Anything, uint64(10), []uint64{}).Return(nil)
+	s.monitorCreatedProducerMock.On("Produce", mock.Anything, mock.AnythingOfType("event.MonitorCreatedMessage")).
+		Return(produceErr)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, produceErr)
+	s.httpMonitorSchedulerMock.AssertNotCalled(s.T(), "Refresh")
+}
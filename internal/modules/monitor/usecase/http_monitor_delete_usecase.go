@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event/producer"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/scheduler"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+)
+
+type HTTPMonitorDeleteInput struct {
+	UserID         uint64 `validate:"required"`
+	OrganizationID uint64
+	MonitorID      uint64 `validate:"required"`
+}
+
+type HTTPMonitorDeleteUseCase struct {
+	httpMonitorRepository  repository.HTTPMonitorRepositoryI
+	httpMonitorScheduler   scheduler.HTTPMonitorSchedulerI
+	monitorDeletedProducer producer.MonitorDeletedProducerI
+	txManager              database.TxManagerI
+	validate               validator.Validate
+	logger                 logger.Logger
+}
+
+func NewHTTPMonitorDeleteUseCase(
+	httpMonitorRepository repository.HTTPMonitorRepositoryI,
+	httpMonitorScheduler scheduler.HTTPMonitorSchedulerI,
+	monitorDeletedProducer producer.MonitorDeletedProducerI,
+	txManager database.TxManagerI,
+	validate validator.Validate,
+	logger logger.Logger,
+) *HTTPMonitorDeleteUseCase {
+	return &HTTPMonitorDeleteUseCase{
+		httpMonitorRepository:  httpMonitorRepository,
+		httpMonitorScheduler:   httpMonitorScheduler,
+		monitorDeletedProducer: monitorDeletedProducer,
+		txManager:              txManager,
+		validate:               validate,
+		logger:                 logger,
+	}
+}
+
+func (uc *HTTPMonitorDeleteUseCase) Execute(ctx context.Context, input HTTPMonitorDeleteInput) error {
+	ctx, span := trace.Span(ctx, "HTTPMonitorDeleteUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return err
+	}
+
+	owner := repository.Owner{UserID: input.UserID, OrganizationID: input.OrganizationID}
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		err = uc.httpMonitorRepository.Delete(ctx, owner, input.MonitorID)
+		if err != nil {
+			if !errors.Is(err, shared_errs.ErrRecordNotFound) {
+				uc.logger.Error().Msgf("error deleting monitor: %v", err)
+			}
+			return err
+		}
+
+		message := event.MonitorDeletedMessage{MonitorID: input.MonitorID, OccurredAt: time.Now().UTC()}
+		err = uc.monitorDeletedProducer.Produce(ctx, message)
+		if err != nil {
+			uc.logger.Error().Msgf("error producing monitor deleted event: %v", err)
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	uc.httpMonitorScheduler.Refresh()
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+)
+
+type HTTPMonitorFindInput struct {
+	UserID         uint64 `validate:"required"`
+	OrganizationID uint64
+	MonitorID      uint64 `validate:"required"`
+}
+
+type HTTPMonitorFindUseCase struct {
+	httpMonitorRepository repository.HTTPMonitorRepositoryI
+	validate              validator.Validate
+	logger                logger.Logger
+}
+
+func NewHTTPMonitorFindUseCase(
+	httpMonitorRepository repository.HTTPMonitorRepositoryI,
+	validate validator.Validate,
+	logger logger.Logger,
+) *HTTPMonitorFindUseCase {
+	return &HTTPMonitorFindUseCase{
+		httpMonitorRepository: httpMonitorRepository,
+		validate:              validate,
+		logger:                logger,
+	}
+}
+
+func (uc *HTTPMonitorFindUseCase) Execute(ctx context.Context, input HTTPMonitorFindInput) (HTTPMonitorOutput, error) {
+	ctx, span := trace.Span(ctx, "HTTPMonitorFindUseCase.Execute")
+	defer span.End()
+
+	output := HTTPMonitorOutput{}
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return output, err
+	}
+
+	owner := repository.Owner{UserID: input.UserID, OrganizationID: input.OrganizationID}
+	monitor, err := uc.httpMonitorRepository.FindByID(ctx, owner, input.MonitorID)
+	if err != nil {
+		if !errors.Is(err, shared_errs.ErrRecordNotFound) {
+			uc.logger.Error().Msgf("error finding monitor by ID %d: %v", input.MonitorID, err)
+		}
+		return output, err
+	}
+
+	contactIDs, err := uc.httpMonitorRepository.FindContactIDs(ctx, []uint64{monitor.ID})
+	if err != nil {
+		uc.logger.Error().Msgf("error finding contacts of monitor ID %d: %v", monitor.ID, err)
+		return output, err
+	}
+
+	return newHTTPMonitorOutput(monitor, contactIDs[monitor.ID]), nil
+}
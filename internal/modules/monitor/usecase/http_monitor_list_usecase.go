@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+)
+
+type HTTPMonitorListInput struct {
+	UserID         uint64 `validate:"required"`
+	OrganizationID uint64
+	Page           int `validate:"required,min=1"`
+	PageSize       int `validate:"required,min=1,max=100"`
+}
+
+type HTTPMonitorListOutput struct {
+	Monitors []HTTPMonitorOutput
+	Total    int64
+}
+
+type HTTPMonitorListUseCase struct {
+	httpMonitorRepository repository.HTTPMonitorRepositoryI
+	validate              validator.Validate
+	logger                logger.Logger
+}
+
+func NewHTTPMonitorListUseCase(
+	httpMonitorRepository repository.HTTPMonitorRepositoryI,
+	validate validator.Validate,
+	logger logger.Logger,
+) *HTTPMonitorListUseCase {
+	return &HTTPMonitorListUseCase{
+		httpMonitorRepository: httpMonitorRepository,
+		validate:              validate,
+		logger:                logger,
+	}
+}
+
+func (uc *HTTPMonitorListUseCase) Execute(
+	ctx context.Context,
+	input HTTPMonitorListInput,
+) (HTTPMonitorListOutput, error) {
+	ctx, span := trace.Span(ctx, "HTTPMonitorListUseCase.Execute")
+	defer span.End()
+
+	output := HTTPMonitorListOutput{}
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return output, err
+	}
+
+	owner := repository.Owner{UserID: input.UserID, OrganizationID: input.OrganizationID}
+	monitors, total, err := uc.httpMonitorRepository.FindAll(ctx, owner, input.Page, input.PageSize)
+	if err != nil {
+		uc.logger.Error().Msgf("error finding monitors: %v", err)
+		return output, err
+	}
+
+	monitorIDs := make([]uint64, len(monitors))
+	for i, monitor := range monitors {
+		monitorIDs[i] = monitor.ID
+	}
+
+	contactIDs, err := uc.httpMonitorRepository.FindContactIDs(ctx, monitorIDs)
+	if err != nil {
+		uc.logger.Error().Msgf("error finding monitor contacts: %v", err)
+		return output, err
+	}
+
+	output.Total = total
+	output.Monitors = make([]HTTPMonitorOutput, len(monitors))
+	for i, monitor := range monitors {
+		output.Monitors[i] = newHTTPMonitorOutput(monitor, contactIDs[monitor.ID])
+	}
+
+	return output, nil
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event/producer"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/scheduler"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+)
+
+type HTTPMonitorSetEnabledInput struct {
+	UserID         uint64 `validate:"required"`
+	OrganizationID uint64
+	MonitorID      uint64 `validate:"required"`
+	IsEnabled      bool
+}
+
+type HTTPMonitorSetEnabledUseCase struct {
+	httpMonitorRepository  repository.HTTPMonitorRepositoryI
+	httpMonitorScheduler   scheduler.HTTPMonitorSchedulerI
+	monitorUpdatedProducer producer.MonitorUpdatedProducerI
+	txManager              database.TxManagerI
+	validate               validator.Validate
+	logger                 logger.Logger
+}
+
+func NewHTTPMonitorSetEnabledUseCase(
+	httpMonitorRepository repository.HTTPMonitorRepositoryI,
+	httpMonitorScheduler scheduler.HTTPMonitorSchedulerI,
+	monitorUpdatedProducer producer.MonitorUpdatedProducerI,
+	txManager database.TxManagerI,
+	validate validator.Validate,
+	logger logger.Logger,
+) *HTTPMonitorSetEnabledUseCase {
+	return &HTTPMonitorSetEnabledUseCase{
+		httpMonitorRepository:  httpMonitorRepository,
+		httpMonitorScheduler:   httpMonitorScheduler,
+		monitorUpdatedProducer: monitorUpdatedProducer,
+		txManager:              txManager,
+		validate:               validate,
+		logger:                 logger,
+	}
+}
+
+func (uc *HTTPMonitorSetEnabledUseCase) Execute(ctx context.Context, input HTTPMonitorSetEnabledInput) error {
+	ctx, span := trace.Span(ctx, "HTTPMonitorSetEnabledUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return err
+	}
+
+	owner := repository.Owner{UserID: input.UserID, OrganizationID: input.OrganizationID}
+	monitor, err := uc.httpMonitorRepository.FindByID(ctx, owner, input.MonitorID)
+	if err != nil {
+		if !errors.Is(err, shared_errs.ErrRecordNotFound) {
+			uc.logger.Error().Msgf("error finding monitor ID %d: %v", input.MonitorID, err)
+		}
+		return err
+	}
+
+	contactIDs, err := uc.httpMonitorRepository.FindContactIDs(ctx, []uint64{monitor.ID})
+	if err != nil {
+		uc.logger.Error().Msgf("error finding contacts of monitor ID %d: %v", monitor.ID, err)
+		return err
+	}
+
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		err = uc.httpMonitorRepository.SetEnabled(ctx, owner, input.MonitorID, input.IsEnabled)
+		if err != nil {
+			if !errors.Is(err, shared_errs.ErrRecordNotFound) {
+				uc.logger.Error().Msgf("error setting enabled flag of monitor ID %d: %v", input.MonitorID, err)
+			}
+			return err
+		}
+
+		err = uc.monitorUpdatedProducer.Produce(ctx, event.MonitorUpdatedMessage{
+			MonitorID:            monitor.ID,
+			Name:                 monitor.Name,
+			HTTPURL:              monitor.HTTPURL,
+			HTTPMethod:           monitor.HTTPMethod,
+			CheckIntervalSeconds: monitor.CheckIntervalSeconds,
+			IsEnabled:            input.IsEnabled,
+			ContactIDs:           append([]uint64{}, contactIDs[monitor.ID]...),
+			OccurredAt:           time.Now().UTC(),
+		})
+		if err != nil {
+			uc.logger.Error().Msgf("error producing monitor updated event: %v", err)
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	uc.httpMonitorScheduler.Refresh()
+
+	return nil
+}
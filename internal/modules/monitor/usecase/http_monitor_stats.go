@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+)
+
+// HTTPMonitorCheckStatsOutput holds the figures of a monitor over a window.
+// Rates and response times are nil when there is no check to compute them from.
+type HTTPMonitorCheckStatsOutput struct {
+	TotalChecks       int64
+	SuccessfulChecks  int64
+	FailedChecks      int64
+	UptimePercentage  *float64
+	SuccessRate       *float64
+	AvgResponseTimeMs *float64
+	P50ResponseTimeMs *float64
+	P95ResponseTimeMs *float64
+	P99ResponseTimeMs *float64
+}
+
+func newHTTPMonitorCheckStatsOutput(stats repository.HTTPMonitorCheckStats) HTTPMonitorCheckStatsOutput {
+	output := HTTPMonitorCheckStatsOutput{
+		TotalChecks:      stats.TotalChecks,
+		SuccessfulChecks: stats.SuccessfulChecks,
+		FailedChecks:     stats.TotalChecks - stats.SuccessfulChecks,
+	}
+
+	if stats.TotalChecks > 0 {
+		successRate := percentage(float64(stats.SuccessfulChecks), float64(stats.TotalChecks))
+		output.SuccessRate = &successRate
+
+		// a single check at the very end of the window covers no time
+		uptime := successRate
+		if stats.ObservedSeconds > 0 {
+			uptime = percentage(stats.UpSeconds, stats.ObservedSeconds)
+		}
+		output.UptimePercentage = &uptime
+	}
+
+	if stats.AvgResponseTimeMs.Valid {
+		output.AvgResponseTimeMs = &stats.AvgResponseTimeMs.Float64
+	}
+	if stats.P50ResponseTimeMs.Valid {
+		output.P50ResponseTimeMs = &stats.P50ResponseTimeMs.Float64
+	}
+	if stats.P95ResponseTimeMs.Valid {
+		output.P95ResponseTimeMs = &stats.P95ResponseTimeMs.Float64
+	}
+	if stats.P99ResponseTimeMs.Valid {
+		output.P99ResponseTimeMs = &stats.P99ResponseTimeMs.Float64
+	}
+
+	return output
+}
+
+func percentage(part, total float64) float64 {
+	return part / total * 100
+}
+
+// resolveStatsWindow turns either a named window ending now or a custom from/to range
+// into UTC bounds, a custom range ending in the future is cut at now and can't be longer
+// than the largest named window.
+func resolveStatsWindow(window string, from, to *time.Time) (time.Time, time.Time, error) {
+	now := time.Now().UTC()
+
+	if from != nil || to != nil {
+		if from == nil || to == nil || window != "" {
+			return time.Time{}, time.Time{}, errs.ErrInvalidTimeRange
+		}
+
+		start := from.UTC()
+		end := to.UTC()
+		if end.After(now) {
+			end = now
+		}
+		if !start.Before(end) || end.Sub(start) > enum.MaxStatsWindowDuration {
+			return time.Time{}, time.Time{}, errs.ErrInvalidTimeRange
+		}
+		return start, end, nil
+	}
+
+	if window == "" {
+		window = enum.StatsWindow24Hours
+	}
+
+	windowEnum, err := enum.NewStatsWindowEnum(window)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	return now.Add(-windowEnum.Duration()), now, nil
+}
@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+)
+
+type HTTPMonitorStatsSummaryInput struct {
+	UserID         uint64 `validate:"required"`
+	OrganizationID uint64
+	Window         string
+	From           *time.Time
+	To             *time.Time
+}
+
+type HTTPMonitorStatsSummaryItemOutput struct {
+	HTTPMonitorCheckStatsOutput
+	MonitorID  uint64
+	Name       string
+	IsEnabled  bool
+	LastStatus *string
+}
+
+type HTTPMonitorStatsSummaryOutput struct {
+	From             time.Time
+	To               time.Time
+	TotalMonitors    int
+	EnabledMonitors  int
+	TotalChecks      int64
+	SuccessfulChecks int64
+	FailedChecks     int64
+	// SuccessRate is computed over the checks of all monitors.
+	SuccessRate *float64
+	// UptimePercentage is the mean uptime of the monitors checked within the window.
+	UptimePercentage *float64
+	Monitors         []HTTPMonitorStatsSummaryItemOutput
+}
+
+type HTTPMonitorStatsSummaryUseCase struct {
+	httpMonitorStatsRepository repository.HTTPMonitorStatsRepositoryI
+	logger                     logger.Logger
+}
+
+func NewHTTPMonitorStatsSummaryUseCase(
+	httpMonitorStatsRepository repository.HTTPMonitorStatsRepositoryI,
+	logger logger.Logger,
+) *HTTPMonitorStatsSummaryUseCase {
+	return &HTTPMonitorStatsSummaryUseCase{
+		httpMonitorStatsRepository: httpMonitorStatsRepository,
+		logger:                     logger,
+	}
+}
+
+func (uc *HTTPMonitorStatsSummaryUseCase) Execute(
+	ctx context.Context,
+	input HTTPMonitorStatsSummaryInput,
+) (HTTPMonitorStatsSummaryOutput, error) {
+	ctx, span := trace.Span(ctx, "HTTPMonitorStatsSummaryUseCase.Execute")
+	defer span.End()
+
+	output := HTTPMonitorStatsSummaryOutput{}
+
+	from, to, err := resolveStatsWindow(input.Window, input.From, input.To)
+	if err != nil {
+		return output, err
+	}
+
+	owner := repository.Owner{UserID: input.UserID, OrganizationID: input.OrganizationID}
+	summaries, err := uc.httpMonitorStatsRepository.FindStatsSummary(ctx, owner, from, to)
+	if err != nil {
+		uc.logger.Error().Msgf("error finding monitors stats summary: %v", err)
+		return output, err
+	}
+
+	output.From = from
+	output.To = to
+	output.TotalMonitors = len(summaries)
+	output.Monitors = make([]HTTPMonitorStatsSummaryItemOutput, len(summaries))
+
+	var uptimeSum float64
+	var checkedMonitors int
+	for i, summary := range summaries {
+		item := HTTPMonitorStatsSummaryItemOutput{
+			HTTPMonitorCheckStatsOutput: newHTTPMonitorCheckStatsOutput(summary.HTTPMonitorCheckStats),
+			MonitorID:                   summary.MonitorID,
+			Name:                        summary.MonitorName,
+			IsEnabled:                   summary.IsEnabled,
+		}
+		if summary.LastStatus.Valid {
+			item.LastStatus = &summary.LastStatus.String
+		}
+		output.Monitors[i] = item
+
+		if item.IsEnabled {
+			output.EnabledMonitors++
+		}
+		output.TotalChecks += item.TotalChecks
+		output.SuccessfulChecks += item.SuccessfulChecks
+		output.FailedChecks += item.FailedChecks
+
+		if item.UptimePercentage != nil {
+			uptimeSum += *item.UptimePercentage
+			checkedMonitors++
+		}
+	}
+
+	if output.TotalChecks > 0 {
+		successRate := percentage(float64(output.SuccessfulChecks), float64(output.TotalChecks))
+		output.SuccessRate = &successRate
+	}
+	if checkedMonitors > 0 {
+		uptime := uptimeSum / float64(checkedMonitors)
+		output.UptimePercentage = &uptime
+	}
+
+	return output, nil
+}
@@ -0,0 +1,116 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/usecase"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+)
+
+type HTTPMonitorStatsSummaryUseCaseTestSuite struct {
+	suite.Suite
+	sut                            *usecase.HTTPMonitorStatsSummaryUseCase
+	httpMonitorStatsRepositoryMock *repository_mocks.MockHTTPMonitorStatsRepositoryI
+	logger                         logger.Logger
+}
+
+func (s *HTTPMonitorStatsSummaryUseCaseTestSuite) SetupTest() {
+	s.httpMonitorStatsRepositoryMock = repository_mocks.NewMockHTTPMonitorStatsRepositoryI(s.T())
+
+	cfg := config.Config{
+		Log: config.Log{
+			LogLevel: "disabled",
+		},
+	}
+	s.logger = logger.New(cfg)
+
+	s.sut = usecase.NewHTTPMonitorStatsSummaryUseCase(
+		s.httpMonitorStatsRepositoryMock,
+		s.logger,
+	)
+}
+
+func TestHTTPMonitorStatsSummaryUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(HTTPMonitorStatsSummaryUseCaseTestSuite))
+}
+
+func (s *HTTPMonitorStatsSummaryUseCaseTestSuite) TestExecute_MonitorsWithAndWithoutChecks_ReturnsTotals() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.HTTPMonitorStatsSummaryInput{UserID: 5, Window: "30d"}
+	owner := repository.Owner{UserID: input.UserID}
+
+	summaries := []repository.HTTPMonitorStatsSummary{
+		{
+			HTTPMonitorCheckStats: repository.HTTPMonitorCheckStats{
+				MonitorID:        1,
+				TotalChecks:      10,
+				SuccessfulChecks: 10,
+				ObservedSeconds:  600,
+				UpSeconds:        600,
+			},
+			MonitorName: "API",
+			IsEnabled:   true,
+			LastStatus:  sql.NullString{String: "up", Valid: true},
+		},
+		{
+			HTTPMonitorCheckStats: repository.HTTPMonitorCheckStats{
+				MonitorID:        2,
+				TotalChecks:      10,
+				SuccessfulChecks: 5,
+				ObservedSeconds:  600,
+				UpSeconds:        300,
+			},
+			MonitorName: "Website",
+			IsEnabled:   true,
+		},
+		{
+			HTTPMonitorCheckStats: repository.HTTPMonitorCheckStats{MonitorID: 3},
+			MonitorName:           "Paused",
+		},
+	}
+
+	s.httpMonitorStatsRepositoryMock.On("FindStatsSummary", mock.Anything, owner, mock.Anything, mock.Anything).
+		Return(summaries, nil)
+
+	// Act
+	output, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(3, output.TotalMonitors)
+	s.Equal(2, output.EnabledMonitors)
+	s.Equal(int64(20), output.TotalChecks)
+	s.Equal(int64(5), output.FailedChecks)
+	s.InDelta(75.0, *output.SuccessRate, 0.001)
+	s.InDelta(75.0, *output.UptimePercentage, 0.001)
+	s.Require().Len(output.Monitors, 3)
+	s.Equal("up", *output.Monitors[0].LastStatus)
+	s.Nil(output.Monitors[2].UptimePercentage)
+}
+
+func (s *HTTPMonitorStatsSummaryUseCaseTestSuite) TestExecute_RepositoryFails_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.HTTPMonitorStatsSummaryInput{UserID: 5}
+	owner := repository.Owner{UserID: input.UserID}
+	repositoryErr := errors.New("database error")
+
+	s.httpMonitorStatsRepositoryMock.On("FindStatsSummary", mock.Anything, owner, mock.Anything, mock.Anything).
+		Return(nil, repositoryErr)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, repositoryErr)
+}
@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+)
+
+type HTTPMonitorStatsInput struct {
+	UserID         uint64 `validate:"required"`
+	OrganizationID uint64
+	MonitorID      uint64 `validate:"required"`
+	Window         string
+	From           *time.Time
+	To             *time.Time
+}
+
+type HTTPMonitorErrorCountOutput struct {
+	StatusCode   *int32
+	ErrorMessage string
+	Count        int64
+}
+
+type HTTPMonitorStatsOutput struct {
+	HTTPMonitorCheckStatsOutput
+	MonitorID uint64
+	From      time.Time
+	To        time.Time
+	Errors    []HTTPMonitorErrorCountOutput
+}
+
+type HTTPMonitorStatsUseCase struct {
+	httpMonitorRepository      repository.HTTPMonitorRepositoryI
+	httpMonitorStatsRepository repository.HTTPMonitorStatsRepositoryI
+	validate                   validator.Validate
+	logger                     logger.Logger
+}
+
+func NewHTTPMonitorStatsUseCase(
+	httpMonitorRepository repository.HTTPMonitorRepositoryI,
+	httpMonitorStatsRepository repository.HTTPMonitorStatsRepositoryI,
+	validate validator.Validate,
+	logger logger.Logger,
+) *HTTPMonitorStatsUseCase {
+	return &HTTPMonitorStatsUseCase{
+		httpMonitorRepository:      httpMonitorRepository,
+		httpMonitorStatsRepository: httpMonitorStatsRepository,
+		validate:                   validate,
+		logger:                     logger,
+	}
+}
+
+func (uc *HTTPMonitorStatsUseCase) Execute(
+	ctx context.Context,
+	input HTTPMonitorStatsInput,
+) (HTTPMonitorStatsOutput, error) {
+	ctx, span := trace.Span(ctx, "HTTPMonitorStatsUseCase.Execute")
+	defer span.End()
+
+	output := HTTPMonitorStatsOutput{}
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return output, err
+	}
+
+	from, to, err := resolveStatsWindow(input.Window, input.From, input.To)
+	if err != nil {
+		return output, err
+	}
+
+	owner := repository.Owner{UserID: input.UserID, OrganizationID: input.OrganizationID}
+	_, err = uc.httpMonitorRepository.FindByID(ctx, owner, input.MonitorID)
+	if err != nil {
+		if !errors.Is(err, shared_errs.ErrRecordNotFound) {
+			uc.logger.Error().Msgf("error finding monitor by ID %d: %v", input.MonitorID, err)
+		}
+		return output, err
+	}
+
+	stats, err := uc.httpMonitorStatsRepository.FindCheckStats(ctx, input.MonitorID, from, to)
+	if err != nil {
+		uc.logger.Error().Msgf("error finding check stats of monitor ID %d: %v", input.MonitorID, err)
+		return output, err
+	}
+
+	errorCounts, err := uc.httpMonitorStatsRepository.FindErrorBreakdown(ctx, input.MonitorID, from, to)
+	if err != nil {
+		uc.logger.Error().Msgf("error finding error breakdown of monitor ID %d: %v", input.MonitorID, err)
+		return output, err
+	}
+
+	output.HTTPMonitorCheckStatsOutput = newHTTPMonitorCheckStatsOutput(stats)
+	output.MonitorID = input.MonitorID
+	output.From = from
+	output.To = to
+	output.Errors = make([]HTTPMonitorErrorCountOutput, len(errorCounts))
+	for i, errorCount := range errorCounts {
+		output.Errors[i] = HTTPMonitorErrorCountOutput{
+			ErrorMessage: errorCount.ErrorMessage,
+			Count:        errorCount.Count,
+		}
+		if errorCount.StatusCode.Valid {
+			output.Errors[i].StatusCode = &errorCount.StatusCode.Int32
+		}
+	}
+
+	return output, nil
+}
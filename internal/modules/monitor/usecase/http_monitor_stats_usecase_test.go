@@ -0,0 +1,198 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/usecase"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	shared_validator_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/validator/mocks"
+)
+
+type HTTPMonitorStatsUseCaseTestSuite struct {
+	suite.Suite
+	sut                            *usecase.HTTPMonitorStatsUseCase
+	httpMonitorRepositoryMock      *repository_mocks.MockHTTPMonitorRepositoryI
+	httpMonitorStatsRepositoryMock *repository_mocks.MockHTTPMonitorStatsRepositoryI
+	validatorMock                  *shared_validator_mocks.MockValidate
+	logger                         logger.Logger
+}
+
+func (s *HTTPMonitorStatsUseCaseTestSuite) SetupTest() {
+	s.httpMonitorRepositoryMock = repository_mocks.NewMockHTTPMonitorRepositoryI(s.T())
+	s.httpMonitorStatsRepositoryMock = repository_mocks.NewMockHTTPMonitorStatsRepositoryI(s.T())
+	s.validatorMock = shared_validator_mocks.NewMockValidate(s.T())
+
+	cfg := config.Config{
+		Log: config.Log{
+			LogLevel: "disabled",
+		},
+	}
+	s.logger = logger.New(cfg)
+
+	s.sut = usecase.NewHTTPMonitorStatsUseCase(
+		s.httpMonitorRepositoryMock,
+		s.httpMonitorStatsRepositoryMock,
+		s.validatorMock,
+		s.logger,
+	)
+}
+
+func TestHTTPMonitorStatsUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(HTTPMonitorStatsUseCaseTestSuite))
+}
+
+func (s *HTTPMonitorStatsUseCaseTestSuite) TestExecute_CustomRange_ReturnsStatsAndErrorBreakdown() {
+	// Arrange
+	ctx := context.Background()
+	from := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	input := usecase.HTTPMonitorStatsInput{UserID: 5, MonitorID: 10, From: &from, To: &to}
+	owner := repository.Owner{UserID: input.UserID}
+
+	stats := repository.HTTPMonitorCheckStats{
+		MonitorID:         input.MonitorID,
+		TotalChecks:       4,
+		SuccessfulChecks:  3,
+		ObservedSeconds:   1000,
+		UpSeconds:         900,
+		AvgResponseTimeMs: sql.NullFloat64{Float64: 120, Valid: true},
+		P50ResponseTimeMs: sql.NullFloat64{Float64: 100, Valid: true},
+		P95ResponseTimeMs: sql.NullFloat64{Float64: 250, Valid: true},
+		P99ResponseTimeMs: sql.NullFloat64{Float64: 290, Valid: true},
+	}
+	errorCounts := []repository.HTTPMonitorCheckErrorCount{
+		{
+			StatusCode:   sql.NullInt32{Int32: 503, Valid: true},
+			ErrorMessage: "unexpected response status code 503",
+			Count:        1,
+		},
+	}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.httpMonitorRepositoryMock.On("FindByID", mock.Anything, owner, input.MonitorID).
+		Return(model.HTTPMonitorModel{ID: input.MonitorID}, nil)
+	s.httpMonitorStatsRepositoryMock.On("FindCheckStats", mock.Anything, input.MonitorID, from, to).
+		Return(stats, nil)
+	s.httpMonitorStatsRepositoryMock.On("FindErrorBreakdown", mock.Anything, input.MonitorID, from, to).
+		Return(errorCounts, nil)
+
+	// Act
+	output, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(from, output.From)
+	s.Equal(to, output.To)
+	s.Equal(int64(4), output.TotalChecks)
+	s.Equal(int64(1), output.FailedChecks)
+	s.InDelta(90.0, *output.UptimePercentage, 0.001)
+	s.InDelta(75.0, *output.SuccessRate, 0.001)
+	s.InDelta(250.0, *output.P95ResponseTimeMs, 0.001)
+	s.Require().Len(output.Errors, 1)
+	s.Equal(int32(503), *output.Errors[0].StatusCode)
+	s.Equal(int64(1), output.Errors[0].Count)
+}
+
+func (s *HTTPMonitorStatsUseCaseTestSuite) TestExecute_DefaultWindow_UsesLast24Hours() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.HTTPMonitorStatsInput{UserID: 5, MonitorID: 10}
+	owner := repository.Owner{UserID: input.UserID}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.httpMonitorRepositoryMock.On("FindByID", mock.Anything, owner, input.MonitorID).
+		Return(model.HTTPMonitorModel{ID: input.MonitorID}, nil)
+	s.httpMonitorStatsRepositoryMock.
+		On("FindCheckStats", mock.Anything, input.MonitorID, mock.Anything, mock.Anything).
+		Return(repository.HTTPMonitorCheckStats{MonitorID: input.MonitorID}, nil)
+	s.httpMonitorStatsRepositoryMock.
+		On("FindErrorBreakdown", mock.Anything, input.MonitorID, mock.Anything, mock.Anything).
+		Return([]repository.HTTPMonitorCheckErrorCount{}, nil)
+
+	// Act
+	output, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(24*time.Hour, output.To.Sub(output.From))
+	s.Zero(output.TotalChecks)
+	s.Nil(output.UptimePercentage)
+	s.Nil(output.SuccessRate)
+	s.Nil(output.AvgResponseTimeMs)
+	s.Empty(output.Errors)
+}
+
+func (s *HTTPMonitorStatsUseCaseTestSuite) TestExecute_InvalidWindow_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.HTTPMonitorStatsInput{UserID: 5, MonitorID: 10, Window: "1y"}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidStatsWindow)
+}
+
+func (s *HTTPMonitorStatsUseCaseTestSuite) TestExecute_RangeWithoutEnd_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	from := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	input := usecase.HTTPMonitorStatsInput{UserID: 5, MonitorID: 10, From: &from}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidTimeRange)
+}
+
+func (s *HTTPMonitorStatsUseCaseTestSuite) TestExecute_RangeLongerThanLargestWindow_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	from := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(enum.MaxStatsWindowDuration + time.Second)
+	input := usecase.HTTPMonitorStatsInput{UserID: 5, MonitorID: 10, From: &from, To: &to}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidTimeRange)
+}
+
+func (s *HTTPMonitorStatsUseCaseTestSuite) TestExecute_MonitorNotFound_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.HTTPMonitorStatsInput{UserID: 5, MonitorID: 10, Window: "7d"}
+	owner := repository.Owner{UserID: input.UserID}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.httpMonitorRepositoryMock.On("FindByID", mock.Anything, owner, input.MonitorID).
+		Return(model.HTTPMonitorModel{}, shared_errs.ErrRecordNotFound)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, shared_errs.ErrRecordNotFound)
+}
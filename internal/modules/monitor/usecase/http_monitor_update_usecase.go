@@ -0,0 +1,159 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event/producer"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/scheduler"
+	monitor_validator "github.com/cristiano-pacheco/pingo/internal/modules/monitor/validator"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+	"github.com/lib/pq"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+)
+
+type HTTPMonitorUpdateInput struct {
+	UserID                uint64 `validate:"required"`
+	OrganizationID        uint64
+	MonitorID             uint64 `validate:"required"`
+	Name                  string `validate:"required,min=3,max=255"`
+	HTTPURL               string `validate:"required,max=2048"`
+	HTTPMethod            string `validate:"required"`
+	CheckTimeout          int    `validate:"required"`
+	FailThreshold         int16  `validate:"required"`
+	CheckIntervalSeconds  int
+	RequestHeaders        map[string]string
+	ValidResponseStatuses []int32 `validate:"required"`
+	ContactIDs            []uint64
+	IsEnabled             bool
+}
+
+type HTTPMonitorUpdateUseCase struct {
+	httpMonitorValidator   monitor_validator.HTTPMonitorValidatorI
+	httpMonitorRepository  repository.HTTPMonitorRepositoryI
+	contactRepository      repository.ContactRepositoryI
+	httpMonitorScheduler   scheduler.HTTPMonitorSchedulerI
+	monitorUpdatedProducer producer.MonitorUpdatedProducerI
+	txManager              database.TxManagerI
+	validate               validator.Validate
+	logger                 logger.Logger
+}
+
+func NewHTTPMonitorUpdateUseCase(
+	httpMonitorValidator monitor_validator.HTTPMonitorValidatorI,
+	httpMonitorRepository repository.HTTPMonitorRepositoryI,
+	contactRepository repository.ContactRepositoryI,
+	httpMonitorScheduler scheduler.HTTPMonitorSchedulerI,
+	monitorUpdatedProducer producer.MonitorUpdatedProducerI,
+	txManager database.TxManagerI,
+	validate validator.Validate,
+	logger logger.Logger,
+) *HTTPMonitorUpdateUseCase {
+	return &HTTPMonitorUpdateUseCase{
+		httpMonitorValidator:   httpMonitorValidator,
+		httpMonitorRepository:  httpMonitorRepository,
+		contactRepository:      contactRepository,
+		httpMonitorScheduler:   httpMonitorScheduler,
+		monitorUpdatedProducer: monitorUpdatedProducer,
+		txManager:              txManager,
+		validate:               validate,
+		logger:                 logger,
+	}
+}
+
+func (uc *HTTPMonitorUpdateUseCase) Execute(ctx context.Context, input HTTPMonitorUpdateInput) error {
+	ctx, span := trace.Span(ctx, "HTTPMonitorUpdateUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return err
+	}
+
+	requestHeaders, err := encodeRequestHeaders(input.RequestHeaders)
+	if err != nil {
+		return err
+	}
+
+	checkIntervalSeconds := input.CheckIntervalSeconds
+	if checkIntervalSeconds == 0 {
+		checkIntervalSeconds = defaultCheckIntervalSeconds
+	}
+
+	monitorModel := model.HTTPMonitorModel{
+		ID:                    input.MonitorID,
+		Name:                  input.Name,
+		CheckTimeout:          input.CheckTimeout,
+		FailThreshold:         input.FailThreshold,
+		CheckIntervalSeconds:  checkIntervalSeconds,
+		IsEnabled:             input.IsEnabled,
+		HTTPURL:               input.HTTPURL,
+		HTTPMethod:            strings.ToUpper(input.HTTPMethod),
+		RequestHeaders:        requestHeaders,
+		ValidResponseStatuses: pq.Int32Array(input.ValidResponseStatuses),
+	}
+
+	if validationErr := uc.httpMonitorValidator.Validate(monitorModel); validationErr != nil {
+		return validationErr
+	}
+
+	owner := repository.Owner{UserID: input.UserID, OrganizationID: input.OrganizationID}
+	contactIDs, err := checkContactsExist(ctx, uc.contactRepository, owner, input.ContactIDs)
+	if err != nil {
+		if !errors.Is(err, errs.ErrContactNotFound) {
+			uc.logger.Error().Msgf("error finding contacts: %v", err)
+		}
+		return err
+	}
+
+	var updatedMonitor model.HTTPMonitorModel
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		updatedMonitor, err = uc.httpMonitorRepository.Update(ctx, owner, monitorModel)
+		if err != nil {
+			if !errors.Is(err, shared_errs.ErrRecordNotFound) {
+				uc.logger.Error().Msgf("error updating monitor: %v", err)
+			}
+			return err
+		}
+
+		err = uc.httpMonitorRepository.AssignContacts(ctx, input.MonitorID, contactIDs)
+		if err != nil {
+			uc.logger.Error().Msgf("error assigning contacts to monitor ID %d: %v", input.MonitorID, err)
+			return err
+		}
+
+		err = uc.monitorUpdatedProducer.Produce(ctx, event.MonitorUpdatedMessage{
+			MonitorID:            updatedMonitor.ID,
+			Name:                 updatedMonitor.Name,
+			HTTPURL:              updatedMonitor.HTTPURL,
+			HTTPMethod:           updatedMonitor.HTTPMethod,
+			CheckIntervalSeconds: updatedMonitor.CheckIntervalSeconds,
+			IsEnabled:            updatedMonitor.IsEnabled,
+			ContactIDs:           contactIDs,
+			OccurredAt:           time.Now().UTC(),
+		})
+		if err != nil {
+			uc.logger.Error().Msgf("error producing monitor updated event: %v", err)
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	uc.httpMonitorScheduler.Refresh()
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/event"
+	producer_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/event/producer/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository/mocks"
+	scheduler_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/scheduler/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/usecase"
+	validator_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/validator/mocks"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	database_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/database/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	shared_validator_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/validator/mocks"
+)
+
+type HTTPMonitorUpdateUseCaseTestSuite struct {
+	suite.Suite
+	sut                        *usecase.HTTPMonitorUpdateUseCase
+	httpMonitorValidatorMock   *validator_mocks.MockHTTPMonitorValidatorI
+	httpMonitorRepositoryMock  *repository_mocks.MockHTTPMonitorRepositoryI
+	contactRepositoryMock      *repository_mocks.MockContactRepositoryI
+	httpMonitorSchedulerMock   *scheduler_mocks.MockHTTPMonitorSchedulerI
+	monitorUpdatedProducerMock *producer_mocks.MockMonitorUpdatedProducerI
+	txManagerMock              *database_mocks.MockTxManagerI
+	validatorMock              *shared_validator_mocks.MockValidate
+	logger                     logger.Logger
+}
+
+func (s *HTTPMonitorUpdateUseCaseTestSuite) SetupTest() {
+	s.httpMonitorValidatorMock = validator_mocks.NewMockHTTPMonitorValidatorI(s.T())
+	s.httpMonitorRepositoryMock = repository_mocks.NewMockHTTPMonitorRepositoryI(s.T())
+	s.contactRepositoryMock = repository_mocks.NewMockContactRepositoryI(s.T())
+	s.httpMonitorSchedulerMock = scheduler_mocks.NewMockHTTPMonitorSchedulerI(s.T())
+	s.monitorUpdatedProducerMock = producer_mocks.NewMockMonitorUpdatedProducerI(s.T())
+	s.txManagerMock = database_mocks.NewMockTxManagerI(s.T())
+	s.txManagerMock.On("WithinTransaction", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) }).
+		Maybe()
+	s.validatorMock = shared_validator_mocks.NewMockValidate(s.T())
+
+	cfg := config.Config{
+		Log: config.Log{
+			LogLevel: "disabled",
+		},
+	}
+	s.logger = logger.New(cfg)
+
+	s.sut = usecase.NewHTTPMonitorUpdateUseCase(
+		s.httpMonitorValidatorMock,
+		s.httpMonitorRepositoryMock,
+		s.contactRepositoryMock,
+		s.httpMonitorSchedulerMock,
+		s.monitorUpdatedProducerMock,
+		s.txManagerMock,
+		s.validatorMock,
+		s.logger,
+	)
+}
+
+func TestHTTPMonitorUpdateUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(HTTPMonitorUpdateUseCaseTestSuite))
+}
+
+func (s *HTTPMonitorUpdateUseCaseTestSuite) newInput() usecase.HTTPMonitorUpdateInput {
+	return usecase.HTTPMonitorUpdateInput{
+		UserID:                5,
+		MonitorID:             10,
+		Name:                  "Example",
+		HTTPURL:               "https://example.com/health",
+		HTTPMethod:            "HEAD",
+		CheckTimeout:          10,
+		FailThreshold:         2,
+		CheckIntervalSeconds:  60,
+		ValidResponseStatuses: []int32{200, 204},
+		IsEnabled:             false,
+	}
+}
+
+func (s *HTTPMonitorUpdateUseCaseTestSuite) TestExecute_ValidInput_UpdatesMonitorAndReplacesContacts() {
+	// Arrange
+	ctx := context.Background()
+	input := s.newInput()
+	owner := repository.Owner{UserID: input.UserID}
+
+	expectedMonitor := model.HTTPMonitorModel{
+		ID:                    input.MonitorID,
+		Name:                  input.Name,
+		CheckTimeout:          input.CheckTimeout,
+		FailThreshold:         input.FailThreshold,
+		CheckIntervalSeconds:  input.CheckIntervalSeconds,
+		IsEnabled:             false,
+		HTTPURL:               input.HTTPURL,
+		HTTPMethod:            input.HTTPMethod,
+		RequestHeaders:        "{}",
+		ValidResponseStatuses: pq.Int32Array{200, 204},
+	}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.httpMonitorValidatorMock.On("Validate", expectedMonitor).Return(nil)
+	s.httpMonitorRepositoryMock.On("Update", mock.Anything, owner, expectedMonitor).Return(expectedMonitor, nil)
+	s.httpMonitorRepositoryMock.On("AssignContacts", mock.Anything, input.MonitorID, []uint64{}).Return(nil)
+	expectedMessage := mock.MatchedBy(func(msg event.MonitorUpdatedMessage) bool {
+		return msg.MonitorID == input.MonitorID && msg.Name == input.Name && !msg.IsEnabled
+	})
+	s.monitorUpdatedProducerMock.On("Produce", mock.Anything, expectedMessage).Return(nil)
+	s.httpMonitorSchedulerMock.On("Refresh").Return()
+
+	// Act
+	err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().NoError(err)
+}
+
+func (s *HTTPMonitorUpdateUseCaseTestSuite) TestExecute_MonitorNotFound_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := s.newInput()
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.httpMonitorValidatorMock.On("Validate", mock.AnythingOfType("model.HTTPMonitorModel")).Return(nil)
+	s.httpMonitorRepositoryMock.
+		On("Update", mock.Anything, mock.Anything, mock.AnythingOfType("model.HTTPMonitorModel")).
+		Return(model.HTTPMonitorModel{}, shared_errs.ErrRecordNotFound)
+
+	// Act
+	err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, shared_errs.ErrRecordNotFound)
+	s.httpMonitorSchedulerMock.AssertNotCalled(s.T(), "Refresh")
+}
+
+func (s *HTTPMonitorUpdateUseCaseTestSuite) TestExecute_InvalidMonitor_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := s.newInput()
+	input.HTTPMethod = "PATCH"
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.httpMonitorValidatorMock.On("Validate", mock.AnythingOfType("model.HTTPMonitorModel")).
+		Return(errs.ErrInvalidHTTPMethod)
+
+	// Act
+	err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidHTTPMethod)
+}
+
+func (s *HTTPMonitorUpdateUseCaseTestSuite) TestExecute_ProduceFails_ReturnsErrorWithoutRefreshing() {
+	// Arrange
+	ctx := context.Background()
+	input := s.newInput()
+	produceErr := errors.New("outbox error")
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.httpMonitorValidatorMock.On("Validate", mock.AnythingOfType("model.HTTPMonitorModel")).Return(nil)
+	s.httpMonitorRepositoryMock.
+		On("Update", mock.Anything, mock.Anything, mock.AnythingOfType("model.HTTPMonitorModel")).
+		Return(model.HTTPMonitorModel{ID: input.MonitorID}, nil)
+	s.httpMonitorRepositoryMock.On("AssignContacts", mock.Anything, input.MonitorID, []uint64{}).Return(nil)
+	s.monitorUpdatedProducerMock.On("Produce", mock.Anything, mock.AnythingOfType("event.MonitorUpdatedMessage")).
+		Return(produceErr)
+
+	// Act
+	err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, produceErr)
+	s.httpMonitorSchedulerMock.AssertNotCalled(s.T(), "Refresh")
+}
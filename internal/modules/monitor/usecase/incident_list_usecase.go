@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+)
+
+// IncidentListInput lists the incidents of a monitor, or of every monitor when MonitorID is zero.
+type IncidentListInput struct {
+	UserID         uint64 `validate:"required"`
+	OrganizationID uint64
+	MonitorID      uint64
+	Status         string
+	From           *time.Time
+	To             *time.Time
+	Page           int `validate:"required,min=1"`
+	PageSize       int `validate:"required,min=1,max=100"`
+}
+
+type IncidentOutput struct {
+	IncidentID         uint64
+	MonitorID          uint64
+	Status             string
+	Cause              string
+	StatusCode         *int32
+	ErrorMessage       *string
+	FirstFailedCheckID *uint64
+	RecoveryCheckID    *uint64
+	StartedAt          time.Time
+	ResolvedAt         *time.Time
+	DurationSeconds    *int64
+}
+
+// IncidentMetricsOutput covers every incident matching the filter, not only the current page.
+type IncidentMetricsOutput struct {
+	TotalIncidents int64
+	OpenIncidents  int64
+	MTTRSeconds    *float64
+	MTBFSeconds    *float64
+}
+
+type IncidentListOutput struct {
+	Incidents []IncidentOutput
+	Total     int64
+	Metrics   IncidentMetricsOutput
+}
+
+type IncidentListUseCase struct {
+	httpMonitorRepository repository.HTTPMonitorRepositoryI
+	incidentRepository    repository.IncidentRepositoryI
+	validate              validator.Validate
+	logger                logger.Logger
+}
+
+func NewIncidentListUseCase(
+	httpMonitorRepository repository.HTTPMonitorRepositoryI,
+	incidentRepository repository.IncidentRepositoryI,
+	validate validator.Validate,
+	logger logger.Logger,
+) *IncidentListUseCase {
+	return &IncidentListUseCase{
+		httpMonitorRepository: httpMonitorRepository,
+		incidentRepository:    incidentRepository,
+		validate:              validate,
+		logger:                logger,
+	}
+}
+
+func (uc *IncidentListUseCase) Execute(ctx context.Context, input IncidentListInput) (IncidentListOutput, error) {
+	ctx, span := trace.Span(ctx, "IncidentListUseCase.Execute")
+	defer span.End()
+
+	output := IncidentListOutput{}
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return output, err
+	}
+
+	filter := repository.IncidentFilter{
+		MonitorID: input.MonitorID,
+		From:      toUTC(input.From),
+		To:        toUTC(input.To),
+	}
+
+	if filter.From != nil && filter.To != nil && filter.From.After(*filter.To) {
+		return output, errs.ErrInvalidTimeRange
+	}
+
+	if input.Status != "" {
+		status, statusErr := enum.NewIncidentStatusEnum(input.Status)
+		if statusErr != nil {
+			return output, statusErr
+		}
+		open := status.String() == enum.IncidentStatusOpen
+		filter.Open = &open
+	}
+
+	owner := repository.Owner{UserID: input.UserID, OrganizationID: input.OrganizationID}
+	if input.MonitorID != 0 {
+		_, err = uc.httpMonitorRepository.FindByID(ctx, owner, input.MonitorID)
+		if err != nil {
+			if !errors.Is(err, shared_errs.ErrRecordNotFound) {
+				uc.logger.Error().Msgf("error finding monitor by ID %d: %v", input.MonitorID, err)
+			}
+			return output, err
+		}
+	}
+
+	incidents, total, err := uc.incidentRepository.FindAll(ctx, owner, filter, input.Page, input.PageSize)
+	if err != nil {
+		uc.logger.Error().Msgf("error finding incidents: %v", err)
+		return output, err
+	}
+
+	metrics, err := uc.incidentRepository.FindMetrics(ctx, owner, filter)
+	if err != nil {
+		uc.logger.Error().Msgf("error finding incident metrics: %v", err)
+		return output, err
+	}
+
+	output.Total = total
+	output.Incidents = make([]IncidentOutput, len(incidents))
+	for i, incident := range incidents {
+		output.Incidents[i] = newIncidentOutput(incident)
+	}
+
+	output.Metrics = IncidentMetricsOutput{
+		TotalIncidents: metrics.TotalIncidents,
+		OpenIncidents:  metrics.OpenIncidents,
+	}
+	if metrics.MTTRSeconds.Valid {
+		output.Metrics.MTTRSeconds = &metrics.MTTRSeconds.Float64
+	}
+	if metrics.MTBFSeconds.Valid {
+		output.Metrics.MTBFSeconds = &metrics.MTBFSeconds.Float64
+	}
+
+	return output, nil
+}
+
+func newIncidentOutput(incident model.IncidentModel) IncidentOutput {
+	output := IncidentOutput{
+		IncidentID: incident.ID,
+		MonitorID:  incident.HTTPMonitorID,
+		Status:     enum.IncidentStatusOpen,
+		Cause:      incident.Cause,
+		StartedAt:  incident.StartedAt,
+	}
+
+	if incident.StatusCode.Valid {
+		output.StatusCode = &incident.StatusCode.Int32
+	}
+	if incident.ErrorMessage.Valid {
+		output.ErrorMessage = &incident.ErrorMessage.String
+	}
+	if incident.FirstFailedCheckID.Valid {
+		checkID := uint64(incident.FirstFailedCheckID.Int64) // #nosec G115
+		output.FirstFailedCheckID = &checkID
+	}
+	if incident.RecoveryCheckID.Valid {
+		checkID := uint64(incident.RecoveryCheckID.Int64) // #nosec G115
+		output.RecoveryCheckID = &checkID
+	}
+	if incident.ResolvedAt.Valid {
+		output.Status = enum.IncidentStatusResolved
+		output.ResolvedAt = &incident.ResolvedAt.Time
+	}
+	if incident.DurationSeconds.Valid {
+		output.DurationSeconds = &incident.DurationSeconds.Int64
+	}
+
+	return output
+}
@@ -0,0 +1,161 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/usecase"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	shared_validator_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/validator/mocks"
+)
+
+type IncidentListUseCaseTestSuite struct {
+	suite.Suite
+	sut                       *usecase.IncidentListUseCase
+	httpMonitorRepositoryMock *repository_mocks.MockHTTPMonitorRepositoryI
+	incidentRepositoryMock    *repository_mocks.MockIncidentRepositoryI
+	validatorMock             *shared_validator_mocks.MockValidate
+	logger                    logger.Logger
+}
+
+func (s *IncidentListUseCaseTestSuite) SetupTest() {
+	s.httpMonitorRepositoryMock = repository_mocks.NewMockHTTPMonitorRepositoryI(s.T())
+	s.incidentRepositoryMock = repository_mocks.NewMockIncidentRepositoryI(s.T())
+	s.validatorMock = shared_validator_mocks.NewMockValidate(s.T())
+
+	cfg := config.Config{
+		Log: config.Log{
+			LogLevel: "disabled",
+		},
+	}
+	s.logger = logger.New(cfg)
+
+	s.sut = usecase.NewIncidentListUseCase(
+		s.httpMonitorRepositoryMock,
+		s.incidentRepositoryMock,
+		s.validatorMock,
+		s.logger,
+	)
+}
+
+func TestIncidentListUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(IncidentListUseCaseTestSuite))
+}
+
+func (s *IncidentListUseCaseTestSuite) TestExecute_MonitorIncidents_ReturnsIncidentsAndMetrics() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.IncidentListInput{
+		UserID:    5,
+		MonitorID: 10,
+		Status:    enum.IncidentStatusResolved,
+		Page:      1,
+		PageSize:  20,
+	}
+	owner := repository.Owner{UserID: input.UserID}
+
+	resolved := false
+	expectedFilter := repository.IncidentFilter{MonitorID: input.MonitorID, Open: &resolved}
+	startedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	incidents := []model.IncidentModel{
+		{
+			ID:                 4,
+			HTTPMonitorID:      input.MonitorID,
+			FirstFailedCheckID: sql.NullInt64{Int64: 7, Valid: true},
+			Cause:              enum.IncidentCauseTimeout,
+			StartedAt:          startedAt,
+			ResolvedAt:         sql.NullTime{Time: startedAt.Add(5 * time.Minute), Valid: true},
+			DurationSeconds:    sql.NullInt64{Int64: 300, Valid: true},
+		},
+	}
+	metrics := repository.IncidentMetrics{
+		TotalIncidents: 1,
+		MTTRSeconds:    sql.NullFloat64{Float64: 300, Valid: true},
+	}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.httpMonitorRepositoryMock.On("FindByID", mock.Anything, owner, input.MonitorID).
+		Return(model.HTTPMonitorModel{ID: input.MonitorID}, nil)
+	s.incidentRepositoryMock.On("FindAll", mock.Anything, owner, expectedFilter, 1, 20).
+		Return(incidents, int64(1), nil)
+	s.incidentRepositoryMock.On("FindMetrics", mock.Anything, owner, expectedFilter).Return(metrics, nil)
+
+	// Act
+	output, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(int64(1), output.Total)
+	s.Require().Len(output.Incidents, 1)
+	s.Equal(enum.IncidentStatusResolved, output.Incidents[0].Status)
+	s.Equal(uint64(7), *output.Incidents[0].FirstFailedCheckID)
+	s.Nil(output.Incidents[0].RecoveryCheckID)
+	s.Equal(int64(300), *output.Incidents[0].DurationSeconds)
+	s.InDelta(300.0, *output.Metrics.MTTRSeconds, 0.001)
+	s.Nil(output.Metrics.MTBFSeconds)
+}
+
+func (s *IncidentListUseCaseTestSuite) TestExecute_AllMonitors_DoesNotLookUpMonitor() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.IncidentListInput{UserID: 5, Page: 1, PageSize: 20}
+	owner := repository.Owner{UserID: input.UserID}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.incidentRepositoryMock.On("FindAll", mock.Anything, owner, repository.IncidentFilter{}, 1, 20).
+		Return([]model.IncidentModel{{ID: 4, HTTPMonitorID: 3}}, int64(1), nil)
+	s.incidentRepositoryMock.On("FindMetrics", mock.Anything, owner, repository.IncidentFilter{}).
+		Return(repository.IncidentMetrics{TotalIncidents: 1, OpenIncidents: 1}, nil)
+
+	// Act
+	output, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(enum.IncidentStatusOpen, output.Incidents[0].Status)
+	s.Equal(int64(1), output.Metrics.OpenIncidents)
+	s.httpMonitorRepositoryMock.AssertNotCalled(s.T(), "FindByID", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *IncidentListUseCaseTestSuite) TestExecute_InvalidStatus_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.IncidentListInput{UserID: 5, Status: "closed", Page: 1, PageSize: 20}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidIncidentStatus)
+}
+
+func (s *IncidentListUseCaseTestSuite) TestExecute_MonitorNotFound_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.IncidentListInput{UserID: 5, MonitorID: 10, Page: 1, PageSize: 20}
+	owner := repository.Owner{UserID: input.UserID}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.httpMonitorRepositoryMock.On("FindByID", mock.Anything, owner, input.MonitorID).
+		Return(model.HTTPMonitorModel{}, shared_errs.ErrRecordNotFound)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, shared_errs.ErrRecordNotFound)
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+)
+
+type NotificationOutput struct {
+	NotificationID   uint64
+	MonitorID        uint64
+	ContactID        uint64
+	IncidentID       *uint64
+	CheckID          *uint64
+	NotificationType string
+	Message          string
+	Status           string
+	ErrorMessage     *string
+	AttemptCount     int
+	SentAt           *time.Time
+	NextAttemptAt    *time.Time
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+func newNotificationOutput(notification model.NotificationModel) NotificationOutput {
+	output := NotificationOutput{
+		NotificationID:   notification.ID,
+		MonitorID:        notification.HTTPMonitorID,
+		ContactID:        notification.ContactID,
+		NotificationType: notification.NotificationType,
+		Message:          notification.Message,
+		Status:           notification.Status,
+		AttemptCount:     notification.AttemptCount,
+		CreatedAt:        notification.CreatedAt,
+		UpdatedAt:        notification.UpdatedAt,
+	}
+
+	if notification.IncidentID.Valid {
+		incidentID := uint64(notification.IncidentID.Int64) // #nosec G115
+		output.IncidentID = &incidentID
+	}
+	if notification.HTTPMonitorCheckID.Valid {
+		checkID := uint64(notification.HTTPMonitorCheckID.Int64) // #nosec G115
+		output.CheckID = &checkID
+	}
+	if notification.ErrorMessage.Valid {
+		output.ErrorMessage = &notification.ErrorMessage.String
+	}
+	if notification.SentAt.Valid {
+		output.SentAt = &notification.SentAt.Time
+	}
+	if notification.NextAttemptAt.Valid {
+		output.NextAttemptAt = &notification.NextAttemptAt.Time
+	}
+
+	return output
+}
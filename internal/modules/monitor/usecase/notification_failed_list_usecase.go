@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+)
+
+type NotificationFailedListInput struct {
+	UserID         uint64 `validate:"required"`
+	OrganizationID uint64
+	Page           int `validate:"required,min=1"`
+	PageSize       int `validate:"required,min=1,max=100"`
+}
+
+type NotificationFailedListOutput struct {
+	Notifications []NotificationOutput
+	Total         int64
+}
+
+type NotificationFailedListUseCase struct {
+	notificationRepository repository.NotificationRepositoryI
+	validate               validator.Validate
+	logger                 logger.Logger
+}
+
+func NewNotificationFailedListUseCase(
+	notificationRepository repository.NotificationRepositoryI,
+	validate validator.Validate,
+	logger logger.Logger,
+) *NotificationFailedListUseCase {
+	return &NotificationFailedListUseCase{
+		notificationRepository: notificationRepository,
+		validate:               validate,
+		logger:                 logger,
+	}
+}
+
+// Execute lists the notifications that ran out of delivery attempts.
+func (uc *NotificationFailedListUseCase) Execute(
+	ctx context.Context,
+	input NotificationFailedListInput,
+) (NotificationFailedListOutput, error) {
+	ctx, span := trace.Span(ctx, "NotificationFailedListUseCase.Execute")
+	defer span.End()
+
+	output := NotificationFailedListOutput{}
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return output, err
+	}
+
+	owner := repository.Owner{UserID: input.UserID, OrganizationID: input.OrganizationID}
+	notifications, total, err := uc.notificationRepository.FindPermanentlyFailed(
+		ctx,
+		owner,
+		input.Page,
+		input.PageSize,
+	)
+	if err != nil {
+		uc.logger.Error().Msgf("error finding failed notifications: %v", err)
+		return output, err
+	}
+
+	output.Total = total
+	output.Notifications = make([]NotificationOutput, len(notifications))
+	for i, notification := range notifications {
+		output.Notifications[i] = newNotificationOutput(notification)
+	}
+
+	return output, nil
+}
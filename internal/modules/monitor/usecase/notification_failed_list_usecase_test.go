@@ -0,0 +1,104 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	repository_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/usecase"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	shared_validator_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/validator/mocks"
+)
+
+type NotificationFailedListUseCaseTestSuite struct {
+	suite.Suite
+	sut                        *usecase.NotificationFailedListUseCase
+	notificationRepositoryMock *repository_mocks.MockNotificationRepositoryI
+	validatorMock              *shared_validator_mocks.MockValidate
+	logger                     logger.Logger
+}
+
+func (s *NotificationFailedListUseCaseTestSuite) SetupTest() {
+	s.notificationRepositoryMock = repository_mocks.NewMockNotificationRepositoryI(s.T())
+	s.validatorMock = shared_validator_mocks.NewMockValidate(s.T())
+
+	cfg := config.Config{
+		Log: config.Log{
+			LogLevel: "disabled",
+		},
+	}
+	s.logger = logger.New(cfg)
+
+	s.sut = usecase.NewNotificationFailedListUseCase(
+		s.notificationRepositoryMock,
+		s.validatorMock,
+		s.logger,
+	)
+}
+
+func TestNotificationFailedListUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(NotificationFailedListUseCaseTestSuite))
+}
+
+func (s *NotificationFailedListUseCaseTestSuite) TestExecute_ValidInput_ReturnsFailedNotifications() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.NotificationFailedListInput{UserID: 5, Page: 2, PageSize: 10}
+	owner := repository.Owner{UserID: input.UserID}
+	notifications := []model.NotificationModel{
+		{
+			ID:               20,
+			HTTPMonitorID:    1,
+			ContactID:        3,
+			NotificationType: enum.NotificationTypeFailure,
+			Status:           enum.NotificationStatusFailed,
+			ErrorMessage:     sql.NullString{String: "smtp unavailable", Valid: true},
+			AttemptCount:     5,
+		},
+	}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.notificationRepositoryMock.On("FindPermanentlyFailed", mock.Anything, owner, 2, 10).
+		Return(notifications, int64(11), nil)
+
+	// Act
+	output, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(int64(11), output.Total)
+	s.Require().Len(output.Notifications, 1)
+	s.Equal(uint64(20), output.Notifications[0].NotificationID)
+	s.Equal(5, output.Notifications[0].AttemptCount)
+	s.Require().NotNil(output.Notifications[0].ErrorMessage)
+	s.Equal("smtp unavailable", *output.Notifications[0].ErrorMessage)
+	s.Nil(output.Notifications[0].IncidentID)
+	s.Nil(output.Notifications[0].SentAt)
+}
+
+func (s *NotificationFailedListUseCaseTestSuite) TestExecute_RepositoryFails_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.NotificationFailedListInput{UserID: 5, Page: 1, PageSize: 20}
+	owner := repository.Owner{UserID: input.UserID}
+	repoErr := errors.New("database error")
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.notificationRepositoryMock.On("FindPermanentlyFailed", mock.Anything, owner, 1, 20).
+		Return(nil, int64(0), repoErr)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, repoErr)
+}
@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/service"
+	shared_errs "github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/validator"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+)
+
+type NotificationRetryInput struct {
+	UserID         uint64 `validate:"required"`
+	OrganizationID uint64
+	NotificationID uint64 `validate:"required"`
+}
+
+type NotificationRetryUseCase struct {
+	notificationDispatcherService service.NotificationDispatcherServiceI
+	validate                      validator.Validate
+	logger                        logger.Logger
+}
+
+func NewNotificationRetryUseCase(
+	notificationDispatcherService service.NotificationDispatcherServiceI,
+	validate validator.Validate,
+	logger logger.Logger,
+) *NotificationRetryUseCase {
+	return &NotificationRetryUseCase{
+		notificationDispatcherService: notificationDispatcherService,
+		validate:                      validate,
+		logger:                        logger,
+	}
+}
+
+// Execute makes one more delivery attempt of a notification that ran out of attempts.
+// The outcome of the attempt is returned, a delivery that fails again is not an error.
+func (uc *NotificationRetryUseCase) Execute(
+	ctx context.Context,
+	input NotificationRetryInput,
+) (NotificationOutput, error) {
+	ctx, span := trace.Span(ctx, "NotificationRetryUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return NotificationOutput{}, err
+	}
+
+	owner := repository.Owner{UserID: input.UserID, OrganizationID: input.OrganizationID}
+	notification, err := uc.notificationDispatcherService.Retry(ctx, owner, input.NotificationID)
+	if err != nil {
+		if !errors.Is(err, shared_errs.ErrRecordNotFound) && !errors.Is(err, errs.ErrNotificationNotRetryable) {
+			uc.logger.Error().Msgf("error retrying notification ID %d: %v", input.NotificationID, err)
+		}
+		return NotificationOutput{}, err
+	}
+
+	return newNotificationOutput(notification), nil
+}
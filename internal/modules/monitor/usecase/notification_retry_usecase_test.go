@@ -0,0 +1,107 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/repository"
+	service_mocks "github.com/cristiano-pacheco/pingo/internal/modules/monitor/service/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/usecase"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	shared_validator_mocks "github.com/cristiano-pacheco/pingo/internal/shared/modules/validator/mocks"
+)
+
+type NotificationRetryUseCaseTestSuite struct {
+	suite.Suite
+	sut                               *usecase.NotificationRetryUseCase
+	notificationDispatcherServiceMock *service_mocks.MockNotificationDispatcherServiceI
+	validatorMock                     *shared_validator_mocks.MockValidate
+	logger                            logger.Logger
+}
+
+func (s *NotificationRetryUseCaseTestSuite) SetupTest() {
+	s.notificationDispatcherServiceMock = service_mocks.NewMockNotificationDispatcherServiceI(s.T())
+	s.validatorMock = shared_validator_mocks.NewMockValidate(s.T())
+
+	cfg := config.Config{
+		Log: config.Log{
+			LogLevel: "disabled",
+		},
+	}
+	s.logger = logger.New(cfg)
+
+	s.sut = usecase.NewNotificationRetryUseCase(
+		s.notificationDispatcherServiceMock,
+		s.validatorMock,
+		s.logger,
+	)
+}
+
+func TestNotificationRetryUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(NotificationRetryUseCaseTestSuite))
+}
+
+func (s *NotificationRetryUseCaseTestSuite) TestExecute_DeliverySucceeds_ReturnsSentNotification() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.NotificationRetryInput{UserID: 5, NotificationID: 20}
+	owner := repository.Owner{UserID: input.UserID}
+	sentAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	notification := model.NotificationModel{
+		ID:                 20,
+		HTTPMonitorID:      1,
+		ContactID:          3,
+		IncidentID:         sql.NullInt64{Int64: 4, Valid: true},
+		HTTPMonitorCheckID: sql.NullInt64{Int64: 9, Valid: true},
+		NotificationType:   enum.NotificationTypeFailure,
+		Status:             enum.NotificationStatusSent,
+		SentAt:             sql.NullTime{Time: sentAt, Valid: true},
+		AttemptCount:       6,
+	}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.notificationDispatcherServiceMock.On("Retry", mock.Anything, owner, uint64(20)).Return(notification, nil)
+
+	// Act
+	output, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(uint64(20), output.NotificationID)
+	s.Equal(enum.NotificationStatusSent, output.Status)
+	s.Equal(6, output.AttemptCount)
+	s.Require().NotNil(output.IncidentID)
+	s.Equal(uint64(4), *output.IncidentID)
+	s.Require().NotNil(output.CheckID)
+	s.Equal(uint64(9), *output.CheckID)
+	s.Require().NotNil(output.SentAt)
+	s.Equal(sentAt, *output.SentAt)
+	s.Nil(output.ErrorMessage)
+	s.Nil(output.NextAttemptAt)
+}
+
+func (s *NotificationRetryUseCaseTestSuite) TestExecute_NotRetryable_ReturnsError() {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.NotificationRetryInput{UserID: 5, NotificationID: 20}
+	owner := repository.Owner{UserID: input.UserID}
+
+	s.validatorMock.On("Struct", input).Return(nil)
+	s.notificationDispatcherServiceMock.On("Retry", mock.Anything, owner, uint64(20)).
+		Return(model.NotificationModel{}, errs.ErrNotificationNotRetryable)
+
+	// Act
+	_, err := s.sut.Execute(ctx, input)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrNotificationNotRetryable)
+}
@@ -0,0 +1,122 @@
+package validator
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+	"unicode"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/enum"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+)
+
+const (
+	minCheckTimeout         = 1
+	maxCheckTimeout         = 300
+	minFailThreshold        = 1
+	maxFailThreshold        = 10
+	minCheckIntervalSeconds = 30
+	maxCheckIntervalSeconds = 86400
+	minResponseStatus       = 100
+	maxResponseStatus       = 599
+	headerNameSeparators    = `"(),/:;<=>?@[\]{}`
+)
+
+type HTTPMonitorValidatorI interface {
+	Validate(monitor model.HTTPMonitorModel) error
+}
+
+type HTTPMonitorValidator struct {
+}
+
+var _ HTTPMonitorValidatorI = (*HTTPMonitorValidator)(nil)
+
+func NewHTTPMonitorValidator() *HTTPMonitorValidator {
+	return &HTTPMonitorValidator{}
+}
+
+func (v *HTTPMonitorValidator) Validate(monitor model.HTTPMonitorModel) error {
+	if err := v.validateURL(monitor.HTTPURL); err != nil {
+		return err
+	}
+
+	if _, err := enum.NewHTTPMethodEnum(monitor.HTTPMethod); err != nil {
+		return err
+	}
+
+	if monitor.CheckTimeout < minCheckTimeout || monitor.CheckTimeout > maxCheckTimeout {
+		return errs.ErrInvalidCheckTimeout
+	}
+
+	if monitor.FailThreshold < minFailThreshold || monitor.FailThreshold > maxFailThreshold {
+		return errs.ErrInvalidFailThreshold
+	}
+
+	if monitor.CheckIntervalSeconds < minCheckIntervalSeconds ||
+		monitor.CheckIntervalSeconds > maxCheckIntervalSeconds {
+		return errs.ErrInvalidCheckInterval
+	}
+
+	if err := v.validateRequestHeaders(monitor.RequestHeaders); err != nil {
+		return err
+	}
+
+	return v.validateResponseStatuses(monitor.ValidResponseStatuses)
+}
+
+func (v *HTTPMonitorValidator) validateURL(httpURL string) error {
+	parsedURL, err := url.ParseRequestURI(httpURL)
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+		return errs.ErrInvalidMonitorURL
+	}
+	return nil
+}
+
+// validateRequestHeaders accepts an empty value or a JSON object mapping header names to values.
+func (v *HTTPMonitorValidator) validateRequestHeaders(requestHeaders string) error {
+	if strings.TrimSpace(requestHeaders) == "" {
+		return nil
+	}
+
+	var headers map[string]string
+	if err := json.Unmarshal([]byte(requestHeaders), &headers); err != nil || headers == nil {
+		return errs.ErrInvalidRequestHeaders
+	}
+
+	for name, value := range headers {
+		if !v.isValidHeaderName(name) || strings.ContainsAny(value, "\r\n") {
+			return errs.ErrInvalidRequestHeaders
+		}
+	}
+
+	return nil
+}
+
+// isValidHeaderName checks the name is an RFC 7230 token.
+func (v *HTTPMonitorValidator) isValidHeaderName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for _, r := range name {
+		if r <= ' ' || r >= unicode.MaxASCII || strings.ContainsRune(headerNameSeparators, r) {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *HTTPMonitorValidator) validateResponseStatuses(statuses []int32) error {
+	if len(statuses) == 0 {
+		return errs.ErrInvalidResponseStatuses
+	}
+
+	for _, status := range statuses {
+		if status < minResponseStatus || status > maxResponseStatus {
+			return errs.ErrInvalidResponseStatuses
+		}
+	}
+
+	return nil
+}
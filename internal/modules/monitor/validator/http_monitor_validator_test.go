@@ -0,0 +1,148 @@
+package validator_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/errs"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	"github.com/cristiano-pacheco/pingo/internal/modules/monitor/validator"
+)
+
+type HTTPMonitorValidatorSuite struct {
+	suite.Suite
+	sut *validator.HTTPMonitorValidator
+}
+
+func (s *HTTPMonitorValidatorSuite) SetupTest() {
+	s.sut = validator.NewHTTPMonitorValidator()
+}
+
+func TestHTTPMonitorValidatorSuite(t *testing.T) {
+	suite.Run(t, new(HTTPMonitorValidatorSuite))
+}
+
+func (s *HTTPMonitorValidatorSuite) validMonitor() model.HTTPMonitorModel {
+	return model.HTTPMonitorModel{
+		Name:                  "Example",
+		HTTPURL:               "https://example.com/health",
+		HTTPMethod:            http.MethodGet,
+		CheckTimeout:          30,
+		FailThreshold:         3,
+		CheckIntervalSeconds:  300,
+		RequestHeaders:        `{"Authorization": "Bearer token"}`,
+		ValidResponseStatuses: pq.Int32Array{http.StatusOK, http.StatusNoContent},
+	}
+}
+
+func (s *HTTPMonitorValidatorSuite) TestValidate_ValidMonitor() {
+	// Arrange
+	monitor := s.validMonitor()
+	// Act
+	err := s.sut.Validate(monitor)
+	// Assert
+	s.Require().NoError(err)
+}
+
+func (s *HTTPMonitorValidatorSuite) TestValidate_EmptyRequestHeaders() {
+	// Arrange
+	monitor := s.validMonitor()
+	monitor.RequestHeaders = ""
+	// Act
+	err := s.sut.Validate(monitor)
+	// Assert
+	s.Require().NoError(err)
+}
+
+func (s *HTTPMonitorValidatorSuite) TestValidate_InvalidURL_ReturnsError() {
+	// Arrange
+	monitor := s.validMonitor()
+	monitor.HTTPURL = "ftp://example.com"
+	// Act
+	err := s.sut.Validate(monitor)
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidMonitorURL)
+}
+
+func (s *HTTPMonitorValidatorSuite) TestValidate_InvalidHTTPMethod_ReturnsError() {
+	// Arrange
+	monitor := s.validMonitor()
+	monitor.HTTPMethod = http.MethodPatch
+	// Act
+	err := s.sut.Validate(monitor)
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidHTTPMethod)
+}
+
+func (s *HTTPMonitorValidatorSuite) TestValidate_CheckTimeoutOutOfRange_ReturnsError() {
+	// Arrange
+	monitor := s.validMonitor()
+	monitor.CheckTimeout = 301
+	// Act
+	err := s.sut.Validate(monitor)
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidCheckTimeout)
+}
+
+func (s *HTTPMonitorValidatorSuite) TestValidate_FailThresholdOutOfRange_ReturnsError() {
+	// Arrange
+	monitor := s.validMonitor()
+	monitor.FailThreshold = 0
+	// Act
+	err := s.sut.Validate(monitor)
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidFailThreshold)
+}
+
+func (s *HTTPMonitorValidatorSuite) TestValidate_CheckIntervalOutOfRange_ReturnsError() {
+	// Arrange
+	monitor := s.validMonitor()
+	monitor.CheckIntervalSeconds = 10
+	// Act
+	err := s.sut.Validate(monitor)
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidCheckInterval)
+}
+
+func (s *HTTPMonitorValidatorSuite) TestValidate_RequestHeadersNotJSONObject_ReturnsError() {
+	// Arrange
+	monitor := s.validMonitor()
+	monitor.RequestHeaders = `["Authorization"]`
+	// Act
+	err := s.sut.Validate(monitor)
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidRequestHeaders)
+}
+
+func (s *HTTPMonitorValidatorSuite) TestValidate_InvalidHeaderName_ReturnsError() {
+	// Arrange
+	monitor := s.validMonitor()
+	monitor.RequestHeaders = `{"X Custom": "value"}`
+	// Act
+	err := s.sut.Validate(monitor)
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidRequestHeaders)
+}
+
+func (s *HTTPMonitorValidatorSuite) TestValidate_EmptyResponseStatuses_ReturnsError() {
+	// Arrange
+	monitor := s.validMonitor()
+	monitor.ValidResponseStatuses = pq.Int32Array{}
+	// Act
+	err := s.sut.Validate(monitor)
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidResponseStatuses)
+}
+
+func (s *HTTPMonitorValidatorSuite) TestValidate_ResponseStatusOutOfRange_ReturnsError() {
+	// Arrange
+	monitor := s.validMonitor()
+	monitor.ValidResponseStatuses = pq.Int32Array{http.StatusOK, 600}
+	// Act
+	err := s.sut.Validate(monitor)
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidResponseStatuses)
+}
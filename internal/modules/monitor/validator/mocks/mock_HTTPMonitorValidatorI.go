@@ -0,0 +1,81 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "github.com/cristiano-pacheco/pingo/internal/modules/monitor/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockHTTPMonitorValidatorI is an autogenerated mock type for the HTTPMonitorValidatorI type
+type MockHTTPMonitorValidatorI struct {
+	mock.Mock
+}
+
+type MockHTTPMonitorValidatorI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockHTTPMonitorValidatorI) EXPECT() *MockHTTPMonitorValidatorI_Expecter {
+	return &MockHTTPMonitorValidatorI_Expecter{mock: &_m.Mock}
+}
+
+// Validate provides a mock function with given fields: monitor
+func (_m *MockHTTPMonitorValidatorI) Validate(monitor model.HTTPMonitorModel) error {
+	ret := _m.Called(monitor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Validate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.HTTPMonitorModel) error); ok {
+		r0 = rf(monitor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockHTTPMonitorValidatorI_Validate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Validate'
+type MockHTTPMonitorValidatorI_Validate_Call struct {
+	*mock.Call
+}
+
+// Validate is a helper method to define mock.On call
+//   - monitor model.HTTPMonitorModel
+func (_e *MockHTTPMonitorValidatorI_Expecter) Validate(monitor interface{}) *MockHTTPMonitorValidatorI_Validate_Call {
+	return &MockHTTPMonitorValidatorI_Validate_Call{Call: _e.mock.On("Validate", monitor)}
+}
+
+func (_c *MockHTTPMonitorValidatorI_Validate_Call) Run(run func(monitor model.HTTPMonitorModel)) *MockHTTPMonitorValidatorI_Validate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.HTTPMonitorModel))
+	})
+	return _c
+}
+
+func (_c *MockHTTPMonitorValidatorI_Validate_Call) Return(_a0 error) *MockHTTPMonitorValidatorI_Validate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockHTTPMonitorValidatorI_Validate_Call) RunAndReturn(run func(model.HTTPMonitorModel) error) *MockHTTPMonitorValidatorI_Validate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockHTTPMonitorValidatorI creates a new instance of MockHTTPMonitorValidatorI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockHTTPMonitorValidatorI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockHTTPMonitorValidatorI {
+	mock := &MockHTTPMonitorValidatorI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,40 @@
+package broker
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+	"github.com/cristiano-pacheco/pingo/pkg/kafka"
+	"github.com/cristiano-pacheco/pingo/pkg/rabbitmq"
+)
+
+var ErrUnsupportedBrokerBackend = errors.New("unsupported message broker backend")
+
+// NewBrokerFacade builds the producers and consumers of the broker backend selected in the config.
+func NewBrokerFacade(cfg config.Config) (broker.Builder, error) {
+	switch cfg.Broker.Backend {
+	case "", config.BrokerBackendKafka:
+		kafkaConfig := kafka.Config{
+			Address: cfg.Kafka.Address,
+		}
+		return kafka.MustNewKafkaBuilder(kafkaConfig), nil
+	case config.BrokerBackendRabbitMQ:
+		rabbitMQConfig, err := rabbitmq.NewConfig(
+			cfg.RabbitMQ.Host,
+			cfg.RabbitMQ.Port,
+			cfg.RabbitMQ.Username,
+			cfg.RabbitMQ.Password,
+			cfg.RabbitMQ.VHost,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return rabbitmq.MustNewRabbitMQBuilder(rabbitMQConfig), nil
+	case config.BrokerBackendMemory:
+		return broker.NewMemoryBuilder(), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedBrokerBackend, cfg.Broker.Backend)
+	}
+}
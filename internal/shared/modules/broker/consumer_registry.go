@@ -0,0 +1,33 @@
+package broker
+
+import "sync"
+
+// ConsumerRegistry keeps track of the consumer runners of the application to report their state.
+type ConsumerRegistry struct {
+	mu      sync.Mutex
+	runners []*ConsumerRunner
+}
+
+func NewConsumerRegistry() *ConsumerRegistry {
+	return &ConsumerRegistry{}
+}
+
+func (r *ConsumerRegistry) Register(runner *ConsumerRunner) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.runners = append(r.runners, runner)
+}
+
+// States returns the state of every registered consumer.
+func (r *ConsumerRegistry) States() []ConsumerState {
+	r.mu.Lock()
+	runners := make([]*ConsumerRunner, len(r.runners))
+	copy(runners, r.runners)
+	r.mu.Unlock()
+
+	states := make([]ConsumerState, len(runners))
+	for i, runner := range runners {
+		states[i] = runner.State()
+	}
+	return states
+}
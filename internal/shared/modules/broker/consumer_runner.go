@@ -0,0 +1,266 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"go.uber.org/fx"
+)
+
+const (
+	initialRestartBackoff = time.Second
+	maxRestartBackoff     = time.Minute
+)
+
+// States of a consumer reported by ConsumerRunner.State.
+const (
+	ConsumerStateStarting   = "starting"
+	ConsumerStateRunning    = "running"
+	ConsumerStateRestarting = "restarting"
+	ConsumerStateStopped    = "stopped"
+)
+
+// MessageProcessor defines the business logic for consuming messages
+type MessageProcessor interface {
+	Topic() string
+	GroupID() string
+	ProcessMessage(ctx context.Context, msg broker.Message) error
+}
+
+// RetryPolicyProvider is implemented by the processors that don't use broker.DefaultRetryPolicy.
+type RetryPolicyProvider interface {
+	RetryPolicy() broker.RetryPolicy
+}
+
+// ConsumerState is a snapshot of the state of a consumer.
+type ConsumerState struct {
+	Name        string
+	Topic       string
+	GroupID     string
+	State       string
+	Restarts    int
+	LastError   string
+	LastErrorAt *time.Time
+	// Lag is only known while the consumer is running.
+	Lag *int64
+}
+
+// ConsumerRunner wires a Consumer with a MessageProcessor
+// and manages its lifecycle via Fx.
+// Messages the processor keeps failing on are published to the dead-letter topic of the
+// processor topic, so a poisoned message doesn't stop the consumption. When the consumer
+// stops with an error anyway, it's rebuilt and restarted with an exponential backoff.
+type ConsumerRunner struct {
+	builder     broker.Builder
+	deadLetter  broker.Producer
+	processor   MessageProcessor
+	retryPolicy broker.RetryPolicy
+	logger      logger.Logger
+	stopped     chan struct{}
+
+	initialRestartBackoff time.Duration
+	maxRestartBackoff     time.Duration
+
+	mu          sync.Mutex
+	consumer    broker.Consumer
+	state       string
+	restarts    int
+	lastError   error
+	lastErrorAt time.Time
+}
+
+// NewConsumerRunner creates a ConsumerRunner that automatically
+// starts/stops with the Fx lifecycle and reports its state to the registry.
+func NewConsumerRunner(
+	builder broker.Builder,
+	processor MessageProcessor,
+	registry *ConsumerRegistry,
+	logger logger.Logger,
+	lc fx.Lifecycle,
+) *ConsumerRunner {
+	runner := &ConsumerRunner{
+		builder:     builder,
+		processor:   processor,
+		retryPolicy: broker.DefaultRetryPolicy(),
+		logger:      logger,
+		stopped:     make(chan struct{}),
+		state:       ConsumerStateStarting,
+
+		initialRestartBackoff: initialRestartBackoff,
+		maxRestartBackoff:     maxRestartBackoff,
+	}
+	if provider, ok := processor.(RetryPolicyProvider); ok {
+		runner.retryPolicy = provider.RetryPolicy()
+	}
+
+	registry.Register(runner)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			runner.deadLetter = builder.BuildProducer(broker.DeadLetterTopic(processor.Topic()))
+
+			go runner.supervise(ctx)
+
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-runner.stopped:
+			case <-stopCtx.Done():
+				return stopCtx.Err()
+			}
+			return runner.Stop()
+		},
+	})
+
+	return runner
+}
+
+// Name identifies the consumer in logs and state reports.
+func (r *ConsumerRunner) Name() string {
+	return fmt.Sprintf("%s:%s", r.processor.Topic(), r.processor.GroupID())
+}
+
+// State returns a snapshot of the state of the consumer.
+func (r *ConsumerRunner) State() ConsumerState {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	state := ConsumerState{
+		Name:     r.Name(),
+		Topic:    r.processor.Topic(),
+		GroupID:  r.processor.GroupID(),
+		State:    r.state,
+		Restarts: r.restarts,
+	}
+	if r.lastError != nil {
+		lastErrorAt := r.lastErrorAt
+		state.LastError = r.lastError.Error()
+		state.LastErrorAt = &lastErrorAt
+	}
+	if r.state == ConsumerStateRunning && r.consumer != nil {
+		lag := r.consumer.Lag()
+		state.Lag = &lag
+	}
+
+	return state
+}
+
+// Run starts the consumer loop and delegates to the processor.
+func (r *ConsumerRunner) Run(ctx context.Context) error {
+	handler := func(ctx context.Context, msg broker.Message) error {
+		ctx, span := trace.Span(ctx, "broker.consumer", oteltrace.WithSpanKind(oteltrace.SpanKindConsumer))
+		defer span.End()
+
+		err := r.processor.ProcessMessage(ctx, msg)
+		if err != nil {
+			r.logger.Error().Msgf(
+				"error processing message %s/%d/%d: %v",
+				msg.Topic,
+				msg.Partition,
+				msg.Offset,
+				err,
+			)
+		}
+		return err
+	}
+
+	r.mu.Lock()
+	consumer := r.consumer
+	r.mu.Unlock()
+
+	return consumer.Consume(ctx, broker.WithDeadLetter(handler, r.deadLetter, r.retryPolicy))
+}
+
+// Stop closes the underlying consumer and dead-letter producer.
+func (r *ConsumerRunner) Stop() error {
+	var errs []error
+	if err := r.closeConsumer(); err != nil {
+		errs = append(errs, err)
+	}
+	if r.deadLetter != nil {
+		errs = append(errs, r.deadLetter.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// supervise runs the consumer until the context is canceled, restarting it with a fresh
+// reader every time it stops with an error. The backoff between restarts doubles up to
+// maxRestartBackoff and starts over once the consumer stays up for longer than that.
+func (r *ConsumerRunner) supervise(ctx context.Context) {
+	defer close(r.stopped)
+
+	backoff := r.initialRestartBackoff
+	for {
+		r.mu.Lock()
+		r.consumer = r.builder.BuildConsumer(r.processor.Topic(), r.processor.GroupID())
+		r.state = ConsumerStateRunning
+		r.mu.Unlock()
+
+		r.logger.Info().Msgf("Starting consumer %s...", r.Name())
+		startedAt := time.Now()
+
+		err := r.Run(ctx)
+		if ctx.Err() != nil {
+			r.setState(ConsumerStateStopped)
+			r.logger.Info().Msgf("Consumer %s stopped gracefully", r.Name())
+			return
+		}
+
+		if time.Since(startedAt) > r.maxRestartBackoff {
+			backoff = r.initialRestartBackoff
+		}
+
+		r.mu.Lock()
+		r.state = ConsumerStateRestarting
+		r.restarts++
+		r.lastError = err
+		r.lastErrorAt = time.Now().UTC()
+		r.mu.Unlock()
+
+		r.logger.Error().Msgf("Consumer %s stopped with error, restarting in %s: %v", r.Name(), backoff, err)
+
+		if closeErr := r.closeConsumer(); closeErr != nil {
+			r.logger.Error().Msgf("error closing consumer %s: %v", r.Name(), closeErr)
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			r.setState(ConsumerStateStopped)
+			return
+		case <-timer.C:
+		}
+
+		backoff = min(backoff*2, r.maxRestartBackoff)
+	}
+}
+
+func (r *ConsumerRunner) setState(state string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.state = state
+}
+
+func (r *ConsumerRunner) closeConsumer() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.consumer == nil {
+		return nil
+	}
+	err := r.consumer.Close()
+	r.consumer = nil
+	return err
+}
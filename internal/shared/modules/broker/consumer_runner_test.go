@@ -0,0 +1,198 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/fx"
+
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/broker/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+	brokermocks "github.com/cristiano-pacheco/pingo/pkg/broker/mocks"
+)
+
+const (
+	testTopic   = "monitor.down"
+	testGroupID = "notification"
+	testTimeout = 5 * time.Second
+	testTick    = 5 * time.Millisecond
+)
+
+var errConsumerCrashed = errors.New("connection reset by peer")
+
+// testLifecycle collects the hooks of the runners so the tests start and stop them.
+type testLifecycle struct {
+	hooks []fx.Hook
+}
+
+func (l *testLifecycle) Append(hook fx.Hook) {
+	l.hooks = append(l.hooks, hook)
+}
+
+// runnerFixture builds runners on mocked consumers, it's shared by the runner and readiness suites.
+type runnerFixture struct {
+	suite.Suite
+	builderMock   *brokermocks.MockBuilder
+	processorMock *mocks.MockMessageProcessor
+	registry      *ConsumerRegistry
+	lifecycle     *testLifecycle
+	logger        logger.Logger
+}
+
+func (s *runnerFixture) SetupTest() {
+	s.builderMock = brokermocks.NewMockBuilder(s.T())
+	s.processorMock = mocks.NewMockMessageProcessor(s.T())
+	s.registry = NewConsumerRegistry()
+	s.lifecycle = &testLifecycle{}
+	s.logger = logger.New(config.Config{Log: config.Log{LogLevel: "disabled"}})
+
+	s.processorMock.On("Topic").Return(testTopic).Maybe()
+	s.processorMock.On("GroupID").Return(testGroupID).Maybe()
+
+	deadLetterMock := brokermocks.NewMockProducer(s.T())
+	deadLetterMock.On("Close").Return(nil).Maybe()
+	s.builderMock.On("BuildProducer", broker.DeadLetterTopic(testTopic)).Return(deadLetterMock).Maybe()
+}
+
+type ConsumerRunnerTestSuite struct {
+	runnerFixture
+}
+
+func TestConsumerRunnerSuite(t *testing.T) {
+	suite.Run(t, new(ConsumerRunnerTestSuite))
+}
+
+func (s *runnerFixture) newRunner(initialBackoff time.Duration) *ConsumerRunner {
+	runner := NewConsumerRunner(s.builderMock, s.processorMock, s.registry, s.logger, s.lifecycle)
+	runner.initialRestartBackoff = initialBackoff
+	runner.maxRestartBackoff = 4 * initialBackoff
+	return runner
+}
+
+// crashingConsumer returns a consumer that stops with errConsumerCrashed as soon as it's consumed.
+func (s *runnerFixture) crashingConsumer() *brokermocks.MockConsumer {
+	consumer := brokermocks.NewMockConsumer(s.T())
+	consumer.On("Consume", mock.Anything, mock.Anything).Return(errConsumerCrashed).Once()
+	consumer.On("Lag").Return(int64(0)).Maybe()
+	consumer.On("Close").Return(nil).Once()
+	return consumer
+}
+
+// healthyConsumer returns a consumer that consumes until its context is canceled.
+func (s *runnerFixture) healthyConsumer(lag int64) *brokermocks.MockConsumer {
+	consumer := brokermocks.NewMockConsumer(s.T())
+	consumer.On("Consume", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			<-args.Get(0).(context.Context).Done()
+		}).
+		Return(context.Canceled).Once()
+	consumer.On("Lag").Return(lag).Maybe()
+	consumer.On("Close").Return(nil).Once()
+	return consumer
+}
+
+func (s *runnerFixture) start() {
+	for _, hook := range s.lifecycle.hooks {
+		s.Require().NoError(hook.OnStart(context.Background()))
+	}
+}
+
+func (s *runnerFixture) stop() error {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	var errs []error
+	for _, hook := range s.lifecycle.hooks {
+		errs = append(errs, hook.OnStop(ctx))
+	}
+	return errors.Join(errs...)
+}
+
+func (s *runnerFixture) eventuallyInState(runner *ConsumerRunner, state string) {
+	s.Eventually(func() bool {
+		return runner.State().State == state
+	}, testTimeout, testTick, "consumer never reached %q", state)
+}
+
+func (s *ConsumerRunnerTestSuite) TestState_NotStarted_ReturnsStarting() {
+	// Arrange
+	runner := s.newRunner(time.Millisecond)
+
+	// Act
+	state := runner.State()
+
+	// Assert
+	s.Equal(ConsumerState{
+		Name:    testTopic + ":" + testGroupID,
+		Topic:   testTopic,
+		GroupID: testGroupID,
+		State:   ConsumerStateStarting,
+	}, state)
+}
+
+func (s *ConsumerRunnerTestSuite) TestSupervise_Running_ReportsLag() {
+	// Arrange
+	runner := s.newRunner(time.Millisecond)
+	s.builderMock.On("BuildConsumer", testTopic, testGroupID).Return(s.healthyConsumer(7)).Once()
+
+	// Act
+	s.start()
+	s.eventuallyInState(runner, ConsumerStateRunning)
+	state := runner.State()
+	err := s.stop()
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().NotNil(state.Lag)
+	s.Equal(int64(7), *state.Lag)
+	s.Equal(0, state.Restarts)
+	s.Equal(ConsumerStateStopped, runner.State().State)
+}
+
+func (s *ConsumerRunnerTestSuite) TestSupervise_ConsumerCrashes_RestartsAndReturnsToRunning() {
+	// Arrange
+	runner := s.newRunner(time.Millisecond)
+	s.builderMock.On("BuildConsumer", testTopic, testGroupID).Return(s.crashingConsumer()).Once()
+	s.builderMock.On("BuildConsumer", testTopic, testGroupID).Return(s.healthyConsumer(0)).Once()
+
+	// Act
+	s.start()
+	s.Eventually(func() bool {
+		state := runner.State()
+		return state.State == ConsumerStateRunning && state.Restarts == 1
+	}, testTimeout, testTick)
+	state := runner.State()
+	err := s.stop()
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(errConsumerCrashed.Error(), state.LastError)
+	s.Require().NotNil(state.LastErrorAt)
+	s.Equal(ConsumerStateStopped, runner.State().State)
+}
+
+func (s *ConsumerRunnerTestSuite) TestSupervise_ShutdownDuringBackoff_StopsWithoutRestarting() {
+	// Arrange
+	runner := s.newRunner(time.Hour)
+	s.builderMock.On("BuildConsumer", testTopic, testGroupID).Return(s.crashingConsumer()).Once()
+	s.start()
+	s.eventuallyInState(runner, ConsumerStateRestarting)
+
+	// Act
+	startedAt := time.Now()
+	err := s.stop()
+
+	// Assert
+	s.Require().NoError(err)
+	s.Less(time.Since(startedAt), testTimeout)
+	state := runner.State()
+	s.Equal(ConsumerStateStopped, state.State)
+	s.Equal(1, state.Restarts)
+	s.Nil(state.Lag)
+}
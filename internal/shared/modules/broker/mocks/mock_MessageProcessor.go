@@ -5,9 +5,8 @@ package mocks
 import (
 	context "context"
 
+	pkgbroker "github.com/cristiano-pacheco/pingo/pkg/broker"
 	mock "github.com/stretchr/testify/mock"
-
-	pkgkafka "github.com/cristiano-pacheco/pingo/pkg/kafka"
 )
 
 // MockMessageProcessor is an autogenerated mock type for the MessageProcessor type
@@ -69,7 +68,7 @@ func (_c *MockMessageProcessor_GroupID_Call) RunAndReturn(run func() string) *Mo
 }
 
 // ProcessMessage provides a mock function with given fields: ctx, msg
-func (_m *MockMessageProcessor) ProcessMessage(ctx context.Context, msg pkgkafka.Message) error {
+func (_m *MockMessageProcessor) ProcessMessage(ctx context.Context, msg pkgbroker.Message) error {
 	ret := _m.Called(ctx, msg)
 
 	if len(ret) == 0 {
@@ -77,7 +76,7 @@ func (_m *MockMessageProcessor) ProcessMessage(ctx context.Context, msg pkgkafka
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, pkgkafka.Message) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, pkgbroker.Message) error); ok {
 		r0 = rf(ctx, msg)
 	} else {
 		r0 = ret.Error(0)
@@ -93,14 +92,14 @@ type MockMessageProcessor_ProcessMessage_Call struct {
 
 // ProcessMessage is a helper method to define mock.On call
 //   - ctx context.Context
-//   - msg pkgkafka.Message
+//   - msg pkgbroker.Message
 func (_e *MockMessageProcessor_Expecter) ProcessMessage(ctx interface{}, msg interface{}) *MockMessageProcessor_ProcessMessage_Call {
 	return &MockMessageProcessor_ProcessMessage_Call{Call: _e.mock.On("ProcessMessage", ctx, msg)}
 }
 
-func (_c *MockMessageProcessor_ProcessMessage_Call) Run(run func(ctx context.Context, msg pkgkafka.Message)) *MockMessageProcessor_ProcessMessage_Call {
+func (_c *MockMessageProcessor_ProcessMessage_Call) Run(run func(ctx context.Context, msg pkgbroker.Message)) *MockMessageProcessor_ProcessMessage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(pkgkafka.Message))
+		run(args[0].(context.Context), args[1].(pkgbroker.Message))
 	})
 	return _c
 }
@@ -110,7 +109,7 @@ func (_c *MockMessageProcessor_ProcessMessage_Call) Return(_a0 error) *MockMessa
 	return _c
 }
 
-func (_c *MockMessageProcessor_ProcessMessage_Call) RunAndReturn(run func(context.Context, pkgkafka.Message) error) *MockMessageProcessor_ProcessMessage_Call {
+func (_c *MockMessageProcessor_ProcessMessage_Call) RunAndReturn(run func(context.Context, pkgbroker.Message) error) *MockMessageProcessor_ProcessMessage_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,80 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	broker "github.com/cristiano-pacheco/pingo/pkg/broker"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRetryPolicyProvider is an autogenerated mock type for the RetryPolicyProvider type
+type MockRetryPolicyProvider struct {
+	mock.Mock
+}
+
+type MockRetryPolicyProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRetryPolicyProvider) EXPECT() *MockRetryPolicyProvider_Expecter {
+	return &MockRetryPolicyProvider_Expecter{mock: &_m.Mock}
+}
+
+// RetryPolicy provides a mock function with no fields
+func (_m *MockRetryPolicyProvider) RetryPolicy() broker.RetryPolicy {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryPolicy")
+	}
+
+	var r0 broker.RetryPolicy
+	if rf, ok := ret.Get(0).(func() broker.RetryPolicy); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(broker.RetryPolicy)
+	}
+
+	return r0
+}
+
+// MockRetryPolicyProvider_RetryPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryPolicy'
+type MockRetryPolicyProvider_RetryPolicy_Call struct {
+	*mock.Call
+}
+
+// RetryPolicy is a helper method to define mock.On call
+func (_e *MockRetryPolicyProvider_Expecter) RetryPolicy() *MockRetryPolicyProvider_RetryPolicy_Call {
+	return &MockRetryPolicyProvider_RetryPolicy_Call{Call: _e.mock.On("RetryPolicy")}
+}
+
+func (_c *MockRetryPolicyProvider_RetryPolicy_Call) Run(run func()) *MockRetryPolicyProvider_RetryPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRetryPolicyProvider_RetryPolicy_Call) Return(_a0 broker.RetryPolicy) *MockRetryPolicyProvider_RetryPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRetryPolicyProvider_RetryPolicy_Call) RunAndReturn(run func() broker.RetryPolicy) *MockRetryPolicyProvider_RetryPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRetryPolicyProvider creates a new instance of MockRetryPolicyProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRetryPolicyProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRetryPolicyProvider {
+	mock := &MockRetryPolicyProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
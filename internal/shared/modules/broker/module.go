@@ -0,0 +1,13 @@
+package broker
+
+import "go.uber.org/fx"
+
+var Module = fx.Module(
+	"broker",
+	fx.Provide(
+		NewBrokerFacade,
+		NewConsumerRegistry,
+		NewReadinessHandler,
+	),
+	fx.Invoke(SetupReadinessRoutes),
+)
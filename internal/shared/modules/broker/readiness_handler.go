@@ -0,0 +1,81 @@
+package broker
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/http/router"
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	readinessStatusReady    = "ready"
+	readinessStatusNotReady = "not_ready"
+)
+
+type readinessResponse struct {
+	Status    string                      `json:"status"`
+	Consumers []consumerReadinessResponse `json:"consumers"`
+}
+
+type consumerReadinessResponse struct {
+	Name        string     `json:"name"`
+	Topic       string     `json:"topic"`
+	GroupID     string     `json:"group_id"`
+	State       string     `json:"state"`
+	Restarts    int        `json:"restarts"`
+	LastError   *string    `json:"last_error"`
+	LastErrorAt *time.Time `json:"last_error_at"`
+	Lag         *int64     `json:"lag"`
+}
+
+type ReadinessHandler struct {
+	consumerRegistry *ConsumerRegistry
+}
+
+func NewReadinessHandler(consumerRegistry *ConsumerRegistry) *ReadinessHandler {
+	return &ReadinessHandler{consumerRegistry: consumerRegistry}
+}
+
+// Readiness reports the state of every consumer. The application is ready when all of them are
+// running, otherwise it responds with 503 so it's taken out of rotation until they recover.
+func (h *ReadinessHandler) Readiness(c *fiber.Ctx) error {
+	states := h.consumerRegistry.States()
+
+	res := readinessResponse{
+		Status:    readinessStatusReady,
+		Consumers: make([]consumerReadinessResponse, len(states)),
+	}
+	for i, state := range states {
+		if state.State != ConsumerStateRunning {
+			res.Status = readinessStatusNotReady
+		}
+
+		res.Consumers[i] = consumerReadinessResponse{
+			Name:        state.Name,
+			Topic:       state.Topic,
+			GroupID:     state.GroupID,
+			State:       state.State,
+			Restarts:    state.Restarts,
+			LastErrorAt: state.LastErrorAt,
+			Lag:         state.Lag,
+		}
+		if state.LastError != "" {
+			lastError := state.LastError
+			res.Consumers[i].LastError = &lastError
+		}
+	}
+
+	status := http.StatusOK
+	if res.Status != readinessStatusReady {
+		status = http.StatusServiceUnavailable
+	}
+	return c.Status(status).JSON(res)
+}
+
+// SetupReadinessRoutes serves the readiness probe next to /healthcheck, without authentication.
+func SetupReadinessRoutes(router *router.FiberRouter, handler *ReadinessHandler) {
+	r := router.Router()
+
+	r.Get("/readiness", handler.Readiness)
+}
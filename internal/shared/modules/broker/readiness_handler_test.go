@@ -0,0 +1,145 @@
+package broker
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/broker/mocks"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+)
+
+type ReadinessHandlerTestSuite struct {
+	runnerFixture
+	app *fiber.App
+}
+
+func (s *ReadinessHandlerTestSuite) SetupTest() {
+	s.runnerFixture.SetupTest()
+	s.app = fiber.New()
+	s.app.Get("/readiness", NewReadinessHandler(s.registry).Readiness)
+}
+
+func TestReadinessHandlerSuite(t *testing.T) {
+	suite.Run(t, new(ReadinessHandlerTestSuite))
+}
+
+func (s *ReadinessHandlerTestSuite) readiness() (int, readinessResponse) {
+	resp, err := s.app.Test(httptest.NewRequest(http.MethodGet, "/readiness", nil))
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	var res readinessResponse
+	s.Require().NoError(json.NewDecoder(resp.Body).Decode(&res))
+	return resp.StatusCode, res
+}
+
+// otherRunner registers a second consumer of another group, running until it's stopped.
+func (s *ReadinessHandlerTestSuite) otherRunner() *ConsumerRunner {
+	processorMock := mocks.NewMockMessageProcessor(s.T())
+	processorMock.On("Topic").Return(testTopic).Maybe()
+	processorMock.On("GroupID").Return("incident").Maybe()
+	s.builderMock.On("BuildConsumer", testTopic, "incident").Return(s.healthyConsumer(0)).Once()
+
+	logger := logger.New(config.Config{Log: config.Log{LogLevel: "disabled"}})
+	return NewConsumerRunner(s.builderMock, processorMock, s.registry, logger, s.lifecycle)
+}
+
+func (s *ReadinessHandlerTestSuite) TestReadiness_NoConsumers_ReturnsReady() {
+	// Act
+	status, res := s.readiness()
+
+	// Assert
+	s.Equal(http.StatusOK, status)
+	s.Equal(readinessStatusReady, res.Status)
+	s.Empty(res.Consumers)
+}
+
+func (s *ReadinessHandlerTestSuite) TestReadiness_ConsumersNotStarted_ReturnsServiceUnavailable() {
+	// Arrange
+	s.newRunner(time.Millisecond)
+
+	// Act
+	status, res := s.readiness()
+
+	// Assert
+	s.Equal(http.StatusServiceUnavailable, status)
+	s.Equal(readinessStatusNotReady, res.Status)
+	s.Require().Len(res.Consumers, 1)
+	s.Equal(ConsumerStateStarting, res.Consumers[0].State)
+}
+
+func (s *ReadinessHandlerTestSuite) TestReadiness_AllConsumersRunning_ReturnsReady() {
+	// Arrange
+	runner := s.newRunner(time.Millisecond)
+	other := s.otherRunner()
+	s.builderMock.On("BuildConsumer", testTopic, testGroupID).Return(s.healthyConsumer(3)).Once()
+	s.start()
+	s.eventuallyInState(runner, ConsumerStateRunning)
+	s.eventuallyInState(other, ConsumerStateRunning)
+
+	// Act
+	status, res := s.readiness()
+
+	// Assert
+	s.Require().NoError(s.stop())
+	s.Equal(http.StatusOK, status)
+	s.Equal(readinessStatusReady, res.Status)
+	s.Require().Len(res.Consumers, 2)
+	s.Equal(testGroupID, res.Consumers[0].GroupID)
+	s.Require().NotNil(res.Consumers[0].Lag)
+	s.Equal(int64(3), *res.Consumers[0].Lag)
+	s.Nil(res.Consumers[0].LastError)
+}
+
+func (s *ReadinessHandlerTestSuite) TestReadiness_ConsumerDown_ReturnsServiceUnavailable() {
+	// Arrange
+	runner := s.newRunner(time.Hour)
+	other := s.otherRunner()
+	s.builderMock.On("BuildConsumer", testTopic, testGroupID).Return(s.crashingConsumer()).Once()
+	s.start()
+	s.eventuallyInState(runner, ConsumerStateRestarting)
+	s.eventuallyInState(other, ConsumerStateRunning)
+
+	// Act
+	status, res := s.readiness()
+
+	// Assert
+	s.Require().NoError(s.stop())
+	s.Equal(http.StatusServiceUnavailable, status)
+	s.Equal(readinessStatusNotReady, res.Status)
+	s.Require().Len(res.Consumers, 2)
+	s.Equal(ConsumerStateRestarting, res.Consumers[0].State)
+	s.Equal(1, res.Consumers[0].Restarts)
+	s.Require().NotNil(res.Consumers[0].LastError)
+	s.Equal(errConsumerCrashed.Error(), *res.Consumers[0].LastError)
+	s.Nil(res.Consumers[0].Lag)
+	s.Equal(ConsumerStateRunning, res.Consumers[1].State)
+}
+
+func (s *ReadinessHandlerTestSuite) TestReadiness_ConsumerRecovered_ReturnsReadyAgain() {
+	// Arrange
+	runner := s.newRunner(time.Millisecond)
+	s.builderMock.On("BuildConsumer", testTopic, testGroupID).Return(s.crashingConsumer()).Once()
+	s.builderMock.On("BuildConsumer", testTopic, testGroupID).Return(s.healthyConsumer(0)).Once()
+	s.start()
+	s.Eventually(func() bool {
+		state := runner.State()
+		return state.State == ConsumerStateRunning && state.Restarts == 1
+	}, testTimeout, testTick)
+
+	// Act
+	status, res := s.readiness()
+
+	// Assert
+	s.Require().NoError(s.stop())
+	s.Equal(http.StatusOK, status)
+	s.Require().Len(res.Consumers, 1)
+	s.Require().NotNil(res.Consumers[0].LastError)
+}
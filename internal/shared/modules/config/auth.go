@@ -0,0 +1,43 @@
+package config
+
+import "fmt"
+
+// Login methods a deployment can enable.
+const (
+	AuthLoginMethodPassword  = "password"
+	AuthLoginMethodMagicLink = "magic_link"
+	AuthLoginMethodBoth      = "both"
+)
+
+type Auth struct {
+	// LoginMethod is how users sign in, password login with the emailed code when it's not set.
+	// The magic link login emails a link that signs the user in without a password.
+	LoginMethod string `mapstructure:"AUTH_LOGIN_METHOD"`
+
+	// TOTPEncryptionKey is the base64 encoded 32 bytes AES key the TOTP secrets are encrypted with,
+	// users can't enroll an authenticator app when it's not set.
+	TOTPEncryptionKey string `mapstructure:"AUTH_TOTP_ENCRYPTION_KEY"`
+}
+
+// Validate fails on a login method other than the ones above, an empty one is the password login.
+func (a *Auth) Validate() error {
+	switch a.LoginMethod {
+	case "", AuthLoginMethodPassword, AuthLoginMethodMagicLink, AuthLoginMethodBoth:
+		return nil
+	default:
+		return fmt.Errorf(
+			"invalid AUTH_LOGIN_METHOD %q, it must be %s, %s or %s",
+			a.LoginMethod, AuthLoginMethodPassword, AuthLoginMethodMagicLink, AuthLoginMethodBoth,
+		)
+	}
+}
+
+// PasswordLoginEnabled reports whether users can sign in with their password.
+func (a *Auth) PasswordLoginEnabled() bool {
+	return a.LoginMethod == "" || a.LoginMethod == AuthLoginMethodPassword || a.LoginMethod == AuthLoginMethodBoth
+}
+
+// MagicLinkLoginEnabled reports whether users can sign in with a magic link.
+func (a *Auth) MagicLinkLoginEnabled() bool {
+	return a.LoginMethod == AuthLoginMethodMagicLink || a.LoginMethod == AuthLoginMethodBoth
+}
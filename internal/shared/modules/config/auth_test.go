@@ -0,0 +1,37 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+)
+
+func TestAuthValidate_KnownLoginMethods_ReturnsNil(t *testing.T) {
+	loginMethods := []string{
+		"",
+		config.AuthLoginMethodPassword,
+		config.AuthLoginMethodMagicLink,
+		config.AuthLoginMethodBoth,
+	}
+	for _, loginMethod := range loginMethods {
+		t.Run(loginMethod, func(t *testing.T) {
+			// Arrange
+			auth := config.Auth{LoginMethod: loginMethod}
+			// Act
+			err := auth.Validate()
+			// Assert
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAuthValidate_UnknownLoginMethod_ReturnsError(t *testing.T) {
+	// Arrange
+	auth := config.Auth{LoginMethod: "magic-link"}
+	// Act
+	err := auth.Validate()
+	// Assert
+	require.Error(t, err)
+}
@@ -0,0 +1,15 @@
+package config
+
+// Message broker backends.
+const (
+	BrokerBackendKafka    = "kafka"
+	BrokerBackendMemory   = "memory"
+	BrokerBackendRabbitMQ = "rabbitmq"
+)
+
+type Broker struct {
+	// Backend is the message broker the events go through, Kafka when it's not set.
+	// RabbitMQ is configured with the RABBITMQ_ variables. The memory backend keeps the events
+	// in process, for single-node deployments and tests.
+	Backend string `mapstructure:"BROKER_BACKEND"`
+}
@@ -12,6 +12,7 @@ type Config struct {
 	Environment   string        `mapstructure:"ENVIRONMENT"`
 	HTTPPort      uint          `mapstructure:"HTTP_PORT"`
 	CORS          CORS          `mapstructure:",squash"`
+	Auth          Auth          `mapstructure:",squash"`
 	JWT           JWT           `mapstructure:",squash"`
 	DB            DB            `mapstructure:",squash"`
 	MAIL          MAIL          `mapstructure:",squash"`
@@ -21,6 +22,11 @@ type Config struct {
 	RabbitMQ      RabbitMQ      `mapstructure:",squash"`
 	Redis         Redis         `mapstructure:",squash"`
 	Kafka         Kafka         `mapstructure:",squash"`
+	Broker        Broker        `mapstructure:",squash"`
+
+	MonitorScheduler MonitorScheduler `mapstructure:",squash"`
+	Notification     Notification     `mapstructure:",squash"`
+	Outbox           Outbox           `mapstructure:",squash"`
 }
 
 const EnvProduction = "production"
@@ -62,6 +68,12 @@ func Init() {
 		slog.Error("Failed to unmarshal config", "error", err)
 		panic(err)
 	}
+
+	if err := _global.Auth.Validate(); err != nil {
+		//nolint:sloglint // this is a module
+		slog.Error("Invalid config", "error", err)
+		panic(err)
+	}
 }
 
 func GetConfig() Config {
@@ -1,7 +1,14 @@
 package config
 
 type JWT struct {
-	PrivateKey          string `mapstructure:"JWT_PRIVATE_KEY"`
-	Issuer              string `mapstructure:"JWT_ISSUER"`
-	ExpirationInSeconds int64  `mapstructure:"JWT_EXPIRATION_IN_SECONDS"`
+	PrivateKey string `mapstructure:"JWT_PRIVATE_KEY"`
+	Issuer     string `mapstructure:"JWT_ISSUER"`
+
+	// ExpirationInSeconds is how long an access token is valid, keep it short as access tokens
+	// are renewed with the refresh token.
+	ExpirationInSeconds int64 `mapstructure:"JWT_EXPIRATION_IN_SECONDS"`
+
+	// RefreshTokenExpirationInSeconds is how long a refresh token is valid, every refresh issues
+	// a new one so a session stays open as long as it is used within this period.
+	RefreshTokenExpirationInSeconds int64 `mapstructure:"JWT_REFRESH_TOKEN_EXPIRATION_IN_SECONDS"`
 }
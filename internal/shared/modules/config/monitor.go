@@ -0,0 +1,10 @@
+package config
+
+type MonitorScheduler struct {
+	// Workers is the maximum number of checks executed concurrently.
+	Workers int `mapstructure:"MONITOR_SCHEDULER_WORKERS"`
+
+	// SyncIntervalSeconds is how often the scheduler reloads the enabled monitors
+	// to pick up monitors that were created, updated, disabled or deleted.
+	SyncIntervalSeconds int `mapstructure:"MONITOR_SCHEDULER_SYNC_INTERVAL_SECONDS"`
+}
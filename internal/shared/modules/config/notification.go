@@ -0,0 +1,16 @@
+package config
+
+type Notification struct {
+	// WebhookTimeoutSeconds is how long a webhook receiver has to answer an alert.
+	WebhookTimeoutSeconds int `mapstructure:"NOTIFICATION_WEBHOOK_TIMEOUT_SECONDS"`
+
+	// MaxAttempts is how many times a notification is sent before it's permanently failed.
+	MaxAttempts int `mapstructure:"NOTIFICATION_MAX_ATTEMPTS"`
+
+	// RetryBaseDelaySeconds is the delay before the first retry, it doubles on every
+	// following attempt.
+	RetryBaseDelaySeconds int `mapstructure:"NOTIFICATION_RETRY_BASE_DELAY_SECONDS"`
+
+	// RetryIntervalSeconds is how often notifications due for a retry are looked up.
+	RetryIntervalSeconds int `mapstructure:"NOTIFICATION_RETRY_INTERVAL_SECONDS"`
+}
@@ -0,0 +1,9 @@
+package config
+
+type Outbox struct {
+	// RelayIntervalMs is how often the relay publishes the pending outbox messages to the broker.
+	RelayIntervalMs int `mapstructure:"OUTBOX_RELAY_INTERVAL_MS"`
+
+	// RelayBatchSize is the maximum number of messages published on every relay run.
+	RelayBatchSize int `mapstructure:"OUTBOX_RELAY_BATCH_SIZE"`
+}
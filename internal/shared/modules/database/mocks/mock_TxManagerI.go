@@ -0,0 +1,83 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockTxManagerI is an autogenerated mock type for the TxManagerI type
+type MockTxManagerI struct {
+	mock.Mock
+}
+
+type MockTxManagerI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTxManagerI) EXPECT() *MockTxManagerI_Expecter {
+	return &MockTxManagerI_Expecter{mock: &_m.Mock}
+}
+
+// WithinTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockTxManagerI) WithinTransaction(ctx context.Context, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithinTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTxManagerI_WithinTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithinTransaction'
+type MockTxManagerI_WithinTransaction_Call struct {
+	*mock.Call
+}
+
+// WithinTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(context.Context) error
+func (_e *MockTxManagerI_Expecter) WithinTransaction(ctx interface{}, fn interface{}) *MockTxManagerI_WithinTransaction_Call {
+	return &MockTxManagerI_WithinTransaction_Call{Call: _e.mock.On("WithinTransaction", ctx, fn)}
+}
+
+func (_c *MockTxManagerI_WithinTransaction_Call) Run(run func(ctx context.Context, fn func(context.Context) error)) *MockTxManagerI_WithinTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(context.Context) error))
+	})
+	return _c
+}
+
+func (_c *MockTxManagerI_WithinTransaction_Call) Return(_a0 error) *MockTxManagerI_WithinTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTxManagerI_WithinTransaction_Call) RunAndReturn(run func(context.Context, func(context.Context) error) error) *MockTxManagerI_WithinTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTxManagerI creates a new instance of MockTxManagerI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTxManagerI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTxManagerI {
+	mock := &MockTxManagerI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -2,4 +2,13 @@ package database
 
 import "go.uber.org/fx"
 
-var Module = fx.Module("sdk/database", fx.Provide(New))
+var Module = fx.Module(
+	"sdk/database",
+	fx.Provide(
+		New,
+		fx.Annotate(
+			NewTxManager,
+			fx.As(new(TxManagerI)),
+		),
+	),
+)
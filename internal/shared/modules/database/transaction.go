@@ -0,0 +1,45 @@
+package database
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type txContextKey struct{}
+
+// TxManagerI runs a function in a database transaction. The repositories called with the
+// context handed to fn take part in the transaction when they query through PingoDB.Conn.
+type TxManagerI interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type TxManager struct {
+	db *PingoDB
+}
+
+var _ TxManagerI = (*TxManager)(nil)
+
+func NewTxManager(db *PingoDB) *TxManager {
+	return &TxManager{db: db}
+}
+
+// WithinTransaction commits the transaction when fn succeeds and rolls it back otherwise.
+// Called within a transaction, fn joins the outer transaction.
+func (m *TxManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txContextKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txContextKey{}, tx))
+	})
+}
+
+// Conn returns the transaction carried by ctx, or the connection pool when there's none.
+func (db *PingoDB) Conn(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value(txContextKey{}).(*gorm.DB); ok {
+		return tx
+	}
+	return db.DB.WithContext(ctx)
+}
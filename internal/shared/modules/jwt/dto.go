@@ -4,4 +4,8 @@ import "github.com/golang-jwt/jwt/v5"
 
 type Claims struct {
 	jwt.RegisteredClaims
+
+	// SessionID is the server-side session the token was issued for, revoking the session
+	// invalidates the token before it expires.
+	SessionID uint64 `json:"sid,omitempty"`
 }
@@ -3,15 +3,16 @@ package modules
 import (
 	"go.uber.org/fx"
 
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/broker"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/http"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/http/httpserver"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/jwt"
-	"github.com/cristiano-pacheco/pingo/internal/shared/modules/kafka"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/mailer"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/outbox"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/redis"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/registry"
 	"github.com/cristiano-pacheco/pingo/internal/shared/modules/translator"
@@ -32,5 +33,6 @@ var Module = fx.Module(
 	otel.Module,
 	http.Module,
 	httpserver.Module,
-	kafka.Module,
+	broker.Module,
+	outbox.Module,
 )
@@ -0,0 +1,85 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	broker "github.com/cristiano-pacheco/pingo/pkg/broker"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockPublisherI is an autogenerated mock type for the PublisherI type
+type MockPublisherI struct {
+	mock.Mock
+}
+
+type MockPublisherI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPublisherI) EXPECT() *MockPublisherI_Expecter {
+	return &MockPublisherI_Expecter{mock: &_m.Mock}
+}
+
+// Publish provides a mock function with given fields: ctx, message
+func (_m *MockPublisherI) Publish(ctx context.Context, message broker.Message) error {
+	ret := _m.Called(ctx, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, broker.Message) error); ok {
+		r0 = rf(ctx, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPublisherI_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type MockPublisherI_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - message broker.Message
+func (_e *MockPublisherI_Expecter) Publish(ctx interface{}, message interface{}) *MockPublisherI_Publish_Call {
+	return &MockPublisherI_Publish_Call{Call: _e.mock.On("Publish", ctx, message)}
+}
+
+func (_c *MockPublisherI_Publish_Call) Run(run func(ctx context.Context, message broker.Message)) *MockPublisherI_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(broker.Message))
+	})
+	return _c
+}
+
+func (_c *MockPublisherI_Publish_Call) Return(_a0 error) *MockPublisherI_Publish_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPublisherI_Publish_Call) RunAndReturn(run func(context.Context, broker.Message) error) *MockPublisherI_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPublisherI creates a new instance of MockPublisherI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPublisherI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPublisherI {
+	mock := &MockPublisherI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
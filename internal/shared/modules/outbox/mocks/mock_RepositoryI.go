@@ -0,0 +1,251 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	outbox "github.com/cristiano-pacheco/pingo/internal/shared/modules/outbox"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockRepositoryI is an autogenerated mock type for the RepositoryI type
+type MockRepositoryI struct {
+	mock.Mock
+}
+
+type MockRepositoryI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepositoryI) EXPECT() *MockRepositoryI_Expecter {
+	return &MockRepositoryI_Expecter{mock: &_m.Mock}
+}
+
+// ClaimDue provides a mock function with given fields: ctx, now, leaseUntil, limit
+func (_m *MockRepositoryI) ClaimDue(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]outbox.MessageModel, error) {
+	ret := _m.Called(ctx, now, leaseUntil, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDue")
+	}
+
+	var r0 []outbox.MessageModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) ([]outbox.MessageModel, error)); ok {
+		return rf(ctx, now, leaseUntil, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) []outbox.MessageModel); ok {
+		r0 = rf(ctx, now, leaseUntil, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]outbox.MessageModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, int) error); ok {
+		r1 = rf(ctx, now, leaseUntil, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepositoryI_ClaimDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDue'
+type MockRepositoryI_ClaimDue_Call struct {
+	*mock.Call
+}
+
+// ClaimDue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - leaseUntil time.Time
+//   - limit int
+func (_e *MockRepositoryI_Expecter) ClaimDue(ctx interface{}, now interface{}, leaseUntil interface{}, limit interface{}) *MockRepositoryI_ClaimDue_Call {
+	return &MockRepositoryI_ClaimDue_Call{Call: _e.mock.On("ClaimDue", ctx, now, leaseUntil, limit)}
+}
+
+func (_c *MockRepositoryI_ClaimDue_Call) Run(run func(ctx context.Context, now time.Time, leaseUntil time.Time, limit int)) *MockRepositoryI_ClaimDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepositoryI_ClaimDue_Call) Return(_a0 []outbox.MessageModel, _a1 error) *MockRepositoryI_ClaimDue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepositoryI_ClaimDue_Call) RunAndReturn(run func(context.Context, time.Time, time.Time, int) ([]outbox.MessageModel, error)) *MockRepositoryI_ClaimDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, message
+func (_m *MockRepositoryI) Create(ctx context.Context, message outbox.MessageModel) (outbox.MessageModel, error) {
+	ret := _m.Called(ctx, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 outbox.MessageModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, outbox.MessageModel) (outbox.MessageModel, error)); ok {
+		return rf(ctx, message)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, outbox.MessageModel) outbox.MessageModel); ok {
+		r0 = rf(ctx, message)
+	} else {
+		r0 = ret.Get(0).(outbox.MessageModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, outbox.MessageModel) error); ok {
+		r1 = rf(ctx, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepositoryI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockRepositoryI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - message outbox.MessageModel
+func (_e *MockRepositoryI_Expecter) Create(ctx interface{}, message interface{}) *MockRepositoryI_Create_Call {
+	return &MockRepositoryI_Create_Call{Call: _e.mock.On("Create", ctx, message)}
+}
+
+func (_c *MockRepositoryI_Create_Call) Run(run func(ctx context.Context, message outbox.MessageModel)) *MockRepositoryI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(outbox.MessageModel))
+	})
+	return _c
+}
+
+func (_c *MockRepositoryI_Create_Call) Return(_a0 outbox.MessageModel, _a1 error) *MockRepositoryI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepositoryI_Create_Call) RunAndReturn(run func(context.Context, outbox.MessageModel) (outbox.MessageModel, error)) *MockRepositoryI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, messageID
+func (_m *MockRepositoryI) Delete(ctx context.Context, messageID uint64) error {
+	ret := _m.Called(ctx, messageID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = rf(ctx, messageID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepositoryI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockRepositoryI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - messageID uint64
+func (_e *MockRepositoryI_Expecter) Delete(ctx interface{}, messageID interface{}) *MockRepositoryI_Delete_Call {
+	return &MockRepositoryI_Delete_Call{Call: _e.mock.On("Delete", ctx, messageID)}
+}
+
+func (_c *MockRepositoryI_Delete_Call) Run(run func(ctx context.Context, messageID uint64)) *MockRepositoryI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockRepositoryI_Delete_Call) Return(_a0 error) *MockRepositoryI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepositoryI_Delete_Call) RunAndReturn(run func(context.Context, uint64) error) *MockRepositoryI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkFailed provides a mock function with given fields: ctx, message
+func (_m *MockRepositoryI) MarkFailed(ctx context.Context, message outbox.MessageModel) error {
+	ret := _m.Called(ctx, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkFailed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, outbox.MessageModel) error); ok {
+		r0 = rf(ctx, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepositoryI_MarkFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkFailed'
+type MockRepositoryI_MarkFailed_Call struct {
+	*mock.Call
+}
+
+// MarkFailed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - message outbox.MessageModel
+func (_e *MockRepositoryI_Expecter) MarkFailed(ctx interface{}, message interface{}) *MockRepositoryI_MarkFailed_Call {
+	return &MockRepositoryI_MarkFailed_Call{Call: _e.mock.On("MarkFailed", ctx, message)}
+}
+
+func (_c *MockRepositoryI_MarkFailed_Call) Run(run func(ctx context.Context, message outbox.MessageModel)) *MockRepositoryI_MarkFailed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(outbox.MessageModel))
+	})
+	return _c
+}
+
+func (_c *MockRepositoryI_MarkFailed_Call) Return(_a0 error) *MockRepositoryI_MarkFailed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepositoryI_MarkFailed_Call) RunAndReturn(run func(context.Context, outbox.MessageModel) error) *MockRepositoryI_MarkFailed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRepositoryI creates a new instance of MockRepositoryI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepositoryI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepositoryI {
+	mock := &MockRepositoryI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,25 @@
+package outbox
+
+import (
+	"database/sql"
+	"time"
+)
+
+// MessageModel is a message waiting in the outbox to be published to the broker.
+// Headers holds the JSON encoded broker.Header list of the message.
+type MessageModel struct {
+	ID            uint64         `gorm:"primarykey"`
+	Topic         string         `gorm:"column:topic"`
+	MessageKey    []byte         `gorm:"column:message_key"`
+	Payload       []byte         `gorm:"column:payload"`
+	Headers       []byte         `gorm:"column:headers"`
+	AttemptCount  int            `gorm:"column:attempt_count"`
+	NextAttemptAt time.Time      `gorm:"column:next_attempt_at"`
+	LastError     sql.NullString `gorm:"column:last_error"`
+	CreatedAt     time.Time      `gorm:"column:created_at"`
+	UpdatedAt     time.Time      `gorm:"column:updated_at"`
+}
+
+func (*MessageModel) TableName() string {
+	return "outbox"
+}
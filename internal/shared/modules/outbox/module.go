@@ -0,0 +1,18 @@
+package outbox
+
+import "go.uber.org/fx"
+
+var Module = fx.Module(
+	"outbox",
+	fx.Provide(
+		fx.Annotate(
+			NewRepository,
+			fx.As(new(RepositoryI)),
+		),
+		fx.Annotate(
+			NewPublisher,
+			fx.As(new(PublisherI)),
+		),
+	),
+	fx.Invoke(NewRelay),
+)
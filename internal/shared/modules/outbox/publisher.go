@@ -0,0 +1,55 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+)
+
+// PublisherI publishes messages through the outbox. Published within a transaction, a message
+// is only relayed to the broker once the transaction commits and it's dropped on rollback.
+type PublisherI interface {
+	Publish(ctx context.Context, message broker.Message) error
+}
+
+type Publisher struct {
+	outboxRepository RepositoryI
+}
+
+var _ PublisherI = (*Publisher)(nil)
+
+func NewPublisher(outboxRepository RepositoryI) *Publisher {
+	return &Publisher{outboxRepository: outboxRepository}
+}
+
+// Publish stores the message in the outbox with the trace context of ctx, it's sent to message.Topic
+// by the Relay.
+func (p *Publisher) Publish(ctx context.Context, message broker.Message) error {
+	ctx, span := trace.Span(ctx, "OutboxPublisher.Publish")
+	defer span.End()
+
+	// the relay publishes the message outside of this request, it continues the trace from the headers
+	broker.InjectTraceContext(ctx, &message)
+
+	headers := message.Headers
+	if headers == nil {
+		headers = []broker.Header{}
+	}
+	encodedHeaders, err := json.Marshal(headers)
+	if err != nil {
+		return err
+	}
+
+	_, err = p.outboxRepository.Create(ctx, MessageModel{
+		Topic:      message.Topic,
+		MessageKey: message.Key,
+		Payload:    message.Value,
+		Headers:    encodedHeaders,
+		// due right away, the relay can't see it before the transaction commits anyway
+		NextAttemptAt: time.Now().UTC(),
+	})
+	return err
+}
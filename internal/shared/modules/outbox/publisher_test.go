@@ -0,0 +1,89 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/outbox"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/outbox/mocks"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+)
+
+type PublisherTestSuite struct {
+	suite.Suite
+	repositoryMock *mocks.MockRepositoryI
+	sut            *outbox.Publisher
+}
+
+func (s *PublisherTestSuite) SetupTest() {
+	s.repositoryMock = mocks.NewMockRepositoryI(s.T())
+	s.sut = outbox.NewPublisher(s.repositoryMock)
+}
+
+func TestPublisherSuite(t *testing.T) {
+	suite.Run(t, new(PublisherTestSuite))
+}
+
+func (s *PublisherTestSuite) TestPublish_Message_StoresItDueRightAway() {
+	// Arrange
+	message := broker.Message{
+		Topic:   "monitor.down",
+		Key:     []byte("42"),
+		Value:   []byte(`{"monitor_id":42}`),
+		Headers: []broker.Header{{Key: "x-event-version", Value: []byte("1")}},
+	}
+	var stored outbox.MessageModel
+	s.repositoryMock.On("Create", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			stored = args.Get(1).(outbox.MessageModel)
+		}).
+		Return(outbox.MessageModel{ID: 1}, nil)
+	before := time.Now().UTC()
+
+	// Act
+	err := s.sut.Publish(context.Background(), message)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal("monitor.down", stored.Topic)
+	s.Equal([]byte("42"), stored.MessageKey)
+	s.Equal([]byte(`{"monitor_id":42}`), stored.Payload)
+	s.JSONEq(`[{"Key":"x-event-version","Value":"MQ=="}]`, string(stored.Headers))
+	s.Zero(stored.AttemptCount)
+	s.False(stored.NextAttemptAt.Before(before))
+	s.False(stored.NextAttemptAt.After(time.Now().UTC()))
+}
+
+func (s *PublisherTestSuite) TestPublish_NoHeaders_StoresEmptyList() {
+	// Arrange
+	var stored outbox.MessageModel
+	s.repositoryMock.On("Create", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			stored = args.Get(1).(outbox.MessageModel)
+		}).
+		Return(outbox.MessageModel{ID: 1}, nil)
+
+	// Act
+	err := s.sut.Publish(context.Background(), broker.Message{Topic: "monitor.down", Value: []byte("{}")})
+
+	// Assert
+	s.Require().NoError(err)
+	s.JSONEq(`[]`, string(stored.Headers))
+}
+
+func (s *PublisherTestSuite) TestPublish_RepositoryFails_ReturnsError() {
+	// Arrange
+	repositoryErr := errors.New("database unavailable")
+	s.repositoryMock.On("Create", mock.Anything, mock.Anything).Return(outbox.MessageModel{}, repositoryErr)
+
+	// Act
+	err := s.sut.Publish(context.Background(), broker.Message{Topic: "monitor.down", Value: []byte("{}")})
+
+	// Assert
+	s.Require().ErrorIs(err, repositoryErr)
+}
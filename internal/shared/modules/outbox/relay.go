@@ -0,0 +1,207 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"go.uber.org/fx"
+)
+
+const (
+	defaultRelayInterval  = time.Second
+	defaultRelayBatchSize = 100
+	// relayLease keeps a claimed message away from other replicas while it's being published,
+	// it must outlast publishing a whole batch.
+	relayLease        = time.Minute
+	initialRetryDelay = time.Second
+	maxRetryDelay     = 5 * time.Minute
+)
+
+// Relay publishes the outbox messages to the broker. A message is deleted once the broker acknowledged it,
+// so it's published at least once: a replica dying between the two publishes it again.
+// A message that fails to be published is retried with an exponential backoff until it succeeds.
+type Relay struct {
+	outboxRepository RepositoryI
+	builder          broker.Builder
+	logger           logger.Logger
+	interval         time.Duration
+	batchSize        int
+	stopped          chan struct{}
+
+	// producers is owned by the Run loop
+	producers map[string]broker.Producer
+}
+
+// NewRelay creates a Relay that automatically starts/stops with the Fx lifecycle.
+func NewRelay(
+	outboxRepository RepositoryI,
+	builder broker.Builder,
+	cfg config.Config,
+	logger logger.Logger,
+	lc fx.Lifecycle,
+) *Relay {
+	interval := defaultRelayInterval
+	if cfg.Outbox.RelayIntervalMs > 0 {
+		interval = time.Duration(cfg.Outbox.RelayIntervalMs) * time.Millisecond
+	}
+
+	batchSize := cfg.Outbox.RelayBatchSize
+	if batchSize <= 0 {
+		batchSize = defaultRelayBatchSize
+	}
+
+	r := &Relay{
+		outboxRepository: outboxRepository,
+		builder:          builder,
+		logger:           logger,
+		interval:         interval,
+		batchSize:        batchSize,
+		stopped:          make(chan struct{}),
+		producers:        make(map[string]broker.Producer),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			go func() {
+				logger.Info().Msgf("Starting outbox relay every %s...", r.interval)
+
+				if err := r.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+					logger.Error().Msgf("outbox relay stopped with error: %v", err)
+					return
+				}
+				logger.Info().Msg("outbox relay stopped gracefully")
+			}()
+
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-r.stopped:
+				return nil
+			case <-stopCtx.Done():
+				return stopCtx.Err()
+			}
+		},
+	})
+
+	return r
+}
+
+// Run relays the due messages on every tick until the context is canceled.
+// A batch already being published when the context is canceled is allowed to finish.
+func (r *Relay) Run(ctx context.Context) error {
+	defer close(r.stopped)
+	defer r.closeProducers()
+
+	relayCtx := context.WithoutCancel(ctx)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := r.relay(relayCtx); err != nil {
+				r.logger.Error().Msgf("error relaying outbox messages: %v", err)
+			}
+		}
+	}
+}
+
+func (r *Relay) relay(ctx context.Context) error {
+	ctx, span := trace.Span(ctx, "OutboxRelay.relay")
+	defer span.End()
+
+	now := time.Now().UTC()
+	messages, err := r.outboxRepository.ClaimDue(ctx, now, now.Add(relayLease), r.batchSize)
+	if err != nil {
+		return err
+	}
+
+	var relayErrs []error
+	for _, message := range messages {
+		if err = r.publish(ctx, message); err != nil {
+			relayErrs = append(relayErrs, err)
+		}
+	}
+
+	return errors.Join(relayErrs...)
+}
+
+func (r *Relay) publish(ctx context.Context, message MessageModel) error {
+	var headers []broker.Header
+	err := json.Unmarshal(message.Headers, &headers)
+	if err == nil {
+		brokerMessage := broker.Message{
+			Key:     message.MessageKey,
+			Value:   message.Payload,
+			Headers: headers,
+		}
+
+		// the message is produced in the trace of the request that published it
+		produceCtx, span := trace.Span(
+			broker.ExtractTraceContext(ctx, brokerMessage),
+			"OutboxRelay.publish",
+			oteltrace.WithSpanKind(oteltrace.SpanKindProducer),
+			oteltrace.WithLinks(oteltrace.LinkFromContext(ctx)),
+		)
+		err = r.producer(message.Topic).Produce(produceCtx, brokerMessage)
+		span.End()
+	}
+
+	if err != nil {
+		r.logger.Error().Msgf(
+			"error publishing outbox message ID %d to %s (attempt %d): %v",
+			message.ID,
+			message.Topic,
+			message.AttemptCount+1,
+			err,
+		)
+
+		message.AttemptCount++
+		message.NextAttemptAt = time.Now().UTC().Add(r.backoff(message.AttemptCount))
+		message.LastError = sql.NullString{String: err.Error(), Valid: true}
+		return r.outboxRepository.MarkFailed(ctx, message)
+	}
+
+	return r.outboxRepository.Delete(ctx, message.ID)
+}
+
+func (r *Relay) producer(topic string) broker.Producer {
+	producer, ok := r.producers[topic]
+	if !ok {
+		producer = r.builder.BuildProducer(topic)
+		r.producers[topic] = producer
+	}
+	return producer
+}
+
+func (r *Relay) closeProducers() {
+	for topic, producer := range r.producers {
+		if err := producer.Close(); err != nil {
+			r.logger.Error().Msgf("error closing the %s producer: %v", topic, err)
+		}
+	}
+}
+
+// backoff doubles the initial delay for every failed attempt, up to maxRetryDelay.
+func (r *Relay) backoff(attempt int) time.Duration {
+	delay := initialRetryDelay
+	for i := 1; i < attempt && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
@@ -0,0 +1,190 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/fx"
+
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/outbox"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/outbox/mocks"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+	brokermocks "github.com/cristiano-pacheco/pingo/pkg/broker/mocks"
+)
+
+const (
+	testTopic   = "monitor.down"
+	testTimeout = 5 * time.Second
+)
+
+// testLifecycle collects the hooks of the relay so the tests start and stop it.
+type testLifecycle struct {
+	hooks []fx.Hook
+}
+
+func (l *testLifecycle) Append(hook fx.Hook) {
+	l.hooks = append(l.hooks, hook)
+}
+
+type RelayTestSuite struct {
+	suite.Suite
+	repositoryMock *mocks.MockRepositoryI
+	lifecycle      *testLifecycle
+	cfg            config.Config
+	logger         logger.Logger
+	message        outbox.MessageModel
+}
+
+func (s *RelayTestSuite) SetupTest() {
+	s.repositoryMock = mocks.NewMockRepositoryI(s.T())
+	s.lifecycle = &testLifecycle{}
+	s.cfg = config.Config{
+		Outbox: config.Outbox{RelayIntervalMs: 5, RelayBatchSize: 10},
+		Log:    config.Log{LogLevel: "disabled"},
+	}
+	s.logger = logger.New(s.cfg)
+	s.message = outbox.MessageModel{
+		ID:         7,
+		Topic:      testTopic,
+		MessageKey: []byte("42"),
+		Payload:    []byte(`{"monitor_id":42}`),
+		Headers:    []byte(`[{"Key":"x-event-version","Value":"MQ=="}]`),
+	}
+}
+
+func TestRelaySuite(t *testing.T) {
+	suite.Run(t, new(RelayTestSuite))
+}
+
+// claimOnce hands the message to the first relay run, the following runs find nothing due.
+func (s *RelayTestSuite) claimOnce(message outbox.MessageModel) {
+	s.repositoryMock.On("ClaimDue", mock.Anything, mock.Anything, mock.Anything, 10).
+		Return([]outbox.MessageModel{message}, nil).Once()
+	s.repositoryMock.On("ClaimDue", mock.Anything, mock.Anything, mock.Anything, 10).
+		Return([]outbox.MessageModel{}, nil).Maybe()
+}
+
+// run relays until done is closed, then stops the relay.
+func (s *RelayTestSuite) run(builder broker.Builder, done <-chan struct{}) {
+	outbox.NewRelay(s.repositoryMock, builder, s.cfg, s.logger, s.lifecycle)
+	s.Require().Len(s.lifecycle.hooks, 1)
+	s.Require().NoError(s.lifecycle.hooks[0].OnStart(context.Background()))
+
+	select {
+	case <-done:
+	case <-time.After(testTimeout):
+		s.Fail("the relay never handled the message")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+	s.Require().NoError(s.lifecycle.hooks[0].OnStop(ctx))
+}
+
+func (s *RelayTestSuite) TestRelay_DueMessage_PublishedAndDeleted() {
+	// Arrange
+	builder := broker.NewMemoryBuilder()
+	consumer := builder.BuildConsumer(testTopic, "notification")
+	s.claimOnce(s.message)
+	deleted := make(chan struct{})
+	s.repositoryMock.On("Delete", mock.Anything, uint64(7)).
+		Run(func(mock.Arguments) { close(deleted) }).
+		Return(nil).Once()
+
+	// Act
+	s.run(builder, deleted)
+
+	// Assert
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+	var published broker.Message
+	err := consumer.Consume(ctx, func(_ context.Context, message broker.Message) error {
+		published = message
+		cancel()
+		return nil
+	})
+	s.Require().ErrorIs(err, context.Canceled)
+	s.Equal([]byte("42"), published.Key)
+	s.Equal([]byte(`{"monitor_id":42}`), published.Value)
+	s.Contains(published.Headers, broker.Header{Key: "x-event-version", Value: []byte("1")})
+}
+
+func (s *RelayTestSuite) TestRelay_BrokerFails_MessageKeptForRetry() {
+	// Arrange
+	brokerErr := errors.New("leader not available")
+	producerMock := brokermocks.NewMockProducer(s.T())
+	producerMock.On("Produce", mock.Anything, mock.Anything).Return(brokerErr).Once()
+	producerMock.On("Close").Return(nil).Once()
+	builderMock := brokermocks.NewMockBuilder(s.T())
+	builderMock.On("BuildProducer", testTopic).Return(producerMock).Once()
+
+	s.message.AttemptCount = 2
+	s.claimOnce(s.message)
+	var failed outbox.MessageModel
+	markedFailed := make(chan struct{})
+	s.repositoryMock.On("MarkFailed", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			failed = args.Get(1).(outbox.MessageModel)
+			close(markedFailed)
+		}).
+		Return(nil).Once()
+	before := time.Now().UTC()
+
+	// Act
+	s.run(builderMock, markedFailed)
+
+	// Assert
+	s.repositoryMock.AssertNotCalled(s.T(), "Delete", mock.Anything, mock.Anything)
+	s.Equal(uint64(7), failed.ID)
+	s.Equal(3, failed.AttemptCount)
+	s.Equal(brokerErr.Error(), failed.LastError.String)
+	s.True(failed.LastError.Valid)
+	// the third failed attempt waits 4 times the initial delay
+	s.WithinDuration(before.Add(4*time.Second), failed.NextAttemptAt, time.Second)
+}
+
+func (s *RelayTestSuite) TestRelay_MalformedHeaders_MessageKeptForRetry() {
+	// Arrange
+	builderMock := brokermocks.NewMockBuilder(s.T())
+	s.message.Headers = []byte(`{"not":"a list"}`)
+	s.claimOnce(s.message)
+	var failed outbox.MessageModel
+	markedFailed := make(chan struct{})
+	s.repositoryMock.On("MarkFailed", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			failed = args.Get(1).(outbox.MessageModel)
+			close(markedFailed)
+		}).
+		Return(nil).Once()
+
+	// Act
+	s.run(builderMock, markedFailed)
+
+	// Assert
+	builderMock.AssertNotCalled(s.T(), "BuildProducer", mock.Anything)
+	s.Equal(1, failed.AttemptCount)
+	s.True(failed.LastError.Valid)
+}
+
+func (s *RelayTestSuite) TestRelay_ClaimFails_KeepsRelaying() {
+	// Arrange
+	s.repositoryMock.On("ClaimDue", mock.Anything, mock.Anything, mock.Anything, 10).
+		Return(nil, errors.New("database unavailable")).Once()
+	s.claimOnce(s.message)
+	deleted := make(chan struct{})
+	s.repositoryMock.On("Delete", mock.Anything, uint64(7)).
+		Run(func(mock.Arguments) { close(deleted) }).
+		Return(nil).Once()
+
+	// Act
+	s.run(broker.NewMemoryBuilder(), deleted)
+
+	// Assert
+	s.repositoryMock.AssertNumberOfCalls(s.T(), "Delete", 1)
+}
@@ -0,0 +1,109 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/cristiano-pacheco/go-otel/trace"
+	"github.com/cristiano-pacheco/pingo/internal/shared/errs"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+	"gorm.io/gorm"
+)
+
+// claimDueQuery locks the due rows it claims and skips the ones locked by another replica.
+const claimDueQuery = `
+UPDATE outbox
+SET next_attempt_at = @lease_until, updated_at = NOW()
+WHERE id IN (
+	SELECT id
+	FROM outbox
+	WHERE next_attempt_at <= @now
+	ORDER BY id
+	LIMIT @limit
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING *`
+
+type RepositoryI interface {
+	Create(ctx context.Context, message MessageModel) (MessageModel, error)
+	ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]MessageModel, error)
+	Delete(ctx context.Context, messageID uint64) error
+	MarkFailed(ctx context.Context, message MessageModel) error
+}
+
+type Repository struct {
+	*database.PingoDB
+}
+
+var _ RepositoryI = (*Repository)(nil)
+
+func NewRepository(db *database.PingoDB) *Repository {
+	return &Repository{db}
+}
+
+// Create stores the message within the transaction carried by ctx, if any.
+func (r *Repository) Create(ctx context.Context, message MessageModel) (MessageModel, error) {
+	ctx, otelSpan := trace.Span(ctx, "OutboxRepository.Create")
+	defer otelSpan.End()
+
+	err := gorm.G[MessageModel](r.Conn(ctx)).Create(ctx, &message)
+	return message, err
+}
+
+// ClaimDue takes up to limit messages due for publishing, oldest first, and pushes their next
+// attempt to leaseUntil, so no other replica publishes them meanwhile. The messages of a replica
+// that dies mid-publishing become due again once the lease expires.
+func (r *Repository) ClaimDue(
+	ctx context.Context,
+	now, leaseUntil time.Time,
+	limit int,
+) ([]MessageModel, error) {
+	ctx, otelSpan := trace.Span(ctx, "OutboxRepository.ClaimDue")
+	defer otelSpan.End()
+
+	var messages []MessageModel
+	err := r.DB.WithContext(ctx).
+		Raw(claimDueQuery, map[string]any{
+			"now":         now,
+			"lease_until": leaseUntil,
+			"limit":       limit,
+		}).
+		Scan(&messages).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+// Delete removes a published message.
+func (r *Repository) Delete(ctx context.Context, messageID uint64) error {
+	ctx, otelSpan := trace.Span(ctx, "OutboxRepository.Delete")
+	defer otelSpan.End()
+
+	_, err := gorm.G[MessageModel](r.DB).Where("id = ?", messageID).Delete(ctx)
+	return err
+}
+
+// MarkFailed stores the outcome of a failed publishing attempt.
+func (r *Repository) MarkFailed(ctx context.Context, message MessageModel) error {
+	ctx, otelSpan := trace.Span(ctx, "OutboxRepository.MarkFailed")
+	defer otelSpan.End()
+
+	result := r.DB.WithContext(ctx).
+		Model(&MessageModel{}).
+		Where("id = ?", message.ID).
+		Updates(map[string]any{
+			"attempt_count":   message.AttemptCount,
+			"next_attempt_at": message.NextAttemptAt,
+			"last_error":      message.LastError,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errs.ErrRecordNotFound
+	}
+
+	return nil
+}
@@ -1,17 +1,48 @@
 package request
 
 import (
+	"context"
 	"net/http"
 )
 
 type contextKey string
 
-const UserIDKey contextKey = "user_id"
+const (
+	UserIDKey         contextKey = "user_id"
+	OrganizationIDKey contextKey = "organization_id"
+	SessionIDKey      contextKey = "session_id"
+)
 
 func GetUserID(r *http.Request) uint64 {
-	userID, ok := r.Context().Value(UserIDKey).(uint64)
-	if !ok {
-		return 0
-	}
+	userID, _ := UserIDFromContext(r.Context())
 	return userID
 }
+
+// UserIDFromContext returns the ID of the authenticated user, set by the auth middleware.
+func UserIDFromContext(ctx context.Context) (uint64, bool) {
+	userID, ok := ctx.Value(UserIDKey).(uint64)
+	if !ok || userID == 0 {
+		return 0, false
+	}
+	return userID, true
+}
+
+// OrganizationIDFromContext returns the ID of the organization the request is made in, set by the
+// organization middleware. Requests made outside of an organization have none.
+func OrganizationIDFromContext(ctx context.Context) (uint64, bool) {
+	organizationID, ok := ctx.Value(OrganizationIDKey).(uint64)
+	if !ok || organizationID == 0 {
+		return 0, false
+	}
+	return organizationID, true
+}
+
+// SessionIDFromContext returns the ID of the session the request is authenticated with, set by the
+// auth middleware. Requests authenticated with an API key have none.
+func SessionIDFromContext(ctx context.Context) (uint64, bool) {
+	sessionID, ok := ctx.Value(SessionIDKey).(uint64)
+	if !ok || sessionID == 0 {
+		return 0, false
+	}
+	return sessionID, true
+}
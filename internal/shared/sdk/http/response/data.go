@@ -8,3 +8,17 @@ func NewEnvelope[T any](data T) Envelope {
 		"data": data,
 	}
 }
+
+type Pagination struct {
+	Page     int   `json:"page"`
+	PageSize int   `json:"page_size"`
+	Total    int64 `json:"total"`
+}
+
+// NewPaginatedEnvelope creates a new Envelope instance with the provided page of values.
+func NewPaginatedEnvelope[T any](data T, pagination Pagination) Envelope {
+	return Envelope{
+		"data":       data,
+		"pagination": pagination,
+	}
+}
@@ -12,7 +12,7 @@ import (
 // @securityDefinitions.apikey BearerAuth
 // @in header
 // @name Authorization
-// @description Enter your bearer token in the format **Bearer <token>**
+// @description Enter your bearer token or API key in the format **Bearer <token>**
 
 // @BasePath  /
 func main() {
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_http_monitor_checks_monitor_checked_at;
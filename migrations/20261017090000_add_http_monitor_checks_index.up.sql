@@ -0,0 +1,4 @@
+-- Check history lookup index
+-- This covers: WHERE http_monitor_id = ? AND checked_at BETWEEN ? AND ? ORDER BY checked_at DESC
+CREATE INDEX IF NOT EXISTS idx_http_monitor_checks_monitor_checked_at
+    ON http_monitor_checks (http_monitor_id, checked_at DESC);
@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS incidents (
+    id BIGSERIAL PRIMARY KEY,
+    http_monitor_id BIGINT NOT NULL,
+    first_failed_check_id BIGINT NULL,
+    recovery_check_id BIGINT NULL,
+    cause VARCHAR(50) NOT NULL, -- 'timeout', 'request_error', 'unexpected_status'
+    status_code INTEGER NULL,
+    error_message TEXT NULL,
+    started_at TIMESTAMP NOT NULL,
+    resolved_at TIMESTAMP NULL,
+    duration_seconds BIGINT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_incident_monitor FOREIGN KEY (http_monitor_id) REFERENCES http_monitors(id) ON DELETE CASCADE,
+    CONSTRAINT fk_incident_first_failed_check FOREIGN KEY (first_failed_check_id)
+        REFERENCES http_monitor_checks(id) ON DELETE SET NULL,
+    CONSTRAINT fk_incident_recovery_check FOREIGN KEY (recovery_check_id)
+        REFERENCES http_monitor_checks(id) ON DELETE SET NULL
+);
+
+-- A monitor has at most one open incident
+-- This covers: WHERE http_monitor_id = ? AND resolved_at IS NULL
+CREATE UNIQUE INDEX IF NOT EXISTS idx_incidents_open_monitor ON incidents (http_monitor_id) WHERE resolved_at IS NULL;
+
+-- Incident history index
+-- This covers: WHERE http_monitor_id = ? ORDER BY started_at DESC
+CREATE INDEX IF NOT EXISTS idx_incidents_monitor_started_at ON incidents (http_monitor_id, started_at DESC);
+
+-- Global incidents feed index
+-- This covers: ORDER BY started_at DESC
+CREATE INDEX IF NOT EXISTS idx_incidents_started_at ON incidents (started_at DESC);
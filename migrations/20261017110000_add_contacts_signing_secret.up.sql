@@ -0,0 +1,3 @@
+ALTER TABLE contacts ADD COLUMN IF NOT EXISTS signing_secret VARCHAR(255);
+
+-- Existing webhook contacts are given a secret by 20261017200000_sign_existing_webhook_contacts
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_notifications_next_attempt;
+
+ALTER TABLE notifications
+    DROP CONSTRAINT IF EXISTS fk_notification_check,
+    DROP CONSTRAINT IF EXISTS fk_notification_incident,
+    DROP COLUMN IF EXISTS next_attempt_at,
+    DROP COLUMN IF EXISTS attempt_count,
+    DROP COLUMN IF EXISTS http_monitor_check_id,
+    DROP COLUMN IF EXISTS incident_id;
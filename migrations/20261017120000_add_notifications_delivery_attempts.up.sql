@@ -0,0 +1,13 @@
+ALTER TABLE notifications
+    ADD COLUMN IF NOT EXISTS incident_id BIGINT NULL,
+    ADD COLUMN IF NOT EXISTS http_monitor_check_id BIGINT NULL,
+    ADD COLUMN IF NOT EXISTS attempt_count INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMP NULL,
+    ADD CONSTRAINT fk_notification_incident FOREIGN KEY (incident_id) REFERENCES incidents(id) ON DELETE SET NULL,
+    ADD CONSTRAINT fk_notification_check FOREIGN KEY (http_monitor_check_id) REFERENCES http_monitor_checks(id) ON DELETE SET NULL;
+
+-- Retry queue lookup
+-- This covers: WHERE next_attempt_at <= ? ORDER BY next_attempt_at
+CREATE INDEX IF NOT EXISTS idx_notifications_next_attempt
+    ON notifications (next_attempt_at)
+    WHERE next_attempt_at IS NOT NULL;
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_http_monitors_user_id;
+DROP INDEX IF EXISTS idx_contacts_user_id;
+
+ALTER TABLE http_monitors DROP CONSTRAINT IF EXISTS fk_monitor_user;
+ALTER TABLE contacts DROP CONSTRAINT IF EXISTS fk_contact_user;
+
+ALTER TABLE http_monitors DROP COLUMN IF EXISTS user_id;
+ALTER TABLE contacts DROP COLUMN IF EXISTS user_id;
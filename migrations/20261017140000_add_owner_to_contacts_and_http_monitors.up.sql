@@ -0,0 +1,49 @@
+ALTER TABLE contacts ADD COLUMN IF NOT EXISTS user_id BIGINT NULL;
+ALTER TABLE http_monitors ADD COLUMN IF NOT EXISTS user_id BIGINT NULL;
+
+-- Contacts and monitors created before they had an owner are given to the oldest active user, or
+-- the oldest user when none is active, so a single user install keeps its data. When there is no
+-- user at all they are given to a suspended placeholder user nobody can sign in as, instead of
+-- being deleted. Every reassignment is reported with a NOTICE, an operator can move the rows to
+-- another owner afterwards with UPDATE contacts/http_monitors SET user_id = ...
+DO $$
+DECLARE
+    orphaned_contacts BIGINT;
+    orphaned_monitors BIGINT;
+    owner_id BIGINT;
+BEGIN
+    SELECT COUNT(*) INTO orphaned_contacts FROM contacts WHERE user_id IS NULL;
+    SELECT COUNT(*) INTO orphaned_monitors FROM http_monitors WHERE user_id IS NULL;
+
+    IF orphaned_contacts = 0 AND orphaned_monitors = 0 THEN
+        RETURN;
+    END IF;
+
+    SELECT id INTO owner_id FROM users ORDER BY (status = 'active') DESC, id LIMIT 1;
+
+    IF owner_id IS NULL THEN
+        INSERT INTO users (email, first_name, last_name, status, password_hash)
+        VALUES ('legacy-owner@pingo.invalid', 'Legacy', 'Owner', 'suspended', '')
+        RETURNING id INTO owner_id;
+    END IF;
+
+    UPDATE contacts SET user_id = owner_id WHERE user_id IS NULL;
+    UPDATE http_monitors SET user_id = owner_id WHERE user_id IS NULL;
+
+    RAISE NOTICE '% contacts and % http monitors without an owner were given to user %',
+        orphaned_contacts, orphaned_monitors, owner_id;
+END
+$$;
+
+ALTER TABLE contacts
+    ALTER COLUMN user_id SET NOT NULL,
+    ADD CONSTRAINT fk_contact_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+
+ALTER TABLE http_monitors
+    ALTER COLUMN user_id SET NOT NULL,
+    ADD CONSTRAINT fk_monitor_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+
+-- Owner lookup indexes
+-- This covers: WHERE user_id = ? ORDER BY id
+CREATE INDEX IF NOT EXISTS idx_contacts_user_id ON contacts (user_id, id);
+CREATE INDEX IF NOT EXISTS idx_http_monitors_user_id ON http_monitors (user_id, id);
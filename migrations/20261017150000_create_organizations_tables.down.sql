@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS idx_http_monitors_organization_id;
+DROP INDEX IF EXISTS idx_contacts_organization_id;
+
+-- The resources shared in an organization go back to the user that created them
+ALTER TABLE http_monitors DROP CONSTRAINT IF EXISTS fk_monitor_organization;
+ALTER TABLE contacts DROP CONSTRAINT IF EXISTS fk_contact_organization;
+
+ALTER TABLE http_monitors DROP COLUMN IF EXISTS organization_id;
+ALTER TABLE contacts DROP COLUMN IF EXISTS organization_id;
+
+DROP TABLE IF EXISTS organization_invitations;
+DROP TABLE IF EXISTS organization_members;
+DROP TABLE IF EXISTS organizations;
@@ -0,0 +1,50 @@
+CREATE TABLE organizations (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE organization_members (
+    organization_id BIGINT NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (organization_id, user_id),
+    CONSTRAINT chk_organization_members_role CHECK (role IN ('owner', 'admin', 'editor', 'viewer'))
+);
+
+-- Membership lookup index: for listing the organizations of a user
+-- This covers: WHERE user_id = ?
+CREATE INDEX idx_organization_members_user_id ON organization_members (user_id);
+
+CREATE TABLE organization_invitations (
+    id BIGSERIAL PRIMARY KEY,
+    organization_id BIGINT NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    one_time_token_id BIGINT NOT NULL REFERENCES one_time_tokens(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL,
+    invited_by_user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_organization_invitations_role CHECK (role IN ('owner', 'admin', 'editor', 'viewer'))
+);
+
+-- Invitation lookup index: for accepting an invitation of a user
+-- This covers: WHERE id = ? AND user_id = ?
+CREATE INDEX idx_organization_invitations_user_id ON organization_invitations (user_id, id);
+
+-- Contacts and monitors of an organization are shared by its members,
+-- the ones without an organization stay personal to the user that owns them
+ALTER TABLE contacts
+    ADD COLUMN organization_id BIGINT NULL,
+    ADD CONSTRAINT fk_contact_organization FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
+
+ALTER TABLE http_monitors
+    ADD COLUMN organization_id BIGINT NULL,
+    ADD CONSTRAINT fk_monitor_organization FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
+
+-- Organization lookup indexes
+-- This covers: WHERE organization_id = ? ORDER BY id
+CREATE INDEX idx_contacts_organization_id ON contacts (organization_id, id) WHERE organization_id IS NOT NULL;
+CREATE INDEX idx_http_monitors_organization_id ON http_monitors (organization_id, id) WHERE organization_id IS NOT NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_api_keys_user_id;
+DROP INDEX IF EXISTS idx_api_keys_key_prefix;
+DROP TABLE IF EXISTS api_keys;
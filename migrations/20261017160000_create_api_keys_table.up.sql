@@ -0,0 +1,20 @@
+CREATE TABLE api_keys (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    scope VARCHAR(20) NOT NULL,
+    key_prefix VARCHAR(50) NOT NULL,
+    key_hash BYTEA NOT NULL,
+    expires_at TIMESTAMPTZ NULL,
+    last_used_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_api_keys_scope CHECK (scope IN ('read', 'write'))
+);
+
+-- Authentication lookup index: the prefix identifies the key before its secret is compared
+-- This covers: WHERE key_prefix = ?
+CREATE UNIQUE INDEX idx_api_keys_key_prefix ON api_keys (key_prefix);
+
+-- Management index: for listing the keys of a user
+-- This covers: WHERE user_id = ? ORDER BY id
+CREATE INDEX idx_api_keys_user_id ON api_keys (user_id, id);
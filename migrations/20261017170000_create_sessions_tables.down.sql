@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_refresh_tokens_expires;
+DROP INDEX IF EXISTS idx_refresh_tokens_token_hash;
+DROP TABLE IF EXISTS refresh_tokens;
+
+DROP INDEX IF EXISTS idx_sessions_user_id;
+DROP TABLE IF EXISTS sessions;
@@ -0,0 +1,29 @@
+-- A session is started on every login, it groups the refresh tokens rotated from the first one
+-- so that revoking the session revokes the whole token family
+CREATE TABLE sessions (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    revoked_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Revocation index: for logging a user out of all its sessions
+-- This covers: UPDATE sessions SET revoked_at = ? WHERE user_id = ? AND revoked_at IS NULL
+CREATE INDEX idx_sessions_user_id ON sessions (user_id) WHERE revoked_at IS NULL;
+
+CREATE TABLE refresh_tokens (
+    id BIGSERIAL PRIMARY KEY,
+    session_id BIGINT NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+    token_hash BYTEA NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Primary lookup index: for refreshing the tokens of a session
+-- This covers: WHERE token_hash = ?
+CREATE UNIQUE INDEX idx_refresh_tokens_token_hash ON refresh_tokens (token_hash);
+
+-- Expiration cleanup index: for background jobs removing expired tokens
+-- This covers: DELETE FROM refresh_tokens WHERE expires_at < NOW()
+CREATE INDEX idx_refresh_tokens_expires ON refresh_tokens (expires_at);
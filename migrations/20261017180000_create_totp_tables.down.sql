@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_totp_recovery_codes_user_id;
+DROP TABLE IF EXISTS totp_recovery_codes;
+
+DROP INDEX IF EXISTS idx_totp_enrollments_user_id;
+DROP TABLE IF EXISTS totp_enrollments;
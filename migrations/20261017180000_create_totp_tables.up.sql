@@ -0,0 +1,26 @@
+-- The TOTP secret of a user, it is encrypted with AUTH_TOTP_ENCRYPTION_KEY and only used for
+-- logging in once confirmed_at is set
+CREATE TABLE totp_enrollments (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    secret_encrypted BYTEA NOT NULL,
+    confirmed_at TIMESTAMPTZ NULL,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Primary lookup index: a user has at most one enrollment
+-- This covers: WHERE user_id = ?
+CREATE UNIQUE INDEX idx_totp_enrollments_user_id ON totp_enrollments (user_id);
+
+CREATE TABLE totp_recovery_codes (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code_hash BYTEA NOT NULL,
+    used_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Primary lookup index: for logging in with a recovery code
+-- This covers: WHERE user_id = ? AND code_hash = ? AND used_at IS NULL
+CREATE INDEX idx_totp_recovery_codes_user_id ON totp_recovery_codes (user_id, code_hash);
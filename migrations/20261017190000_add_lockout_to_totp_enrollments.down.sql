@@ -0,0 +1,2 @@
+ALTER TABLE totp_enrollments DROP COLUMN IF EXISTS locked_until;
+ALTER TABLE totp_enrollments DROP COLUMN IF EXISTS failed_attempts;
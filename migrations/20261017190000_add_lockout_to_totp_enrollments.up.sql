@@ -0,0 +1,5 @@
+-- Invalid codes in a row of a user, the codes are locked until locked_until once there are too
+-- many of them, so the six digits of a code can't be guessed by trying them all
+ALTER TABLE totp_enrollments
+    ADD COLUMN failed_attempts INT NOT NULL DEFAULT 0,
+    ADD COLUMN locked_until TIMESTAMPTZ NULL;
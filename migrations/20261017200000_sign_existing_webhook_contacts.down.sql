@@ -0,0 +1,2 @@
+-- The secrets can't be told apart from the ones set afterwards, they are kept.
+SELECT 1;
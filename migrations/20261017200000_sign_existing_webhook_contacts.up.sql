@@ -0,0 +1,8 @@
+-- Webhook contacts created before signing was added are given a random secret, so no delivery is
+-- ever sent unsigned. Receivers that don't verify signatures keep working, the owners read the
+-- secret by rotating it with POST /api/v1/contacts/{id}/rotate-secret.
+-- gen_random_uuid() draws from a strong random source, two of them give 64 hex characters like
+-- the secrets generated by the application.
+UPDATE contacts
+SET signing_secret = REPLACE(gen_random_uuid()::TEXT, '-', '') || REPLACE(gen_random_uuid()::TEXT, '-', '')
+WHERE contact_type = 'webhook' AND signing_secret IS NULL;
@@ -0,0 +1,31 @@
+// Package broker defines the message broker the events of the application go through.
+// The backends live in their own packages, pkg/kafka and pkg/rabbitmq, the in-process
+// memory backend lives here.
+package broker
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrConsumerClosed = errors.New("consumer closed")
+
+// Builder creates the producers and consumers of a backend.
+type Builder interface {
+	BuildProducer(topic string) Producer
+	BuildConsumer(topic, groupID string) Consumer
+}
+
+type Producer interface {
+	Produce(ctx context.Context, message Message) error
+	Close() error
+}
+
+type MessageHandler func(ctx context.Context, message Message) error
+
+type Consumer interface {
+	Consume(ctx context.Context, handler MessageHandler) error
+	// Lag returns how many messages the consumer is behind the end of the topic it reads.
+	Lag() int64
+	Close() error
+}
@@ -0,0 +1,255 @@
+package broker
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// memoryRetention bounds the messages kept by a topic no consumer group reads yet.
+const memoryRetention = 10000
+
+// memoryBroker keeps every topic in memory, it's shared by the producers and consumers
+// of a memory builder.
+type memoryBroker struct {
+	mu     sync.Mutex
+	topics map[string]*memoryTopic
+}
+
+// memoryTopic is an append-only log of messages read by consumer groups, every group receives
+// every message the topic holds when the group first consumes it and all the following ones.
+type memoryTopic struct {
+	name string
+
+	mu sync.Mutex
+	// base is the offset of messages[0], the messages every group has read are dropped
+	base     int64
+	messages []Message
+	groups   map[string]*memoryGroup
+	// produced is closed and replaced every time a message is produced
+	produced chan struct{}
+}
+
+type memoryGroup struct {
+	// committed is the offset of the first message the group hasn't handled successfully
+	committed int64
+	// active is held by the consumer reading for the group, the others wait for their turn
+	// like the consumers of a group outnumbering the partitions of a Kafka topic
+	active chan struct{}
+}
+
+type memoryBuilder struct {
+	broker *memoryBroker
+}
+
+// NewMemoryBuilder creates a Builder whose producers and consumers exchange the messages in process,
+// through a single partition per topic. Messages are lost when the process stops, it's meant for
+// single-node deployments and tests that run without Kafka.
+func NewMemoryBuilder() Builder {
+	return &memoryBuilder{
+		broker: &memoryBroker{topics: make(map[string]*memoryTopic)},
+	}
+}
+
+func (b *memoryBuilder) BuildProducer(topic string) Producer {
+	return &memoryProducer{topic: b.broker.topic(topic)}
+}
+
+// BuildConsumer joins the group to the topic right away, so the messages produced from then on are
+// kept for the group even before it consumes them.
+func (b *memoryBuilder) BuildConsumer(topic, groupID string) Consumer {
+	memoryTopic := b.broker.topic(topic)
+	memoryTopic.join(groupID)
+
+	return &memoryConsumer{
+		topic:   memoryTopic,
+		groupID: groupID,
+		closed:  make(chan struct{}),
+	}
+}
+
+func (b *memoryBroker) topic(name string) *memoryTopic {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	topic, ok := b.topics[name]
+	if !ok {
+		topic = &memoryTopic{
+			name:     name,
+			groups:   make(map[string]*memoryGroup),
+			produced: make(chan struct{}),
+		}
+		b.topics[name] = topic
+	}
+	return topic
+}
+
+func (t *memoryTopic) end() int64 {
+	return t.base + int64(len(t.messages))
+}
+
+func (t *memoryTopic) append(message Message) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	message.Topic = t.name
+	message.Offset = t.end()
+	t.messages = append(t.messages, message)
+	t.trim()
+
+	close(t.produced)
+	t.produced = make(chan struct{})
+}
+
+// group returns the group, a group consuming the topic for the first time starts at its oldest
+// message, like a new Kafka consumer group.
+func (t *memoryTopic) group(groupID string) *memoryGroup {
+	group, ok := t.groups[groupID]
+	if !ok {
+		group = &memoryGroup{committed: t.base, active: make(chan struct{}, 1)}
+		t.groups[groupID] = group
+	}
+	return group
+}
+
+// fetch returns the next message of the group, or a channel closed once a message is produced
+// when the group has read them all.
+func (t *memoryTopic) fetch(groupID string) (Message, bool, <-chan struct{}) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	group := t.group(groupID)
+	if group.committed >= t.end() {
+		return Message{}, false, t.produced
+	}
+
+	message := t.messages[group.committed-t.base]
+	message.HighWaterMark = t.end()
+	return message, true, nil
+}
+
+func (t *memoryTopic) commit(groupID string, offset int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.group(groupID).committed = offset + 1
+	t.trim()
+}
+
+func (t *memoryTopic) join(groupID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.group(groupID)
+}
+
+func (t *memoryTopic) activeSlot(groupID string) chan struct{} {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.group(groupID).active
+}
+
+func (t *memoryTopic) lag(groupID string) int64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.end() - t.group(groupID).committed
+}
+
+// trim drops the messages every group has committed, or the oldest ones over memoryRetention
+// while no group reads the topic.
+func (t *memoryTopic) trim() {
+	keepFrom := t.end() - memoryRetention
+	if len(t.groups) > 0 {
+		keepFrom = t.end()
+		for _, group := range t.groups {
+			keepFrom = min(keepFrom, group.committed)
+		}
+	}
+
+	if drop := keepFrom - t.base; drop > 0 {
+		t.messages = append([]Message(nil), t.messages[drop:]...)
+		t.base = keepFrom
+	}
+}
+
+type memoryProducer struct {
+	topic *memoryTopic
+}
+
+// Produce appends the message to the topic with the trace context of ctx in its headers.
+func (p *memoryProducer) Produce(ctx context.Context, message Message) error {
+	InjectTraceContext(ctx, &message)
+
+	p.topic.append(Message{
+		Key:     message.Key,
+		Value:   message.Value,
+		Headers: message.Headers,
+		Time:    time.Now().UTC(),
+	})
+	return nil
+}
+
+func (p *memoryProducer) Close() error {
+	return nil
+}
+
+type memoryConsumer struct {
+	topic     *memoryTopic
+	groupID   string
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// Consume hands the messages to handler one at a time, with the trace context of the message
+// headers. A message is committed once handler succeeds, when it fails Consume returns the error
+// and the message is delivered again the next time the group consumes the topic.
+func (c *memoryConsumer) Consume(ctx context.Context, handler MessageHandler) error {
+	active := c.topic.activeSlot(c.groupID)
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-c.closed:
+		return ErrConsumerClosed
+	case active <- struct{}{}:
+	}
+	defer func() { <-active }()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-c.closed:
+			return ErrConsumerClosed
+		default:
+		}
+
+		message, ok, produced := c.topic.fetch(c.groupID)
+		if !ok {
+			select {
+			case <-ctx.Done():
+			case <-c.closed:
+			case <-produced:
+			}
+			continue
+		}
+
+		if err := handler(ExtractTraceContext(ctx, message), message); err != nil {
+			return err
+		}
+
+		c.topic.commit(c.groupID, message.Offset)
+	}
+}
+
+func (c *memoryConsumer) Lag() int64 {
+	return c.topic.lag(c.groupID)
+}
+
+func (c *memoryConsumer) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+	})
+	return nil
+}
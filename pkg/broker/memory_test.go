@@ -0,0 +1,238 @@
+package broker_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+)
+
+const (
+	testTopic   = "monitor.down"
+	testTimeout = 5 * time.Second
+)
+
+var errHandler = errors.New("handler failed")
+
+type MemoryBrokerTestSuite struct {
+	suite.Suite
+	builder broker.Builder
+}
+
+func (s *MemoryBrokerTestSuite) SetupTest() {
+	s.builder = broker.NewMemoryBuilder()
+}
+
+func TestMemoryBrokerSuite(t *testing.T) {
+	suite.Run(t, new(MemoryBrokerTestSuite))
+}
+
+func (s *MemoryBrokerTestSuite) produce(values ...string) {
+	producer := s.builder.BuildProducer(testTopic)
+	for _, value := range values {
+		err := producer.Produce(context.Background(), broker.Message{Key: []byte(value), Value: []byte(value)})
+		s.Require().NoError(err)
+	}
+}
+
+// consume reads the messages of the consumer until count of them were handled.
+func (s *MemoryBrokerTestSuite) consume(consumer broker.Consumer, count int) []broker.Message {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	var messages []broker.Message
+	err := consumer.Consume(ctx, func(_ context.Context, message broker.Message) error {
+		messages = append(messages, message)
+		if len(messages) == count {
+			cancel()
+		}
+		return nil
+	})
+
+	s.Require().ErrorIs(err, context.Canceled)
+	s.Require().Len(messages, count)
+	return messages
+}
+
+func (s *MemoryBrokerTestSuite) TestConsume_ProducedMessages_HandedInOrder() {
+	// Arrange
+	consumer := s.builder.BuildConsumer(testTopic, "notification")
+	s.produce("first", "second")
+
+	// Act
+	messages := s.consume(consumer, 2)
+
+	// Assert
+	s.Equal(testTopic, messages[0].Topic)
+	s.Equal([]byte("first"), messages[0].Value)
+	s.Equal([]byte("first"), messages[0].Key)
+	s.Equal(int64(0), messages[0].Offset)
+	s.Equal([]byte("second"), messages[1].Value)
+	s.Equal(int64(1), messages[1].Offset)
+	s.Equal(int64(2), messages[1].HighWaterMark)
+	s.False(messages[0].Time.IsZero())
+	s.Equal(int64(0), consumer.Lag())
+}
+
+func (s *MemoryBrokerTestSuite) TestConsume_MessageProducedWhileWaiting_IsHanded() {
+	// Arrange
+	consumer := s.builder.BuildConsumer(testTopic, "notification")
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		s.produce("late")
+	}()
+
+	// Act
+	messages := s.consume(consumer, 1)
+
+	// Assert
+	s.Equal([]byte("late"), messages[0].Value)
+}
+
+func (s *MemoryBrokerTestSuite) TestConsume_HandlerFails_MessageIsNotCommitted() {
+	// Arrange
+	consumer := s.builder.BuildConsumer(testTopic, "notification")
+	s.produce("first", "second")
+
+	// Act
+	err := consumer.Consume(context.Background(), func(context.Context, broker.Message) error {
+		return errHandler
+	})
+
+	// Assert
+	s.Require().ErrorIs(err, errHandler)
+	s.Equal(int64(2), consumer.Lag())
+	messages := s.consume(consumer, 2)
+	s.Equal([]byte("first"), messages[0].Value)
+	s.Equal(int64(0), consumer.Lag())
+}
+
+func (s *MemoryBrokerTestSuite) TestConsume_CommittedOffset_SurvivesNewConsumerOfTheGroup() {
+	// Arrange
+	first := s.builder.BuildConsumer(testTopic, "notification")
+	s.produce("first", "second")
+	s.consume(first, 1)
+	s.Require().NoError(first.Close())
+
+	// Act
+	messages := s.consume(s.builder.BuildConsumer(testTopic, "notification"), 1)
+
+	// Assert
+	s.Equal([]byte("second"), messages[0].Value)
+}
+
+func (s *MemoryBrokerTestSuite) TestConsume_TwoGroups_EachReceivesEveryMessage() {
+	// Arrange
+	notification := s.builder.BuildConsumer(testTopic, "notification")
+	incident := s.builder.BuildConsumer(testTopic, "incident")
+	s.produce("first", "second")
+
+	// Act
+	notificationMessages := s.consume(notification, 2)
+	incidentMessages := s.consume(incident, 2)
+
+	// Assert
+	s.Equal(notificationMessages[0].Value, incidentMessages[0].Value)
+	s.Equal(notificationMessages[1].Value, incidentMessages[1].Value)
+}
+
+func (s *MemoryBrokerTestSuite) TestConsume_ConcurrentConsumersOfAGroup_HandleEveryMessageOnce() {
+	// Arrange
+	const (
+		producers   = 4
+		perProducer = 50
+		consumers   = 3
+		total       = producers * perProducer
+	)
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	var (
+		mu      sync.Mutex
+		handled = make(map[string]int)
+	)
+	handler := func(_ context.Context, message broker.Message) error {
+		mu.Lock()
+		defer mu.Unlock()
+		handled[string(message.Value)]++
+		if len(handled) == total {
+			cancel()
+		}
+		return nil
+	}
+
+	group := make([]broker.Consumer, consumers)
+	for i := range group {
+		group[i] = s.builder.BuildConsumer(testTopic, "notification")
+	}
+
+	// Act
+	var wg sync.WaitGroup
+	errs := make(chan error, consumers)
+	for _, consumer := range group {
+		wg.Go(func() {
+			errs <- consumer.Consume(ctx, handler)
+		})
+	}
+	for p := range producers {
+		wg.Go(func() {
+			producer := s.builder.BuildProducer(testTopic)
+			for i := range perProducer {
+				value := []byte(fmt.Sprintf("%d-%d", p, i))
+				s.NoError(producer.Produce(ctx, broker.Message{Value: value}))
+			}
+		})
+	}
+	wg.Wait()
+	close(errs)
+
+	// Assert
+	for err := range errs {
+		s.Require().ErrorIs(err, context.Canceled)
+	}
+	s.Len(handled, total)
+	for value, count := range handled {
+		s.Equal(1, count, value)
+	}
+	s.Equal(int64(0), group[0].Lag())
+}
+
+func (s *MemoryBrokerTestSuite) TestConsume_ClosedWhileWaiting_ReturnsErrConsumerClosed() {
+	// Arrange
+	consumer := s.builder.BuildConsumer(testTopic, "notification")
+	done := make(chan error, 1)
+	go func() {
+		done <- consumer.Consume(context.Background(), func(context.Context, broker.Message) error {
+			return nil
+		})
+	}()
+
+	// Act
+	time.Sleep(10 * time.Millisecond)
+	s.Require().NoError(consumer.Close())
+
+	// Assert
+	select {
+	case err := <-done:
+		s.Require().ErrorIs(err, broker.ErrConsumerClosed)
+	case <-time.After(testTimeout):
+		s.Fail("Consume did not return after Close")
+	}
+}
+
+func (s *MemoryBrokerTestSuite) TestLag_MessagesNotConsumed_CountsThem() {
+	// Arrange
+	consumer := s.builder.BuildConsumer(testTopic, "notification")
+
+	// Act
+	s.produce("first", "second", "third")
+
+	// Assert
+	s.Equal(int64(3), consumer.Lag())
+}
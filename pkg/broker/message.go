@@ -1,15 +1,14 @@
-package kafka
+package broker
 
 import "time"
 
 type Message struct {
-	// Topic indicates which topic this message was consumed from via Reader.
-	//
-	// When being used with Writer, this can be used to configure the topic if
-	// not already specified on the writer itself.
+	// Topic is the topic the message was consumed from.
 	Topic string
 
-	// Partition is read-only and MUST NOT be set when writing messages
+	// Partition, Offset and HighWaterMark locate a consumed message in its topic, they are
+	// read-only and MUST NOT be set when producing messages. Backends without partitions
+	// report a single partition.
 	Partition     int
 	Offset        int64
 	HighWaterMark int64
@@ -3,7 +3,7 @@
 package mocks
 
 import (
-	kafka "github.com/cristiano-pacheco/pingo/pkg/kafka"
+	broker "github.com/cristiano-pacheco/pingo/pkg/broker"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -21,19 +21,19 @@ func (_m *MockBuilder) EXPECT() *MockBuilder_Expecter {
 }
 
 // BuildConsumer provides a mock function with given fields: topic, groupID
-func (_m *MockBuilder) BuildConsumer(topic string, groupID string) kafka.Consumer {
+func (_m *MockBuilder) BuildConsumer(topic string, groupID string) broker.Consumer {
 	ret := _m.Called(topic, groupID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for BuildConsumer")
 	}
 
-	var r0 kafka.Consumer
-	if rf, ok := ret.Get(0).(func(string, string) kafka.Consumer); ok {
+	var r0 broker.Consumer
+	if rf, ok := ret.Get(0).(func(string, string) broker.Consumer); ok {
 		r0 = rf(topic, groupID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(kafka.Consumer)
+			r0 = ret.Get(0).(broker.Consumer)
 		}
 	}
 
@@ -59,30 +59,30 @@ func (_c *MockBuilder_BuildConsumer_Call) Run(run func(topic string, groupID str
 	return _c
 }
 
-func (_c *MockBuilder_BuildConsumer_Call) Return(_a0 kafka.Consumer) *MockBuilder_BuildConsumer_Call {
+func (_c *MockBuilder_BuildConsumer_Call) Return(_a0 broker.Consumer) *MockBuilder_BuildConsumer_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockBuilder_BuildConsumer_Call) RunAndReturn(run func(string, string) kafka.Consumer) *MockBuilder_BuildConsumer_Call {
+func (_c *MockBuilder_BuildConsumer_Call) RunAndReturn(run func(string, string) broker.Consumer) *MockBuilder_BuildConsumer_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // BuildProducer provides a mock function with given fields: topic
-func (_m *MockBuilder) BuildProducer(topic string) kafka.Producer {
+func (_m *MockBuilder) BuildProducer(topic string) broker.Producer {
 	ret := _m.Called(topic)
 
 	if len(ret) == 0 {
 		panic("no return value specified for BuildProducer")
 	}
 
-	var r0 kafka.Producer
-	if rf, ok := ret.Get(0).(func(string) kafka.Producer); ok {
+	var r0 broker.Producer
+	if rf, ok := ret.Get(0).(func(string) broker.Producer); ok {
 		r0 = rf(topic)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(kafka.Producer)
+			r0 = ret.Get(0).(broker.Producer)
 		}
 	}
 
@@ -107,12 +107,12 @@ func (_c *MockBuilder_BuildProducer_Call) Run(run func(topic string)) *MockBuild
 	return _c
 }
 
-func (_c *MockBuilder_BuildProducer_Call) Return(_a0 kafka.Producer) *MockBuilder_BuildProducer_Call {
+func (_c *MockBuilder_BuildProducer_Call) Return(_a0 broker.Producer) *MockBuilder_BuildProducer_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockBuilder_BuildProducer_Call) RunAndReturn(run func(string) kafka.Producer) *MockBuilder_BuildProducer_Call {
+func (_c *MockBuilder_BuildProducer_Call) RunAndReturn(run func(string) broker.Producer) *MockBuilder_BuildProducer_Call {
 	_c.Call.Return(run)
 	return _c
 }
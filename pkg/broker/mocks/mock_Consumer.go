@@ -5,7 +5,8 @@ package mocks
 import (
 	context "context"
 
-	kafka "github.com/cristiano-pacheco/pingo/pkg/kafka"
+	broker "github.com/cristiano-pacheco/pingo/pkg/broker"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -68,7 +69,7 @@ func (_c *MockConsumer_Close_Call) RunAndReturn(run func() error) *MockConsumer_
 }
 
 // Consume provides a mock function with given fields: ctx, handler
-func (_m *MockConsumer) Consume(ctx context.Context, handler kafka.MessageHandler) error {
+func (_m *MockConsumer) Consume(ctx context.Context, handler broker.MessageHandler) error {
 	ret := _m.Called(ctx, handler)
 
 	if len(ret) == 0 {
@@ -76,7 +77,7 @@ func (_m *MockConsumer) Consume(ctx context.Context, handler kafka.MessageHandle
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, kafka.MessageHandler) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, broker.MessageHandler) error); ok {
 		r0 = rf(ctx, handler)
 	} else {
 		r0 = ret.Error(0)
@@ -92,14 +93,14 @@ type MockConsumer_Consume_Call struct {
 
 // Consume is a helper method to define mock.On call
 //   - ctx context.Context
-//   - handler kafka.MessageHandler
+//   - handler broker.MessageHandler
 func (_e *MockConsumer_Expecter) Consume(ctx interface{}, handler interface{}) *MockConsumer_Consume_Call {
 	return &MockConsumer_Consume_Call{Call: _e.mock.On("Consume", ctx, handler)}
 }
 
-func (_c *MockConsumer_Consume_Call) Run(run func(ctx context.Context, handler kafka.MessageHandler)) *MockConsumer_Consume_Call {
+func (_c *MockConsumer_Consume_Call) Run(run func(ctx context.Context, handler broker.MessageHandler)) *MockConsumer_Consume_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(kafka.MessageHandler))
+		run(args[0].(context.Context), args[1].(broker.MessageHandler))
 	})
 	return _c
 }
@@ -109,7 +110,52 @@ func (_c *MockConsumer_Consume_Call) Return(_a0 error) *MockConsumer_Consume_Cal
 	return _c
 }
 
-func (_c *MockConsumer_Consume_Call) RunAndReturn(run func(context.Context, kafka.MessageHandler) error) *MockConsumer_Consume_Call {
+func (_c *MockConsumer_Consume_Call) RunAndReturn(run func(context.Context, broker.MessageHandler) error) *MockConsumer_Consume_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Lag provides a mock function with no fields
+func (_m *MockConsumer) Lag() int64 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Lag")
+	}
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
+// MockConsumer_Lag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Lag'
+type MockConsumer_Lag_Call struct {
+	*mock.Call
+}
+
+// Lag is a helper method to define mock.On call
+func (_e *MockConsumer_Expecter) Lag() *MockConsumer_Lag_Call {
+	return &MockConsumer_Lag_Call{Call: _e.mock.On("Lag")}
+}
+
+func (_c *MockConsumer_Lag_Call) Run(run func()) *MockConsumer_Lag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConsumer_Lag_Call) Return(_a0 int64) *MockConsumer_Lag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockConsumer_Lag_Call) RunAndReturn(run func() int64) *MockConsumer_Lag_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -5,7 +5,8 @@ package mocks
 import (
 	context "context"
 
-	kafka "github.com/cristiano-pacheco/pingo/pkg/kafka"
+	broker "github.com/cristiano-pacheco/pingo/pkg/broker"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -23,7 +24,7 @@ func (_m *MockMessageHandler) EXPECT() *MockMessageHandler_Expecter {
 }
 
 // Execute provides a mock function with given fields: ctx, message
-func (_m *MockMessageHandler) Execute(ctx context.Context, message kafka.Message) error {
+func (_m *MockMessageHandler) Execute(ctx context.Context, message broker.Message) error {
 	ret := _m.Called(ctx, message)
 
 	if len(ret) == 0 {
@@ -31,7 +32,7 @@ func (_m *MockMessageHandler) Execute(ctx context.Context, message kafka.Message
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, kafka.Message) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, broker.Message) error); ok {
 		r0 = rf(ctx, message)
 	} else {
 		r0 = ret.Error(0)
@@ -47,14 +48,14 @@ type MockMessageHandler_Execute_Call struct {
 
 // Execute is a helper method to define mock.On call
 //   - ctx context.Context
-//   - message kafka.Message
+//   - message broker.Message
 func (_e *MockMessageHandler_Expecter) Execute(ctx interface{}, message interface{}) *MockMessageHandler_Execute_Call {
 	return &MockMessageHandler_Execute_Call{Call: _e.mock.On("Execute", ctx, message)}
 }
 
-func (_c *MockMessageHandler_Execute_Call) Run(run func(ctx context.Context, message kafka.Message)) *MockMessageHandler_Execute_Call {
+func (_c *MockMessageHandler_Execute_Call) Run(run func(ctx context.Context, message broker.Message)) *MockMessageHandler_Execute_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(kafka.Message))
+		run(args[0].(context.Context), args[1].(broker.Message))
 	})
 	return _c
 }
@@ -64,7 +65,7 @@ func (_c *MockMessageHandler_Execute_Call) Return(_a0 error) *MockMessageHandler
 	return _c
 }
 
-func (_c *MockMessageHandler_Execute_Call) RunAndReturn(run func(context.Context, kafka.Message) error) *MockMessageHandler_Execute_Call {
+func (_c *MockMessageHandler_Execute_Call) RunAndReturn(run func(context.Context, broker.Message) error) *MockMessageHandler_Execute_Call {
 	_c.Call.Return(run)
 	return _c
 }
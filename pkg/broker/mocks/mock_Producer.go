@@ -5,7 +5,8 @@ package mocks
 import (
 	context "context"
 
-	kafka "github.com/cristiano-pacheco/pingo/pkg/kafka"
+	broker "github.com/cristiano-pacheco/pingo/pkg/broker"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -68,7 +69,7 @@ func (_c *MockProducer_Close_Call) RunAndReturn(run func() error) *MockProducer_
 }
 
 // Produce provides a mock function with given fields: ctx, message
-func (_m *MockProducer) Produce(ctx context.Context, message kafka.Message) error {
+func (_m *MockProducer) Produce(ctx context.Context, message broker.Message) error {
 	ret := _m.Called(ctx, message)
 
 	if len(ret) == 0 {
@@ -76,7 +77,7 @@ func (_m *MockProducer) Produce(ctx context.Context, message kafka.Message) erro
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, kafka.Message) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, broker.Message) error); ok {
 		r0 = rf(ctx, message)
 	} else {
 		r0 = ret.Error(0)
@@ -92,14 +93,14 @@ type MockProducer_Produce_Call struct {
 
 // Produce is a helper method to define mock.On call
 //   - ctx context.Context
-//   - message kafka.Message
+//   - message broker.Message
 func (_e *MockProducer_Expecter) Produce(ctx interface{}, message interface{}) *MockProducer_Produce_Call {
 	return &MockProducer_Produce_Call{Call: _e.mock.On("Produce", ctx, message)}
 }
 
-func (_c *MockProducer_Produce_Call) Run(run func(ctx context.Context, message kafka.Message)) *MockProducer_Produce_Call {
+func (_c *MockProducer_Produce_Call) Run(run func(ctx context.Context, message broker.Message)) *MockProducer_Produce_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(kafka.Message))
+		run(args[0].(context.Context), args[1].(broker.Message))
 	})
 	return _c
 }
@@ -109,7 +110,7 @@ func (_c *MockProducer_Produce_Call) Return(_a0 error) *MockProducer_Produce_Cal
 	return _c
 }
 
-func (_c *MockProducer_Produce_Call) RunAndReturn(run func(context.Context, kafka.Message) error) *MockProducer_Produce_Call {
+func (_c *MockProducer_Produce_Call) RunAndReturn(run func(context.Context, broker.Message) error) *MockProducer_Produce_Call {
 	_c.Call.Return(run)
 	return _c
 }
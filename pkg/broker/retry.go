@@ -0,0 +1,133 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const deadLetterTopicSuffix = ".dlq"
+
+// Headers added to the messages published to a dead-letter topic, on top of the original headers.
+const (
+	HeaderDeadLetterError             = "x-dlq-error"
+	HeaderDeadLetterAttempts          = "x-dlq-attempts"
+	HeaderDeadLetterOriginalTopic     = "x-dlq-original-topic"
+	HeaderDeadLetterOriginalPartition = "x-dlq-original-partition"
+	HeaderDeadLetterOriginalOffset    = "x-dlq-original-offset"
+)
+
+// RetryPolicy tells how many times a message is handled before it's dead-lettered and how long
+// to wait between attempts. The delay doubles after every attempt, up to MaxBackoff.
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Second,
+		MaxBackoff:     30 * time.Second,
+	}
+}
+
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 1; i < attempt && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, p.MaxBackoff)
+}
+
+type nonRetryableError struct {
+	err error
+}
+
+func (e *nonRetryableError) Error() string {
+	return e.err.Error()
+}
+
+func (e *nonRetryableError) Unwrap() error {
+	return e.err
+}
+
+// NonRetryable marks err so the message is dead-lettered right away, without further attempts.
+// Use it for errors retrying can't fix, like a malformed payload.
+func NonRetryable(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &nonRetryableError{err: err}
+}
+
+// IsNonRetryable reports whether err, or an error it wraps, was marked with NonRetryable.
+func IsNonRetryable(err error) bool {
+	var nonRetryable *nonRetryableError
+	return errors.As(err, &nonRetryable)
+}
+
+// DeadLetterTopic returns the topic the messages of topic are dead-lettered to.
+func DeadLetterTopic(topic string) string {
+	return topic + deadLetterTopicSuffix
+}
+
+// IsDeadLetterTopic reports whether topic is the dead-letter topic of another topic.
+func IsDeadLetterTopic(topic string) bool {
+	return strings.HasSuffix(topic, deadLetterTopicSuffix)
+}
+
+// WithDeadLetter wraps handler so a failing message is retried according to policy and then
+// published to deadLetter, letting the consumer move on to the next message. The returned handler
+// only fails when the message can't be dead-lettered or the context is canceled while waiting
+// for the next attempt, the message is not committed in both cases.
+func WithDeadLetter(handler MessageHandler, deadLetter Producer, policy RetryPolicy) MessageHandler {
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = 1
+	}
+
+	return func(ctx context.Context, message Message) error {
+		var err error
+		attempt := 1
+		for ; ; attempt++ {
+			err = handler(ctx, message)
+			if err == nil {
+				return nil
+			}
+			if IsNonRetryable(err) || attempt >= policy.MaxAttempts {
+				break
+			}
+
+			timer := time.NewTimer(policy.backoff(attempt))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C:
+			}
+		}
+
+		return deadLetter.Produce(ctx, deadLetterMessage(message, err, attempt))
+	}
+}
+
+func deadLetterMessage(message Message, err error, attempts int) Message {
+	headers := make([]Header, 0, len(message.Headers)+5)
+	headers = append(headers, message.Headers...)
+	headers = append(headers,
+		Header{Key: HeaderDeadLetterError, Value: []byte(err.Error())},
+		Header{Key: HeaderDeadLetterAttempts, Value: []byte(strconv.Itoa(attempts))},
+		Header{Key: HeaderDeadLetterOriginalTopic, Value: []byte(message.Topic)},
+		Header{Key: HeaderDeadLetterOriginalPartition, Value: []byte(strconv.Itoa(message.Partition))},
+		Header{Key: HeaderDeadLetterOriginalOffset, Value: []byte(strconv.FormatInt(message.Offset, 10))},
+	)
+
+	return Message{
+		Key:     message.Key,
+		Value:   message.Value,
+		Headers: headers,
+	}
+}
@@ -0,0 +1,58 @@
+package broker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type RetryPolicyTestSuite struct {
+	suite.Suite
+}
+
+func TestRetryPolicySuite(t *testing.T) {
+	suite.Run(t, new(RetryPolicyTestSuite))
+}
+
+func (s *RetryPolicyTestSuite) TestBackoff_ConsecutiveAttempts_DoublesUpToMaxBackoff() {
+	// Arrange
+	policy := RetryPolicy{MaxAttempts: 10, InitialBackoff: time.Second, MaxBackoff: 5 * time.Second}
+	expected := []time.Duration{
+		time.Second,
+		2 * time.Second,
+		4 * time.Second,
+		5 * time.Second,
+		5 * time.Second,
+	}
+
+	for i, delay := range expected {
+		// Act
+		backoff := policy.backoff(i + 1)
+
+		// Assert
+		s.Equal(delay, backoff, "attempt %d", i+1)
+	}
+}
+
+func (s *RetryPolicyTestSuite) TestBackoff_ManyAttempts_DoesNotOverflow() {
+	// Arrange
+	policy := DefaultRetryPolicy()
+
+	// Act
+	backoff := policy.backoff(1000)
+
+	// Assert
+	s.Equal(policy.MaxBackoff, backoff)
+}
+
+func (s *RetryPolicyTestSuite) TestBackoff_InitialBackoffOverMax_UsesMaxBackoff() {
+	// Arrange
+	policy := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Minute, MaxBackoff: time.Second}
+
+	// Act
+	backoff := policy.backoff(1)
+
+	// Assert
+	s.Equal(time.Second, backoff)
+}
@@ -0,0 +1,210 @@
+package broker_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+	"github.com/cristiano-pacheco/pingo/pkg/broker/mocks"
+)
+
+type WithDeadLetterTestSuite struct {
+	suite.Suite
+	builder    broker.Builder
+	deadLetter broker.Producer
+	policy     broker.RetryPolicy
+	message    broker.Message
+	attempts   int
+}
+
+func (s *WithDeadLetterTestSuite) SetupTest() {
+	s.builder = broker.NewMemoryBuilder()
+	s.deadLetter = s.builder.BuildProducer(broker.DeadLetterTopic(testTopic))
+	s.policy = broker.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+	s.message = broker.Message{
+		Topic:     testTopic,
+		Partition: 2,
+		Offset:    41,
+		Key:       []byte("monitor-42"),
+		Value:     []byte(`{"monitor_id":42}`),
+		Headers:   []broker.Header{{Key: "x-event-version", Value: []byte("1")}},
+	}
+	s.attempts = 0
+}
+
+func TestWithDeadLetterSuite(t *testing.T) {
+	suite.Run(t, new(WithDeadLetterTestSuite))
+}
+
+// failing returns a handler that fails with err the first failures times it's called.
+func (s *WithDeadLetterTestSuite) failing(failures int, err error) broker.MessageHandler {
+	return func(context.Context, broker.Message) error {
+		s.attempts++
+		if s.attempts <= failures {
+			return err
+		}
+		return nil
+	}
+}
+
+func (s *WithDeadLetterTestSuite) deadLettered() broker.Consumer {
+	return s.builder.BuildConsumer(broker.DeadLetterTopic(testTopic), "inspection")
+}
+
+func (s *WithDeadLetterTestSuite) TestHandler_SucceedsAfterRetries_NotDeadLettered() {
+	// Arrange
+	dlq := s.deadLettered()
+	handler := broker.WithDeadLetter(s.failing(2, errHandler), s.deadLetter, s.policy)
+
+	// Act
+	err := handler(context.Background(), s.message)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(3, s.attempts)
+	s.Equal(int64(0), dlq.Lag())
+}
+
+func (s *WithDeadLetterTestSuite) TestHandler_KeepsFailing_StopsAtMaxAttempts() {
+	// Arrange
+	dlq := s.deadLettered()
+	handler := broker.WithDeadLetter(s.failing(10, errHandler), s.deadLetter, s.policy)
+
+	// Act
+	err := handler(context.Background(), s.message)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(3, s.attempts)
+	s.Equal(int64(1), dlq.Lag())
+}
+
+func (s *WithDeadLetterTestSuite) TestHandler_NoMaxAttempts_HandledOnce() {
+	// Arrange
+	dlq := s.deadLettered()
+	handler := broker.WithDeadLetter(s.failing(10, errHandler), s.deadLetter, broker.RetryPolicy{})
+
+	// Act
+	err := handler(context.Background(), s.message)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(1, s.attempts)
+	s.Equal(int64(1), dlq.Lag())
+}
+
+func (s *WithDeadLetterTestSuite) TestHandler_NonRetryable_DeadLetteredRightAway() {
+	// Arrange
+	dlq := s.deadLettered()
+	malformed := fmt.Errorf("decode message: %w", broker.NonRetryable(errors.New("invalid json")))
+	s.policy.InitialBackoff = time.Hour
+	s.policy.MaxBackoff = time.Hour
+	handler := broker.WithDeadLetter(s.failing(10, malformed), s.deadLetter, s.policy)
+
+	// Act
+	err := handler(context.Background(), s.message)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(1, s.attempts)
+	message := s.consumeOne(dlq)
+	s.Equal(broker.DeadLetterTopic(testTopic), message.Topic)
+	s.Equal([]byte("1"), header(message, broker.HeaderDeadLetterAttempts))
+}
+
+func (s *WithDeadLetterTestSuite) TestHandler_DeadLettered_KeepsPayloadAndAddsHeaders() {
+	// Arrange
+	dlq := s.deadLettered()
+	handler := broker.WithDeadLetter(s.failing(10, errHandler), s.deadLetter, s.policy)
+
+	// Act
+	err := handler(context.Background(), s.message)
+
+	// Assert
+	s.Require().NoError(err)
+	message := s.consumeOne(dlq)
+	s.Equal(s.message.Key, message.Key)
+	s.Equal(s.message.Value, message.Value)
+	s.Equal([]byte("1"), header(message, "x-event-version"))
+	s.Equal([]byte(errHandler.Error()), header(message, broker.HeaderDeadLetterError))
+	s.Equal([]byte("3"), header(message, broker.HeaderDeadLetterAttempts))
+	s.Equal([]byte(testTopic), header(message, broker.HeaderDeadLetterOriginalTopic))
+	s.Equal([]byte("2"), header(message, broker.HeaderDeadLetterOriginalPartition))
+	s.Equal([]byte("41"), header(message, broker.HeaderDeadLetterOriginalOffset))
+}
+
+func (s *WithDeadLetterTestSuite) TestHandler_ContextCanceledDuringBackoff_ReturnsErrorWithoutDeadLettering() {
+	// Arrange
+	dlq := s.deadLettered()
+	s.policy.InitialBackoff = time.Hour
+	s.policy.MaxBackoff = time.Hour
+	ctx, cancel := context.WithCancel(context.Background())
+	handler := broker.WithDeadLetter(func(context.Context, broker.Message) error {
+		cancel()
+		return errHandler
+	}, s.deadLetter, s.policy)
+
+	// Act
+	err := handler(ctx, s.message)
+
+	// Assert
+	s.Require().ErrorIs(err, context.Canceled)
+	s.Equal(int64(0), dlq.Lag())
+}
+
+func (s *WithDeadLetterTestSuite) TestHandler_DeadLetterFails_ReturnsError() {
+	// Arrange
+	produceErr := errors.New("broker unavailable")
+	deadLetter := mocks.NewMockProducer(s.T())
+	deadLetter.On("Produce", mock.Anything, mock.Anything).Return(produceErr)
+	handler := broker.WithDeadLetter(s.failing(10, errHandler), deadLetter, s.policy)
+
+	// Act
+	err := handler(context.Background(), s.message)
+
+	// Assert
+	s.Require().ErrorIs(err, produceErr)
+}
+
+func (s *WithDeadLetterTestSuite) TestIsNonRetryable() {
+	s.True(broker.IsNonRetryable(broker.NonRetryable(errHandler)))
+	s.True(broker.IsNonRetryable(fmt.Errorf("wrapped: %w", broker.NonRetryable(errHandler))))
+	s.False(broker.IsNonRetryable(errHandler))
+	s.NoError(broker.NonRetryable(nil))
+	s.ErrorIs(broker.NonRetryable(errHandler), errHandler)
+}
+
+func (s *WithDeadLetterTestSuite) TestDeadLetterTopic() {
+	s.Equal("monitor.down.dlq", broker.DeadLetterTopic(testTopic))
+	s.True(broker.IsDeadLetterTopic(broker.DeadLetterTopic(testTopic)))
+	s.False(broker.IsDeadLetterTopic(testTopic))
+}
+
+func (s *WithDeadLetterTestSuite) consumeOne(consumer broker.Consumer) broker.Message {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	var message broker.Message
+	err := consumer.Consume(ctx, func(_ context.Context, m broker.Message) error {
+		message = m
+		cancel()
+		return nil
+	})
+	s.Require().ErrorIs(err, context.Canceled)
+	return message
+}
+
+func header(message broker.Message, key string) []byte {
+	for _, h := range message.Headers {
+		if h.Key == key {
+			return h.Value
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package broker
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// headerCarrier adapts the headers of a message to the OpenTelemetry propagators.
+type headerCarrier struct {
+	headers *[]Header
+}
+
+var _ propagation.TextMapCarrier = headerCarrier{}
+
+func (c headerCarrier) Get(key string) string {
+	for _, header := range *c.headers {
+		if header.Key == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+// Set replaces the header, so a message that is produced again carries the current trace context.
+func (c headerCarrier) Set(key, value string) {
+	for i, header := range *c.headers {
+		if header.Key == key {
+			(*c.headers)[i].Value = []byte(value)
+			return
+		}
+	}
+	*c.headers = append(*c.headers, Header{Key: key, Value: []byte(value)})
+}
+
+func (c headerCarrier) Keys() []string {
+	keys := make([]string, len(*c.headers))
+	for i, header := range *c.headers {
+		keys[i] = header.Key
+	}
+	return keys
+}
+
+// InjectTraceContext writes the trace context of ctx to the message headers, as a W3C traceparent
+// with the propagators configured globally.
+func InjectTraceContext(ctx context.Context, message *Message) {
+	// copied so the headers of the caller are left untouched
+	headers := append([]Header(nil), message.Headers...)
+
+	otel.GetTextMapPropagator().Inject(ctx, headerCarrier{headers: &headers})
+	message.Headers = headers
+}
+
+// ExtractTraceContext returns a copy of ctx carrying the trace context of the message headers,
+// so the spans started while handling the message belong to the trace that produced it.
+func ExtractTraceContext(ctx context.Context, message Message) context.Context {
+	headers := message.Headers
+	return otel.GetTextMapPropagator().Extract(ctx, headerCarrier{headers: &headers})
+}
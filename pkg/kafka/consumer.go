@@ -3,22 +3,16 @@ package kafka
 import (
 	"context"
 
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
 	"github.com/segmentio/kafka-go"
 )
 
-type MessageHandler func(ctx context.Context, message Message) error
-
-type Consumer interface {
-	Consume(ctx context.Context, handler MessageHandler) error
-	Close() error
-}
-
 type consumer struct {
 	config Config
 	reader *kafka.Reader
 }
 
-func newConsumer(config Config, topic, groupID string) Consumer {
+func newConsumer(config Config, topic, groupID string) broker.Consumer {
 	return &consumer{
 		config: config,
 		reader: kafka.NewReader(kafka.ReaderConfig{
@@ -29,7 +23,10 @@ func newConsumer(config Config, topic, groupID string) Consumer {
 	}
 }
 
-func (c *consumer) Consume(ctx context.Context, handler MessageHandler) error {
+// Consume hands the messages to handler one at a time, with the trace context of the message
+// headers. A message is committed once handler succeeds, when it fails Consume returns the error
+// and the message is delivered again the next time the group consumes the topic.
+func (c *consumer) Consume(ctx context.Context, handler broker.MessageHandler) error {
 	for {
 		select {
 		case <-ctx.Done():
@@ -37,20 +34,20 @@ func (c *consumer) Consume(ctx context.Context, handler MessageHandler) error {
 		default:
 		}
 
-		rawMessage, err := c.reader.ReadMessage(ctx)
+		rawMessage, err := c.reader.FetchMessage(ctx)
 		if err != nil {
 			return err
 		}
 
-		headers := make([]Header, len(rawMessage.Headers))
+		headers := make([]broker.Header, len(rawMessage.Headers))
 		for i := range rawMessage.Headers {
-			headers[i] = Header{
+			headers[i] = broker.Header{
 				Key:   rawMessage.Headers[i].Key,
 				Value: rawMessage.Headers[i].Value,
 			}
 		}
 
-		message := Message{
+		message := broker.Message{
 			Topic:         rawMessage.Topic,
 			Partition:     rawMessage.Partition,
 			Offset:        rawMessage.Offset,
@@ -62,13 +59,20 @@ func (c *consumer) Consume(ctx context.Context, handler MessageHandler) error {
 			Time:          rawMessage.Time,
 		}
 
-		if err = handler(ctx, message); err != nil {
-			// TODO: implement DLQ
+		if err = handler(broker.ExtractTraceContext(ctx, message), message); err != nil {
+			return err
+		}
+
+		if err = c.reader.CommitMessages(ctx, rawMessage); err != nil {
 			return err
 		}
 	}
 }
 
+func (c *consumer) Lag() int64 {
+	return c.reader.Stats().Lag
+}
+
 func (c *consumer) Close() error {
 	return c.reader.Close()
 }
@@ -5,6 +5,7 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
 	"github.com/segmentio/kafka-go"
 )
 
@@ -12,33 +13,28 @@ const (
 	connectionTimeout = 10 * time.Second
 )
 
-type Builder interface {
-	BuildProducer(topic string) Producer
-	BuildConsumer(topic, groupID string) Consumer
-}
-
 type builder struct {
 	config Config
 }
 
-func NewKafkaBuilder(config Config) Builder {
+func NewKafkaBuilder(config Config) broker.Builder {
 	return &builder{
 		config: config,
 	}
 }
 
-func MustNewKafkaBuilder(config Config) Builder {
+func MustNewKafkaBuilder(config Config) broker.Builder {
 	mustConnection(context.Background(), config)
 	return &builder{
 		config: config,
 	}
 }
 
-func (b *builder) BuildProducer(topic string) Producer {
+func (b *builder) BuildProducer(topic string) broker.Producer {
 	return newProducer(b.config, topic)
 }
 
-func (b *builder) BuildConsumer(topic, groupID string) Consumer {
+func (b *builder) BuildConsumer(topic, groupID string) broker.Consumer {
 	return newConsumer(b.config, topic, groupID)
 }
 
@@ -3,20 +3,16 @@ package kafka
 import (
 	"context"
 
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
 	"github.com/segmentio/kafka-go"
 )
 
-type Producer interface {
-	Produce(ctx context.Context, message Message) error
-	Close() error
-}
-
 // producer implementation
 type producer struct {
 	writer *kafka.Writer
 }
 
-func newProducer(config Config, topic string) Producer {
+func newProducer(config Config, topic string) broker.Producer {
 	writer := &kafka.Writer{
 		Addr:         kafka.TCP(config.Address...),
 		RequiredAcks: kafka.RequireOne,
@@ -28,7 +24,10 @@ func newProducer(config Config, topic string) Producer {
 	}
 }
 
-func (p *producer) Produce(ctx context.Context, message Message) error {
+// Produce writes the message with the trace context of ctx in its headers.
+func (p *producer) Produce(ctx context.Context, message broker.Message) error {
+	broker.InjectTraceContext(ctx, &message)
+
 	kafkaHeaders := make([]kafka.Header, len(message.Headers))
 	for i, h := range message.Headers {
 		kafkaHeaders[i] = kafka.Header{
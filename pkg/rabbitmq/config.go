@@ -0,0 +1,48 @@
+package rabbitmq
+
+import (
+	"net"
+	"net/url"
+)
+
+const defaultPort = "5672"
+
+type Config struct {
+	URL string
+}
+
+func MustNewConfig(host, port, username, password, vhost string) Config {
+	config, err := NewConfig(host, port, username, password, vhost)
+	if err != nil {
+		panic(err)
+	}
+	return config
+}
+
+// NewConfig builds the AMQP URL of the server, the default port and the "/" virtual host are
+// used when they are not set.
+func NewConfig(host, port, username, password, vhost string) (Config, error) {
+	if host == "" {
+		return Config{}, ErrInvalidRabbitMQHost
+	}
+	if port == "" {
+		port = defaultPort
+	}
+	if vhost == "" {
+		vhost = "/"
+	}
+
+	amqpURL := url.URL{
+		Scheme:  "amqp",
+		Host:    net.JoinHostPort(host, port),
+		Path:    "/" + vhost,
+		RawPath: "/" + url.PathEscape(vhost),
+	}
+	if username != "" {
+		amqpURL.User = url.UserPassword(username, password)
+	}
+
+	return Config{
+		URL: amqpURL.String(),
+	}, nil
+}
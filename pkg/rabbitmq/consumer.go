@@ -0,0 +1,188 @@
+package rabbitmq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+type consumer struct {
+	builder *builder
+	topic   string
+	queue   string
+
+	mu sync.Mutex
+	ch *amqp.Channel
+
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// Consume hands the messages of the group queue to handler one at a time, with the trace context
+// of the message headers. A message is acknowledged once handler succeeds, when it fails Consume
+// returns the error and the message is requeued, so it's delivered again.
+func (c *consumer) Consume(ctx context.Context, handler broker.MessageHandler) error {
+	ch, err := c.open()
+	if err != nil {
+		return err
+	}
+
+	channelClosed := ch.NotifyClose(make(chan *amqp.Error, 1))
+	deliveries, err := ch.ConsumeWithContext(ctx, c.queue, "", false, false, false, false, nil)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-c.closed:
+			return broker.ErrConsumerClosed
+		case amqpErr := <-channelClosed:
+			if amqpErr == nil {
+				return ErrChannelClosed
+			}
+			return fmt.Errorf("%w: %w", ErrChannelClosed, amqpErr)
+		case delivery, ok := <-deliveries:
+			if !ok {
+				return ErrChannelClosed
+			}
+
+			message := toMessage(c.topic, delivery)
+			if err = handler(broker.ExtractTraceContext(ctx, message), message); err != nil {
+				_ = delivery.Nack(false, true)
+				return err
+			}
+
+			if err = delivery.Ack(false); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Lag returns the messages waiting in the group queue, zero when it can't be inspected.
+func (c *consumer) Lag() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.ch == nil || c.ch.IsClosed() {
+		return 0
+	}
+
+	queue, err := c.ch.QueueDeclarePassive(c.queue, true, false, false, false, nil)
+	if err != nil {
+		return 0
+	}
+	return int64(queue.Messages)
+}
+
+func (c *consumer) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+	})
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.ch == nil {
+		return nil
+	}
+	err := c.ch.Close()
+	c.ch = nil
+	if errors.Is(err, amqp.ErrClosed) {
+		return nil
+	}
+	return err
+}
+
+// open opens the channel of the consumer and declares the topic and the group queue, receiving
+// one unacknowledged message at a time.
+func (c *consumer) open() (*amqp.Channel, error) {
+	ch, err := c.builder.channel()
+	if err != nil {
+		return nil, err
+	}
+
+	err = declareTopic(ch, c.topic)
+	if err == nil {
+		err = declareQueue(ch, c.topic, c.queue)
+	}
+	if err == nil {
+		err = ch.Qos(1, 0, false)
+	}
+	if err != nil {
+		_ = ch.Close()
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	select {
+	case <-c.closed:
+		_ = ch.Close()
+		return nil, broker.ErrConsumerClosed
+	default:
+	}
+
+	c.ch = ch
+	return ch, nil
+}
+
+func toMessage(topic string, delivery amqp.Delivery) broker.Message {
+	return broker.Message{
+		Topic: topic,
+		// delivery tags count up from 1 on every channel, they can't overflow an int64
+		Offset:  int64(delivery.DeliveryTag), // #nosec G115
+		Key:     []byte(delivery.MessageId),
+		Value:   delivery.Body,
+		Headers: fromTable(delivery.Headers),
+		Time:    delivery.Timestamp,
+	}
+}
+
+func toTable(headers []broker.Header) amqp.Table {
+	if len(headers) == 0 {
+		return nil
+	}
+
+	table := make(amqp.Table, len(headers))
+	for _, header := range headers {
+		table[header.Key] = header.Value
+	}
+	return table
+}
+
+// fromTable returns the headers sorted by key, the values set by other publishers are
+// converted to their text representation.
+func fromTable(table amqp.Table) []broker.Header {
+	if len(table) == 0 {
+		return nil
+	}
+
+	headers := make([]broker.Header, 0, len(table))
+	for key, value := range table {
+		var raw []byte
+		switch v := value.(type) {
+		case []byte:
+			raw = v
+		case string:
+			raw = []byte(v)
+		default:
+			raw = []byte(fmt.Sprint(v))
+		}
+		headers = append(headers, broker.Header{Key: key, Value: raw})
+	}
+
+	sort.Slice(headers, func(i, j int) bool {
+		return headers[i].Key < headers[j].Key
+	})
+	return headers
+}
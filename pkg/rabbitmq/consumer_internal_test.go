@@ -0,0 +1,68 @@
+package rabbitmq
+
+import (
+	"testing"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+)
+
+type MessageConversionTestSuite struct {
+	suite.Suite
+}
+
+func TestMessageConversionSuite(t *testing.T) {
+	suite.Run(t, new(MessageConversionTestSuite))
+}
+
+func (s *MessageConversionTestSuite) TestToMessage_Delivery_KeepsKeyValueAndHeaders() {
+	// Arrange
+	headers := []broker.Header{
+		{Key: "traceparent", Value: []byte("00-trace-span-01")},
+		{Key: "x-dlq-attempts", Value: []byte("3")},
+	}
+	sentAt := time.Date(2026, 10, 17, 14, 0, 0, 0, time.UTC)
+	delivery := amqp.Delivery{
+		Headers:     toTable(headers),
+		MessageId:   "monitor-42",
+		Timestamp:   sentAt,
+		DeliveryTag: 7,
+		Body:        []byte(`{"monitor_id":42}`),
+	}
+
+	// Act
+	message := toMessage("monitor.down", delivery)
+
+	// Assert
+	s.Equal("monitor.down", message.Topic)
+	s.Equal(int64(7), message.Offset)
+	s.Equal([]byte("monitor-42"), message.Key)
+	s.Equal([]byte(`{"monitor_id":42}`), message.Value)
+	s.Equal(headers, message.Headers)
+	s.Equal(sentAt, message.Time)
+}
+
+func (s *MessageConversionTestSuite) TestFromTable_ForeignValues_ConvertedToText() {
+	// Arrange
+	table := amqp.Table{"x-retries": int32(2), "source": "legacy"}
+
+	// Act
+	headers := fromTable(table)
+
+	// Assert
+	s.Equal([]broker.Header{
+		{Key: "source", Value: []byte("legacy")},
+		{Key: "x-retries", Value: []byte("2")},
+	}, headers)
+}
+
+func (s *MessageConversionTestSuite) TestToTable_NoHeaders_ReturnsNil() {
+	// Act
+	table := toTable(nil)
+
+	// Assert
+	s.Nil(table)
+}
@@ -0,0 +1,9 @@
+package rabbitmq
+
+import "errors"
+
+var (
+	ErrInvalidRabbitMQHost = errors.New("invalid RabbitMQ host")
+	ErrPublishNotConfirmed = errors.New("rabbitmq: the broker did not confirm the message")
+	ErrChannelClosed       = errors.New("rabbitmq: channel closed")
+)
@@ -0,0 +1,114 @@
+package rabbitmq
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+type producer struct {
+	builder *builder
+	topic   string
+
+	mu sync.Mutex
+	ch *amqp.Channel
+}
+
+// Produce publishes the message to the exchange of the topic with the trace context of ctx in
+// its headers. It returns once the broker confirmed the message is stored.
+func (p *producer) Produce(ctx context.Context, message broker.Message) error {
+	broker.InjectTraceContext(ctx, &message)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ch, err := p.channel()
+	if err != nil {
+		return err
+	}
+
+	confirmation, err := ch.PublishWithDeferredConfirmWithContext(ctx, p.topic, "", false, false, amqp.Publishing{
+		Headers:      toTable(message.Headers),
+		DeliveryMode: amqp.Persistent,
+		MessageId:    string(message.Key),
+		Timestamp:    time.Now().UTC(),
+		Body:         message.Value,
+	})
+	if err != nil {
+		p.reset()
+		return err
+	}
+
+	acked, err := confirmation.WaitContext(ctx)
+	if err != nil {
+		p.reset()
+		return err
+	}
+	if !acked {
+		return ErrPublishNotConfirmed
+	}
+
+	return nil
+}
+
+func (p *producer) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.ch == nil {
+		return nil
+	}
+	err := p.ch.Close()
+	p.ch = nil
+	if errors.Is(err, amqp.ErrClosed) {
+		return nil
+	}
+	return err
+}
+
+// channel returns the channel of the producer, opening it in confirm mode and declaring the
+// topic the first time and after the channel was lost.
+func (p *producer) channel() (*amqp.Channel, error) {
+	if p.ch != nil && !p.ch.IsClosed() {
+		return p.ch, nil
+	}
+
+	ch, err := p.builder.channel()
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.declare(ch)
+	if err != nil {
+		_ = ch.Close()
+		return nil, err
+	}
+
+	p.ch = ch
+	return ch, nil
+}
+
+func (p *producer) declare(ch *amqp.Channel) error {
+	if err := ch.Confirm(false); err != nil {
+		return err
+	}
+	if err := declareTopic(ch, p.topic); err != nil {
+		return err
+	}
+	if broker.IsDeadLetterTopic(p.topic) {
+		return declareQueue(ch, p.topic, p.topic)
+	}
+	return nil
+}
+
+// reset drops a channel that failed, the next message opens a new one.
+func (p *producer) reset() {
+	if p.ch != nil {
+		_ = p.ch.Close()
+		p.ch = nil
+	}
+}
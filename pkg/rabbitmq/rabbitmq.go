@@ -0,0 +1,99 @@
+// Package rabbitmq is the RabbitMQ backend of the message broker.
+//
+// A topic is a durable fanout exchange and a consumer group is a durable queue bound to it,
+// named "<topic>.<group>", so every group receives every message like a Kafka consumer group.
+// A group receives the messages produced after its queue is first declared, the queue then
+// keeps them while the group is not consuming. Dead-letter topics get a queue named after the
+// topic, so the dead-lettered messages are kept for inspection.
+package rabbitmq
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+type builder struct {
+	config Config
+
+	mu   sync.Mutex
+	conn *amqp.Connection
+}
+
+func NewRabbitMQBuilder(config Config) broker.Builder {
+	return &builder{
+		config: config,
+	}
+}
+
+func MustNewRabbitMQBuilder(config Config) broker.Builder {
+	b := &builder{
+		config: config,
+	}
+	mustConnection(context.Background(), b)
+	return b
+}
+
+func (b *builder) BuildProducer(topic string) broker.Producer {
+	return &producer{
+		builder: b,
+		topic:   topic,
+	}
+}
+
+func (b *builder) BuildConsumer(topic, groupID string) broker.Consumer {
+	return &consumer{
+		builder: b,
+		topic:   topic,
+		queue:   queueName(topic, groupID),
+		closed:  make(chan struct{}),
+	}
+}
+
+// channel opens a channel on the connection of the builder, connecting again when the
+// connection was lost.
+func (b *builder) channel() (*amqp.Channel, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.conn == nil || b.conn.IsClosed() {
+		conn, err := amqp.Dial(b.config.URL)
+		if err != nil {
+			return nil, err
+		}
+		b.conn = conn
+	}
+
+	return b.conn.Channel()
+}
+
+func mustConnection(ctx context.Context, b *builder) {
+	logger := slog.Default()
+
+	logger.InfoContext(ctx, "RABBITMQ: checking connection to RabbitMQ...")
+	ch, err := b.channel()
+	if err != nil {
+		logger.ErrorContext(ctx, "RABBITMQ: failed to connect to RabbitMQ", slog.Any("error", err))
+		panic(err)
+	}
+	logger.InfoContext(ctx, "RABBITMQ: connected to RabbitMQ successfully")
+	_ = ch.Close()
+}
+
+func queueName(topic, groupID string) string {
+	return topic + "." + groupID
+}
+
+func declareTopic(ch *amqp.Channel, topic string) error {
+	return ch.ExchangeDeclare(topic, amqp.ExchangeFanout, true, false, false, false, nil)
+}
+
+func declareQueue(ch *amqp.Channel, topic, queue string) error {
+	if _, err := ch.QueueDeclare(queue, true, false, false, false, nil); err != nil {
+		return err
+	}
+	return ch.QueueBind(queue, "", topic, false, nil)
+}
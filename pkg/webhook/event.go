@@ -0,0 +1,74 @@
+// Package webhook describes the events Pingo POSTs to webhook contacts.
+//
+// Every event is a JSON object sent with the "application/json" content type.
+// Fields are only added within a version, receivers must ignore unknown fields.
+// A breaking change to the payload bumps Version.
+//
+// Every request is signed with the secret of the contact, see Verify and VerifyRequest
+// for checking the SignatureHeader and TimestampHeader on the receiving side.
+//
+//	{
+//	  "version": "1",
+//	  "type": "monitor.down",
+//	  "occurred_at": "2026-10-17T12:03:00Z",
+//	  "monitor": {"id": 1, "name": "API", "url": "https://example.com/health", "method": "GET"},
+//	  "check": {"id": 9, "checked_at": "2026-10-17T12:03:00Z", "success": false, "status_code": 503,
+//	            "response_time_ms": 245, "error_message": "unexpected response status code 503"},
+//	  "incident": {"id": 4, "cause": "unexpected_status", "started_at": "2026-10-17T12:01:00Z",
+//	               "status_code": 503, "error_message": "unexpected response status code 503"}
+//	}
+package webhook
+
+import "time"
+
+// Version is the version of the event payload.
+const Version = "1"
+
+const (
+	// EventTypeMonitorDown is sent when a monitor reaches its fail threshold and an incident opens.
+	EventTypeMonitorDown = "monitor.down"
+	// EventTypeMonitorUp is sent when a monitor recovers and its incident is resolved.
+	EventTypeMonitorUp = "monitor.up"
+	// EventTypeMonitorMaintenance is sent when a monitor enters maintenance.
+	EventTypeMonitorMaintenance = "monitor.maintenance"
+)
+
+// Event is the body of every webhook request.
+// Check and Incident are omitted when the event has none, as for maintenance.
+type Event struct {
+	Version    string    `json:"version"`
+	Type       string    `json:"type"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Monitor    Monitor   `json:"monitor"`
+	Check      *Check    `json:"check,omitempty"`
+	Incident   *Incident `json:"incident,omitempty"`
+}
+
+type Monitor struct {
+	ID     uint64 `json:"id"`
+	Name   string `json:"name"`
+	URL    string `json:"url"`
+	Method string `json:"method"`
+}
+
+// Check is the check that triggered the event.
+type Check struct {
+	ID             uint64    `json:"id"`
+	CheckedAt      time.Time `json:"checked_at"`
+	Success        bool      `json:"success"`
+	StatusCode     *int32    `json:"status_code,omitempty"`
+	ResponseTimeMs *int32    `json:"response_time_ms,omitempty"`
+	ErrorMessage   *string   `json:"error_message,omitempty"`
+}
+
+// Incident is the incident opened or resolved by the event,
+// the resolution fields are only set once it is resolved.
+type Incident struct {
+	ID              uint64     `json:"id"`
+	Cause           string     `json:"cause"`
+	StartedAt       time.Time  `json:"started_at"`
+	ResolvedAt      *time.Time `json:"resolved_at,omitempty"`
+	DurationSeconds *int64     `json:"duration_seconds,omitempty"`
+	StatusCode      *int32     `json:"status_code,omitempty"`
+	ErrorMessage    *string    `json:"error_message,omitempty"`
+}
@@ -0,0 +1,106 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// SignatureHeader carries the signature of the request, "v1=" followed by the hex encoded
+	// HMAC-SHA256 of "<timestamp>.<body>" keyed with the signing secret of the contact.
+	SignatureHeader = "X-Pingo-Signature"
+	// TimestampHeader carries the Unix time in seconds the request was signed at.
+	TimestampHeader = "X-Pingo-Timestamp"
+
+	// DefaultTolerance is how old a signed request can be before it's rejected as a replay.
+	DefaultTolerance = 5 * time.Minute
+
+	signatureScheme = "v1="
+	secretSize      = 32
+)
+
+var (
+	ErrMissingSignature = errors.New("webhook: missing signature or timestamp header")
+	ErrInvalidTimestamp = errors.New("webhook: invalid timestamp")
+	ErrExpiredTimestamp = errors.New("webhook: timestamp outside the tolerance")
+	ErrInvalidSignature = errors.New("webhook: signature mismatch")
+)
+
+// GenerateSecret returns a random hex encoded signing secret.
+func GenerateSecret() (string, error) {
+	secret := make([]byte, secretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+// Sign returns the value of the SignatureHeader for the body signed at the timestamp.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = fmt.Fprintf(mac, "%d.", timestamp.Unix())
+	_, _ = mac.Write(body)
+	return signatureScheme + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature and timestamp headers of a delivery against its raw body.
+// Requests signed more than tolerance ago, or in the future, are rejected so a captured
+// request can't be replayed later. A tolerance of zero uses DefaultTolerance.
+func Verify(secret string, body []byte, signature, timestamp string, tolerance time.Duration) error {
+	if signature == "" || timestamp == "" {
+		return ErrMissingSignature
+	}
+
+	unixSeconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidTimestamp
+	}
+
+	if tolerance <= 0 {
+		tolerance = DefaultTolerance
+	}
+
+	signedAt := time.Unix(unixSeconds, 0)
+	age := time.Since(signedAt)
+	if age > tolerance || age < -tolerance {
+		return ErrExpiredTimestamp
+	}
+
+	if !strings.HasPrefix(signature, signatureScheme) {
+		return ErrInvalidSignature
+	}
+
+	expected := Sign(secret, signedAt, body)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// VerifyRequest verifies an incoming delivery and returns its body.
+// The request body is consumed and replaced, so handlers can still decode it.
+func VerifyRequest(r *http.Request, secret string, tolerance time.Duration) ([]byte, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	err = Verify(secret, body, r.Header.Get(SignatureHeader), r.Header.Get(TimestampHeader), tolerance)
+	if err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
@@ -0,0 +1,183 @@
+package webhook_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/pingo/pkg/webhook"
+)
+
+const testSecret = "0f4c2a9e8b7d6c5b4a3928171615141312111009080706050403020100ffeedd"
+
+type SignatureTestSuite struct {
+	suite.Suite
+	body []byte
+}
+
+func (s *SignatureTestSuite) SetupTest() {
+	s.body = []byte(`{"version":"1","type":"monitor.down"}`)
+}
+
+func TestSignatureSuite(t *testing.T) {
+	suite.Run(t, new(SignatureTestSuite))
+}
+
+func (s *SignatureTestSuite) headers(secret string, signedAt time.Time, body []byte) (string, string) {
+	return webhook.Sign(secret, signedAt, body), strconv.FormatInt(signedAt.Unix(), 10)
+}
+
+func (s *SignatureTestSuite) TestVerify_ValidSignature_ReturnsNil() {
+	// Arrange
+	signature, timestamp := s.headers(testSecret, time.Now(), s.body)
+
+	// Act
+	err := webhook.Verify(testSecret, s.body, signature, timestamp, webhook.DefaultTolerance)
+
+	// Assert
+	s.Require().NoError(err)
+}
+
+func (s *SignatureTestSuite) TestVerify_TamperedBody_ReturnsInvalidSignature() {
+	// Arrange
+	signature, timestamp := s.headers(testSecret, time.Now(), s.body)
+	tampered := []byte(`{"version":"1","type":"monitor.recovered"}`)
+
+	// Act
+	err := webhook.Verify(testSecret, tampered, signature, timestamp, webhook.DefaultTolerance)
+
+	// Assert
+	s.Require().ErrorIs(err, webhook.ErrInvalidSignature)
+}
+
+func (s *SignatureTestSuite) TestVerify_WrongSecret_ReturnsInvalidSignature() {
+	// Arrange
+	signature, timestamp := s.headers(testSecret, time.Now(), s.body)
+
+	// Act
+	err := webhook.Verify("another-secret", s.body, signature, timestamp, webhook.DefaultTolerance)
+
+	// Assert
+	s.Require().ErrorIs(err, webhook.ErrInvalidSignature)
+}
+
+func (s *SignatureTestSuite) TestVerify_TamperedTimestamp_ReturnsInvalidSignature() {
+	// Arrange
+	signedAt := time.Now()
+	signature, _ := s.headers(testSecret, signedAt, s.body)
+	timestamp := strconv.FormatInt(signedAt.Add(-time.Second).Unix(), 10)
+
+	// Act
+	err := webhook.Verify(testSecret, s.body, signature, timestamp, webhook.DefaultTolerance)
+
+	// Assert
+	s.Require().ErrorIs(err, webhook.ErrInvalidSignature)
+}
+
+func (s *SignatureTestSuite) TestVerify_StaleTimestamp_ReturnsExpiredTimestamp() {
+	// Arrange
+	signature, timestamp := s.headers(testSecret, time.Now().Add(-6*time.Minute), s.body)
+
+	// Act
+	err := webhook.Verify(testSecret, s.body, signature, timestamp, webhook.DefaultTolerance)
+
+	// Assert
+	s.Require().ErrorIs(err, webhook.ErrExpiredTimestamp)
+}
+
+func (s *SignatureTestSuite) TestVerify_FutureTimestamp_ReturnsExpiredTimestamp() {
+	// Arrange
+	signature, timestamp := s.headers(testSecret, time.Now().Add(6*time.Minute), s.body)
+
+	// Act
+	err := webhook.Verify(testSecret, s.body, signature, timestamp, webhook.DefaultTolerance)
+
+	// Assert
+	s.Require().ErrorIs(err, webhook.ErrExpiredTimestamp)
+}
+
+func (s *SignatureTestSuite) TestVerify_ZeroTolerance_UsesDefaultTolerance() {
+	// Arrange
+	signature, timestamp := s.headers(testSecret, time.Now().Add(-4*time.Minute), s.body)
+
+	// Act
+	err := webhook.Verify(testSecret, s.body, signature, timestamp, 0)
+
+	// Assert
+	s.Require().NoError(err)
+}
+
+func (s *SignatureTestSuite) TestVerify_MalformedHeaders_ReturnsError() {
+	// Arrange
+	signature, timestamp := s.headers(testSecret, time.Now(), s.body)
+	otherScheme := "v2=" + signature[3:]
+	cases := []struct {
+		name      string
+		signature string
+		timestamp string
+		err       error
+	}{
+		{name: "missing signature", signature: "", timestamp: timestamp, err: webhook.ErrMissingSignature},
+		{name: "missing timestamp", signature: signature, timestamp: "", err: webhook.ErrMissingSignature},
+		{name: "not a number", signature: signature, timestamp: "yesterday", err: webhook.ErrInvalidTimestamp},
+		{name: "other scheme", signature: otherScheme, timestamp: timestamp, err: webhook.ErrInvalidSignature},
+		{name: "no scheme", signature: signature[3:], timestamp: timestamp, err: webhook.ErrInvalidSignature},
+		{name: "not hex", signature: "v1=zz", timestamp: timestamp, err: webhook.ErrInvalidSignature},
+	}
+
+	for _, tc := range cases {
+		// Act
+		err := webhook.Verify(testSecret, s.body, tc.signature, tc.timestamp, webhook.DefaultTolerance)
+
+		// Assert
+		s.Require().ErrorIs(err, tc.err, tc.name)
+	}
+}
+
+func (s *SignatureTestSuite) TestVerifyRequest_ValidRequest_ReturnsBodyAndKeepsItReadable() {
+	// Arrange
+	signature, timestamp := s.headers(testSecret, time.Now(), s.body)
+	req := httptest.NewRequest(http.MethodPost, "/hooks/pingo", bytes.NewReader(s.body))
+	req.Header.Set(webhook.SignatureHeader, signature)
+	req.Header.Set(webhook.TimestampHeader, timestamp)
+
+	// Act
+	body, err := webhook.VerifyRequest(req, testSecret, webhook.DefaultTolerance)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(s.body, body)
+	rest, err := io.ReadAll(req.Body)
+	s.Require().NoError(err)
+	s.Equal(s.body, rest)
+}
+
+func (s *SignatureTestSuite) TestVerifyRequest_MissingHeaders_ReturnsError() {
+	// Arrange
+	req := httptest.NewRequest(http.MethodPost, "/hooks/pingo", bytes.NewReader(s.body))
+
+	// Act
+	body, err := webhook.VerifyRequest(req, testSecret, webhook.DefaultTolerance)
+
+	// Assert
+	s.Require().ErrorIs(err, webhook.ErrMissingSignature)
+	s.Nil(body)
+}
+
+func (s *SignatureTestSuite) TestGenerateSecret_ReturnsDistinctHexSecrets() {
+	// Act
+	first, err := webhook.GenerateSecret()
+	s.Require().NoError(err)
+	second, err := webhook.GenerateSecret()
+	s.Require().NoError(err)
+
+	// Assert
+	s.Len(first, 64)
+	s.NotEqual(first, second)
+}
@@ -0,0 +1,172 @@
+package test
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	_ "github.com/lib/pq" // postgres driver
+)
+
+// TestUser is an active user created for a test, Headers authenticate its requests.
+type TestUser struct {
+	ID      uint64
+	Email   string
+	Headers map[string]string
+}
+
+// CreateAuthenticatedUser creates an active user and returns the bearer headers of a token issued for it.
+// The activation is done directly in the database, the DB_* and JWT_* environment variables of the
+// application under test must be set.
+func CreateAuthenticatedUser() (map[string]string, error) {
+	user, err := CreateTestUser()
+	if err != nil {
+		return nil, err
+	}
+	return user.Headers, nil
+}
+
+// CreateTestUser is CreateAuthenticatedUser for the tests that also need the ID and email of the user.
+func CreateTestUser() (TestUser, error) {
+	email := fmt.Sprintf("test%d@gmail.com", time.Now().UnixNano())
+	requestBody := map[string]interface{}{
+		"first_name": "pingo",
+		"last_name":  "tester",
+		"email":      email,
+		"password":   "Ci@23456789",
+	}
+
+	resp, err := MakeRequest(http.MethodPost, "/api/v1/users", requestBody, nil)
+	if err != nil {
+		return TestUser{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return TestUser{}, err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return TestUser{}, fmt.Errorf("creating user: status %d: %s", resp.StatusCode, body)
+	}
+
+	var response struct {
+		Data struct {
+			UserID uint64 `json:"user_id"`
+		} `json:"data"`
+	}
+	if err = json.Unmarshal(body, &response); err != nil {
+		return TestUser{}, err
+	}
+
+	if err = activateUser(response.Data.UserID); err != nil {
+		return TestUser{}, err
+	}
+
+	sessionID, err := createSession(response.Data.UserID)
+	if err != nil {
+		return TestUser{}, err
+	}
+
+	token, err := signToken(response.Data.UserID, sessionID)
+	if err != nil {
+		return TestUser{}, err
+	}
+
+	user := TestUser{
+		ID:    response.Data.UserID,
+		Email: email,
+		Headers: map[string]string{
+			"Accept":        "application/json",
+			"Authorization": "Bearer " + token,
+		},
+	}
+
+	return user, nil
+}
+
+// OpenDB connects to the database of the application under test.
+func OpenDB() (*sql.DB, error) {
+	dsn := fmt.Sprintf(
+		"host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+		os.Getenv("DB_HOST"),
+		os.Getenv("DB_PORT"),
+		os.Getenv("DB_USER"),
+		os.Getenv("DB_PASS"),
+		os.Getenv("DB_NAME"),
+	)
+
+	return sql.Open("postgres", dsn)
+}
+
+func activateUser(userID uint64) error {
+	db, err := OpenDB()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	_, err = db.Exec("UPDATE users SET status = 'active' WHERE id = $1", userID)
+	return err
+}
+
+// createSession stores the server-side session the tokens signed for the user are issued for.
+func createSession(userID uint64) (uint64, error) {
+	db, err := OpenDB()
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+
+	var sessionID uint64
+	err = db.QueryRow("INSERT INTO sessions (user_id) VALUES ($1) RETURNING id", userID).Scan(&sessionID)
+	return sessionID, err
+}
+
+func signToken(userID, sessionID uint64) (string, error) {
+	pemKey, err := base64.StdEncoding.DecodeString(os.Getenv("JWT_PRIVATE_KEY"))
+	if err != nil {
+		return "", err
+	}
+
+	block, _ := pem.Decode(pemKey)
+	if block == nil {
+		return "", errors.New("JWT_PRIVATE_KEY is not a PEM encoded key")
+	}
+
+	var parsedKey interface{}
+	if parsedKey, err = x509.ParsePKCS1PrivateKey(block.Bytes); err != nil {
+		if parsedKey, err = x509.ParsePKCS8PrivateKey(block.Bytes); err != nil {
+			return "", err
+		}
+	}
+
+	pk, ok := parsedKey.(*rsa.PrivateKey)
+	if !ok {
+		return "", errors.New("JWT_PRIVATE_KEY is not an RSA private key")
+	}
+
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"exp": jwt.NewNumericDate(now.Add(time.Hour)),
+		"iat": jwt.NewNumericDate(now),
+		"nbf": jwt.NewNumericDate(now),
+		"iss": os.Getenv("JWT_ISSUER"),
+		"sub": strconv.FormatUint(userID, 10),
+		"sid": sessionID,
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodRS256, claims).SignedString(pk)
+}
@@ -0,0 +1,138 @@
+//go:build e2e
+
+package identity_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/cristiano-pacheco/pingo/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIKeyAuthentication(t *testing.T) {
+	// Arrange
+	user, err := test.CreateTestUser()
+	require.NoError(t, err)
+
+	readKeyID, readKey := createAPIKey(t, user.Headers, "read")
+	_, writeKey := createAPIKey(t, user.Headers, "write")
+	contactBody := map[string]interface{}{
+		"name":         "ops",
+		"contact_type": "email",
+		"contact_data": "ops@gmail.com",
+	}
+
+	// Act
+	readListResp, err := test.MakeRequest(http.MethodGet, "/api/v1/contacts", nil, apiKeyHeaders(readKey))
+	require.NoError(t, err)
+	defer readListResp.Body.Close()
+	readCreateResp, err := test.MakeRequest(http.MethodPost, "/api/v1/contacts", contactBody, apiKeyHeaders(readKey))
+	require.NoError(t, err)
+	defer readCreateResp.Body.Close()
+	writeCreateResp, err := test.MakeRequest(http.MethodPost, "/api/v1/contacts", contactBody, apiKeyHeaders(writeKey))
+	require.NoError(t, err)
+	defer writeCreateResp.Body.Close()
+	wrongSecretResp, err := test.MakeRequest(http.MethodGet, "/api/v1/contacts", nil, apiKeyHeaders(readKey+"x"))
+	require.NoError(t, err)
+	defer wrongSecretResp.Body.Close()
+
+	deleteURL := fmt.Sprintf("/api/v1/api-keys/%d", readKeyID)
+	deleteResp, err := test.MakeRequest(http.MethodDelete, deleteURL, nil, user.Headers)
+	require.NoError(t, err)
+	defer deleteResp.Body.Close()
+	deletedKeyResp, err := test.MakeRequest(http.MethodGet, "/api/v1/contacts", nil, apiKeyHeaders(readKey))
+	require.NoError(t, err)
+	defer deletedKeyResp.Body.Close()
+
+	// Assert
+	assert.Equal(t, http.StatusOK, readListResp.StatusCode)
+	assert.Equal(t, http.StatusForbidden, readCreateResp.StatusCode)
+	assert.Equal(t, http.StatusCreated, writeCreateResp.StatusCode)
+	assert.Equal(t, http.StatusUnauthorized, wrongSecretResp.StatusCode)
+	assert.Equal(t, http.StatusNoContent, deleteResp.StatusCode)
+	assert.Equal(t, http.StatusUnauthorized, deletedKeyResp.StatusCode)
+}
+
+func createAPIKey(t *testing.T, headers map[string]string, scope string) (uint64, string) {
+	t.Helper()
+
+	requestBody := map[string]interface{}{
+		"name":  "ci pipeline",
+		"scope": scope,
+	}
+
+	resp, err := test.MakeRequest(http.MethodPost, "/api/v1/api-keys", requestBody, headers)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	resbody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode, "Response body: %s", string(resbody))
+
+	var response struct {
+		Data struct {
+			APIKeyID uint64 `json:"api_key_id"`
+			Key      string `json:"key"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(resbody, &response))
+
+	return response.Data.APIKeyID, response.Data.Key
+}
+
+func apiKeyHeaders(key string) map[string]string {
+	return map[string]string{
+		"Accept":        "application/json",
+		"Authorization": "Bearer " + key,
+	}
+}
+
+func TestAPIKey_CredentialAndAccountRoutes_ReturnForbidden(t *testing.T) {
+	// Arrange
+	user, err := test.CreateTestUser()
+	require.NoError(t, err)
+
+	writeKeyID, writeKey := createAPIKey(t, user.Headers, "write")
+	routes := []struct {
+		method string
+		url    string
+	}{
+		{http.MethodGet, "/api/v1/api-keys"},
+		{http.MethodPost, "/api/v1/api-keys"},
+		{http.MethodDelete, fmt.Sprintf("/api/v1/api-keys/%d", writeKeyID)},
+		{http.MethodPost, "/api/v1/auth/totp"},
+		{http.MethodPost, "/api/v1/auth/totp/confirm"},
+		{http.MethodPost, "/api/v1/auth/totp/disable"},
+		{http.MethodPost, "/api/v1/auth/logout"},
+		{http.MethodPost, "/api/v1/auth/logout/all"},
+		{http.MethodPut, "/api/v1/users"},
+		{http.MethodGet, "/api/v1/organizations"},
+		{http.MethodPost, "/api/v1/organizations"},
+		{http.MethodPost, "/api/v1/organizations/invitations/accept"},
+		{http.MethodGet, "/api/v1/organizations/1/members"},
+		{http.MethodPut, "/api/v1/organizations/1/members/1"},
+		{http.MethodDelete, "/api/v1/organizations/1/members/1"},
+		{http.MethodPost, "/api/v1/organizations/1/invitations"},
+	}
+	requestBody := map[string]interface{}{}
+
+	for _, route := range routes {
+		// Act
+		resp, err := test.MakeRequest(route.method, route.url, requestBody, apiKeyHeaders(writeKey))
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode, "%s %s", route.method, route.url)
+	}
+
+	listResp, err := test.MakeRequest(http.MethodGet, "/api/v1/api-keys", nil, user.Headers)
+	require.NoError(t, err)
+	defer listResp.Body.Close()
+	assert.Equal(t, http.StatusOK, listResp.StatusCode)
+}
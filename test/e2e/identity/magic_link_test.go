@@ -0,0 +1,105 @@
+//go:build e2e
+
+package identity_test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMagicLinkLogin_TokenIsSingleUse(t *testing.T) {
+	// Arrange
+	user, err := test.CreateTestUser()
+	require.NoError(t, err)
+	requestBody := map[string]interface{}{
+		"user_id": user.ID,
+		"token":   createMagicLinkToken(t, user.ID),
+	}
+
+	// Act
+	loginResp, err := test.MakeRequest(http.MethodPost, "/api/v1/auth/magic-link/token", requestBody, nil)
+	require.NoError(t, err)
+	defer loginResp.Body.Close()
+	if loginResp.StatusCode == http.StatusForbidden {
+		t.Skip("magic link login is disabled in the application under test")
+	}
+	reusedResp, err := test.MakeRequest(http.MethodPost, "/api/v1/auth/magic-link/token", requestBody, nil)
+	require.NoError(t, err)
+	defer reusedResp.Body.Close()
+
+	// Assert
+	assert.Equal(t, http.StatusOK, loginResp.StatusCode)
+	assert.Equal(t, http.StatusUnauthorized, reusedResp.StatusCode)
+}
+
+func TestMagicLinkLogin_TOTPEnrolled_ReturnsChallengeForTheAuthenticatorCode(t *testing.T) {
+	// Arrange
+	user, err := test.CreateTestUser()
+	require.NoError(t, err)
+	recoveryCodes := enrollTOTP(t, user.Headers)
+	requestBody := map[string]interface{}{
+		"user_id": user.ID,
+		"token":   createMagicLinkToken(t, user.ID),
+	}
+
+	// Act
+	loginResp, err := test.MakeRequest(http.MethodPost, "/api/v1/auth/magic-link/token", requestBody, nil)
+	require.NoError(t, err)
+	defer loginResp.Body.Close()
+	if loginResp.StatusCode == http.StatusForbidden {
+		t.Skip("magic link login is disabled in the application under test")
+	}
+	var login struct {
+		Data struct {
+			Token          string `json:"token"`
+			TOTPRequired   bool   `json:"totp_required"`
+			ChallengeToken string `json:"challenge_token"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(loginResp.Body).Decode(&login))
+	tokenBody := map[string]interface{}{
+		"user_id":         user.ID,
+		"challenge_token": login.Data.ChallengeToken,
+		"code":            recoveryCodes[0],
+	}
+	tokenResp, err := test.MakeRequest(http.MethodPost, "/api/v1/auth/token", tokenBody, nil)
+	require.NoError(t, err)
+	defer tokenResp.Body.Close()
+
+	// Assert
+	assert.Equal(t, http.StatusOK, loginResp.StatusCode)
+	assert.True(t, login.Data.TOTPRequired)
+	assert.Empty(t, login.Data.Token)
+	assert.Equal(t, http.StatusOK, tokenResp.StatusCode)
+}
+
+// createMagicLinkToken stores a magic link token the way the magic link email does and returns
+// the token of the link.
+func createMagicLinkToken(t *testing.T, userID uint64) string {
+	t.Helper()
+
+	db, err := test.OpenDB()
+	require.NoError(t, err)
+	defer db.Close()
+
+	token := []byte("e2e-magic-link-token")
+	tokenHash := sha256.Sum256(token)
+	_, err = db.Exec(
+		"INSERT INTO one_time_tokens (user_id, token_hash, token_type, expires_at) VALUES ($1, $2, $3, $4)",
+		userID,
+		tokenHash[:],
+		"magic_link",
+		time.Now().UTC().Add(15*time.Minute),
+	)
+	require.NoError(t, err)
+
+	return base64.StdEncoding.EncodeToString(token)
+}
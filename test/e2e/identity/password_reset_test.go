@@ -0,0 +1,79 @@
+//go:build e2e
+
+package identity_test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForgotPassword_UnknownEmail_ReturnsAccepted(t *testing.T) {
+	// Arrange
+	requestBody := map[string]interface{}{"email": "unknown-user@gmail.com"}
+
+	// Act
+	resp, err := test.MakeRequest(http.MethodPost, "/api/v1/auth/password/forgot", requestBody, nil)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	// Assert
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+}
+
+func TestResetPassword_ValidToken_RevokesSessions(t *testing.T) {
+	// Arrange
+	user, err := test.CreateTestUser()
+	require.NoError(t, err)
+	token := createPasswordResetToken(t, user.ID)
+	requestBody := map[string]interface{}{
+		"user_id":  user.ID,
+		"token":    token,
+		"password": "N3w@password",
+	}
+
+	// Act
+	resetResp, err := test.MakeRequest(http.MethodPost, "/api/v1/auth/password/reset", requestBody, nil)
+	require.NoError(t, err)
+	defer resetResp.Body.Close()
+	reusedResp, err := test.MakeRequest(http.MethodPost, "/api/v1/auth/password/reset", requestBody, nil)
+	require.NoError(t, err)
+	defer reusedResp.Body.Close()
+	sessionResp, err := test.MakeRequest(http.MethodGet, "/api/v1/contacts", nil, user.Headers)
+	require.NoError(t, err)
+	defer sessionResp.Body.Close()
+
+	// Assert
+	assert.Equal(t, http.StatusNoContent, resetResp.StatusCode)
+	assert.Equal(t, http.StatusBadRequest, reusedResp.StatusCode)
+	assert.Equal(t, http.StatusUnauthorized, sessionResp.StatusCode)
+}
+
+// createPasswordResetToken stores a reset token the way the password reset email does and returns
+// the token of the link.
+func createPasswordResetToken(t *testing.T, userID uint64) string {
+	t.Helper()
+
+	db, err := test.OpenDB()
+	require.NoError(t, err)
+	defer db.Close()
+
+	token := []byte("e2e-password-reset-token")
+	tokenHash := sha256.Sum256(token)
+	_, err = db.Exec(
+		"INSERT INTO one_time_tokens (user_id, token_hash, token_type, expires_at) VALUES ($1, $2, $3, $4)",
+		userID,
+		tokenHash[:],
+		"reset_password",
+		time.Now().UTC().Add(time.Hour),
+	)
+	require.NoError(t, err)
+
+	return base64.StdEncoding.EncodeToString(token)
+}
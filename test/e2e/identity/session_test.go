@@ -0,0 +1,66 @@
+//go:build e2e
+
+package identity_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cristiano-pacheco/pingo/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogout_RevokesAccessToken(t *testing.T) {
+	// Arrange
+	user, err := test.CreateTestUser()
+	require.NoError(t, err)
+
+	// Act
+	logoutResp, err := test.MakeRequest(http.MethodPost, "/api/v1/auth/logout", nil, user.Headers)
+	require.NoError(t, err)
+	defer logoutResp.Body.Close()
+	afterLogoutResp, err := test.MakeRequest(http.MethodGet, "/api/v1/contacts", nil, user.Headers)
+	require.NoError(t, err)
+	defer afterLogoutResp.Body.Close()
+
+	// Assert
+	assert.Equal(t, http.StatusNoContent, logoutResp.StatusCode)
+	assert.Equal(t, http.StatusUnauthorized, afterLogoutResp.StatusCode)
+}
+
+func TestLogoutAll_KeepsAPIKeysWorking(t *testing.T) {
+	// Arrange
+	user, err := test.CreateTestUser()
+	require.NoError(t, err)
+	_, key := createAPIKey(t, user.Headers, "read")
+
+	// Act
+	logoutResp, err := test.MakeRequest(http.MethodPost, "/api/v1/auth/logout/all", nil, user.Headers)
+	require.NoError(t, err)
+	defer logoutResp.Body.Close()
+	sessionResp, err := test.MakeRequest(http.MethodGet, "/api/v1/contacts", nil, user.Headers)
+	require.NoError(t, err)
+	defer sessionResp.Body.Close()
+	apiKeyResp, err := test.MakeRequest(http.MethodGet, "/api/v1/contacts", nil, apiKeyHeaders(key))
+	require.NoError(t, err)
+	defer apiKeyResp.Body.Close()
+
+	// Assert
+	assert.Equal(t, http.StatusNoContent, logoutResp.StatusCode)
+	assert.Equal(t, http.StatusUnauthorized, sessionResp.StatusCode)
+	assert.Equal(t, http.StatusOK, apiKeyResp.StatusCode)
+}
+
+func TestRefresh_UnknownToken_ReturnsUnauthorized(t *testing.T) {
+	// Arrange
+	requestBody := map[string]interface{}{"refresh_token": "unknown"}
+
+	// Act
+	resp, err := test.MakeRequest(http.MethodPost, "/api/v1/auth/refresh", requestBody, nil)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	// Assert
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
@@ -0,0 +1,209 @@
+//go:build e2e
+
+package identity_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // RFC 6238 authenticator apps use HMAC-SHA1
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cristiano-pacheco/pingo/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTOTP_RecoveryCodeLogsInOnce(t *testing.T) {
+	// Arrange
+	user, err := test.CreateTestUser()
+	require.NoError(t, err)
+
+	recoveryCodes := enrollTOTP(t, user.Headers)
+
+	loginBody := map[string]interface{}{"email": user.Email, "password": "Ci@23456789"}
+
+	// Act
+	login, loginStatus := loginWithPassword(t, loginBody)
+	tokenBody := map[string]interface{}{
+		"user_id":         user.ID,
+		"challenge_token": login.ChallengeToken,
+		"code":            recoveryCodes[0],
+	}
+	tokenResp, err := test.MakeRequest(http.MethodPost, "/api/v1/auth/token", tokenBody, nil)
+	require.NoError(t, err)
+	defer tokenResp.Body.Close()
+	reusedResp, err := test.MakeRequest(http.MethodPost, "/api/v1/auth/token", tokenBody, nil)
+	require.NoError(t, err)
+	defer reusedResp.Body.Close()
+
+	// Assert
+	assert.Equal(t, http.StatusOK, loginStatus)
+	assert.True(t, login.TOTPRequired)
+	assert.NotEmpty(t, login.ChallengeToken)
+	assert.Equal(t, http.StatusOK, tokenResp.StatusCode)
+	assert.Equal(t, http.StatusUnauthorized, reusedResp.StatusCode)
+}
+
+func TestTOTP_CodeWithoutLoginChallenge_ReturnsUnauthorized(t *testing.T) {
+	// Arrange
+	user, err := test.CreateTestUser()
+	require.NoError(t, err)
+
+	recoveryCodes := enrollTOTP(t, user.Headers)
+
+	tokenBody := map[string]interface{}{
+		"user_id":         user.ID,
+		"challenge_token": "Zm9yZ2VkLWNoYWxsZW5nZQ==",
+		"code":            recoveryCodes[0],
+	}
+
+	// Act
+	resp, err := test.MakeRequest(http.MethodPost, "/api/v1/auth/token", tokenBody, nil)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	// Assert
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestTOTPConfirm_NotEnrolled_ReturnsError(t *testing.T) {
+	// Arrange
+	user, err := test.CreateTestUser()
+	require.NoError(t, err)
+	requestBody := map[string]interface{}{"code": "123456"}
+
+	// Act
+	resp, err := test.MakeRequest(http.MethodPost, "/api/v1/auth/totp/confirm", requestBody, user.Headers)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestTOTP_TooManyInvalidCodes_LocksTheCodes(t *testing.T) {
+	// Arrange
+	user, err := test.CreateTestUser()
+	require.NoError(t, err)
+
+	recoveryCodes := enrollTOTP(t, user.Headers)
+
+	login, _ := loginWithPassword(t, map[string]interface{}{"email": user.Email, "password": "Ci@23456789"})
+	tokenBody := func(code string) map[string]interface{} {
+		return map[string]interface{}{"user_id": user.ID, "challenge_token": login.ChallengeToken, "code": code}
+	}
+
+	// Act
+	for range 5 {
+		resp, reqErr := test.MakeRequest(http.MethodPost, "/api/v1/auth/token", tokenBody("not-a-code"), nil)
+		require.NoError(t, reqErr)
+		resp.Body.Close()
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	}
+	lockedResp, err := test.MakeRequest(http.MethodPost, "/api/v1/auth/token", tokenBody(recoveryCodes[0]), nil)
+	require.NoError(t, err)
+	defer lockedResp.Body.Close()
+
+	// Assert
+	assert.Equal(t, http.StatusTooManyRequests, lockedResp.StatusCode)
+}
+
+type loginResponse struct {
+	TOTPRequired   bool   `json:"totp_required"`
+	ChallengeToken string `json:"challenge_token"`
+}
+
+// loginWithPassword runs the first step of the login, it skips the test when password login is
+// disabled in the application under test.
+func loginWithPassword(t *testing.T, loginBody map[string]interface{}) (loginResponse, int) {
+	t.Helper()
+
+	resp, err := test.MakeRequest(http.MethodPost, "/api/v1/auth/login", loginBody, nil)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusForbidden {
+		t.Skip("password login is disabled in the application under test")
+	}
+
+	var response struct {
+		Data loginResponse `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+
+	return response.Data, resp.StatusCode
+}
+
+// enrollTOTP enrolls and confirms an authenticator app for the user and returns the recovery codes,
+// it skips the test when authenticator apps are not configured in the application under test.
+func enrollTOTP(t *testing.T, headers map[string]string) []string {
+	t.Helper()
+
+	resp, err := test.MakeRequest(http.MethodPost, "/api/v1/auth/totp", nil, headers)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusServiceUnavailable {
+		t.Skip("authenticator apps are not configured in the application under test")
+	}
+	resbody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode, "Response body: %s", string(resbody))
+
+	var enrollment struct {
+		Data struct {
+			Secret string `json:"secret"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(resbody, &enrollment))
+
+	recoveryCodes := confirmTOTP(t, headers, totpCode(t, enrollment.Data.Secret, time.Now()))
+	require.NotEmpty(t, recoveryCodes)
+
+	return recoveryCodes
+}
+
+func confirmTOTP(t *testing.T, headers map[string]string, code string) []string {
+	t.Helper()
+
+	requestBody := map[string]interface{}{"code": code}
+	resp, err := test.MakeRequest(http.MethodPost, "/api/v1/auth/totp/confirm", requestBody, headers)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	resbody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode, "Response body: %s", string(resbody))
+
+	var response struct {
+		Data struct {
+			RecoveryCodes []string `json:"recovery_codes"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(resbody, &response))
+
+	return response.Data.RecoveryCodes
+}
+
+// totpCode computes the code an authenticator app shows for the base32 secret, as in RFC 6238.
+func totpCode(t *testing.T, secret string, at time.Time) string {
+	t.Helper()
+
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	require.NoError(t, err)
+
+	counter := make([]byte, 8)
+	binary.BigEndian.PutUint64(counter, uint64(at.Unix()/30))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%06d", value%1_000_000)
+}
@@ -0,0 +1,184 @@
+//go:build e2e
+
+package monitor_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/cristiano-pacheco/pingo/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContactIsolation(t *testing.T) {
+	// Arrange
+	ownerHeaders, err := test.CreateAuthenticatedUser()
+	require.NoError(t, err)
+	otherHeaders, err := test.CreateAuthenticatedUser()
+	require.NoError(t, err)
+
+	contactID := createContact(t, ownerHeaders)
+	contactURL := fmt.Sprintf("/api/v1/contacts/%d", contactID)
+	updateBody := map[string]interface{}{
+		"name":         "renamed",
+		"contact_type": "email",
+		"contact_data": "renamed@gmail.com",
+	}
+
+	// Act
+	otherIDs := listIDs(t, "/api/v1/contacts", "contact_id", otherHeaders)
+	updateResp, err := test.MakeRequest(http.MethodPut, contactURL, updateBody, otherHeaders)
+	require.NoError(t, err)
+	defer updateResp.Body.Close()
+	deleteResp, err := test.MakeRequest(http.MethodDelete, contactURL, nil, otherHeaders)
+	require.NoError(t, err)
+	defer deleteResp.Body.Close()
+
+	// Assert
+	assert.NotContains(t, otherIDs, contactID)
+	assert.Equal(t, http.StatusNotFound, updateResp.StatusCode)
+	assert.Equal(t, http.StatusNotFound, deleteResp.StatusCode)
+	assert.Contains(t, listIDs(t, "/api/v1/contacts", "contact_id", ownerHeaders), contactID)
+}
+
+func TestMonitorIsolation(t *testing.T) {
+	// Arrange
+	ownerHeaders, err := test.CreateAuthenticatedUser()
+	require.NoError(t, err)
+	otherHeaders, err := test.CreateAuthenticatedUser()
+	require.NoError(t, err)
+
+	monitorID := createMonitor(t, ownerHeaders, nil)
+	monitorURL := fmt.Sprintf("/api/v1/monitors/%d", monitorID)
+
+	// Act
+	otherIDs := listIDs(t, "/api/v1/monitors", "monitor_id", otherHeaders)
+	findResp, err := test.MakeRequest(http.MethodGet, monitorURL, nil, otherHeaders)
+	require.NoError(t, err)
+	defer findResp.Body.Close()
+	disableResp, err := test.MakeRequest(http.MethodPost, monitorURL+"/disable", nil, otherHeaders)
+	require.NoError(t, err)
+	defer disableResp.Body.Close()
+	deleteResp, err := test.MakeRequest(http.MethodDelete, monitorURL, nil, otherHeaders)
+	require.NoError(t, err)
+	defer deleteResp.Body.Close()
+
+	// Assert
+	assert.NotContains(t, otherIDs, monitorID)
+	assert.Equal(t, http.StatusNotFound, findResp.StatusCode)
+	assert.Equal(t, http.StatusNotFound, disableResp.StatusCode)
+	assert.Equal(t, http.StatusNotFound, deleteResp.StatusCode)
+
+	ownerResp, err := test.MakeRequest(http.MethodGet, monitorURL, nil, ownerHeaders)
+	require.NoError(t, err)
+	defer ownerResp.Body.Close()
+	assert.Equal(t, http.StatusOK, ownerResp.StatusCode)
+}
+
+func TestMonitorCreate_ContactOfAnotherUser_ReturnsError(t *testing.T) {
+	// Arrange
+	ownerHeaders, err := test.CreateAuthenticatedUser()
+	require.NoError(t, err)
+	otherHeaders, err := test.CreateAuthenticatedUser()
+	require.NoError(t, err)
+
+	contactID := createContact(t, ownerHeaders)
+
+	// Act
+	resp, err := test.MakeRequest(http.MethodPost, "/api/v1/monitors", monitorBody([]uint64{contactID}), otherHeaders)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	// Assert
+	assert.NotEqual(t, http.StatusCreated, resp.StatusCode)
+}
+
+func createContact(t *testing.T, headers map[string]string) uint64 {
+	t.Helper()
+
+	requestBody := map[string]interface{}{
+		"name":         "ops",
+		"contact_type": "email",
+		"contact_data": "ops@gmail.com",
+	}
+
+	resp, err := test.MakeRequest(http.MethodPost, "/api/v1/contacts", requestBody, headers)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	resbody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode, "Response body: %s", string(resbody))
+
+	var response struct {
+		Data struct {
+			ContactID uint64 `json:"contact_id"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(resbody, &response))
+
+	return response.Data.ContactID
+}
+
+func createMonitor(t *testing.T, headers map[string]string, contactIDs []uint64) uint64 {
+	t.Helper()
+
+	resp, err := test.MakeRequest(http.MethodPost, "/api/v1/monitors", monitorBody(contactIDs), headers)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	resbody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode, "Response body: %s", string(resbody))
+
+	var response struct {
+		Data struct {
+			MonitorID uint64 `json:"monitor_id"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(resbody, &response))
+
+	return response.Data.MonitorID
+}
+
+func monitorBody(contactIDs []uint64) map[string]interface{} {
+	return map[string]interface{}{
+		"name":                    "example",
+		"http_url":                "https://example.com",
+		"http_method":             "GET",
+		"check_timeout":           10,
+		"fail_threshold":          3,
+		"valid_response_statuses": []int32{200},
+		"contact_ids":             contactIDs,
+	}
+}
+
+func listIDs(t *testing.T, url, idField string, headers map[string]string) []uint64 {
+	t.Helper()
+
+	resp, err := test.MakeRequest(http.MethodGet, url, nil, headers)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	resbody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode, "Response body: %s", string(resbody))
+
+	var response struct {
+		Data []map[string]interface{} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(resbody, &response))
+
+	ids := make([]uint64, 0, len(response.Data))
+	for _, item := range response.Data {
+		if id, ok := item[idField].(float64); ok {
+			ids = append(ids, uint64(id))
+		}
+	}
+
+	return ids
+}
@@ -0,0 +1,165 @@
+//go:build e2e
+
+package monitor_test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/cristiano-pacheco/pingo/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganizationSharedMonitors(t *testing.T) {
+	// Arrange
+	owner, err := test.CreateTestUser()
+	require.NoError(t, err)
+	viewer, err := test.CreateTestUser()
+	require.NoError(t, err)
+	outsider, err := test.CreateTestUser()
+	require.NoError(t, err)
+
+	organizationID := createOrganization(t, owner.Headers)
+	inviteMember(t, owner.Headers, organizationID, viewer.Email, "viewer")
+	acceptInvitation(t, viewer.Headers, organizationID, viewer.ID)
+
+	monitorID := createMonitor(t, withOrganization(owner.Headers, organizationID), nil)
+
+	// Act
+	viewerIDs := listIDs(t, "/api/v1/monitors", "monitor_id", withOrganization(viewer.Headers, organizationID))
+	viewerCreateResp, err := test.MakeRequest(
+		http.MethodPost,
+		"/api/v1/monitors",
+		monitorBody(nil),
+		withOrganization(viewer.Headers, organizationID),
+	)
+	require.NoError(t, err)
+	defer viewerCreateResp.Body.Close()
+	outsiderResp, err := test.MakeRequest(
+		http.MethodGet,
+		"/api/v1/monitors",
+		nil,
+		withOrganization(outsider.Headers, organizationID),
+	)
+	require.NoError(t, err)
+	defer outsiderResp.Body.Close()
+	personalIDs := listIDs(t, "/api/v1/monitors", "monitor_id", owner.Headers)
+
+	// Assert
+	assert.Contains(t, viewerIDs, monitorID)
+	assert.Equal(t, http.StatusForbidden, viewerCreateResp.StatusCode)
+	assert.Equal(t, http.StatusForbidden, outsiderResp.StatusCode)
+	assert.NotContains(t, personalIDs, monitorID)
+}
+
+func TestOrganizationInvite_UnknownEmail_RespondsLikeARegisteredEmail(t *testing.T) {
+	// Arrange
+	owner, err := test.CreateTestUser()
+	require.NoError(t, err)
+	organizationID := createOrganization(t, owner.Headers)
+	url := fmt.Sprintf("/api/v1/organizations/%d/invitations", organizationID)
+	requestBody := map[string]interface{}{"email": "nobody-" + owner.Email, "role": "viewer"}
+
+	// Act
+	resp, err := test.MakeRequest(http.MethodPost, url, requestBody, owner.Headers)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	// Assert
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+}
+
+func withOrganization(headers map[string]string, organizationID uint64) map[string]string {
+	organizationHeaders := make(map[string]string, len(headers)+1)
+	for key, value := range headers {
+		organizationHeaders[key] = value
+	}
+	organizationHeaders["X-Organization-ID"] = strconv.FormatUint(organizationID, 10)
+	return organizationHeaders
+}
+
+func createOrganization(t *testing.T, headers map[string]string) uint64 {
+	t.Helper()
+
+	requestBody := map[string]interface{}{"name": "Platform"}
+	var response struct {
+		Data struct {
+			OrganizationID uint64 `json:"organization_id"`
+		} `json:"data"`
+	}
+	postJSON(t, "/api/v1/organizations", requestBody, headers, http.StatusCreated, &response)
+
+	return response.Data.OrganizationID
+}
+
+func inviteMember(t *testing.T, headers map[string]string, organizationID uint64, email, role string) {
+	t.Helper()
+
+	url := fmt.Sprintf("/api/v1/organizations/%d/invitations", organizationID)
+	requestBody := map[string]interface{}{"email": email, "role": role}
+	postJSON(t, url, requestBody, headers, http.StatusAccepted, nil)
+}
+
+// acceptInvitation reads the invitation of the user from the database and replaces its token with a
+// known one, since only its hash is stored, then accepts it as the invitee would from the email.
+func acceptInvitation(t *testing.T, headers map[string]string, organizationID, userID uint64) {
+	t.Helper()
+
+	db, err := test.OpenDB()
+	require.NoError(t, err)
+	defer db.Close()
+
+	var invitationID uint64
+	err = db.QueryRow(
+		"SELECT id FROM organization_invitations WHERE organization_id = $1 AND user_id = $2",
+		organizationID,
+		userID,
+	).Scan(&invitationID)
+	require.NoError(t, err)
+
+	token := []byte("e2e-invitation-token")
+	tokenHash := sha256.Sum256(token)
+	_, err = db.Exec(
+		`UPDATE one_time_tokens SET token_hash = $1
+		WHERE id = (SELECT one_time_token_id FROM organization_invitations WHERE id = $2)`,
+		tokenHash[:],
+		invitationID,
+	)
+	require.NoError(t, err)
+
+	requestBody := map[string]interface{}{
+		"invitation_id": invitationID,
+		"token":         base64.StdEncoding.EncodeToString(token),
+	}
+	postJSON(t, "/api/v1/organizations/invitations/accept", requestBody, headers, http.StatusOK, nil)
+}
+
+func postJSON(
+	t *testing.T,
+	url string,
+	requestBody map[string]interface{},
+	headers map[string]string,
+	expectedStatus int,
+	response interface{},
+) {
+	t.Helper()
+
+	resp, err := test.MakeRequest(http.MethodPost, url, requestBody, headers)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	resbody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, expectedStatus, resp.StatusCode, "Response body: %s", string(resbody))
+
+	if response != nil {
+		require.NoError(t, json.Unmarshal(resbody, response))
+	}
+}
@@ -0,0 +1,243 @@
+//go:build e2e
+
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/pingo/internal/shared/modules/outbox"
+	"github.com/cristiano-pacheco/pingo/pkg/broker"
+)
+
+const (
+	testTopic   = "outbox.test"
+	testTimeout = 10 * time.Second
+)
+
+var errRollback = errors.New("rolled back")
+
+// testLifecycle collects the hooks of the relay so the tests start and stop it.
+type testLifecycle struct {
+	hooks []fx.Hook
+}
+
+func (l *testLifecycle) Append(hook fx.Hook) {
+	l.hooks = append(l.hooks, hook)
+}
+
+// failingBuilder builds producers the broker never acknowledges.
+type failingBuilder struct {
+	broker.Builder
+}
+
+func (failingBuilder) BuildProducer(string) broker.Producer {
+	return failingProducer{}
+}
+
+type failingProducer struct{}
+
+func (failingProducer) Produce(context.Context, broker.Message) error {
+	return errors.New("leader not available")
+}
+
+func (failingProducer) Close() error {
+	return nil
+}
+
+// openOutboxDB connects to the database of the application under test with a schema of its own,
+// holding an empty outbox, so the relay of the application doesn't take the messages of the test.
+func openOutboxDB(t *testing.T) *database.PingoDB {
+	t.Helper()
+
+	schema := fmt.Sprintf("outbox_test_%d", time.Now().UnixNano())
+	dsn := fmt.Sprintf(
+		"host=%s port=%s user=%s password=%s dbname=%s sslmode=disable search_path=%s",
+		os.Getenv("DB_HOST"),
+		os.Getenv("DB_PORT"),
+		os.Getenv("DB_USER"),
+		os.Getenv("DB_PASS"),
+		os.Getenv("DB_NAME"),
+		schema,
+	)
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+
+	require.NoError(t, db.Exec("CREATE SCHEMA "+schema).Error)
+	require.NoError(t, db.Exec("CREATE TABLE "+schema+".outbox (LIKE public.outbox INCLUDING ALL)").Error)
+	t.Cleanup(func() {
+		db.Exec("DROP SCHEMA " + schema + " CASCADE")
+		if sqlDB, dbErr := db.DB(); dbErr == nil {
+			sqlDB.Close()
+		}
+	})
+
+	return database.NewFromGorm(db)
+}
+
+func countMessages(t *testing.T, db *gorm.DB) int64 {
+	t.Helper()
+
+	var count int64
+	require.NoError(t, db.Model(&outbox.MessageModel{}).Where("topic = ?", testTopic).Count(&count).Error)
+	return count
+}
+
+func publish(ctx context.Context, publisher *outbox.Publisher) error {
+	return publisher.Publish(ctx, broker.Message{
+		Topic: testTopic,
+		Key:   []byte("42"),
+		Value: []byte(`{"monitor_id":42}`),
+	})
+}
+
+// startRelay runs a relay on the outbox of db until the test ends.
+func startRelay(t *testing.T, db *database.PingoDB, builder broker.Builder) {
+	t.Helper()
+
+	cfg := config.Config{
+		Outbox: config.Outbox{RelayIntervalMs: 10},
+		Log:    config.Log{LogLevel: "disabled"},
+	}
+	lifecycle := &testLifecycle{}
+	outbox.NewRelay(outbox.NewRepository(db), builder, cfg, logger.New(cfg), lifecycle)
+	require.NoError(t, lifecycle.hooks[0].OnStart(context.Background()))
+
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+		defer cancel()
+		assert.NoError(t, lifecycle.hooks[0].OnStop(ctx))
+	})
+}
+
+func TestOutbox_TransactionRolledBack_DiscardsMessage(t *testing.T) {
+	// Arrange
+	db := openOutboxDB(t)
+	txManager := database.NewTxManager(db)
+	publisher := outbox.NewPublisher(outbox.NewRepository(db))
+	var countWithinTx int64
+
+	// Act
+	err := txManager.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		require.NoError(t, publish(ctx, publisher))
+		countWithinTx = countMessages(t, db.Conn(ctx))
+		return errRollback
+	})
+
+	// Assert
+	require.ErrorIs(t, err, errRollback)
+	assert.Equal(t, int64(1), countWithinTx)
+	assert.Equal(t, int64(0), countMessages(t, db.DB))
+}
+
+func TestOutbox_NestedTransactionRolledBack_DiscardsMessage(t *testing.T) {
+	// Arrange
+	db := openOutboxDB(t)
+	txManager := database.NewTxManager(db)
+	publisher := outbox.NewPublisher(outbox.NewRepository(db))
+
+	// Act
+	err := txManager.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		innerErr := txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+			return publish(ctx, publisher)
+		})
+		require.NoError(t, innerErr)
+		return errRollback
+	})
+
+	// Assert
+	require.ErrorIs(t, err, errRollback)
+	assert.Equal(t, int64(0), countMessages(t, db.DB))
+}
+
+func TestOutbox_UncommittedMessage_NotVisibleOutsideTransaction(t *testing.T) {
+	// Arrange
+	db := openOutboxDB(t)
+	txManager := database.NewTxManager(db)
+	publisher := outbox.NewPublisher(outbox.NewRepository(db))
+	var countOutsideTx int64
+
+	// Act
+	err := txManager.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		if err := publish(ctx, publisher); err != nil {
+			return err
+		}
+		countOutsideTx = countMessages(t, db.Conn(context.Background()))
+		return nil
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), countOutsideTx)
+	assert.Equal(t, int64(1), countMessages(t, db.DB))
+}
+
+func TestOutbox_TransactionCommitted_RelayPublishesMessage(t *testing.T) {
+	// Arrange
+	db := openOutboxDB(t)
+	txManager := database.NewTxManager(db)
+	publisher := outbox.NewPublisher(outbox.NewRepository(db))
+	builder := broker.NewMemoryBuilder()
+	consumer := builder.BuildConsumer(testTopic, "outbox-test")
+
+	err := txManager.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		return publish(ctx, publisher)
+	})
+	require.NoError(t, err)
+
+	// Act
+	startRelay(t, db, builder)
+
+	// Assert
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+	var published broker.Message
+	err = consumer.Consume(ctx, func(_ context.Context, message broker.Message) error {
+		published = message
+		cancel()
+		return nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []byte("42"), published.Key)
+	assert.Equal(t, []byte(`{"monitor_id":42}`), published.Value)
+	assert.Eventually(t, func() bool {
+		var count int64
+		err := db.Model(&outbox.MessageModel{}).Where("topic = ?", testTopic).Count(&count).Error
+		return err == nil && count == 0
+	}, testTimeout, 10*time.Millisecond)
+}
+
+func TestOutbox_BrokerFails_MessageStaysPendingForRetry(t *testing.T) {
+	// Arrange
+	db := openOutboxDB(t)
+	publisher := outbox.NewPublisher(outbox.NewRepository(db))
+	require.NoError(t, publish(context.Background(), publisher))
+	relayedAt := time.Now().UTC()
+
+	// Act
+	startRelay(t, db, failingBuilder{})
+
+	// Assert
+	var message outbox.MessageModel
+	require.Eventually(t, func() bool {
+		message = outbox.MessageModel{}
+		err := db.Where("topic = ?", testTopic).First(&message).Error
+		return err == nil && message.AttemptCount == 1
+	}, testTimeout, 10*time.Millisecond)
+	assert.True(t, message.LastError.Valid)
+	assert.Equal(t, "leader not available", message.LastError.String)
+	assert.True(t, message.NextAttemptAt.After(relayedAt))
+}